@@ -28,12 +28,16 @@ import (
 	"net"
 	"net/http"
 	"net/http/pprof"
+	"os"
 	"strconv"
 	"time"
 
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/clientcmd"
 	clientcmdapi "github.com/GoogleCloudPlatform/kubernetes/pkg/client/clientcmd/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/leaderelection"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider/nodecontroller"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider/routecontroller"
@@ -42,6 +46,8 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/healthz"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/namespace"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/podautoscaler"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/podautoscaler/metrics"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/resourcequota"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/service"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
@@ -55,26 +61,33 @@ import (
 
 // CMServer is the main context object for the controller manager.
 type CMServer struct {
-	Port                    int
-	Address                 util.IP
-	CloudProvider           string
-	CloudConfigFile         string
-	ConcurrentEndpointSyncs int
-	ConcurrentRCSyncs       int
-	NodeSyncPeriod          time.Duration
-	ResourceQuotaSyncPeriod time.Duration
-	NamespaceSyncPeriod     time.Duration
-	PVClaimBinderSyncPeriod time.Duration
-	RegisterRetryCount      int
-	NodeMonitorGracePeriod  time.Duration
-	NodeStartupGracePeriod  time.Duration
-	NodeMonitorPeriod       time.Duration
-	NodeStatusUpdateRetry   int
-	PodEvictionTimeout      time.Duration
-	DeletingPodsQps         float32
-	DeletingPodsBurst       int
-	ServiceAccountKeyFile   string
-	RootCAFile              string
+	Port                      int
+	Address                   util.IP
+	CloudProvider             string
+	CloudConfigFile           string
+	ConcurrentEndpointSyncs   int
+	ConcurrentRCSyncs         int
+	ConcurrentJobSyncs        int
+	ConcurrentDSCSyncs        int
+	ConcurrentDeploymentSyncs int
+	NodeSyncPeriod            time.Duration
+	ResourceQuotaSyncPeriod   time.Duration
+	NamespaceSyncPeriod       time.Duration
+	PVClaimBinderSyncPeriod   time.Duration
+	RegisterRetryCount        int
+	NodeMonitorGracePeriod    time.Duration
+	NodeStartupGracePeriod    time.Duration
+	NodeMonitorPeriod         time.Duration
+	NodeStatusUpdateRetry     int
+	PodEvictionTimeout        time.Duration
+	DeletingPodsQps           float32
+	DeletingPodsBurst         int
+	ServiceAccountKeyFile     string
+	RootCAFile                string
+
+	HorizontalPodAutoscalerSyncPeriod     time.Duration
+	HorizontalPodAutoscalerUpscaleDelay   time.Duration
+	HorizontalPodAutoscalerDownscaleDelay time.Duration
 
 	ClusterName       string
 	ClusterCIDR       util.IPNet
@@ -83,22 +96,32 @@ type CMServer struct {
 
 	Master     string
 	Kubeconfig string
+
+	LeaderElection leaderelection.LeaderElectionCLIConfig
 }
 
 // NewCMServer creates a new CMServer with a default config.
 func NewCMServer() *CMServer {
 	s := CMServer{
-		Port:                    ports.ControllerManagerPort,
-		Address:                 util.IP(net.ParseIP("127.0.0.1")),
-		ConcurrentEndpointSyncs: 5,
-		ConcurrentRCSyncs:       5,
-		NodeSyncPeriod:          10 * time.Second,
-		ResourceQuotaSyncPeriod: 10 * time.Second,
-		NamespaceSyncPeriod:     5 * time.Minute,
-		PVClaimBinderSyncPeriod: 10 * time.Second,
-		RegisterRetryCount:      10,
-		PodEvictionTimeout:      5 * time.Minute,
-		ClusterName:             "kubernetes",
+		Port:                      ports.ControllerManagerPort,
+		Address:                   util.IP(net.ParseIP("127.0.0.1")),
+		ConcurrentEndpointSyncs:   5,
+		ConcurrentRCSyncs:         5,
+		ConcurrentJobSyncs:        5,
+		ConcurrentDSCSyncs:        2,
+		ConcurrentDeploymentSyncs: 5,
+		NodeSyncPeriod:            10 * time.Second,
+		ResourceQuotaSyncPeriod:   10 * time.Second,
+		NamespaceSyncPeriod:       5 * time.Minute,
+		PVClaimBinderSyncPeriod:   10 * time.Second,
+		RegisterRetryCount:        10,
+		PodEvictionTimeout:        5 * time.Minute,
+		ClusterName:               "kubernetes",
+		LeaderElection:            leaderelection.DefaultLeaderElectionCLIConfig(),
+
+		HorizontalPodAutoscalerSyncPeriod:     30 * time.Second,
+		HorizontalPodAutoscalerUpscaleDelay:   3 * time.Minute,
+		HorizontalPodAutoscalerDownscaleDelay: 5 * time.Minute,
 	}
 	return &s
 }
@@ -111,12 +134,18 @@ func (s *CMServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.CloudConfigFile, "cloud-config", s.CloudConfigFile, "The path to the cloud provider configuration file.  Empty string for no configuration file.")
 	fs.IntVar(&s.ConcurrentEndpointSyncs, "concurrent-endpoint-syncs", s.ConcurrentEndpointSyncs, "The number of endpoint syncing operations that will be done concurrently. Larger number = faster endpoint updating, but more CPU (and network) load")
 	fs.IntVar(&s.ConcurrentRCSyncs, "concurrent_rc_syncs", s.ConcurrentRCSyncs, "The number of replication controllers that are allowed to sync concurrently. Larger number = more reponsive replica management, but more CPU (and network) load")
+	fs.IntVar(&s.ConcurrentJobSyncs, "concurrent-job-syncs", s.ConcurrentJobSyncs, "The number of jobs that are allowed to sync concurrently. Larger number = more responsive job management, but more CPU (and network) load")
+	fs.IntVar(&s.ConcurrentDSCSyncs, "concurrent-daemonset-syncs", s.ConcurrentDSCSyncs, "The number of daemon sets that are allowed to sync concurrently. Larger number = more responsive daemon set management, but more CPU (and network) load")
+	fs.IntVar(&s.ConcurrentDeploymentSyncs, "concurrent-deployment-syncs", s.ConcurrentDeploymentSyncs, "The number of deployments that are allowed to sync concurrently. Larger number = more responsive deployments, but more CPU (and network) load")
 	fs.DurationVar(&s.NodeSyncPeriod, "node-sync-period", s.NodeSyncPeriod, ""+
 		"The period for syncing nodes from cloudprovider. Longer periods will result in "+
 		"fewer calls to cloud provider, but may delay addition of new nodes to cluster.")
 	fs.DurationVar(&s.ResourceQuotaSyncPeriod, "resource-quota-sync-period", s.ResourceQuotaSyncPeriod, "The period for syncing quota usage status in the system")
 	fs.DurationVar(&s.NamespaceSyncPeriod, "namespace-sync-period", s.NamespaceSyncPeriod, "The period for syncing namespace life-cycle updates")
 	fs.DurationVar(&s.PVClaimBinderSyncPeriod, "pvclaimbinder-sync-period", s.PVClaimBinderSyncPeriod, "The period for syncing persistent volumes and persistent volume claims")
+	fs.DurationVar(&s.HorizontalPodAutoscalerSyncPeriod, "horizontal-pod-autoscaler-sync-period", s.HorizontalPodAutoscalerSyncPeriod, "The period for syncing the number of pods in horizontal pod autoscaler.")
+	fs.DurationVar(&s.HorizontalPodAutoscalerUpscaleDelay, "horizontal-pod-autoscaler-upscale-delay", s.HorizontalPodAutoscalerUpscaleDelay, "The period since the last rescale during which horizontal pod autoscalers do not scale up again.")
+	fs.DurationVar(&s.HorizontalPodAutoscalerDownscaleDelay, "horizontal-pod-autoscaler-downscale-delay", s.HorizontalPodAutoscalerDownscaleDelay, "The period since the last rescale during which horizontal pod autoscalers do not scale down again.")
 	fs.DurationVar(&s.PodEvictionTimeout, "pod-eviction-timeout", s.PodEvictionTimeout, "The grace peroid for deleting pods on failed nodes.")
 	fs.Float32Var(&s.DeletingPodsQps, "deleting-pods-qps", 0.1, "Number of nodes per second on which pods are deleted in case of node failure.")
 	fs.IntVar(&s.DeletingPodsBurst, "deleting-pods-burst", 10, "Number of nodes on which pods are bursty deleted in case of node failure. For more details look into RateLimiter.")
@@ -138,6 +167,7 @@ func (s *CMServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.Master, "master", s.Master, "The address of the Kubernetes API server (overrides any value in kubeconfig)")
 	fs.StringVar(&s.Kubeconfig, "kubeconfig", s.Kubeconfig, "Path to kubeconfig file with authorization and master location information.")
 	fs.StringVar(&s.RootCAFile, "root-ca-file", s.RootCAFile, "If set, this root certificate authority will be included in service account's token secret. This must be a valid PEM-encoded CA bundle.")
+	leaderelection.BindFlags(&s.LeaderElection, fs)
 }
 
 // Run runs the CMServer.  This should never exit.
@@ -180,12 +210,76 @@ func (s *CMServer) Run(_ []string) error {
 		glog.Fatal(server.ListenAndServe())
 	}()
 
+	var rootCA []byte
+
+	if s.RootCAFile != "" {
+		rootCA, err = ioutil.ReadFile(s.RootCAFile)
+		if err != nil {
+			return fmt.Errorf("error reading root-ca-file at %s: %v", s.RootCAFile, err)
+		}
+		if _, err := util.CertsFromPEM(rootCA); err != nil {
+			return fmt.Errorf("error parsing root-ca-file at %s: %v", s.RootCAFile, err)
+		}
+	} else {
+		rootCA = kubeconfig.CAData
+	}
+
+	run := func(stop <-chan struct{}) {
+		s.runControllers(kubeClient, rootCA)
+	}
+
+	if !s.LeaderElection.LeaderElect {
+		run(nil)
+		select {}
+	}
+
+	id, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(kubeClient.Events(""))
+	recorder := eventBroadcaster.NewRecorder(api.EventSource{Component: "controller-manager"})
+
+	leaderelection.RunOrDie(leaderelection.LeaderElectionConfig{
+		EndpointsMeta: api.ObjectMeta{
+			Namespace: api.NamespaceDefault,
+			Name:      "kube-controller-manager",
+		},
+		Client:        kubeClient,
+		Identity:      id,
+		EventRecorder: recorder,
+		LeaseDuration: s.LeaderElection.LeaseDuration,
+		RenewDeadline: s.LeaderElection.RenewDeadline,
+		RetryPeriod:   s.LeaderElection.RetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: run,
+			OnStoppedLeading: func() {
+				glog.Fatalf("lost master")
+			},
+		},
+	})
+
+	glog.Fatal("this statement is unreachable")
+	panic("unreachable")
+}
+
+// runControllers starts all of the controllers run by the controller manager.
+func (s *CMServer) runControllers(kubeClient *client.Client, rootCA []byte) {
 	endpoints := service.NewEndpointController(kubeClient)
 	go endpoints.Run(s.ConcurrentEndpointSyncs, util.NeverStop)
 
 	controllerManager := replicationControllerPkg.NewReplicationManager(kubeClient, replicationControllerPkg.BurstReplicas)
 	go controllerManager.Run(s.ConcurrentRCSyncs, util.NeverStop)
 
+	go replicationControllerPkg.NewJobManager(kubeClient).Run(s.ConcurrentJobSyncs, util.NeverStop)
+
+	go replicationControllerPkg.NewDaemonSetsController(kubeClient).Run(s.ConcurrentDSCSyncs, util.NeverStop)
+
+	go replicationControllerPkg.NewDeploymentController(kubeClient).Run(s.ConcurrentDeploymentSyncs, util.NeverStop)
+
 	cloud := cloudprovider.InitCloudProvider(s.CloudProvider, s.CloudConfigFile)
 
 	nodeController := nodecontroller.NewNodeController(cloud, kubeClient, s.RegisterRetryCount,
@@ -210,6 +304,13 @@ func (s *CMServer) Run(_ []string) error {
 	resourceQuotaManager := resourcequota.NewResourceQuotaManager(kubeClient)
 	resourceQuotaManager.Run(s.ResourceQuotaSyncPeriod)
 
+	metricsClient := metrics.NewMetricsClient(kubeClient, metrics.NewKubeletSource(&client.HTTPContainerInfoGetter{
+		Client: http.DefaultClient,
+		Port:   ports.KubeletPort,
+	}))
+	podautoscaler.NewHorizontalController(kubeClient, metricsClient, s.HorizontalPodAutoscalerUpscaleDelay, s.HorizontalPodAutoscalerDownscaleDelay).
+		Run(s.HorizontalPodAutoscalerSyncPeriod)
+
 	namespaceManager := namespace.NewNamespaceManager(kubeClient, s.NamespaceSyncPeriod)
 	namespaceManager.Run()
 
@@ -221,20 +322,6 @@ func (s *CMServer) Run(_ []string) error {
 	}
 	pvRecycler.Run()
 
-	var rootCA []byte
-
-	if s.RootCAFile != "" {
-		rootCA, err = ioutil.ReadFile(s.RootCAFile)
-		if err != nil {
-			return fmt.Errorf("error reading root-ca-file at %s: %v", s.RootCAFile, err)
-		}
-		if _, err := util.CertsFromPEM(rootCA); err != nil {
-			return fmt.Errorf("error parsing root-ca-file at %s: %v", s.RootCAFile, err)
-		}
-	} else {
-		rootCA = kubeconfig.CAData
-	}
-
 	if len(s.ServiceAccountKeyFile) > 0 {
 		privateKey, err := serviceaccount.ReadPrivateKey(s.ServiceAccountKeyFile)
 		if err != nil {
@@ -254,7 +341,4 @@ func (s *CMServer) Run(_ []string) error {
 		kubeClient,
 		serviceaccount.DefaultServiceAccountsControllerOptions(),
 	).Run()
-
-	select {}
-	return nil
 }
@@ -29,8 +29,10 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/clientcmd"
 	clientcmdapi "github.com/GoogleCloudPlatform/kubernetes/pkg/client/clientcmd/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/qos"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/proxy"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/proxy/config"
+	proxyiptables "github.com/GoogleCloudPlatform/kubernetes/pkg/proxy/iptables"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/iptables"
@@ -49,16 +51,27 @@ type ProxyServer struct {
 	Master             string
 	Kubeconfig         string
 	PortRange          util.PortRange
+	ProxyMode          string
+	IptablesSyncPeriod time.Duration
+	MasqueradeAll      bool
 }
 
+// Values accepted by --proxy-mode.
+const (
+	proxyModeUserspace = "userspace"
+	proxyModeIptables  = "iptables"
+)
+
 // NewProxyServer creates a new ProxyServer object with default parameters
 func NewProxyServer() *ProxyServer {
 	return &ProxyServer{
 		BindAddress:        util.IP(net.ParseIP("0.0.0.0")),
 		HealthzPort:        10249,
 		HealthzBindAddress: util.IP(net.ParseIP("127.0.0.1")),
-		OOMScoreAdj:        -899,
+		OOMScoreAdj:        qos.KubeProxyOOMScoreAdj,
 		ResourceContainer:  "/kube-proxy",
+		ProxyMode:          proxyModeUserspace,
+		IptablesSyncPeriod: 30 * time.Second,
 	}
 }
 
@@ -72,6 +85,9 @@ func (s *ProxyServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.ResourceContainer, "resource-container", s.ResourceContainer, "Absolute name of the resource-only container to create and run the Kube-proxy in (Default: /kube-proxy).")
 	fs.StringVar(&s.Kubeconfig, "kubeconfig", s.Kubeconfig, "Path to kubeconfig file with authorization information (the master location is set by the master flag).")
 	fs.Var(&s.PortRange, "proxy-port-range", "Range of host ports (beginPort-endPort, inclusive) that may be consumed in order to proxy service traffic. If unspecified (0-0) then ports will be randomly chosen.")
+	fs.StringVar(&s.ProxyMode, "proxy-mode", s.ProxyMode, "Which proxy mode to use: 'userspace' (copies traffic through a userspace socket) or 'iptables' (programs DNAT rules to endpoints directly). If the iptables mode is requested but the host's iptables is too old, the userspace proxier is used.")
+	fs.DurationVar(&s.IptablesSyncPeriod, "iptables-sync-period", s.IptablesSyncPeriod, "How often iptables rules are refreshed when --proxy-mode=iptables (e.g. '5s', '1m', '2h22m').  Must be greater than 0.")
+	fs.BoolVar(&s.MasqueradeAll, "masquerade-all", s.MasqueradeAll, "If using the iptables proxy mode, SNAT everything sent to a service cluster IP.")
 }
 
 // Run runs the specified ProxyServer.  This should never exit.
@@ -95,16 +111,44 @@ func (s *ProxyServer) Run(_ []string) error {
 	if net.IP(s.BindAddress).To4() == nil {
 		protocol = iptables.ProtocolIpv6
 	}
-	loadBalancer := proxy.NewLoadBalancerRR()
-	proxier, err := proxy.NewProxier(loadBalancer, net.IP(s.BindAddress), iptables.New(exec.New(), protocol), s.PortRange)
-	if err != nil {
-		glog.Fatalf("Unable to create proxer: %v", err)
+	execer := exec.New()
+	ipt := iptables.New(execer, protocol)
+
+	var proxier proxy.ProxyProvider
+	var endpointsHandler config.EndpointsConfigHandler
+
+	if s.shouldUseIptables(execer) {
+		glog.V(2).Info("Using iptables Proxier.")
+		proxierIptables, err := proxyiptables.NewProxier(ipt, s.IptablesSyncPeriod, s.MasqueradeAll)
+		if err != nil {
+			glog.Fatalf("Unable to create proxier: %v", err)
+		}
+		proxier = proxierIptables
+		endpointsHandler = proxierIptables
+		// No turning back. Remove artifacts that might still exist from the userspace Proxier.
+		glog.V(2).Info("Tearing down userspace rules. Errors here are acceptable.")
+		proxy.CleanupLeftovers(ipt)
+	} else {
+		glog.V(2).Info("Using userspace Proxier.")
+		// This is a proxy.LoadBalancer which NewProxier needs but has methods we don't need for
+		// our config.EndpointsConfigHandler.
+		loadBalancer := proxy.NewLoadBalancerRR()
+		// set EndpointsConfigHandler to our loadBalancer
+		endpointsHandler = loadBalancer
+		proxierUserspace, err := proxy.NewProxier(loadBalancer, net.IP(s.BindAddress), ipt, s.PortRange)
+		if err != nil {
+			glog.Fatalf("Unable to create proxier: %v", err)
+		}
+		proxier = proxierUserspace
+		// Remove artifacts from the pure-iptables Proxier.
+		glog.V(2).Info("Tearing down pure-iptables proxy rules. Errors here are acceptable.")
+		proxyiptables.CleanupLeftovers(ipt)
 	}
 
 	// Wire proxier to handle changes to services
 	serviceConfig.RegisterHandler(proxier)
-	// And wire loadBalancer to handle changes to endpoints to services
-	endpointsConfig.RegisterHandler(loadBalancer)
+	// And wire endpointsHandler to handle changes to endpoints to services
+	endpointsConfig.RegisterHandler(endpointsHandler)
 
 	// Note: RegisterHandler() calls need to happen before creation of Sources because sources
 	// only notify on changes, and the initial update (on process start) may be lost if no handlers
@@ -150,3 +194,26 @@ func (s *ProxyServer) Run(_ []string) error {
 	proxier.SyncLoop()
 	return nil
 }
+
+// shouldUseIptables returns true if the iptables Proxier was requested and
+// the host is able to run it.
+func (s *ProxyServer) shouldUseIptables(execer exec.Interface) bool {
+	switch s.ProxyMode {
+	case proxyModeUserspace:
+		return false
+	case proxyModeIptables:
+		// guaranteed false on error, error only necessary for debugging
+		useIptables, err := proxyiptables.CanUseIptablesProxier(execer)
+		if err != nil {
+			glog.Errorf("Can't determine whether to use iptables or userspace, using userspace proxier: %v", err)
+			return false
+		}
+		if !useIptables {
+			glog.Warningf("The installed iptables is too old for the iptables proxier, using userspace proxier")
+		}
+		return useIptables
+	default:
+		glog.Warningf("Unknown proxy mode %q, using userspace proxier", s.ProxyMode)
+		return false
+	}
+}
@@ -48,6 +48,7 @@ import (
 	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/dockertools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/qos"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
@@ -64,60 +65,64 @@ const defaultRootDir = "/var/lib/kubelet"
 // KubeletServer encapsulates all of the parameters necessary for starting up
 // a kubelet. These can either be set via command line or directly.
 type KubeletServer struct {
-	Config                         string
-	SyncFrequency                  time.Duration
-	FileCheckFrequency             time.Duration
-	HTTPCheckFrequency             time.Duration
-	ManifestURL                    string
-	EnableServer                   bool
-	Address                        util.IP
-	Port                           uint
-	ReadOnlyPort                   uint
-	HostnameOverride               string
-	PodInfraContainerImage         string
-	DockerEndpoint                 string
-	RootDirectory                  string
-	AllowPrivileged                bool
-	HostNetworkSources             string
-	RegistryPullQPS                float64
-	RegistryBurst                  int
-	RunOnce                        bool
-	EnableDebuggingHandlers        bool
-	MinimumGCAge                   time.Duration
-	MaxPerPodContainerCount        int
-	MaxContainerCount              int
-	AuthPath                       util.StringFlag // Deprecated -- use KubeConfig instead
-	KubeConfig                     util.StringFlag
-	CadvisorPort                   uint
-	HealthzPort                    int
-	HealthzBindAddress             util.IP
-	OOMScoreAdj                    int
-	APIServerList                  util.StringList
-	RegisterNode                   bool
-	StandaloneMode                 bool
-	ClusterDomain                  string
-	MasterServiceNamespace         string
-	ClusterDNS                     util.IP
-	StreamingConnectionIdleTimeout time.Duration
-	ImageGCHighThresholdPercent    int
-	ImageGCLowThresholdPercent     int
-	LowDiskSpaceThresholdMB        int
-	NetworkPluginName              string
-	CloudProvider                  string
-	CloudConfigFile                string
-	TLSCertFile                    string
-	TLSPrivateKeyFile              string
-	CertDirectory                  string
-	NodeStatusUpdateFrequency      time.Duration
-	ResourceContainer              string
-	CgroupRoot                     string
-	ContainerRuntime               string
-	DockerDaemonContainer          string
-	SystemContainer                string
-	ConfigureCBR0                  bool
-	PodCIDR                        string
-	MaxPods                        int
-	DockerExecHandlerName          string
+	Config                           string
+	SyncFrequency                    time.Duration
+	FileCheckFrequency               time.Duration
+	HTTPCheckFrequency               time.Duration
+	ManifestURL                      string
+	EnableServer                     bool
+	Address                          util.IP
+	Port                             uint
+	ReadOnlyPort                     uint
+	HostnameOverride                 string
+	PodInfraContainerImage           string
+	DockerEndpoint                   string
+	RootDirectory                    string
+	AllowPrivileged                  bool
+	HostNetworkSources               string
+	RegistryPullQPS                  float64
+	RegistryBurst                    int
+	RunOnce                          bool
+	EnableDebuggingHandlers          bool
+	MinimumGCAge                     time.Duration
+	MaxPerPodContainerCount          int
+	MaxContainerCount                int
+	AuthPath                         util.StringFlag // Deprecated -- use KubeConfig instead
+	KubeConfig                       util.StringFlag
+	CadvisorPort                     uint
+	HealthzPort                      int
+	HealthzBindAddress               util.IP
+	OOMScoreAdj                      int
+	APIServerList                    util.StringList
+	RegisterNode                     bool
+	StandaloneMode                   bool
+	ClusterDomain                    string
+	MasterServiceNamespace           string
+	ClusterDNS                       util.IP
+	StreamingConnectionIdleTimeout   time.Duration
+	ImageGCHighThresholdPercent      int
+	ImageGCLowThresholdPercent       int
+	LowDiskSpaceThresholdMB          int
+	EvictionHard                     string
+	EvictionSoft                     string
+	EvictionSoftGracePeriod          string
+	EvictionPressureTransitionPeriod time.Duration
+	NetworkPluginName                string
+	CloudProvider                    string
+	CloudConfigFile                  string
+	TLSCertFile                      string
+	TLSPrivateKeyFile                string
+	CertDirectory                    string
+	NodeStatusUpdateFrequency        time.Duration
+	ResourceContainer                string
+	CgroupRoot                       string
+	ContainerRuntime                 string
+	DockerDaemonContainer            string
+	SystemContainer                  string
+	ConfigureCBR0                    bool
+	PodCIDR                          string
+	MaxPods                          int
+	DockerExecHandlerName            string
 
 	// Flags intended for testing
 
@@ -145,42 +150,43 @@ type KubeletBuilder func(kc *KubeletConfig) (KubeletBootstrap, *config.PodConfig
 // NewKubeletServer will create a new KubeletServer with default values.
 func NewKubeletServer() *KubeletServer {
 	return &KubeletServer{
-		SyncFrequency:               10 * time.Second,
-		FileCheckFrequency:          20 * time.Second,
-		HTTPCheckFrequency:          20 * time.Second,
-		EnableServer:                true,
-		Address:                     util.IP(net.ParseIP("0.0.0.0")),
-		Port:                        ports.KubeletPort,
-		ReadOnlyPort:                ports.KubeletReadOnlyPort,
-		PodInfraContainerImage:      dockertools.PodInfraContainerImage,
-		RootDirectory:               defaultRootDir,
-		RegistryBurst:               10,
-		EnableDebuggingHandlers:     true,
-		MinimumGCAge:                1 * time.Minute,
-		MaxPerPodContainerCount:     2,
-		MaxContainerCount:           100,
-		AuthPath:                    util.NewStringFlag("/var/lib/kubelet/kubernetes_auth"), // deprecated
-		KubeConfig:                  util.NewStringFlag("/var/lib/kubelet/kubeconfig"),
-		CadvisorPort:                4194,
-		HealthzPort:                 10248,
-		HealthzBindAddress:          util.IP(net.ParseIP("127.0.0.1")),
-		RegisterNode:                true, // will be ignored if no apiserver is configured
-		OOMScoreAdj:                 -900,
-		MasterServiceNamespace:      api.NamespaceDefault,
-		ImageGCHighThresholdPercent: 90,
-		ImageGCLowThresholdPercent:  80,
-		LowDiskSpaceThresholdMB:     256,
-		NetworkPluginName:           "",
-		HostNetworkSources:          kubelet.FileSource,
-		CertDirectory:               "/var/run/kubernetes",
-		NodeStatusUpdateFrequency:   10 * time.Second,
-		ResourceContainer:           "/kubelet",
-		CgroupRoot:                  "",
-		ContainerRuntime:            "docker",
-		DockerDaemonContainer:       "/docker-daemon",
-		SystemContainer:             "",
-		ConfigureCBR0:               false,
-		DockerExecHandlerName:       "native",
+		SyncFrequency:                    1 * time.Minute,
+		FileCheckFrequency:               20 * time.Second,
+		HTTPCheckFrequency:               20 * time.Second,
+		EnableServer:                     true,
+		Address:                          util.IP(net.ParseIP("0.0.0.0")),
+		Port:                             ports.KubeletPort,
+		ReadOnlyPort:                     ports.KubeletReadOnlyPort,
+		PodInfraContainerImage:           dockertools.PodInfraContainerImage,
+		RootDirectory:                    defaultRootDir,
+		RegistryBurst:                    10,
+		EnableDebuggingHandlers:          true,
+		MinimumGCAge:                     1 * time.Minute,
+		MaxPerPodContainerCount:          2,
+		MaxContainerCount:                100,
+		AuthPath:                         util.NewStringFlag("/var/lib/kubelet/kubernetes_auth"), // deprecated
+		KubeConfig:                       util.NewStringFlag("/var/lib/kubelet/kubeconfig"),
+		CadvisorPort:                     4194,
+		HealthzPort:                      10248,
+		HealthzBindAddress:               util.IP(net.ParseIP("127.0.0.1")),
+		RegisterNode:                     true, // will be ignored if no apiserver is configured
+		OOMScoreAdj:                      qos.KubeletOOMScoreAdj,
+		MasterServiceNamespace:           api.NamespaceDefault,
+		ImageGCHighThresholdPercent:      90,
+		ImageGCLowThresholdPercent:       80,
+		LowDiskSpaceThresholdMB:          256,
+		EvictionPressureTransitionPeriod: 5 * time.Minute,
+		NetworkPluginName:                "",
+		HostNetworkSources:               kubelet.FileSource,
+		CertDirectory:                    "/var/run/kubernetes",
+		NodeStatusUpdateFrequency:        10 * time.Second,
+		ResourceContainer:                "/kubelet",
+		CgroupRoot:                       "",
+		ContainerRuntime:                 "docker",
+		DockerDaemonContainer:            "/docker-daemon",
+		SystemContainer:                  "",
+		ConfigureCBR0:                    false,
+		DockerExecHandlerName:            "native",
 	}
 }
 
@@ -232,6 +238,10 @@ func (s *KubeletServer) AddFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&s.ImageGCHighThresholdPercent, "image-gc-high-threshold", s.ImageGCHighThresholdPercent, "The percent of disk usage after which image garbage collection is always run. Default: 90%%")
 	fs.IntVar(&s.ImageGCLowThresholdPercent, "image-gc-low-threshold", s.ImageGCLowThresholdPercent, "The percent of disk usage before which image garbage collection is never run. Lowest disk usage to garbage collect to. Default: 80%%")
 	fs.IntVar(&s.LowDiskSpaceThresholdMB, "low-diskspace-threshold-mb", s.LowDiskSpaceThresholdMB, "The absolute free disk space, in MB, to maintain. When disk space falls below this threshold, new pods would be rejected. Default: 256")
+	fs.StringVar(&s.EvictionHard, "eviction-hard", s.EvictionHard, "A set of eviction thresholds (e.g. memory.available<100Mi,nodefs.available<1Gi) that if met would trigger a pod eviction. Supported signals are memory.available and nodefs.available. Only supported with the docker container runtime.")
+	fs.StringVar(&s.EvictionSoft, "eviction-soft", s.EvictionSoft, "A set of eviction thresholds (e.g. memory.available<300Mi) that if met over a corresponding grace period would trigger a pod eviction. Only supported with the docker container runtime.")
+	fs.StringVar(&s.EvictionSoftGracePeriod, "eviction-soft-grace-period", s.EvictionSoftGracePeriod, "A set of eviction grace periods (e.g. memory.available=1m30s) that correspond to how long a soft eviction threshold must hold before triggering a pod eviction.")
+	fs.DurationVar(&s.EvictionPressureTransitionPeriod, "eviction-pressure-transition-period", s.EvictionPressureTransitionPeriod, "Duration for which the kubelet has to wait before transitioning out of an eviction pressure condition. Default: 5m")
 	fs.StringVar(&s.NetworkPluginName, "network-plugin", s.NetworkPluginName, "<Warning: Alpha feature> The name of the network plugin to be invoked for various events in kubelet/pod lifecycle")
 	fs.StringVar(&s.CloudProvider, "cloud-provider", s.CloudProvider, "The provider for cloud services.  Empty string for no provider.")
 	fs.StringVar(&s.CloudConfigFile, "cloud-config", s.CloudConfigFile, "The path to the cloud provider configuration file.  Empty string for no configuration file.")
@@ -286,6 +296,10 @@ func (s *KubeletServer) Run(_ []string) error {
 		DockerFreeDiskMB: s.LowDiskSpaceThresholdMB,
 		RootFreeDiskMB:   s.LowDiskSpaceThresholdMB,
 	}
+	evictionPolicy, err := kubelet.ParseEvictionPolicy(s.EvictionHard, s.EvictionSoft, s.EvictionSoftGracePeriod, s.EvictionPressureTransitionPeriod)
+	if err != nil {
+		return err
+	}
 	cloud := cloudprovider.InitCloudProvider(s.CloudProvider, s.CloudConfigFile)
 	glog.V(2).Infof("Successfully initialized cloud provider: %q from the config file: %q\n", s.CloudProvider, s.CloudConfigFile)
 
@@ -353,6 +367,7 @@ func (s *KubeletServer) Run(_ []string) error {
 		TLSOptions:                     tlsOptions,
 		ImageGCPolicy:                  imageGCPolicy,
 		DiskSpacePolicy:                diskSpacePolicy,
+		EvictionPolicy:                 evictionPolicy,
 		Cloud:                          cloud,
 		NodeStatusUpdateFrequency: s.NodeStatusUpdateFrequency,
 		ResourceContainer:         s.ResourceContainer,
@@ -706,6 +721,7 @@ type KubeletConfig struct {
 	TLSOptions                     *kubelet.TLSOptions
 	ImageGCPolicy                  kubelet.ImageGCPolicy
 	DiskSpacePolicy                kubelet.DiskSpacePolicy
+	EvictionPolicy                 kubelet.EvictionPolicy
 	Cloud                          cloudprovider.Interface
 	NodeStatusUpdateFrequency      time.Duration
 	ResourceContainer              string
@@ -732,7 +748,7 @@ func createAndInitKubelet(kc *KubeletConfig) (k KubeletBootstrap, pc *config.Pod
 		kubeClient = kc.KubeClient
 	}
 
-	gcPolicy := kubelet.ContainerGCPolicy{
+	gcPolicy := kubecontainer.ContainerGCPolicy{
 		MinAge:             kc.MinimumGCAge,
 		MaxPerPodContainer: kc.MaxPerPodContainerCount,
 		MaxContainers:      kc.MaxContainerCount,
@@ -764,6 +780,7 @@ func createAndInitKubelet(kc *KubeletConfig) (k KubeletBootstrap, pc *config.Pod
 		kc.CadvisorInterface,
 		kc.ImageGCPolicy,
 		kc.DiskSpacePolicy,
+		kc.EvictionPolicy,
 		kc.Cloud,
 		kc.NodeStatusUpdateFrequency,
 		kc.ResourceContainer,
@@ -15,7 +15,8 @@ limitations under the License.
 */
 
 // A binary that is capable of running a complete, standalone kubernetes cluster.
-// Expects an etcd server is available, or on the path somewhere.
+// Expects an etcd server is available, or on the path somewhere, unless
+// --in-memory-storage is set.
 // Does *not* currently setup the Kubernetes network model, that must be done ahead of time.
 // TODO: Setup the k8s network bridge as part of setup.
 // TODO: combine this with the hypercube thingy.
@@ -30,6 +31,7 @@ import (
 
 	kubeletapp "github.com/GoogleCloudPlatform/kubernetes/cmd/kubelet/app"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
@@ -41,6 +43,8 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/dockertools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/master"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/service"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage/memory"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler"
@@ -56,6 +60,7 @@ var (
 	port                   = flag.Int("port", 8080, "The port for the apiserver to use.")
 	dockerEndpoint         = flag.String("docker-endpoint", "", "If non-empty, use this for the docker endpoint to communicate with")
 	etcdServer             = flag.String("etcd-server", "http://localhost:4001", "If non-empty, path to the set of etcd server to use")
+	inMemoryStorage        = flag.Bool("in-memory-storage", false, "If true, keep all cluster state in memory instead of etcd. The state is lost when the process exits; for development only.")
 	masterServiceNamespace = flag.String("master-service-namespace", api.NamespaceDefault, "The namespace from which the kubernetes master services should be injected into pods")
 	enableProfiling        = flag.Bool("profiling", false, "Enable profiling via web interface host:port/debug/pprof/")
 	deletingPodsQps        = flag.Float32("deleting-pods-qps", 0.1, "")
@@ -75,17 +80,12 @@ func (h *delegateHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 }
 
 // RunApiServer starts an API server in a go routine.
-func runApiServer(etcdClient tools.EtcdClient, addr net.IP, port int, masterServiceNamespace string) {
+func runApiServer(databaseStorage storage.Interface, addr net.IP, port int, masterServiceNamespace string) {
 	handler := delegateHandler{}
 
-	helper, err := master.NewEtcdHelper(etcdClient, "", master.DefaultEtcdPathPrefix)
-	if err != nil {
-		glog.Fatalf("Unable to get etcd helper: %v", err)
-	}
-
 	// Create a master and install handlers into mux.
 	m := master.New(&master.Config{
-		EtcdHelper: helper,
+		DatabaseStorage: databaseStorage,
 		KubeletClient: &client.HTTPKubeletClient{
 			Client: http.DefaultClient,
 			Config: &client.KubeletConfig{Port: 10250},
@@ -137,8 +137,8 @@ func runControllerManager(cl *client.Client) {
 	go controllerManager.Run(5, util.NeverStop)
 }
 
-func startComponents(etcdClient tools.EtcdClient, cl *client.Client, addr net.IP, port int) {
-	runApiServer(etcdClient, addr, port, *masterServiceNamespace)
+func startComponents(databaseStorage storage.Interface, cl *client.Client, addr net.IP, port int) {
+	runApiServer(databaseStorage, addr, port, *masterServiceNamespace)
 	runScheduler(cl)
 	runControllerManager(cl)
 
@@ -158,6 +158,25 @@ func newApiClient(addr net.IP, port int) *client.Client {
 	return cl
 }
 
+// newDatabaseStorage returns the storage the apiserver keeps cluster state in.
+func newDatabaseStorage() storage.Interface {
+	if *inMemoryStorage {
+		glog.Infof("Keeping cluster state in memory")
+		return memory.NewStorage(latest.Codec)
+	}
+
+	glog.Infof("Creating etcd client pointing to %v", *etcdServer)
+	etcdClient, err := tools.NewEtcdClientStartServerIfNecessary(*etcdServer)
+	if err != nil {
+		glog.Fatalf("Failed to connect to etcd: %v", err)
+	}
+	helper, err := master.NewEtcdHelper(etcdClient, "", master.DefaultEtcdPathPrefix)
+	if err != nil {
+		glog.Fatalf("Unable to get etcd helper: %v", err)
+	}
+	return helper
+}
+
 func main() {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 
@@ -165,13 +184,8 @@ func main() {
 	util.InitLogs()
 	defer util.FlushLogs()
 
-	glog.Infof("Creating etcd client pointing to %v", *etcdServer)
-	etcdClient, err := tools.NewEtcdClientStartServerIfNecessary(*etcdServer)
-	if err != nil {
-		glog.Fatalf("Failed to connect to etcd: %v", err)
-	}
 	address := net.ParseIP(*addr)
-	startComponents(etcdClient, newApiClient(address, *port), address, *port)
+	startComponents(newDatabaseStorage(), newApiClient(address, *port), address, *port)
 	glog.Infof("Kubernetes API Server is up and running on http://%s:%d", *addr, *port)
 
 	select {}
@@ -32,7 +32,10 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider/servicecontroller"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/healthz"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/namespace"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/podautoscaler"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/podautoscaler/metrics"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/resourcequota"
 	kendpoint "github.com/GoogleCloudPlatform/kubernetes/pkg/service"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
@@ -111,6 +114,12 @@ func (s *CMServer) Run(_ []string) error {
 	controllerManager := controller.NewReplicationManager(kubeClient, controller.BurstReplicas)
 	go controllerManager.Run(s.ConcurrentRCSyncs, util.NeverStop)
 
+	go controller.NewJobManager(kubeClient).Run(s.ConcurrentJobSyncs, util.NeverStop)
+
+	go controller.NewDaemonSetsController(kubeClient).Run(s.ConcurrentDSCSyncs, util.NeverStop)
+
+	go controller.NewDeploymentController(kubeClient).Run(s.ConcurrentDeploymentSyncs, util.NeverStop)
+
 	//TODO(jdef) should eventually support more cloud providers here
 	if s.CloudProvider != mesos.ProviderName {
 		glog.Fatalf("Only provider %v is supported, you specified %v", mesos.ProviderName, s.CloudProvider)
@@ -139,6 +148,13 @@ func (s *CMServer) Run(_ []string) error {
 	resourceQuotaManager := resourcequota.NewResourceQuotaManager(kubeClient)
 	resourceQuotaManager.Run(s.ResourceQuotaSyncPeriod)
 
+	metricsClient := metrics.NewMetricsClient(kubeClient, metrics.NewKubeletSource(&client.HTTPContainerInfoGetter{
+		Client: http.DefaultClient,
+		Port:   ports.KubeletPort,
+	}))
+	podautoscaler.NewHorizontalController(kubeClient, metricsClient, s.HorizontalPodAutoscalerUpscaleDelay, s.HorizontalPodAutoscalerDownscaleDelay).
+		Run(s.HorizontalPodAutoscalerSyncPeriod)
+
 	namespaceManager := namespace.NewNamespaceManager(kubeClient, s.NamespaceSyncPeriod)
 	namespaceManager.Run()
 
@@ -227,7 +227,8 @@ func TestExampleObjectSchemas(t *testing.T) {
 			"namespace-prod":      &api.Namespace{},
 		},
 		"../examples/downward-api": {
-			"dapi-pod": &api.Pod{},
+			"dapi-pod":    &api.Pod{},
+			"dapi-volume": &api.Pod{},
 		},
 		"../examples/elasticsearch": {
 			"apiserver-secret": nil,
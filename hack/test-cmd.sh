@@ -175,6 +175,7 @@ for version in "${kube_api_versions[@]}"; do
   fi
   id_field=".metadata.name"
   labels_field=".metadata.labels"
+  annotations_field=".metadata.annotations"
   service_selector_field=".spec.selector"
   rc_replicas_field=".spec.replicas"
   rc_status_replicas_field=".status.replicas"
@@ -236,6 +237,10 @@ for version in "${kube_api_versions[@]}"; do
   kube::test::get_object_assert 'pods/valid-pod' "{{$id_field}}" 'valid-pod'
   # Describe command should print detailed information
   kube::test::describe_object_assert pods 'valid-pod' "Name:" "Image(s):" "Node:" "Labels:" "Status:" "Replication Controllers"
+  # JSONPath and custom columns output should select the same fields
+  [[ "$(kubectl get "${kube_flags[@]}" pods -o jsonpath='{.items[*].metadata.name}')" == "valid-pod" ]]
+  [[ "$(kubectl get "${kube_flags[@]}" pod valid-pod -o jsonpath='{.metadata.name}')" == "valid-pod" ]]
+  kubectl get "${kube_flags[@]}" pods -o custom-columns=NAME:.metadata.name --sort-by=.metadata.name | grep -q "valid-pod"
 
   ### Dump current valid-pod POD
   output_pod=$(kubectl get pod valid-pod -o yaml --output-version=v1beta3 "${kube_flags[@]}")
@@ -386,6 +391,17 @@ for version in "${kube_api_versions[@]}"; do
   # Post-condition: valid-pod POD has image nginx
   kube::test::get_object_assert pods "{{range.items}}{{$image_field}}:{{end}}" 'nginx:'
 
+  ## Patch pod can change image
+  # Command
+  kubectl patch "${kube_flags[@]}" pod valid-pod -p='{"spec":{"containers":[{"name": "kubernetes-serve-hostname", "image": "kubernetes/pause"}]}}'
+  # Post-condition: valid-pod POD has image kubernetes/pause
+  kube::test::get_object_assert pods "{{range.items}}{{$image_field}}:{{end}}" 'kubernetes/pause:'
+  ## Patch pod can change image with a json patch
+  # Command
+  kubectl patch "${kube_flags[@]}" pod valid-pod --type=json -p='[{"op": "replace", "path": "/spec/containers/0/image", "value": "nginx"}]'
+  # Post-condition: valid-pod POD has image nginx
+  kube::test::get_object_assert pods "{{range.items}}{{$image_field}}:{{end}}" 'nginx:'
+
   ## --force update pod can change other field, e.g., spec.container.name
   # Command
   kubectl get "${kube_flags[@]}" pod valid-pod -o json | sed 's/"kubernetes-serve-hostname"/"update-k8s-serve-hostname"/g' > tmp-valid-pod.json
@@ -409,6 +425,24 @@ for version in "${kube_api_versions[@]}"; do
   # Post-condition: name is valid-pod-super-sayan
   kube::test::get_object_assert 'pod valid-pod' "{{${labels_field}.name}}" 'valid-pod-super-sayan'
 
+  ### Annotate the valid-pod POD
+  # Command
+  kubectl annotate pods valid-pod description='my frontend' "${kube_flags[@]}"
+  # Post-condition: valid-pod is annotated
+  kube::test::get_object_assert 'pod valid-pod' "{{${annotations_field}.description}}" 'my frontend'
+
+  ### Overwriting an existing annotation is not permitted
+  # Command
+  ! kubectl annotate pods valid-pod description='my backend' "${kube_flags[@]}"
+  # Post-condition: the annotation is unchanged
+  kube::test::get_object_assert 'pod valid-pod' "{{${annotations_field}.description}}" 'my frontend'
+
+  ### Remove the annotation from the valid-pod POD
+  # Command
+  kubectl annotate pods valid-pod description- "${kube_flags[@]}"
+  # Post-condition: valid-pod is not annotated
+  kube::test::get_object_assert 'pod valid-pod' "{{${annotations_field}.description}}" '<no value>'
+
   ### Delete POD by label
   # Pre-condition: valid-pod POD is running
   kube::test::get_object_assert pods "{{range.items}}{{$id_field}}:{{end}}" 'valid-pod:'
@@ -417,6 +451,32 @@ for version in "${kube_api_versions[@]}"; do
   # Post-condition: no POD is running
   kube::test::get_object_assert pods "{{range.items}}{{$id_field}}:{{end}}" ''
 
+  ### Apply creates a POD
+  # Pre-condition: no POD is running
+  kube::test::get_object_assert pods "{{range.items}}{{$id_field}}:{{end}}" ''
+  # Command
+  kubectl apply -f examples/limitrange/valid-pod.json "${kube_flags[@]}"
+  # Post-condition: valid-pod POD is running
+  kube::test::get_object_assert pods "{{range.items}}{{$id_field}}:{{end}}" 'valid-pod:'
+
+  ## apply changes the image and keeps labels set by others
+  # Pre-condition: valid-pod POD is labeled by someone else
+  kubectl label pods valid-pod owner=ops "${kube_flags[@]}"
+  # Command
+  sed 's#gcr.io/google_containers/serve_hostname#nginx#' examples/limitrange/valid-pod.json > tmp-valid-pod.json
+  kubectl apply -f tmp-valid-pod.json "${kube_flags[@]}"
+  # Post-condition: valid-pod POD has image nginx and is still labeled by someone else
+  kube::test::get_object_assert pods "{{range.items}}{{$image_field}}:{{end}}" 'nginx:'
+  kube::test::get_object_assert 'pod valid-pod' "{{${labels_field}.owner}}" 'ops'
+
+  ### Delete POD valid-pod
+  # Pre-condition: valid-pod POD is running
+  kube::test::get_object_assert pods "{{range.items}}{{$id_field}}:{{end}}" 'valid-pod:'
+  # Command
+  kubectl delete pods valid-pod "${kube_flags[@]}"
+  # Post-condition: no POD is running
+  kube::test::get_object_assert pods "{{range.items}}{{$id_field}}:{{end}}" ''
+
 
   ##############
   # Namespaces #
@@ -721,6 +781,23 @@ __EOF__
   # Post-condition: node is schedulable
   kube::test::get_object_assert "nodes 127.0.0.1" "{{.spec.unschedulable}}" '<no value>'
 
+  ### kubectl cordon and drain mark the node unschedulable, uncordon reverts it
+  # Pre-condition: node is schedulable
+  kube::test::get_object_assert "nodes 127.0.0.1" "{{.spec.unschedulable}}" '<no value>'
+  # Command
+  kubectl cordon "${kube_flags[@]}" "127.0.0.1"
+  # Post-condition: node is unschedulable
+  kube::test::get_object_assert "nodes 127.0.0.1" "{{.spec.unschedulable}}" 'true'
+  # Command
+  kubectl uncordon "${kube_flags[@]}" "127.0.0.1"
+  # Post-condition: node is schedulable
+  kube::test::get_object_assert "nodes 127.0.0.1" "{{.spec.unschedulable}}" '<no value>'
+  # Command
+  kubectl drain "${kube_flags[@]}" "127.0.0.1" --force
+  # Post-condition: node is unschedulable
+  kube::test::get_object_assert "nodes 127.0.0.1" "{{.spec.unschedulable}}" 'true'
+  kubectl uncordon "${kube_flags[@]}" "127.0.0.1"
+
   ###########
   # Nodes #
   ###########
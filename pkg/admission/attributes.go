@@ -76,3 +76,20 @@ func (record *attributesRecord) GetObject() runtime.Object {
 func (record *attributesRecord) GetUserInfo() user.Info {
 	return record.userInfo
 }
+
+func (record *attributesRecord) IsDryRun() bool {
+	return false
+}
+
+type dryRunAttributes struct {
+	Attributes
+}
+
+// NewDryRunAttributes returns a copy of the attributes of a request that will not be persisted.
+func NewDryRunAttributes(a Attributes) Attributes {
+	return &dryRunAttributes{a}
+}
+
+func (dryRunAttributes) IsDryRun() bool {
+	return true
+}
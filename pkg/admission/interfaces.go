@@ -44,6 +44,9 @@ type Attributes interface {
 	GetKind() string
 	// GetUserInfo is information about the requesting user
 	GetUserInfo() user.Info
+	// IsDryRun returns true if the request will not be persisted. Admission controllers must
+	// not have side effects on dry run requests.
+	IsDryRun() bool
 }
 
 // Interface is an abstract, pluggable interface for Admission Control decisions.
@@ -37,6 +37,9 @@ const namespaceKey key = 0
 // userKey is the context key for the request user.
 const userKey key = 1
 
+// dryRunKey is the context key for requests that must not be persisted.
+const dryRunKey key = 2
+
 // NewContext instantiates a base context object for request flows.
 func NewContext() Context {
 	return context.TODO()
@@ -101,3 +104,15 @@ func UserFrom(ctx Context) (user.Info, bool) {
 	user, ok := ctx.Value(userKey).(user.Info)
 	return user, ok
 }
+
+// WithDryRun returns a copy of parent marked as a dry run. Storage that honors
+// the mark runs the request as usual but does not persist the result.
+func WithDryRun(parent Context) Context {
+	return WithValue(parent, dryRunKey, true)
+}
+
+// IsDryRun returns true if the ctx is marked as a dry run
+func IsDryRun(ctx Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey).(bool)
+	return dryRun
+}
@@ -66,3 +66,18 @@ func TestValidNamespace(t *testing.T) {
 		t.Errorf("Expected the empty string")
 	}
 }
+
+// TestDryRunContext validates that a dry run can be set on a context object
+func TestDryRunContext(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	if api.IsDryRun(ctx) {
+		t.Errorf("Expected a new context not to be a dry run")
+	}
+	ctx = api.WithDryRun(ctx)
+	if !api.IsDryRun(ctx) {
+		t.Errorf("Expected the context to be a dry run")
+	}
+	if api.NamespaceValue(ctx) != api.NamespaceDefault {
+		t.Errorf("Expected the namespace to be preserved, got %s", api.NamespaceValue(ctx))
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package api
 
 import (
+	"time"
+
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
@@ -39,6 +41,10 @@ func init() {
 			obj.Stderr = true
 			obj.Stdout = true
 		},
+		func(obj *PodAttachOptions) {
+			obj.Stderr = true
+			obj.Stdout = true
+		},
 	)
 	Scheme.AddConversionFuncs(
 		func(in *util.Time, out *util.Time, s conversion.Scope) error {
@@ -46,6 +52,19 @@ func init() {
 			*out = *in
 			return nil
 		},
+		func(in *[]string, out **util.Time, s conversion.Scope) error {
+			// Query parameters carry times in RFC3339 format.
+			if len(*in) == 0 {
+				*out = nil
+				return nil
+			}
+			t, err := time.Parse(time.RFC3339, (*in)[0])
+			if err != nil {
+				return err
+			}
+			*out = &util.Time{t}
+			return nil
+		},
 		func(in *string, out *labels.Selector, s conversion.Scope) error {
 			selector, err := labels.Parse(*in)
 			if err != nil {
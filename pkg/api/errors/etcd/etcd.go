@@ -18,47 +18,47 @@ package etcd
 
 import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
 )
 
-// InterpretGetError converts a generic etcd error on a retrieval
+// InterpretGetError converts a generic storage error on a retrieval
 // operation into the appropriate API error.
 func InterpretGetError(err error, kind, name string) error {
 	switch {
-	case tools.IsEtcdNotFound(err):
+	case storage.IsNotFound(err):
 		return errors.NewNotFound(kind, name)
 	default:
 		return err
 	}
 }
 
-// InterpretCreateError converts a generic etcd error on a create
+// InterpretCreateError converts a generic storage error on a create
 // operation into the appropriate API error.
 func InterpretCreateError(err error, kind, name string) error {
 	switch {
-	case tools.IsEtcdNodeExist(err):
+	case storage.IsNodeExist(err):
 		return errors.NewAlreadyExists(kind, name)
 	default:
 		return err
 	}
 }
 
-// InterpretUpdateError converts a generic etcd error on a update
+// InterpretUpdateError converts a generic storage error on a update
 // operation into the appropriate API error.
 func InterpretUpdateError(err error, kind, name string) error {
 	switch {
-	case tools.IsEtcdTestFailed(err), tools.IsEtcdNodeExist(err):
+	case storage.IsTestFailed(err), storage.IsNodeExist(err):
 		return errors.NewConflict(kind, name, err)
 	default:
 		return err
 	}
 }
 
-// InterpretDeleteError converts a generic etcd error on a delete
+// InterpretDeleteError converts a generic storage error on a delete
 // operation into the appropriate API error.
 func InterpretDeleteError(err error, kind, name string) error {
 	switch {
-	case tools.IsEtcdNotFound(err):
+	case storage.IsNotFound(err):
 		return errors.NewNotFound(kind, name)
 	default:
 		return err
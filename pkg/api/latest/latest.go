@@ -104,6 +104,7 @@ func init() {
 		"DeleteOptions",
 		"Status",
 		"PodLogOptions",
+		"PodAttachOptions",
 		"PodExecOptions",
 		"PodProxyOptions")
 
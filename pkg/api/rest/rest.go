@@ -65,6 +65,15 @@ type Lister interface {
 	List(ctx api.Context, label labels.Selector, field fields.Selector) (runtime.Object, error)
 }
 
+// VersionedLister is an object that can retrieve resources that match the provided field and
+// label criteria from a state that is at least as fresh as a given resource version. Storage that
+// keeps the resource in memory implements it to answer such lists without reading from etcd.
+type VersionedLister interface {
+	// ListFromVersion selects resources in the storage which match to the selector. An empty
+	// resourceVersion behaves like List.
+	ListFromVersion(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (runtime.Object, error)
+}
+
 // Getter is an object that can retrieve a named RESTful resource.
 type Getter interface {
 	// Get finds a resource in the storage by name and returns it.
@@ -176,6 +185,14 @@ type Patcher interface {
 	Updater
 }
 
+// DryRunner is an object that honors api.IsDryRun on the context passed to its Create, Update
+// and Delete methods. A dry run executes the same defaulting, validation and strategy hooks as a
+// real request and returns the resulting object, but leaves storage untouched.
+type DryRunner interface {
+	// SupportsDryRun returns true if requests marked as a dry run may be passed to the object.
+	SupportsDryRun() bool
+}
+
 // Watcher should be implemented by all Storage objects that
 // want to offer the ability to watch for changes through the watch api.
 type Watcher interface {
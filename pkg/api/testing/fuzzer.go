@@ -113,6 +113,35 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 			// only replicas round trips
 			j.Replicas = int(c.RandUint64())
 		},
+		func(j *api.JobSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// completions and parallelism are defaulted, so they must be set to round trip
+			completions := c.Rand.Int()
+			parallelism := c.Rand.Int()
+			j.Completions = &completions
+			j.Parallelism = &parallelism
+		},
+		func(j *api.DeploymentStrategy, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// type and the rolling update parameters are defaulted, so they must be set to round trip
+			if c.RandBool() {
+				j.Type = api.RecreateDeploymentStrategyType
+				j.RollingUpdate = nil
+			} else {
+				j.Type = api.RollingUpdateDeploymentStrategyType
+				if j.RollingUpdate == nil {
+					j.RollingUpdate = &api.RollingUpdateDeployment{}
+				}
+				j.RollingUpdate.MaxUnavailable = util.NewIntOrStringFromInt(int(c.RandUint64()))
+				j.RollingUpdate.MaxSurge = util.NewIntOrStringFromString(strconv.Itoa(c.Intn(100)) + "%")
+			}
+		},
+		func(j *api.HorizontalPodAutoscalerSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// min replicas is defaulted, so it must be set to round trip
+			minReplicas := c.Rand.Int()
+			j.MinReplicas = &minReplicas
+		},
 		func(j *api.List, c fuzz.Continue) {
 			c.FuzzNoCustom(j) // fuzz self without calling this function again
 			// TODO: uncomment when round trip starts from a versioned object
@@ -172,7 +201,14 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 			i := int(c.RandUint64() % uint64(v.NumField()))
 			v = v.Field(i).Addr()
 			// Use a new fuzzer which cannot populate nil to ensure one field will be set.
-			fuzz.New().NilChance(0).NumElements(1, 1).Fuzz(v.Interface())
+			// Field selectors need a real API version, which is otherwise defaulted.
+			fuzz.New().NilChance(0).NumElements(1, 1).Funcs(
+				func(fs *api.ObjectFieldSelector, c fuzz.Continue) {
+					versions := []string{"v1beta1", "v1beta2", "v1beta3"}
+					fs.APIVersion = versions[c.Rand.Intn(len(versions))]
+					fs.FieldPath = c.RandString()
+				},
+			).Fuzz(v.Interface())
 		},
 		func(d *api.DNSPolicy, c fuzz.Continue) {
 			policies := []api.DNSPolicy{api.DNSClusterFirst, api.DNSDefault}
@@ -200,12 +236,17 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 				ev.Value = c.RandString()
 			} else {
 				ev.ValueFrom = &api.EnvVarSource{}
-				ev.ValueFrom.FieldRef = &api.ObjectFieldSelector{}
+				if c.RandBool() {
+					ev.ValueFrom.FieldRef = &api.ObjectFieldSelector{}
 
-				versions := []string{"v1beta1", "v1beta2", "v1beta3"}
+					versions := []string{"v1beta1", "v1beta2", "v1beta3"}
 
-				ev.ValueFrom.FieldRef.APIVersion = versions[c.Rand.Intn(len(versions))]
-				ev.ValueFrom.FieldRef.FieldPath = c.RandString()
+					ev.ValueFrom.FieldRef.APIVersion = versions[c.Rand.Intn(len(versions))]
+					ev.ValueFrom.FieldRef.FieldPath = c.RandString()
+				} else {
+					ev.ValueFrom.ConfigMapKeyRef = &api.ConfigMapKeySelector{}
+					c.Fuzz(ev.ValueFrom.ConfigMapKeyRef)
+				}
 			}
 		},
 		func(sc *api.SecurityContext, c fuzz.Continue) {
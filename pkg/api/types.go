@@ -206,6 +206,10 @@ type VolumeSource struct {
 	GitRepo *GitRepoVolumeSource `json:"gitRepo,omitempty"`
 	// Secret represents a secret that should populate this volume.
 	Secret *SecretVolumeSource `json:"secret,omitempty"`
+	// ConfigMap represents a ConfigMap that should populate this volume.
+	ConfigMap *ConfigMapVolumeSource `json:"configMap,omitempty"`
+	// DownwardAPI represents metadata about the pod that should populate this volume.
+	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI,omitempty"`
 	// NFS represents an NFS mount on the host that shares a pod's lifetime
 	NFS *NFSVolumeSource `json:"nfs,omitempty"`
 	// ISCSIVolumeSource represents an ISCSI Disk resource that is attached to a
@@ -499,6 +503,34 @@ type SecretVolumeSource struct {
 	SecretName string `json:"secretName"`
 }
 
+// ConfigMapVolumeSource adapts a ConfigMap into a VolumeSource.
+//
+// The contents of the target ConfigMap's Data field will be presented in a
+// volume as files using the keys in the Data field as the file names. The
+// files are kept up to date as the ConfigMap changes.
+type ConfigMapVolumeSource struct {
+	// Name of the ConfigMap in the pod's namespace to use
+	Name string `json:"name"`
+}
+
+// DownwardAPIVolumeSource represents a volume containing downward API info.
+//
+// Each item selects a field of the pod and names the file, relative to the
+// volume root, that the field's value is written to. The files are updated
+// as the pod's metadata changes.
+type DownwardAPIVolumeSource struct {
+	// Items is a list of downward API volume files
+	Items []DownwardAPIVolumeFile `json:"items,omitempty"`
+}
+
+// DownwardAPIVolumeFile represents a single file containing information from the downward API.
+type DownwardAPIVolumeFile struct {
+	// Required: Path is the relative path name of the file to be created.
+	Path string `json:"path"`
+	// Required: Selects a field of the pod; only annotations, labels, name and namespace are supported.
+	FieldRef ObjectFieldSelector `json:"fieldRef"`
+}
+
 // NFSVolumeSource represents an NFS Mount that lasts the lifetime of a pod
 type NFSVolumeSource struct {
 	// Server is the hostname or IP address of the NFS server
@@ -594,8 +626,10 @@ type EnvVar struct {
 
 // EnvVarSource represents a source for the value of an EnvVar.
 type EnvVarSource struct {
-	// Required: Selects a field of the pod; only name and namespace are supported.
-	FieldRef *ObjectFieldSelector `json:"fieldRef"`
+	// Selects a field of the pod; only name and namespace are supported.
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty"`
+	// Selects a key of a ConfigMap in the pod's namespace.
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
 }
 
 // ObjectFieldSelector selects an APIVersioned field of an object.
@@ -608,6 +642,14 @@ type ObjectFieldSelector struct {
 	FieldPath string `json:"fieldPath"`
 }
 
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Required: Name of the ConfigMap in the pod's namespace
+	Name string `json:"name"`
+	// Required: The key of the ConfigMap to select
+	Key string `json:"key"`
+}
+
 // HTTPGetAction describes an action based on HTTP Get requests.
 type HTTPGetAction struct {
 	// Optional: Path to access on the HTTP server.
@@ -724,6 +766,11 @@ type Container struct {
 	ImagePullPolicy PullPolicy `json:"imagePullPolicy"`
 	// Optional: SecurityContext defines the security options the pod should be run with
 	SecurityContext *SecurityContext `json:"securityContext,omitempty" description:"security options the pod should run with"`
+
+	// Variables for interactive containers, these have very specialized use-cases (e.g. debugging)
+	// and shouldn't be used for general purpose containers.
+	Stdin bool `json:"stdin,omitempty"`
+	TTY   bool `json:"tty,omitempty"`
 }
 
 // Handler defines a specific action that should be taken
@@ -849,6 +896,22 @@ type PodCondition struct {
 	Status ConditionStatus  `json:"status"`
 }
 
+// PodQOSClass is the quality of service class of a pod, derived from the
+// resource requests and limits of its containers.
+type PodQOSClass string
+
+// These are valid quality of service classes of pods.
+const (
+	// PodQOSGuaranteed means every container sets limits, and requests equal
+	// to them, for both cpu and memory.
+	PodQOSGuaranteed PodQOSClass = "Guaranteed"
+	// PodQOSBurstable means some container sets requests or limits, but the
+	// pod does not qualify as guaranteed.
+	PodQOSBurstable PodQOSClass = "Burstable"
+	// PodQOSBestEffort means no container sets requests or limits.
+	PodQOSBestEffort PodQOSClass = "BestEffort"
+)
+
 // RestartPolicy describes how the container should be restarted.
 // Only one of the following restart policies may be specified.
 // If none of the following policies is specified, the default one
@@ -945,6 +1008,9 @@ type PodStatus struct {
 	// TODO: Make real decisions about what our info should look like. Re-enable fuzz test
 	// when we have done this.
 	ContainerStatuses []ContainerStatus `json:"containerStatuses,omitempty"`
+
+	// Quality of service class of the pod, set by the Kubelet.
+	QOSClass PodQOSClass `json:"qosClass,omitempty"`
 }
 
 // PodStatusResult is a wrapper for PodStatus returned by kubelet that can be encode/decoded
@@ -1048,6 +1114,341 @@ type ReplicationControllerList struct {
 	Items []ReplicationController `json:"items"`
 }
 
+// JobSpec describes how the job execution will look like.
+type JobSpec struct {
+	// Parallelism specifies the maximum desired number of pods the job should
+	// run at any given time. The actual number of pods running in steady state will
+	// be less than this number when ((.spec.completions - .status.successful) < .spec.parallelism),
+	// i.e. when the work left to do is less than max parallelism.
+	Parallelism *int `json:"parallelism,omitempty"`
+
+	// Completions specifies the desired number of successfully finished pods the
+	// job should be run with. Defaults to 1.
+	Completions *int `json:"completions,omitempty"`
+
+	// ActiveDeadlineSeconds is the duration in seconds relative to the StartTime
+	// that the job may be active before the system tries to terminate it.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Selector is a label query over pods that should match the pod count.
+	Selector map[string]string `json:"selector"`
+
+	// Template is the object that describes the pod that will be created when
+	// executing a job.
+	Template *PodTemplateSpec `json:"template,omitempty"`
+}
+
+// JobStatus represents the current state of a Job.
+type JobStatus struct {
+	// Conditions represent the latest available observations of an object's current state.
+	Conditions []JobCondition `json:"conditions,omitempty"`
+
+	// StartTime represents time when the job was acknowledged by the Job Manager.
+	StartTime *util.Time `json:"startTime,omitempty"`
+
+	// CompletionTime represents time when the job was completed.
+	CompletionTime *util.Time `json:"completionTime,omitempty"`
+
+	// Active is the number of actively running pods.
+	Active int `json:"active,omitempty"`
+
+	// Succeeded is the number of pods which reached Phase Succeeded.
+	Succeeded int `json:"succeeded,omitempty"`
+
+	// Failed is the number of pods which reached Phase Failed.
+	Failed int `json:"failed,omitempty"`
+}
+
+type JobConditionType string
+
+// These are valid conditions of a job.
+const (
+	// JobComplete means the job has completed its execution.
+	JobComplete JobConditionType = "Complete"
+	// JobFailed means the job has failed its execution.
+	JobFailed JobConditionType = "Failed"
+)
+
+// JobCondition describes current state of a job.
+type JobCondition struct {
+	Type               JobConditionType `json:"type"`
+	Status             ConditionStatus  `json:"status"`
+	LastProbeTime      util.Time        `json:"lastProbeTime,omitempty"`
+	LastTransitionTime util.Time        `json:"lastTransitionTime,omitempty"`
+	Reason             string           `json:"reason,omitempty"`
+	Message            string           `json:"message,omitempty"`
+}
+
+// Job represents the configuration of a single job.
+type Job struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is a structure defining the expected behavior of a job.
+	Spec JobSpec `json:"spec,omitempty"`
+
+	// Status is a structure describing current status of a job.
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Job `json:"items"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	// Selector is a label query over pods that are managed by the daemon set.
+	// Must match in order to be controlled.
+	Selector map[string]string `json:"selector"`
+
+	// Template is the object that describes the pod that will be created.
+	// The DaemonSet will create exactly one copy of this pod on every node
+	// that matches the template's node selector (or on every node if no node
+	// selector is specified).
+	Template *PodTemplateSpec `json:"template,omitempty"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	// CurrentNumberScheduled is the number of nodes that are running exactly one
+	// daemon pod and are supposed to run the daemon pod.
+	CurrentNumberScheduled int `json:"currentNumberScheduled"`
+
+	// NumberMisscheduled is the number of nodes that are running the daemon pod,
+	// but are not supposed to run the daemon pod.
+	NumberMisscheduled int `json:"numberMisscheduled"`
+
+	// DesiredNumberScheduled is the total number of nodes that should be running
+	// the daemon pod (including nodes correctly running the daemon pod).
+	DesiredNumberScheduled int `json:"desiredNumberScheduled"`
+}
+
+// DaemonSet represents the configuration of a daemon set.
+type DaemonSet struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of this daemon set.
+	Spec DaemonSetSpec `json:"spec,omitempty"`
+
+	// Status is the current status of this daemon set.
+	Status DaemonSetStatus `json:"status,omitempty"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []DaemonSet `json:"items"`
+}
+
+// DeploymentSpec is the specification of the desired behavior of a Deployment.
+type DeploymentSpec struct {
+	// Replicas is the number of desired pods.
+	Replicas int `json:"replicas,omitempty"`
+
+	// Selector is a label query over pods that should match the Replicas count.
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// Template describes the pods that will be created.
+	Template *PodTemplateSpec `json:"template,omitempty"`
+
+	// Strategy is the deployment strategy to use to replace existing pods with new ones.
+	Strategy DeploymentStrategy `json:"strategy,omitempty"`
+
+	// UniqueLabelKey is the key of the selector that is added to existing
+	// replication controllers (and label key that is added to their pods) to
+	// prevent the existing controllers from selecting new pods (and old pods
+	// being selected by the new controller).
+	UniqueLabelKey string `json:"uniqueLabelKey,omitempty"`
+
+	// RevisionHistoryLimit is the number of old replication controllers to
+	// retain to allow rollback. Nil means all old controllers are kept.
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit,omitempty"`
+
+	// RollbackTo is the config this deployment is rolling back to. Will be
+	// cleared after rollback is done.
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty"`
+}
+
+// RollbackConfig describes the revision a deployment should roll back to.
+type RollbackConfig struct {
+	// Revision is the revision to roll back to. If set to 0, rolls back to the
+	// last revision.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+const (
+	// DefaultDeploymentUniqueLabelKey is the default key of the selector that is
+	// added to existing replication controllers (and label key that is added to
+	// its pods) to prevent the existing controllers from selecting new pods.
+	DefaultDeploymentUniqueLabelKey string = "deployment.kubernetes.io/podTemplateHash"
+
+	// DeploymentRevisionAnnotation is the annotation on replication controllers
+	// owned by a deployment that records the revision of the deployment they
+	// were created for.
+	DeploymentRevisionAnnotation string = "deployment.kubernetes.io/revision"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	// Type of deployment. Can be "Recreate" or "RollingUpdate".
+	Type DeploymentStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate is the rolling update config params. Present only if
+	// DeploymentStrategyType = RollingUpdate.
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty"`
+}
+
+type DeploymentStrategyType string
+
+const (
+	// RecreateDeploymentStrategyType kills all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+
+	// RollingUpdateDeploymentStrategyType replaces the old controllers by the
+	// new one using rolling update i.e gradually scale down the old controllers
+	// and scale up the new one.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+)
+
+// RollingUpdateDeployment is the spec to control the desired behavior of a
+// rolling update.
+type RollingUpdateDeployment struct {
+	// MaxUnavailable is the maximum number of pods that can be unavailable
+	// during the update. Value can be an absolute number (ex: 5) or a
+	// percentage of total pods at the start of update (ex: 10%). Absolute
+	// number is calculated from percentage by rounding down.
+	MaxUnavailable util.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MaxSurge is the maximum number of pods that can be scheduled above the
+	// original number of pods. Value can be an absolute number (ex: 5) or a
+	// percentage of total pods at the start of the update (ex: 10%). Absolute
+	// number is calculated from percentage by rounding up.
+	MaxSurge util.IntOrString `json:"maxSurge,omitempty"`
+
+	// MinReadySeconds is the minimum number of seconds for which a newly
+	// created pod should be ready without any of its containers crashing, for
+	// it to be considered available.
+	MinReadySeconds int `json:"minReadySeconds,omitempty"`
+}
+
+// DeploymentStatus is the most recently observed status of the Deployment.
+type DeploymentStatus struct {
+	// Replicas is the total number of running pods targeted by this deployment
+	// (their labels match the selector).
+	Replicas int `json:"replicas,omitempty"`
+
+	// UpdatedReplicas is the total number of running pods targeted by this
+	// deployment that have the desired template spec.
+	UpdatedReplicas int `json:"updatedReplicas,omitempty"`
+}
+
+// Deployment enables declarative updates for pods and replication controllers.
+type Deployment struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the desired behavior of the Deployment.
+	Spec DeploymentSpec `json:"spec,omitempty"`
+
+	// Status is the most recently observed status of the Deployment.
+	Status DeploymentStatus `json:"status,omitempty"`
+}
+
+// DeploymentList is a list of Deployments.
+type DeploymentList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Deployment `json:"items"`
+}
+
+// ScaleReference identifies the object whose replica count is managed by a
+// horizontal pod autoscaler. The object lives in the autoscaler's namespace.
+type ScaleReference struct {
+	// Kind of the referent; only ReplicationController is supported.
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the referent.
+	Name string `json:"name,omitempty"`
+
+	// APIVersion of the referent.
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// CPUTargetUtilization is the target average CPU utilization of the pods of
+// the scaled object, expressed as a percentage of the requested CPU.
+type CPUTargetUtilization struct {
+	// TargetPercentage is the target average CPU utilization (represented as a
+	// percentage of requested CPU) over all the pods.
+	TargetPercentage int `json:"targetPercentage"`
+}
+
+// HorizontalPodAutoscalerSpec is the specification of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerSpec struct {
+	// ScaleRef is a reference to the object whose replica count is managed by
+	// the autoscaler.
+	ScaleRef ScaleReference `json:"scaleRef"`
+
+	// MinReplicas is the lower limit for the number of pods that can be set by
+	// the autoscaler.
+	MinReplicas *int `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of pods that can be set by
+	// the autoscaler. It cannot be smaller than MinReplicas.
+	MaxReplicas int `json:"maxReplicas"`
+
+	// CPUUtilization is the target average CPU utilization over all the pods.
+	// If not specified, the autoscaler uses its default target.
+	CPUUtilization *CPUTargetUtilization `json:"cpuUtilization,omitempty"`
+}
+
+// HorizontalPodAutoscalerStatus is the current status of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	// LastScaleTime is the last time the autoscaler changed the number of pods.
+	// It is used to enforce the upscale and downscale stabilization windows.
+	LastScaleTime *util.Time `json:"lastScaleTime,omitempty"`
+
+	// CurrentReplicas is the number of pods managed by the autoscaler as last
+	// seen by the autoscaler.
+	CurrentReplicas int `json:"currentReplicas"`
+
+	// DesiredReplicas is the number of pods the autoscaler last computed as
+	// desired.
+	DesiredReplicas int `json:"desiredReplicas"`
+
+	// CurrentCPUUtilizationPercentage is the average CPU utilization over all
+	// the pods, represented as a percentage of requested CPU.
+	CurrentCPUUtilizationPercentage *int `json:"currentCPUUtilizationPercentage,omitempty"`
+}
+
+// HorizontalPodAutoscaler represents the configuration of a horizontal pod
+// autoscaler.
+type HorizontalPodAutoscaler struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the behaviour of the autoscaler.
+	Spec HorizontalPodAutoscalerSpec `json:"spec,omitempty"`
+
+	// Status is the current information about the autoscaler.
+	Status HorizontalPodAutoscalerStatus `json:"status,omitempty"`
+}
+
+// HorizontalPodAutoscalerList is a list of horizontal pod autoscalers.
+type HorizontalPodAutoscalerList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []HorizontalPodAutoscaler `json:"items"`
+}
+
 const (
 	// ClusterIPNone - do not assign a cluster IP
 	// no proxying required and no environment variables should be created for pods
@@ -1348,6 +1749,10 @@ type NodeConditionType string
 const (
 	// NodeReady means kubelet is healthy and ready to accept pods.
 	NodeReady NodeConditionType = "Ready"
+	// NodeMemoryPressure means the kubelet is under pressure due to insufficient available memory.
+	NodeMemoryPressure NodeConditionType = "MemoryPressure"
+	// NodeDiskPressure means the kubelet is under pressure due to insufficient available disk.
+	NodeDiskPressure NodeConditionType = "DiskPressure"
 )
 
 type NodeCondition struct {
@@ -1500,7 +1905,8 @@ type ListOptions struct {
 	FieldSelector fields.Selector
 	// If true, watch for changes to this list
 	Watch bool
-	// The resource version to watch (no effect on list yet)
+	// For a watch, the resource version to watch from. For a list, the
+	// resource version the returned list must be at least as fresh as.
 	ResourceVersion string
 }
 
@@ -1516,6 +1922,40 @@ type PodLogOptions struct {
 
 	// If true, return previous terminated container logs
 	Previous bool
+
+	// A relative time in seconds before the current time from which to show logs. If this value
+	// precedes the time a pod was started, only logs since the pod start will be returned.
+	// Only one of SinceSeconds or SinceTime may be specified.
+	SinceSeconds *int64
+
+	// An RFC3339 timestamp from which to show logs. If this value precedes the time a pod
+	// was started, only logs since the pod start will be returned.
+	// Only one of SinceSeconds or SinceTime may be specified.
+	SinceTime *util.Time
+
+	// If true, add an RFC3339 or RFC3339Nano timestamp at the beginning of every line
+	// of log output.
+	Timestamps bool
+}
+
+// PodAttachOptions is the query options to a Pod's remote attach call
+type PodAttachOptions struct {
+	TypeMeta
+
+	// Stdin if true indicates that stdin is to be redirected for the attach call
+	Stdin bool
+
+	// Stdout if true indicates that stdout is to be redirected for the attach call
+	Stdout bool
+
+	// Stderr if true indicates that stderr is to be redirected for the attach call
+	Stderr bool
+
+	// TTY if true indicates that a tty will be allocated for the attach call
+	TTY bool
+
+	// Container to attach to.
+	Container string
 }
 
 // PodExecOptions is the query options to a Pod's remote exec call
@@ -1999,6 +2439,24 @@ type SecretList struct {
 	Items []Secret `json:"items"`
 }
 
+// ConfigMap holds configuration data for pods to consume.
+type ConfigMap struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Data contains the configuration data. Each key must be a valid
+	// DNS_SUBDOMAIN or leading dot followed by valid DNS_SUBDOMAIN.
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// ConfigMapList is a list of ConfigMaps.
+type ConfigMapList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ConfigMap `json:"items"`
+}
+
 // These constants are for remote command execution and port forwarding and are
 // used by both the client side and server side components.
 //
@@ -228,6 +228,163 @@ func TestSetDefaultReplicationControllerReplicas(t *testing.T) {
 	}
 }
 
+func TestSetDefaultJob(t *testing.T) {
+	template := &versioned.PodTemplateSpec{
+		ObjectMeta: versioned.ObjectMeta{
+			Labels: map[string]string{"foo": "bar"},
+		},
+	}
+	tests := []struct {
+		job               versioned.Job
+		expectCompletions int
+		expectParallelism int
+	}{
+		{
+			job:               versioned.Job{Spec: versioned.JobSpec{Template: template}},
+			expectCompletions: 1,
+			expectParallelism: 1,
+		},
+		{
+			job:               versioned.Job{Spec: versioned.JobSpec{Completions: newInt(5), Template: template}},
+			expectCompletions: 5,
+			expectParallelism: 5,
+		},
+		{
+			job:               versioned.Job{Spec: versioned.JobSpec{Completions: newInt(5), Parallelism: newInt(2), Template: template}},
+			expectCompletions: 5,
+			expectParallelism: 2,
+		},
+	}
+
+	for _, test := range tests {
+		obj2 := roundTrip(t, runtime.Object(&test.job))
+		job2, ok := obj2.(*versioned.Job)
+		if !ok {
+			t.Fatalf("unexpected object: %v", obj2)
+		}
+		if job2.Spec.Completions == nil || *job2.Spec.Completions != test.expectCompletions {
+			t.Errorf("expected %d completions, got %v", test.expectCompletions, job2.Spec.Completions)
+		}
+		if job2.Spec.Parallelism == nil || *job2.Spec.Parallelism != test.expectParallelism {
+			t.Errorf("expected %d parallelism, got %v", test.expectParallelism, job2.Spec.Parallelism)
+		}
+		if !reflect.DeepEqual(job2.Spec.Selector, template.Labels) {
+			t.Errorf("expected selector to default to %v, got %v", template.Labels, job2.Spec.Selector)
+		}
+		if !reflect.DeepEqual(job2.Labels, template.Labels) {
+			t.Errorf("expected labels to default to %v, got %v", template.Labels, job2.Labels)
+		}
+	}
+}
+
+func TestSetDefaultDaemonSet(t *testing.T) {
+	template := &versioned.PodTemplateSpec{
+		ObjectMeta: versioned.ObjectMeta{
+			Labels: map[string]string{"foo": "bar"},
+		},
+	}
+	tests := []struct {
+		ds             versioned.DaemonSet
+		expectSelector map[string]string
+		expectLabels   map[string]string
+	}{
+		{
+			ds:             versioned.DaemonSet{Spec: versioned.DaemonSetSpec{Template: template}},
+			expectSelector: map[string]string{"foo": "bar"},
+			expectLabels:   map[string]string{"foo": "bar"},
+		},
+		{
+			ds: versioned.DaemonSet{
+				ObjectMeta: versioned.ObjectMeta{Labels: map[string]string{"app": "logging"}},
+				Spec:       versioned.DaemonSetSpec{Selector: map[string]string{"foo": "bar"}, Template: template},
+			},
+			expectSelector: map[string]string{"foo": "bar"},
+			expectLabels:   map[string]string{"app": "logging"},
+		},
+	}
+
+	for _, test := range tests {
+		obj2 := roundTrip(t, runtime.Object(&test.ds))
+		ds2, ok := obj2.(*versioned.DaemonSet)
+		if !ok {
+			t.Fatalf("unexpected object: %v", obj2)
+		}
+		if !reflect.DeepEqual(ds2.Spec.Selector, test.expectSelector) {
+			t.Errorf("expected selector %v, got %v", test.expectSelector, ds2.Spec.Selector)
+		}
+		if !reflect.DeepEqual(ds2.Labels, test.expectLabels) {
+			t.Errorf("expected labels %v, got %v", test.expectLabels, ds2.Labels)
+		}
+	}
+}
+
+func TestSetDefaultDeployment(t *testing.T) {
+	template := &versioned.PodTemplateSpec{
+		ObjectMeta: versioned.ObjectMeta{
+			Labels: map[string]string{"foo": "bar"},
+		},
+	}
+	d := &versioned.Deployment{Spec: versioned.DeploymentSpec{Template: template}}
+	obj2 := roundTrip(t, runtime.Object(d))
+	d2, ok := obj2.(*versioned.Deployment)
+	if !ok {
+		t.Fatalf("unexpected object: %v", obj2)
+	}
+	if !reflect.DeepEqual(d2.Spec.Selector, template.Labels) {
+		t.Errorf("expected selector %v, got %v", template.Labels, d2.Spec.Selector)
+	}
+	if !reflect.DeepEqual(d2.Labels, template.Labels) {
+		t.Errorf("expected labels %v, got %v", template.Labels, d2.Labels)
+	}
+	if d2.Spec.Replicas == nil || *d2.Spec.Replicas != 1 {
+		t.Errorf("expected 1 replica, got %v", d2.Spec.Replicas)
+	}
+	if d2.Spec.UniqueLabelKey == nil || *d2.Spec.UniqueLabelKey != versioned.DefaultDeploymentUniqueLabelKey {
+		t.Errorf("expected unique label key %q, got %v", versioned.DefaultDeploymentUniqueLabelKey, d2.Spec.UniqueLabelKey)
+	}
+	strategy := d2.Spec.Strategy
+	if strategy.Type != versioned.RollingUpdateDeploymentStrategyType {
+		t.Errorf("expected strategy %q, got %q", versioned.RollingUpdateDeploymentStrategyType, strategy.Type)
+	}
+	one := util.NewIntOrStringFromInt(1)
+	if strategy.RollingUpdate == nil {
+		t.Fatalf("expected rolling update parameters to be defaulted")
+	}
+	if strategy.RollingUpdate.MaxUnavailable == nil || *strategy.RollingUpdate.MaxUnavailable != one {
+		t.Errorf("expected maxUnavailable %v, got %v", one, strategy.RollingUpdate.MaxUnavailable)
+	}
+	if strategy.RollingUpdate.MaxSurge == nil || *strategy.RollingUpdate.MaxSurge != one {
+		t.Errorf("expected maxSurge %v, got %v", one, strategy.RollingUpdate.MaxSurge)
+	}
+
+	recreate := &versioned.Deployment{Spec: versioned.DeploymentSpec{
+		Template: template,
+		Strategy: versioned.DeploymentStrategy{Type: versioned.RecreateDeploymentStrategyType},
+	}}
+	obj2 = roundTrip(t, runtime.Object(recreate))
+	d2 = obj2.(*versioned.Deployment)
+	if d2.Spec.Strategy.RollingUpdate != nil {
+		t.Errorf("expected no rolling update parameters for recreate strategy, got %v", d2.Spec.Strategy.RollingUpdate)
+	}
+}
+
+func TestSetDefaultHorizontalPodAutoscaler(t *testing.T) {
+	hpa := &versioned.HorizontalPodAutoscaler{
+		Spec: versioned.HorizontalPodAutoscalerSpec{
+			ScaleRef:    versioned.ScaleReference{Kind: "ReplicationController", Name: "frontend"},
+			MaxReplicas: 5,
+		},
+	}
+	obj2 := roundTrip(t, runtime.Object(hpa))
+	hpa2 := obj2.(*versioned.HorizontalPodAutoscaler)
+	if hpa2.Spec.MinReplicas == nil || *hpa2.Spec.MinReplicas != 1 {
+		t.Errorf("expected minReplicas 1, got %v", hpa2.Spec.MinReplicas)
+	}
+	if hpa2.Spec.CPUUtilization != nil {
+		t.Errorf("expected cpuUtilization to be left unset, got %v", hpa2.Spec.CPUUtilization)
+	}
+}
+
 func TestSetDefaultService(t *testing.T) {
 	svc := &versioned.Service{}
 	obj2 := roundTrip(t, runtime.Object(svc))
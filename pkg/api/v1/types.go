@@ -191,6 +191,10 @@ type VolumeSource struct {
 	GitRepo *GitRepoVolumeSource `json:"gitRepo,omitempty" description:"git repository at a particular revision"`
 	// Secret represents a secret that should populate this volume.
 	Secret *SecretVolumeSource `json:"secret,omitempty" description:"secret to populate volume"`
+	// ConfigMap represents a ConfigMap that should populate this volume.
+	ConfigMap *ConfigMapVolumeSource `json:"configMap,omitempty" description:"ConfigMap to populate volume"`
+	// DownwardAPI represents metadata about the pod that should populate this volume.
+	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI,omitempty" description:"metadata about the pod that should populate this volume"`
 	// NFS represents an NFS mount on the host that shares a pod's lifetime
 	NFS *NFSVolumeSource `json:"nfs,omitempty" description:"NFS volume that will be mounted in the host machine"`
 	// ISCSI represents an ISCSI Disk resource that is attached to a
@@ -495,6 +499,34 @@ type SecretVolumeSource struct {
 	SecretName string `json:"secretName" description:"secretName is the name of a secret in the pod's namespace"`
 }
 
+// ConfigMapVolumeSource adapts a ConfigMap into a VolumeSource.
+//
+// The contents of the target ConfigMap's Data field will be presented in a
+// volume as files using the keys in the Data field as the file names. The
+// files are kept up to date as the ConfigMap changes.
+type ConfigMapVolumeSource struct {
+	// Name of the ConfigMap in the pod's namespace to use
+	Name string `json:"name" description:"name of a ConfigMap in the pod's namespace"`
+}
+
+// DownwardAPIVolumeSource represents a volume containing downward API info.
+//
+// Each item selects a field of the pod and names the file, relative to the
+// volume root, that the field's value is written to. The files are updated
+// as the pod's metadata changes.
+type DownwardAPIVolumeSource struct {
+	// Items is a list of downward API volume files
+	Items []DownwardAPIVolumeFile `json:"items,omitempty" description:"list of downward API volume files"`
+}
+
+// DownwardAPIVolumeFile represents a single file containing information from the downward API.
+type DownwardAPIVolumeFile struct {
+	// Required: Path is the relative path name of the file to be created.
+	Path string `json:"path" description:"the relative path name of the file to be created; must not be absolute or contain the '..' path element"`
+	// Required: Selects a field of the pod; only annotations, labels, name and namespace are supported.
+	FieldRef ObjectFieldSelector `json:"fieldRef" description:"selects a field of the pod; only annotations, labels, name and namespace are supported"`
+}
+
 // NFSVolumeSource represents an NFS mount that lasts the lifetime of a pod
 type NFSVolumeSource struct {
 	// Server is the hostname or IP address of the NFS server
@@ -572,8 +604,10 @@ type EnvVar struct {
 
 // EnvVarSource represents a source for the value of an EnvVar.
 type EnvVarSource struct {
-	// Required: Selects a field of the pod; only name and namespace are supported.
-	FieldRef *ObjectFieldSelector `json:"fieldRef" description:"selects a field of the pod; only name and namespace are supported"`
+	// Selects a field of the pod; only name and namespace are supported.
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty" description:"selects a field of the pod; only name and namespace are supported"`
+	// Selects a key of a ConfigMap in the pod's namespace.
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty" description:"selects a key of a ConfigMap in the pod's namespace"`
 }
 
 // ObjectFieldSelector selects an APIVersioned field of an object.
@@ -584,6 +618,14 @@ type ObjectFieldSelector struct {
 	FieldPath string `json:"fieldPath" description:"path of the field to select in the specified API version"`
 }
 
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Required: Name of the ConfigMap in the pod's namespace
+	Name string `json:"name" description:"name of a ConfigMap in the pod's namespace"`
+	// Required: The key of the ConfigMap to select
+	Key string `json:"key" description:"key of the ConfigMap to select"`
+}
+
 // HTTPGetAction describes an action based on HTTP Get requests.
 type HTTPGetAction struct {
 	// Optional: Path to access on the HTTP server.
@@ -704,6 +746,11 @@ type Container struct {
 	ImagePullPolicy PullPolicy `json:"imagePullPolicy,omitempty" description:"image pull policy; one of Always, Never, IfNotPresent; defaults to Always if :latest tag is specified, or IfNotPresent otherwise; cannot be updated"`
 	// Optional: SecurityContext defines the security options the pod should be run with
 	SecurityContext *SecurityContext `json:"securityContext,omitempty" description:"security options the pod should run with"`
+
+	// Variables for interactive containers, these have very specialized use-cases (e.g. debugging)
+	// and shouldn't be used for general purpose containers.
+	Stdin bool `json:"stdin,omitempty" description:"Whether this container should allocate a buffer for stdin in the container runtime; default is false"`
+	TTY   bool `json:"tty,omitempty" description:"Whether this container should allocate a TTY for itself, also requires 'stdin' to be true; default is false"`
 }
 
 // Handler defines a specific action that should be taken
@@ -830,6 +877,22 @@ type PodCondition struct {
 	Status ConditionStatus `json:"status" description:"status of the condition, one of True, False, Unknown"`
 }
 
+// PodQOSClass is the quality of service class of a pod, derived from the
+// resource requests and limits of its containers.
+type PodQOSClass string
+
+// These are valid quality of service classes of pods.
+const (
+	// PodQOSGuaranteed means every container sets limits, and requests equal
+	// to them, for both cpu and memory.
+	PodQOSGuaranteed PodQOSClass = "Guaranteed"
+	// PodQOSBurstable means some container sets requests or limits, but the
+	// pod does not qualify as guaranteed.
+	PodQOSBurstable PodQOSClass = "Burstable"
+	// PodQOSBestEffort means no container sets requests or limits.
+	PodQOSBestEffort PodQOSClass = "BestEffort"
+)
+
 // RestartPolicy describes how the container should be restarted.
 // Only one of the following restart policies may be specified.
 // If none of the following policies is specified, the default one
@@ -910,6 +973,8 @@ type PodStatus struct {
 	// The list has one entry per container in the manifest. Each entry is currently the output
 	// of `docker inspect`.
 	ContainerStatuses []ContainerStatus `json:"containerStatuses,omitempty" description:"list of container statuses"`
+
+	QOSClass PodQOSClass `json:"qosClass,omitempty" description:"quality of service class of the pod derived from the requests and limits of its containers: Guaranteed, Burstable or BestEffort; set by the Kubelet"`
 }
 
 // PodStatusResult is a wrapper for PodStatus returned by kubelet that can be encode/decoded
@@ -1021,6 +1086,324 @@ type ReplicationControllerList struct {
 	Items []ReplicationController `json:"items" description:"list of replication controllers"`
 }
 
+// JobSpec describes how the job execution will look like.
+type JobSpec struct {
+	// Parallelism specifies the maximum desired number of pods the job should
+	// run at any given time.
+	Parallelism *int `json:"parallelism,omitempty" description:"maximum number of pods the job should run at any given time; defaults to completions"`
+
+	// Completions specifies the desired number of successfully finished pods the
+	// job should be run with.
+	Completions *int `json:"completions,omitempty" description:"desired number of successfully finished pods; defaults to 1"`
+
+	// ActiveDeadlineSeconds is the duration in seconds relative to the StartTime
+	// that the job may be active before the system tries to terminate it.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty" description:"optional duration in seconds relative to the job's start time that the job may be active before the system actively tries to terminate it; value must be a positive integer"`
+
+	// Selector is a label query over pods that should match the pod count.
+	// If Selector is empty, it is defaulted to the labels present on the Pod template.
+	Selector map[string]string `json:"selector,omitempty" description:"label keys and values that must match in order to be counted by this job, if empty defaulted to labels on Pod template"`
+
+	// Template is the object that describes the pod that will be created when
+	// executing a job.
+	Template *PodTemplateSpec `json:"template,omitempty" description:"object that describes the pod that will be created when executing a job"`
+}
+
+// JobStatus represents the current state of a Job.
+type JobStatus struct {
+	// Conditions represent the latest available observations of an object's current state.
+	Conditions []JobCondition `json:"conditions,omitempty" description:"latest available observations of the job's current state"`
+
+	// StartTime represents time when the job was acknowledged by the Job Manager.
+	StartTime *util.Time `json:"startTime,omitempty" description:"RFC 3339 date and time at which the job was acknowledged by the job controller"`
+
+	// CompletionTime represents time when the job was completed.
+	CompletionTime *util.Time `json:"completionTime,omitempty" description:"RFC 3339 date and time at which the job completed"`
+
+	// Active is the number of actively running pods.
+	Active int `json:"active,omitempty" description:"number of actively running pods"`
+
+	// Succeeded is the number of pods which reached Phase Succeeded.
+	Succeeded int `json:"succeeded,omitempty" description:"number of pods which reached phase Succeeded"`
+
+	// Failed is the number of pods which reached Phase Failed.
+	Failed int `json:"failed,omitempty" description:"number of pods which reached phase Failed"`
+}
+
+type JobConditionType string
+
+// These are valid conditions of a job.
+const (
+	// JobComplete means the job has completed its execution.
+	JobComplete JobConditionType = "Complete"
+	// JobFailed means the job has failed its execution.
+	JobFailed JobConditionType = "Failed"
+)
+
+// JobCondition describes current state of a job.
+type JobCondition struct {
+	Type               JobConditionType `json:"type" description:"type of job condition, Complete or Failed"`
+	Status             ConditionStatus  `json:"status" description:"status of the condition, one of True, False, Unknown"`
+	LastProbeTime      util.Time        `json:"lastProbeTime,omitempty" description:"last time the condition was checked"`
+	LastTransitionTime util.Time        `json:"lastTransitionTime,omitempty" description:"last time the condition transit from one status to another"`
+	Reason             string           `json:"reason,omitempty" description:"(brief) reason for the condition's last transition"`
+	Message            string           `json:"message,omitempty" description:"human readable message indicating details about last transition"`
+}
+
+// Job represents the configuration of a single job.
+type Job struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	// Spec is a structure defining the expected behavior of a job.
+	Spec JobSpec `json:"spec,omitempty" description:"specification of the desired behavior of the job; http://docs.k8s.io/api-conventions.md#spec-and-status"`
+
+	// Status is a structure describing current status of a job.
+	Status JobStatus `json:"status,omitempty" description:"most recently observed status of the job; populated by the system, read-only; http://docs.k8s.io/api-conventions.md#spec-and-status"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	Items []Job `json:"items" description:"list of jobs"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	// Selector is a label query over pods that are managed by the daemon set.
+	// If Selector is empty, it is defaulted to the labels present on the Pod template.
+	Selector map[string]string `json:"selector,omitempty" description:"label keys and values that must match in order to be controlled by this daemon set, if empty defaulted to labels on Pod template"`
+
+	// Template is the object that describes the pod that will be created on
+	// every node that matches the template's node selector.
+	Template *PodTemplateSpec `json:"template,omitempty" description:"object that describes the pod that will be created on every eligible node; the node selector of the template restricts the eligible nodes"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	// CurrentNumberScheduled is the number of nodes that are running exactly one
+	// daemon pod and are supposed to run the daemon pod.
+	CurrentNumberScheduled int `json:"currentNumberScheduled" description:"number of nodes that are running exactly one daemon pod and are supposed to run the daemon pod"`
+
+	// NumberMisscheduled is the number of nodes that are running the daemon pod,
+	// but are not supposed to run the daemon pod.
+	NumberMisscheduled int `json:"numberMisscheduled" description:"number of nodes that are running the daemon pod, but are not supposed to run the daemon pod"`
+
+	// DesiredNumberScheduled is the total number of nodes that should be running
+	// the daemon pod (including nodes correctly running the daemon pod).
+	DesiredNumberScheduled int `json:"desiredNumberScheduled" description:"total number of nodes that should be running the daemon pod"`
+}
+
+// DaemonSet represents the configuration of a daemon set.
+type DaemonSet struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of this daemon set.
+	Spec DaemonSetSpec `json:"spec,omitempty" description:"specification of the desired behavior of the daemon set; http://docs.k8s.io/api-conventions.md#spec-and-status"`
+
+	// Status is the current status of this daemon set.
+	Status DaemonSetStatus `json:"status,omitempty" description:"most recently observed status of the daemon set; populated by the system, read-only; http://docs.k8s.io/api-conventions.md#spec-and-status"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	Items []DaemonSet `json:"items" description:"list of daemon sets"`
+}
+
+// DeploymentSpec is the specification of the desired behavior of a Deployment.
+type DeploymentSpec struct {
+	// Replicas is the number of desired pods.
+	Replicas *int `json:"replicas,omitempty" description:"number of desired pods; defaults to 1"`
+
+	// Selector is a label query over pods that should match the Replicas count.
+	// If Selector is empty, it is defaulted to the labels present on the Pod template.
+	Selector map[string]string `json:"selector,omitempty" description:"label keys and values that must match in order to be controlled by this deployment, if empty defaulted to labels on Pod template"`
+
+	// Template describes the pods that will be created.
+	Template *PodTemplateSpec `json:"template,omitempty" description:"object that describes the pod that will be created"`
+
+	// Strategy is the deployment strategy to use to replace existing pods with new ones.
+	Strategy DeploymentStrategy `json:"strategy,omitempty" description:"deployment strategy to use to replace existing pods with new ones"`
+
+	// UniqueLabelKey is the key of the selector that is added to existing
+	// replication controllers (and label key that is added to their pods) to
+	// prevent the existing controllers from selecting new pods.
+	UniqueLabelKey *string `json:"uniqueLabelKey,omitempty" description:"key of the selector that is added to replication controllers owned by this deployment to tell them apart; defaults to deployment.kubernetes.io/podTemplateHash"`
+
+	// RevisionHistoryLimit is the number of old replication controllers to
+	// retain to allow rollback.
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit,omitempty" description:"number of old replication controllers to retain to allow rollback; all are kept if unset"`
+
+	// RollbackTo is the config this deployment is rolling back to.
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty" description:"the revision this deployment is rolling back to; cleared once the rollback is done"`
+}
+
+// RollbackConfig describes the revision a deployment should roll back to.
+type RollbackConfig struct {
+	// Revision is the revision to roll back to.
+	Revision int64 `json:"revision,omitempty" description:"revision to roll back to; 0 rolls back to the last revision"`
+}
+
+const (
+	// DefaultDeploymentUniqueLabelKey is the default key of the selector that is
+	// added to existing replication controllers (and label key that is added to
+	// its pods) to prevent the existing controllers from selecting new pods.
+	DefaultDeploymentUniqueLabelKey string = "deployment.kubernetes.io/podTemplateHash"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	// Type of deployment. Can be "Recreate" or "RollingUpdate".
+	Type DeploymentStrategyType `json:"type,omitempty" description:"type of deployment, Recreate or RollingUpdate; defaults to RollingUpdate"`
+
+	// RollingUpdate is the rolling update config params.
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty" description:"rolling update config params; present only if type is RollingUpdate"`
+}
+
+type DeploymentStrategyType string
+
+const (
+	// RecreateDeploymentStrategyType kills all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+
+	// RollingUpdateDeploymentStrategyType replaces the old controllers by the
+	// new one using rolling update i.e gradually scale down the old controllers
+	// and scale up the new one.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+)
+
+// RollingUpdateDeployment is the spec to control the desired behavior of a
+// rolling update.
+type RollingUpdateDeployment struct {
+	// MaxUnavailable is the maximum number of pods that can be unavailable
+	// during the update. Absolute number is calculated from percentage by
+	// rounding down.
+	MaxUnavailable *util.IntOrString `json:"maxUnavailable,omitempty" description:"maximum number or percentage of pods that can be unavailable during the update; percentages are rounded down; defaults to 1"`
+
+	// MaxSurge is the maximum number of pods that can be scheduled above the
+	// original number of pods.
+	MaxSurge *util.IntOrString `json:"maxSurge,omitempty" description:"maximum number or percentage of pods that can be scheduled above the desired number of pods; defaults to 1"`
+
+	// MinReadySeconds is the minimum number of seconds for which a newly
+	// created pod should be ready for it to be considered available.
+	MinReadySeconds int `json:"minReadySeconds,omitempty" description:"minimum number of seconds for which a newly created pod should be ready for it to be considered available; defaults to 0"`
+}
+
+// DeploymentStatus is the most recently observed status of the Deployment.
+type DeploymentStatus struct {
+	// Replicas is the total number of running pods targeted by this deployment.
+	Replicas int `json:"replicas,omitempty" description:"total number of running pods targeted by this deployment"`
+
+	// UpdatedReplicas is the total number of running pods targeted by this
+	// deployment that have the desired template spec.
+	UpdatedReplicas int `json:"updatedReplicas,omitempty" description:"total number of running pods targeted by this deployment that have the desired template spec"`
+}
+
+// Deployment enables declarative updates for pods and replication controllers.
+type Deployment struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	// Spec is the specification of the desired behavior of the Deployment.
+	Spec DeploymentSpec `json:"spec,omitempty" description:"specification of the desired behavior of the deployment; http://docs.k8s.io/api-conventions.md#spec-and-status"`
+
+	// Status is the most recently observed status of the Deployment.
+	Status DeploymentStatus `json:"status,omitempty" description:"most recently observed status of the deployment; populated by the system, read-only; http://docs.k8s.io/api-conventions.md#spec-and-status"`
+}
+
+// DeploymentList is a list of Deployments.
+type DeploymentList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	Items []Deployment `json:"items" description:"list of deployments"`
+}
+
+// ScaleReference identifies the object whose replica count is managed by a
+// horizontal pod autoscaler. The object lives in the autoscaler's namespace.
+type ScaleReference struct {
+	// Kind of the referent; only ReplicationController is supported.
+	Kind string `json:"kind,omitempty" description:"kind of the referent; only ReplicationController is supported"`
+
+	// Name of the referent.
+	Name string `json:"name,omitempty" description:"name of the referent"`
+
+	// APIVersion of the referent.
+	APIVersion string `json:"apiVersion,omitempty" description:"API version of the referent"`
+}
+
+// CPUTargetUtilization is the target average CPU utilization of the pods of
+// the scaled object, expressed as a percentage of the requested CPU.
+type CPUTargetUtilization struct {
+	// TargetPercentage is the target average CPU utilization (represented as a
+	// percentage of requested CPU) over all the pods.
+	TargetPercentage int `json:"targetPercentage" description:"target average CPU utilization (represented as a percentage of requested CPU) over all the pods"`
+}
+
+// HorizontalPodAutoscalerSpec is the specification of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerSpec struct {
+	// ScaleRef is a reference to the object whose replica count is managed by
+	// the autoscaler.
+	ScaleRef ScaleReference `json:"scaleRef" description:"reference to the object whose replica count is managed by the autoscaler"`
+
+	// MinReplicas is the lower limit for the number of pods that can be set by
+	// the autoscaler.
+	MinReplicas *int `json:"minReplicas,omitempty" description:"lower limit for the number of pods that can be set by the autoscaler; defaults to 1"`
+
+	// MaxReplicas is the upper limit for the number of pods that can be set by
+	// the autoscaler. It cannot be smaller than MinReplicas.
+	MaxReplicas int `json:"maxReplicas" description:"upper limit for the number of pods that can be set by the autoscaler; cannot be smaller than minReplicas"`
+
+	// CPUUtilization is the target average CPU utilization over all the pods.
+	// If not specified, the autoscaler uses its default target.
+	CPUUtilization *CPUTargetUtilization `json:"cpuUtilization,omitempty" description:"target average CPU utilization over all the pods; if not specified the autoscaler uses its default target"`
+}
+
+// HorizontalPodAutoscalerStatus is the current status of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	// LastScaleTime is the last time the autoscaler changed the number of pods.
+	LastScaleTime *util.Time `json:"lastScaleTime,omitempty" description:"last time the autoscaler changed the number of pods"`
+
+	// CurrentReplicas is the number of pods managed by the autoscaler as last
+	// seen by the autoscaler.
+	CurrentReplicas int `json:"currentReplicas" description:"number of pods managed by the autoscaler as last seen by the autoscaler"`
+
+	// DesiredReplicas is the number of pods the autoscaler last computed as
+	// desired.
+	DesiredReplicas int `json:"desiredReplicas" description:"number of pods the autoscaler last computed as desired"`
+
+	// CurrentCPUUtilizationPercentage is the average CPU utilization over all
+	// the pods, represented as a percentage of requested CPU.
+	CurrentCPUUtilizationPercentage *int `json:"currentCPUUtilizationPercentage,omitempty" description:"average CPU utilization over all the pods, represented as a percentage of requested CPU"`
+}
+
+// HorizontalPodAutoscaler represents the configuration of a horizontal pod
+// autoscaler.
+type HorizontalPodAutoscaler struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	// Spec defines the behaviour of the autoscaler.
+	Spec HorizontalPodAutoscalerSpec `json:"spec,omitempty" description:"behaviour of the autoscaler; http://docs.k8s.io/api-conventions.md#spec-and-status"`
+
+	// Status is the current information about the autoscaler.
+	Status HorizontalPodAutoscalerStatus `json:"status,omitempty" description:"current information about the autoscaler; populated by the system, read-only; http://docs.k8s.io/api-conventions.md#spec-and-status"`
+}
+
+// HorizontalPodAutoscalerList is a list of horizontal pod autoscalers.
+type HorizontalPodAutoscalerList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	Items []HorizontalPodAutoscaler `json:"items" description:"list of horizontal pod autoscalers"`
+}
+
 // Session Affinity Type string
 type ServiceAffinity string
 
@@ -1313,10 +1696,14 @@ type NodeConditionType string
 const (
 	// NodeReady means kubelet is healthy and ready to accept pods.
 	NodeReady NodeConditionType = "Ready"
+	// NodeMemoryPressure means the kubelet is under pressure due to insufficient available memory.
+	NodeMemoryPressure NodeConditionType = "MemoryPressure"
+	// NodeDiskPressure means the kubelet is under pressure due to insufficient available disk.
+	NodeDiskPressure NodeConditionType = "DiskPressure"
 )
 
 type NodeCondition struct {
-	Type               NodeConditionType `json:"type" description:"type of node condition, currently only Ready"`
+	Type               NodeConditionType `json:"type" description:"type of node condition: Ready, MemoryPressure or DiskPressure"`
 	Status             ConditionStatus   `json:"status" description:"status of the condition, one of True, False, Unknown"`
 	LastHeartbeatTime  util.Time         `json:"lastHeartbeatTime,omitempty" description:"last time we got an update on a given condition"`
 	LastTransitionTime util.Time         `json:"lastTransitionTime,omitempty" description:"last time the condition transit from one status to another"`
@@ -1471,6 +1858,35 @@ type PodLogOptions struct {
 
 	//  If true, return previous terminated container logs
 	Previous bool `json:"previous,omitempty" description:"return previous terminated container logs; defaults to false"`
+
+	// A relative time in seconds before the current time from which to show logs
+	SinceSeconds *int64 `json:"sinceSeconds,omitempty" description:"relative time in seconds before the current time from which to show logs; if this value precedes the time a pod was started, only logs since the pod start will be returned; only one of sinceSeconds or sinceTime may be specified"`
+
+	// An RFC3339 timestamp from which to show logs
+	SinceTime *util.Time `json:"sinceTime,omitempty" description:"RFC 3339 timestamp from which to show logs; if this value precedes the time a pod was started, only logs since the pod start will be returned; only one of sinceSeconds or sinceTime may be specified"`
+
+	// If true, add a timestamp at the beginning of every line of log output
+	Timestamps bool `json:"timestamps,omitempty" description:"add an RFC 3339 or RFC 3339 with nanoseconds timestamp at the beginning of every line of log output; defaults to false"`
+}
+
+// PodAttachOptions is the query options to a Pod's remote attach call
+type PodAttachOptions struct {
+	TypeMeta `json:",inline"`
+
+	// Stdin if true indicates that stdin is to be redirected for the attach call
+	Stdin bool `json:"stdin,omitempty" description:"redirect the standard input stream of the pod for this call; defaults to false"`
+
+	// Stdout if true indicates that stdout is to be redirected for the attach call
+	Stdout bool `json:"stdout,omitempty" description:"redirect the standard output stream of the pod for this call; defaults to true"`
+
+	// Stderr if true indicates that stderr is to be redirected for the attach call
+	Stderr bool `json:"stderr,omitempty" description:"redirect the standard error stream of the pod for this call; defaults to true"`
+
+	// TTY if true indicates that a tty will be allocated for the attach call
+	TTY bool `json:"tty,omitempty" description:"allocate a terminal for this attach call; defaults to false"`
+
+	// Container to attach to.
+	Container string `json:"container,omitempty" description:"the container in which to attach. Defaults to only container if there is only one container in the pod."`
 }
 
 // PodExecOptions is the query options to a Pod's remote exec call
@@ -1900,6 +2316,24 @@ type SecretList struct {
 	Items []Secret `json:"items" description:"items is a list of secret objects"`
 }
 
+// ConfigMap holds configuration data for pods to consume.
+type ConfigMap struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	// Data contains the configuration data. Each key must be a valid
+	// DNS_SUBDOMAIN or leading dot followed by valid DNS_SUBDOMAIN.
+	Data map[string]string `json:"data,omitempty" description:"configuration data; each key must be a valid DNS_SUBDOMAIN or leading dot followed by valid DNS_SUBDOMAIN"`
+}
+
+// ConfigMapList is a list of ConfigMaps.
+type ConfigMapList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	Items []ConfigMap `json:"items" description:"items is a list of ConfigMap objects"`
+}
+
 // Type and constants for component health validation.
 type ComponentConditionType string
 
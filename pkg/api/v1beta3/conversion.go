@@ -22,6 +22,7 @@ import (
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 )
 
 func addConversionFuncs() {
@@ -43,6 +44,10 @@ func addConversionFuncs() {
 		convert_api_StatusCause_To_v1beta3_StatusCause,
 		convert_api_ReplicationControllerSpec_To_v1beta3_ReplicationControllerSpec,
 		convert_v1beta3_ReplicationControllerSpec_To_api_ReplicationControllerSpec,
+		convert_api_DeploymentSpec_To_v1beta3_DeploymentSpec,
+		convert_v1beta3_DeploymentSpec_To_api_DeploymentSpec,
+		convert_api_RollingUpdateDeployment_To_v1beta3_RollingUpdateDeployment,
+		convert_v1beta3_RollingUpdateDeployment_To_api_RollingUpdateDeployment,
 	)
 	if err != nil {
 		// If one of the conversion functions is malformed, detect it immediately.
@@ -53,7 +58,9 @@ func addConversionFuncs() {
 	err = api.Scheme.AddFieldLabelConversionFunc("v1beta3", "Pod",
 		func(label, value string) (string, string, error) {
 			switch label {
-			case "metadata.name",
+			case "metadata.annotations",
+				"metadata.labels",
+				"metadata.name",
 				"metadata.namespace",
 				"status.phase":
 				return label, value, nil
@@ -264,6 +271,8 @@ func convert_v1beta3_Container_To_api_Container(in *Container, out *api.Containe
 	} else {
 		out.SecurityContext = nil
 	}
+	out.Stdin = in.Stdin
+	out.TTY = in.TTY
 	return nil
 }
 
@@ -355,6 +364,8 @@ func convert_api_Container_To_v1beta3_Container(in *api.Container, out *Containe
 	if out.SecurityContext != nil && out.SecurityContext.Capabilities != nil {
 		out.Capabilities = *out.SecurityContext.Capabilities
 	}
+	out.Stdin = in.Stdin
+	out.TTY = in.TTY
 	return nil
 }
 
@@ -776,3 +787,123 @@ func convert_v1beta3_ReplicationControllerSpec_To_api_ReplicationControllerSpec(
 	}
 	return nil
 }
+
+func convert_api_DeploymentSpec_To_v1beta3_DeploymentSpec(in *api.DeploymentSpec, out *DeploymentSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.DeploymentSpec))(in)
+	}
+	out.Replicas = new(int)
+	*out.Replicas = in.Replicas
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	if in.Template != nil {
+		out.Template = new(PodTemplateSpec)
+		if err := convert_api_PodTemplateSpec_To_v1beta3_PodTemplateSpec(in.Template, out.Template, s); err != nil {
+			return err
+		}
+	} else {
+		out.Template = nil
+	}
+	if err := s.Convert(&in.Strategy, &out.Strategy, 0); err != nil {
+		return err
+	}
+	out.UniqueLabelKey = new(string)
+	*out.UniqueLabelKey = in.UniqueLabelKey
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(RollbackConfig)
+		if err := convert_api_RollbackConfig_To_v1beta3_RollbackConfig(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_DeploymentSpec_To_api_DeploymentSpec(in *DeploymentSpec, out *api.DeploymentSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*DeploymentSpec))(in)
+	}
+	if in.Replicas != nil {
+		out.Replicas = *in.Replicas
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	if in.Template != nil {
+		out.Template = new(api.PodTemplateSpec)
+		if err := convert_v1beta3_PodTemplateSpec_To_api_PodTemplateSpec(in.Template, out.Template, s); err != nil {
+			return err
+		}
+	} else {
+		out.Template = nil
+	}
+	if err := s.Convert(&in.Strategy, &out.Strategy, 0); err != nil {
+		return err
+	}
+	if in.UniqueLabelKey != nil {
+		out.UniqueLabelKey = *in.UniqueLabelKey
+	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(api.RollbackConfig)
+		if err := convert_v1beta3_RollbackConfig_To_api_RollbackConfig(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
+	return nil
+}
+
+func convert_api_RollingUpdateDeployment_To_v1beta3_RollingUpdateDeployment(in *api.RollingUpdateDeployment, out *RollingUpdateDeployment, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.RollingUpdateDeployment))(in)
+	}
+	if out.MaxUnavailable == nil {
+		out.MaxUnavailable = &util.IntOrString{}
+	}
+	*out.MaxUnavailable = in.MaxUnavailable
+	if out.MaxSurge == nil {
+		out.MaxSurge = &util.IntOrString{}
+	}
+	*out.MaxSurge = in.MaxSurge
+	out.MinReadySeconds = in.MinReadySeconds
+	return nil
+}
+
+func convert_v1beta3_RollingUpdateDeployment_To_api_RollingUpdateDeployment(in *RollingUpdateDeployment, out *api.RollingUpdateDeployment, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*RollingUpdateDeployment))(in)
+	}
+	if in.MaxUnavailable != nil {
+		out.MaxUnavailable = *in.MaxUnavailable
+	}
+	if in.MaxSurge != nil {
+		out.MaxSurge = *in.MaxSurge
+	}
+	out.MinReadySeconds = in.MinReadySeconds
+	return nil
+}
@@ -45,6 +45,87 @@ func addDefaultingFuncs() {
 				*obj.Spec.Replicas = 0
 			}
 		},
+		func(obj *Job) {
+			var labels map[string]string
+			if obj.Spec.Template != nil {
+				labels = obj.Spec.Template.Labels
+			}
+			if labels != nil {
+				if len(obj.Spec.Selector) == 0 {
+					obj.Spec.Selector = labels
+				}
+				if len(obj.Labels) == 0 {
+					obj.Labels = labels
+				}
+			}
+			if obj.Spec.Completions == nil {
+				completions := 1
+				obj.Spec.Completions = &completions
+			}
+			if obj.Spec.Parallelism == nil {
+				parallelism := *obj.Spec.Completions
+				obj.Spec.Parallelism = &parallelism
+			}
+		},
+		func(obj *DaemonSet) {
+			var labels map[string]string
+			if obj.Spec.Template != nil {
+				labels = obj.Spec.Template.Labels
+			}
+			if labels != nil {
+				if len(obj.Spec.Selector) == 0 {
+					obj.Spec.Selector = labels
+				}
+				if len(obj.Labels) == 0 {
+					obj.Labels = labels
+				}
+			}
+		},
+		func(obj *Deployment) {
+			var labels map[string]string
+			if obj.Spec.Template != nil {
+				labels = obj.Spec.Template.Labels
+			}
+			if labels != nil {
+				if len(obj.Spec.Selector) == 0 {
+					obj.Spec.Selector = labels
+				}
+				if len(obj.Labels) == 0 {
+					obj.Labels = labels
+				}
+			}
+			if obj.Spec.Replicas == nil {
+				obj.Spec.Replicas = new(int)
+				*obj.Spec.Replicas = 1
+			}
+			strategy := &obj.Spec.Strategy
+			if strategy.Type == "" {
+				strategy.Type = RollingUpdateDeploymentStrategyType
+			}
+			if strategy.Type == RollingUpdateDeploymentStrategyType {
+				if strategy.RollingUpdate == nil {
+					strategy.RollingUpdate = &RollingUpdateDeployment{}
+				}
+				if strategy.RollingUpdate.MaxUnavailable == nil {
+					maxUnavailable := util.NewIntOrStringFromInt(1)
+					strategy.RollingUpdate.MaxUnavailable = &maxUnavailable
+				}
+				if strategy.RollingUpdate.MaxSurge == nil {
+					maxSurge := util.NewIntOrStringFromInt(1)
+					strategy.RollingUpdate.MaxSurge = &maxSurge
+				}
+			}
+			if obj.Spec.UniqueLabelKey == nil {
+				obj.Spec.UniqueLabelKey = new(string)
+				*obj.Spec.UniqueLabelKey = DefaultDeploymentUniqueLabelKey
+			}
+		},
+		func(obj *HorizontalPodAutoscaler) {
+			if obj.Spec.MinReplicas == nil {
+				minReplicas := 1
+				obj.Spec.MinReplicas = &minReplicas
+			}
+		},
 		func(obj *Volume) {
 			if util.AllPtrFieldsNil(&obj.VolumeSource) {
 				obj.VolumeSource = VolumeSource{
@@ -112,6 +112,34 @@ func ValidateReplicationControllerName(name string, prefix bool) (bool, string)
 	return nameIsDNSSubdomain(name, prefix)
 }
 
+// ValidateJobName can be used to check whether the given job name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateJobName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateDaemonSetName can be used to check whether the given daemon set name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateDaemonSetName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateDeploymentName can be used to check whether the given deployment name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateDeploymentName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateHorizontalPodAutoscalerName can be used to check whether the given autoscaler name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateHorizontalPodAutoscalerName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
 // ValidateServiceName can be used to check whether the given service name is valid.
 // Prefix indicates this name will be used as part of generation, in which case
 // trailing dashes are allowed.
@@ -155,6 +183,13 @@ func ValidateSecretName(name string, prefix bool) (bool, string) {
 	return nameIsDNSSubdomain(name, prefix)
 }
 
+// ValidateConfigMapName can be used to check whether the given ConfigMap name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateConfigMapName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
 // ValidateServiceAccountName can be used to check whether the given service account name is valid.
 // Prefix indicates this name will be used as part of generation, in which case
 // trailing dashes are allowed.
@@ -329,6 +364,14 @@ func validateSource(source *api.VolumeSource) errs.ValidationErrorList {
 		numVolumes++
 		allErrs = append(allErrs, validateSecretVolumeSource(source.Secret).Prefix("secret")...)
 	}
+	if source.ConfigMap != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateConfigMapVolumeSource(source.ConfigMap).Prefix("configMap")...)
+	}
+	if source.DownwardAPI != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateDownwardAPIVolumeSource(source.DownwardAPI).Prefix("downwardAPI")...)
+	}
 	if source.NFS != nil {
 		numVolumes++
 		allErrs = append(allErrs, validateNFS(source.NFS).Prefix("nfs")...)
@@ -425,6 +468,44 @@ func validateSecretVolumeSource(secretSource *api.SecretVolumeSource) errs.Valid
 	return allErrs
 }
 
+func validateConfigMapVolumeSource(configMapSource *api.ConfigMapVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if configMapSource.Name == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	} else if ok, msg := ValidateConfigMapName(configMapSource.Name, false); !ok {
+		allErrs = append(allErrs, errs.NewFieldInvalid("name", configMapSource.Name, msg))
+	}
+	return allErrs
+}
+
+var validDownwardAPIFieldPathExpressions = util.NewStringSet("metadata.annotations", "metadata.labels", "metadata.name", "metadata.namespace")
+
+func validateDownwardAPIVolumeSource(downwardAPIVolume *api.DownwardAPIVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	paths := util.StringSet{}
+	for i, file := range downwardAPIVolume.Items {
+		fileErrs := errs.ValidationErrorList{}
+		if len(file.Path) == 0 {
+			fileErrs = append(fileErrs, errs.NewFieldRequired("path"))
+		} else if path.IsAbs(file.Path) {
+			fileErrs = append(fileErrs, errs.NewFieldInvalid("path", file.Path, "must not be an absolute path"))
+		} else if strings.HasPrefix(file.Path, "..") {
+			fileErrs = append(fileErrs, errs.NewFieldInvalid("path", file.Path, "must not start with '..'"))
+		} else if util.NewStringSet(strings.Split(file.Path, "/")...).Has("..") {
+			fileErrs = append(fileErrs, errs.NewFieldInvalid("path", file.Path, "must not contain '..'"))
+		} else if util.NewStringSet(strings.Split(file.Path, "/")...).Has(".") {
+			fileErrs = append(fileErrs, errs.NewFieldInvalid("path", file.Path, "must not contain '.'"))
+		} else if paths.Has(path.Clean(file.Path)) {
+			fileErrs = append(fileErrs, errs.NewFieldDuplicate("path", file.Path))
+		} else {
+			paths.Insert(path.Clean(file.Path))
+		}
+		fileErrs = append(fileErrs, validateObjectFieldSelector(&file.FieldRef, validDownwardAPIFieldPathExpressions).Prefix("fieldRef")...)
+		allErrs = append(allErrs, fileErrs.PrefixIndex(i).Prefix("items")...)
+	}
+	return allErrs
+}
+
 func validatePersistentClaimVolumeSource(claim *api.PersistentVolumeClaimVolumeSource) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	if claim.ClaimName == "" {
@@ -646,12 +727,18 @@ func validateEnvVarValueFrom(ev api.EnvVar) errs.ValidationErrorList {
 
 	numSources := 0
 
-	switch {
-	case ev.ValueFrom.FieldRef != nil:
+	if ev.ValueFrom.FieldRef != nil {
+		numSources++
+		allErrs = append(allErrs, validateObjectFieldSelector(ev.ValueFrom.FieldRef, validFieldPathExpressions).Prefix("fieldRef")...)
+	}
+	if ev.ValueFrom.ConfigMapKeyRef != nil {
 		numSources++
-		allErrs = append(allErrs, validateObjectFieldSelector(ev.ValueFrom.FieldRef).Prefix("fieldRef")...)
+		allErrs = append(allErrs, validateConfigMapKeySelector(ev.ValueFrom.ConfigMapKeyRef).Prefix("configMapKeyRef")...)
 	}
 
+	if numSources > 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("", "", "may not have more than one source specified"))
+	}
 	if ev.Value != "" && numSources != 0 {
 		allErrs = append(allErrs, errs.NewFieldInvalid("", "", "sources cannot be specified when value is not empty"))
 	}
@@ -659,9 +746,26 @@ func validateEnvVarValueFrom(ev api.EnvVar) errs.ValidationErrorList {
 	return allErrs
 }
 
+func validateConfigMapKeySelector(s *api.ConfigMapKeySelector) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if s.Name == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	} else if ok, msg := ValidateConfigMapName(s.Name, false); !ok {
+		allErrs = append(allErrs, errs.NewFieldInvalid("name", s.Name, msg))
+	}
+	if s.Key == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("key"))
+	} else if !IsSecretKey(s.Key) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("key", s.Key, fmt.Sprintf("must have at most %d characters and match regex %s", util.DNS1123SubdomainMaxLength, SecretKeyFmt)))
+	}
+
+	return allErrs
+}
+
 var validFieldPathExpressions = util.NewStringSet("metadata.name", "metadata.namespace")
 
-func validateObjectFieldSelector(fs *api.ObjectFieldSelector) errs.ValidationErrorList {
+func validateObjectFieldSelector(fs *api.ObjectFieldSelector, expressions util.StringSet) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 
 	if fs.APIVersion == "" {
@@ -672,8 +776,8 @@ func validateObjectFieldSelector(fs *api.ObjectFieldSelector) errs.ValidationErr
 		internalFieldPath, _, err := api.Scheme.ConvertFieldLabel(fs.APIVersion, "Pod", fs.FieldPath, "")
 		if err != nil {
 			allErrs = append(allErrs, errs.NewFieldInvalid("fieldPath", fs.FieldPath, "error converting fieldPath"))
-		} else if !validFieldPathExpressions.Has(internalFieldPath) {
-			allErrs = append(allErrs, errs.NewFieldValueNotSupported("fieldPath", internalFieldPath, validFieldPathExpressions.List()))
+		} else if !expressions.Has(internalFieldPath) {
+			allErrs = append(allErrs, errs.NewFieldValueNotSupported("fieldPath", internalFieldPath, expressions.List()))
 		}
 	}
 
@@ -1203,6 +1307,356 @@ func ValidateReplicationControllerSpec(spec *api.ReplicationControllerSpec) errs
 	return allErrs
 }
 
+// ValidateJob tests if required fields in the job are set.
+func ValidateJob(job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&job.ObjectMeta, true, ValidateJobName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateJobSpec(&job.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateJobSpec tests if required fields in the job spec are set.
+func ValidateJobSpec(spec *api.JobSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if spec.Parallelism != nil && *spec.Parallelism < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("parallelism", *spec.Parallelism, isNegativeErrorMsg))
+	}
+	if spec.Completions != nil && *spec.Completions < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("completions", *spec.Completions, isNegativeErrorMsg))
+	}
+	if spec.ActiveDeadlineSeconds != nil && *spec.ActiveDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("activeDeadlineSeconds", *spec.ActiveDeadlineSeconds, "must be greater than 0"))
+	}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	}
+
+	if spec.Template == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("template"))
+	} else {
+		labels := labels.Set(spec.Template.Labels)
+		if !selector.Matches(labels) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+		}
+		parallelism := 1
+		if spec.Parallelism != nil {
+			parallelism = *spec.Parallelism
+		}
+		allErrs = append(allErrs, ValidatePodTemplateSpec(spec.Template, parallelism).Prefix("template")...)
+		// RestartPolicy has already been first-order validated as per ValidatePodTemplateSpec().
+		if spec.Template.Spec.RestartPolicy != api.RestartPolicyOnFailure &&
+			spec.Template.Spec.RestartPolicy != api.RestartPolicyNever {
+			allErrs = append(allErrs, errs.NewFieldValueNotSupported("template.spec.restartPolicy",
+				spec.Template.Spec.RestartPolicy, []string{string(api.RestartPolicyOnFailure), string(api.RestartPolicyNever)}))
+		}
+	}
+	return allErrs
+}
+
+// ValidateJobUpdate tests if required fields in the job are set, and that
+// only mutable fields of the spec are changed.
+func ValidateJobUpdate(oldJob, job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&job.ObjectMeta, &oldJob.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateJobSpecUpdate(&oldJob.Spec, &job.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateJobSpecUpdate tests that only the parallelism and the active
+// deadline of a job are changed on update.
+func ValidateJobSpecUpdate(oldSpec, spec *api.JobSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateJobSpec(spec)...)
+	if !api.Semantic.DeepEqual(oldSpec.Completions, spec.Completions) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("completions", spec.Completions, "field is immutable"))
+	}
+	if !api.Semantic.DeepEqual(oldSpec.Selector, spec.Selector) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("selector", spec.Selector, "field is immutable"))
+	}
+	if !api.Semantic.DeepEqual(oldSpec.Template, spec.Template) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template", "[omitted]", "field is immutable"))
+	}
+	return allErrs
+}
+
+// ValidateJobStatusUpdate tests that the status of a job is valid.
+func ValidateJobStatusUpdate(oldJob, job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&job.ObjectMeta, &oldJob.ObjectMeta).Prefix("metadata")...)
+	status := job.Status
+	if status.Active < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.active", status.Active, isNegativeErrorMsg))
+	}
+	if status.Succeeded < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.succeeded", status.Succeeded, isNegativeErrorMsg))
+	}
+	if status.Failed < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.failed", status.Failed, isNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+// ValidateDaemonSet tests if required fields in the daemon set are set.
+func ValidateDaemonSet(ds *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&ds.ObjectMeta, true, ValidateDaemonSetName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDaemonSetSpec(&ds.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDaemonSetSpec tests if required fields in the daemon set spec are set.
+func ValidateDaemonSetSpec(spec *api.DaemonSetSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	}
+
+	if spec.Template == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("template"))
+	} else {
+		labels := labels.Set(spec.Template.Labels)
+		if !selector.Matches(labels) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+		}
+		allErrs = append(allErrs, ValidatePodTemplateSpec(spec.Template, 1).Prefix("template")...)
+		// RestartPolicy has already been first-order validated as per ValidatePodTemplateSpec().
+		if spec.Template.Spec.RestartPolicy != api.RestartPolicyAlways {
+			allErrs = append(allErrs, errs.NewFieldValueNotSupported("template.spec.restartPolicy",
+				spec.Template.Spec.RestartPolicy, []string{string(api.RestartPolicyAlways)}))
+		}
+		if len(spec.Template.Spec.NodeName) != 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("template.spec.nodeName", spec.Template.Spec.NodeName, "must not be set, the daemon set assigns nodes itself"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateDaemonSetUpdate tests if required fields in the daemon set are set.
+func ValidateDaemonSetUpdate(oldDS, ds *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&ds.ObjectMeta, &oldDS.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDaemonSetSpec(&ds.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDaemonSetStatusUpdate tests that the status of a daemon set is valid.
+func ValidateDaemonSetStatusUpdate(oldDS, ds *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&ds.ObjectMeta, &oldDS.ObjectMeta).Prefix("metadata")...)
+	status := ds.Status
+	if status.CurrentNumberScheduled < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.currentNumberScheduled", status.CurrentNumberScheduled, isNegativeErrorMsg))
+	}
+	if status.NumberMisscheduled < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.numberMisscheduled", status.NumberMisscheduled, isNegativeErrorMsg))
+	}
+	if status.DesiredNumberScheduled < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.desiredNumberScheduled", status.DesiredNumberScheduled, isNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+// ValidateDeployment tests if required fields in the deployment are set.
+func ValidateDeployment(d *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&d.ObjectMeta, true, ValidateDeploymentName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDeploymentSpec(&d.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDeploymentSpec tests if required fields in the deployment spec are set.
+func ValidateDeploymentSpec(spec *api.DeploymentSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if spec.Replicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("replicas", spec.Replicas, isNegativeErrorMsg))
+	}
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("revisionHistoryLimit", *spec.RevisionHistoryLimit, isNegativeErrorMsg))
+	}
+	if spec.RollbackTo != nil && spec.RollbackTo.Revision < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("rollbackTo.revision", spec.RollbackTo.Revision, isNegativeErrorMsg))
+	}
+	if len(spec.UniqueLabelKey) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("uniqueLabelKey"))
+	} else if !util.IsQualifiedName(spec.UniqueLabelKey) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("uniqueLabelKey", spec.UniqueLabelKey, qualifiedNameErrorMsg))
+	}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	} else if _, found := spec.Selector[spec.UniqueLabelKey]; found {
+		allErrs = append(allErrs, errs.NewFieldInvalid("selector", spec.Selector, "must not contain the unique label key"))
+	}
+
+	if spec.Template == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("template"))
+	} else {
+		labels := labels.Set(spec.Template.Labels)
+		if !selector.Matches(labels) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+		}
+		allErrs = append(allErrs, ValidatePodTemplateSpec(spec.Template, spec.Replicas).Prefix("template")...)
+		// RestartPolicy has already been first-order validated as per ValidatePodTemplateSpec().
+		if spec.Template.Spec.RestartPolicy != api.RestartPolicyAlways {
+			allErrs = append(allErrs, errs.NewFieldValueNotSupported("template.spec.restartPolicy",
+				spec.Template.Spec.RestartPolicy, []string{string(api.RestartPolicyAlways)}))
+		}
+	}
+
+	allErrs = append(allErrs, validateDeploymentStrategy(&spec.Strategy).Prefix("strategy")...)
+	return allErrs
+}
+
+func validateDeploymentStrategy(strategy *api.DeploymentStrategy) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	switch strategy.Type {
+	case api.RecreateDeploymentStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, errs.NewFieldForbidden("rollingUpdate", strategy.RollingUpdate))
+		}
+	case api.RollingUpdateDeploymentStrategyType:
+		if strategy.RollingUpdate == nil {
+			allErrs = append(allErrs, errs.NewFieldRequired("rollingUpdate"))
+		} else {
+			allErrs = append(allErrs, validateRollingUpdateDeployment(strategy.RollingUpdate).Prefix("rollingUpdate")...)
+		}
+	case "":
+		allErrs = append(allErrs, errs.NewFieldRequired("type"))
+	default:
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("type", strategy.Type,
+			[]string{string(api.RecreateDeploymentStrategyType), string(api.RollingUpdateDeploymentStrategyType)}))
+	}
+	return allErrs
+}
+
+func validateRollingUpdateDeployment(rollingUpdate *api.RollingUpdateDeployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	maxUnavailable, unavailableErrs := validateIntOrPercent(&rollingUpdate.MaxUnavailable, "maxUnavailable")
+	allErrs = append(allErrs, unavailableErrs...)
+	maxSurge, surgeErrs := validateIntOrPercent(&rollingUpdate.MaxSurge, "maxSurge")
+	allErrs = append(allErrs, surgeErrs...)
+	if len(allErrs) == 0 && maxUnavailable == 0 && maxSurge == 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("maxUnavailable", rollingUpdate.MaxUnavailable.String(), "may not be 0 when maxSurge is 0"))
+	}
+	if rollingUpdate.MinReadySeconds < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("minReadySeconds", rollingUpdate.MinReadySeconds, isNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+// validateIntOrPercent checks that value is a non-negative integer or a
+// percentage between 0% and 100%, and returns the parsed value.
+func validateIntOrPercent(value *util.IntOrString, field string) (int, errs.ValidationErrorList) {
+	allErrs := errs.ValidationErrorList{}
+	v, isPercent, err := util.GetIntOrPercentValue(value)
+	if err != nil {
+		return 0, append(allErrs, errs.NewFieldInvalid(field, value.String(), err.Error()))
+	}
+	if v < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid(field, value.String(), isNegativeErrorMsg))
+	}
+	if isPercent && v > 100 {
+		allErrs = append(allErrs, errs.NewFieldInvalid(field, value.String(), "must not be greater than 100%"))
+	}
+	return v, allErrs
+}
+
+// ValidateDeploymentUpdate tests if required fields in the deployment are set.
+func ValidateDeploymentUpdate(oldDeployment, d *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&d.ObjectMeta, &oldDeployment.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDeploymentSpec(&d.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDeploymentStatusUpdate tests that the status of a deployment is valid.
+func ValidateDeploymentStatusUpdate(oldDeployment, d *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&d.ObjectMeta, &oldDeployment.ObjectMeta).Prefix("metadata")...)
+	status := d.Status
+	if status.Replicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.replicas", status.Replicas, isNegativeErrorMsg))
+	}
+	if status.UpdatedReplicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.updatedReplicas", status.UpdatedReplicas, isNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscaler tests if required fields in the autoscaler are set.
+func ValidateHorizontalPodAutoscaler(autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&autoscaler.ObjectMeta, true, ValidateHorizontalPodAutoscalerName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateHorizontalPodAutoscalerSpec(&autoscaler.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerSpec tests if required fields in the autoscaler spec are set.
+func ValidateHorizontalPodAutoscalerSpec(spec *api.HorizontalPodAutoscalerSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, validateScaleReference(&spec.ScaleRef).Prefix("scaleRef")...)
+	minReplicas := 1
+	if spec.MinReplicas != nil {
+		minReplicas = *spec.MinReplicas
+		if minReplicas < 1 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("minReplicas", minReplicas, "must be greater than 0"))
+		}
+	}
+	if spec.MaxReplicas < minReplicas {
+		allErrs = append(allErrs, errs.NewFieldInvalid("maxReplicas", spec.MaxReplicas, "must be greater than or equal to minReplicas"))
+	}
+	if spec.CPUUtilization != nil && spec.CPUUtilization.TargetPercentage < 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("cpuUtilization.targetPercentage", spec.CPUUtilization.TargetPercentage, "must be greater than 0"))
+	}
+	return allErrs
+}
+
+var supportedScaleKinds = util.NewStringSet("ReplicationController")
+
+func validateScaleReference(ref *api.ScaleReference) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(ref.Kind) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("kind"))
+	} else if !supportedScaleKinds.Has(ref.Kind) {
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("kind", ref.Kind, supportedScaleKinds.List()))
+	}
+	if len(ref.Name) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	} else if ok, qualifier := ValidateReplicationControllerName(ref.Name, false); !ok {
+		allErrs = append(allErrs, errs.NewFieldInvalid("name", ref.Name, qualifier))
+	}
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerUpdate tests if required fields in the autoscaler are set.
+func ValidateHorizontalPodAutoscalerUpdate(oldAutoscaler, autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&autoscaler.ObjectMeta, &oldAutoscaler.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateHorizontalPodAutoscalerSpec(&autoscaler.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerStatusUpdate tests that the status of an autoscaler is valid.
+func ValidateHorizontalPodAutoscalerStatusUpdate(oldAutoscaler, autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&autoscaler.ObjectMeta, &oldAutoscaler.ObjectMeta).Prefix("metadata")...)
+	status := autoscaler.Status
+	if status.CurrentReplicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.currentReplicas", status.CurrentReplicas, isNegativeErrorMsg))
+	}
+	if status.DesiredReplicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.desiredReplicas", status.DesiredReplicas, isNegativeErrorMsg))
+	}
+	return allErrs
+}
+
 // ValidatePodTemplateSpec validates the spec of a pod template
 func ValidatePodTemplateSpec(spec *api.PodTemplateSpec, replicas int) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -1456,6 +1910,28 @@ func ValidateSecretUpdate(oldSecret, newSecret *api.Secret) errs.ValidationError
 	return allErrs
 }
 
+// ValidateConfigMap tests if required fields in the ConfigMap are set.
+func ValidateConfigMap(configMap *api.ConfigMap) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&configMap.ObjectMeta, true, ValidateConfigMapName).Prefix("metadata")...)
+
+	for key := range configMap.Data {
+		if !IsSecretKey(key) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("data[%s]", key), key, fmt.Sprintf("must have at most %d characters and match regex %s", util.DNS1123SubdomainMaxLength, SecretKeyFmt)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateConfigMapUpdate tests if required fields in the ConfigMap are set.
+func ValidateConfigMapUpdate(oldConfigMap, newConfigMap *api.ConfigMap) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newConfigMap.ObjectMeta, &oldConfigMap.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateConfigMap(newConfigMap)...)
+	return allErrs
+}
+
 func validateBasicResource(quantity resource.Quantity) errs.ValidationErrorList {
 	if quantity.Value() < 0 {
 		return errs.ValidationErrorList{errs.NewFieldInvalid("", quantity.Value(), "must be a valid resource quantity")}
@@ -1703,3 +2179,16 @@ func ValidateSecurityContext(sc *api.SecurityContext) errs.ValidationErrorList {
 	}
 	return allErrs
 }
+
+// ValidatePodLogOptions checks that the since options of a log request are
+// consistent.
+func ValidatePodLogOptions(opts *api.PodLogOptions) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if opts.SinceSeconds != nil && opts.SinceTime != nil {
+		allErrs = append(allErrs, errs.NewFieldInvalid("sinceSeconds", *opts.SinceSeconds, "only one of sinceTime or sinceSeconds can be provided"))
+	}
+	if opts.SinceSeconds != nil && *opts.SinceSeconds < 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("sinceSeconds", *opts.SinceSeconds, "must be greater than 0"))
+	}
+	return allErrs
+}
@@ -433,6 +433,13 @@ func TestValidateVolumes(t *testing.T) {
 		{Name: "gitrepo", VolumeSource: api.VolumeSource{GitRepo: &api.GitRepoVolumeSource{"my-repo", "hashstring"}}},
 		{Name: "iscsidisk", VolumeSource: api.VolumeSource{ISCSI: &api.ISCSIVolumeSource{"127.0.0.1", "iqn.2015-02.example.com:test", 1, "ext4", false}}},
 		{Name: "secret", VolumeSource: api.VolumeSource{Secret: &api.SecretVolumeSource{"my-secret"}}},
+		{Name: "configmap", VolumeSource: api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{"my-config"}}},
+		{Name: "downwardapi", VolumeSource: api.VolumeSource{DownwardAPI: &api.DownwardAPIVolumeSource{Items: []api.DownwardAPIVolumeFile{
+			{Path: "labels", FieldRef: api.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.labels"}},
+			{Path: "annotations", FieldRef: api.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.annotations"}},
+			{Path: "path/to/name", FieldRef: api.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.name"}},
+			{Path: "my..namespace", FieldRef: api.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.namespace"}},
+		}}}},
 		{Name: "glusterfs", VolumeSource: api.VolumeSource{Glusterfs: &api.GlusterfsVolumeSource{"host1", "path", false}}},
 		{Name: "rbd", VolumeSource: api.VolumeSource{RBD: &api.RBDVolumeSource{CephMonitors: []string{"foo"}, RBDImage: "bar", FSType: "ext4"}}},
 	}
@@ -450,21 +457,30 @@ func TestValidateVolumes(t *testing.T) {
 	emptyPath := api.VolumeSource{Glusterfs: &api.GlusterfsVolumeSource{"host", "", false}}
 	emptyMon := api.VolumeSource{RBD: &api.RBDVolumeSource{CephMonitors: []string{}, RBDImage: "bar", FSType: "ext4"}}
 	emptyImage := api.VolumeSource{RBD: &api.RBDVolumeSource{CephMonitors: []string{"foo"}, RBDImage: "", FSType: "ext4"}}
+	emptyConfigMap := api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{}}
+	invalidConfigMap := api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{"My_Config"}}
+	emptyDownwardAPIPath := api.VolumeSource{DownwardAPI: &api.DownwardAPIVolumeSource{Items: []api.DownwardAPIVolumeFile{
+		{FieldRef: api.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.labels"}},
+	}}}
 	errorCases := map[string]struct {
 		V []api.Volume
 		T errors.ValidationErrorType
 		F string
 	}{
-		"zero-length name":     {[]api.Volume{{Name: "", VolumeSource: emptyVS}}, errors.ValidationErrorTypeRequired, "[0].name"},
-		"name > 63 characters": {[]api.Volume{{Name: strings.Repeat("a", 64), VolumeSource: emptyVS}}, errors.ValidationErrorTypeInvalid, "[0].name"},
-		"name not a DNS label": {[]api.Volume{{Name: "a.b.c", VolumeSource: emptyVS}}, errors.ValidationErrorTypeInvalid, "[0].name"},
-		"name not unique":      {[]api.Volume{{Name: "abc", VolumeSource: emptyVS}, {Name: "abc", VolumeSource: emptyVS}}, errors.ValidationErrorTypeDuplicate, "[1].name"},
-		"empty portal":         {[]api.Volume{{Name: "badportal", VolumeSource: emptyPortal}}, errors.ValidationErrorTypeRequired, "[0].source.iscsi.targetPortal"},
-		"empty iqn":            {[]api.Volume{{Name: "badiqn", VolumeSource: emptyIQN}}, errors.ValidationErrorTypeRequired, "[0].source.iscsi.iqn"},
-		"empty hosts":          {[]api.Volume{{Name: "badhost", VolumeSource: emptyHosts}}, errors.ValidationErrorTypeRequired, "[0].source.glusterfs.endpoints"},
-		"empty path":           {[]api.Volume{{Name: "badpath", VolumeSource: emptyPath}}, errors.ValidationErrorTypeRequired, "[0].source.glusterfs.path"},
-		"empty mon":            {[]api.Volume{{Name: "badmon", VolumeSource: emptyMon}}, errors.ValidationErrorTypeRequired, "[0].source.rbd.monitors"},
-		"empty image":          {[]api.Volume{{Name: "badimage", VolumeSource: emptyImage}}, errors.ValidationErrorTypeRequired, "[0].source.rbd.image"},
+		"zero-length name":       {[]api.Volume{{Name: "", VolumeSource: emptyVS}}, errors.ValidationErrorTypeRequired, "[0].name"},
+		"name > 63 characters":   {[]api.Volume{{Name: strings.Repeat("a", 64), VolumeSource: emptyVS}}, errors.ValidationErrorTypeInvalid, "[0].name"},
+		"name not a DNS label":   {[]api.Volume{{Name: "a.b.c", VolumeSource: emptyVS}}, errors.ValidationErrorTypeInvalid, "[0].name"},
+		"name not unique":        {[]api.Volume{{Name: "abc", VolumeSource: emptyVS}, {Name: "abc", VolumeSource: emptyVS}}, errors.ValidationErrorTypeDuplicate, "[1].name"},
+		"empty portal":           {[]api.Volume{{Name: "badportal", VolumeSource: emptyPortal}}, errors.ValidationErrorTypeRequired, "[0].source.iscsi.targetPortal"},
+		"empty iqn":              {[]api.Volume{{Name: "badiqn", VolumeSource: emptyIQN}}, errors.ValidationErrorTypeRequired, "[0].source.iscsi.iqn"},
+		"empty hosts":            {[]api.Volume{{Name: "badhost", VolumeSource: emptyHosts}}, errors.ValidationErrorTypeRequired, "[0].source.glusterfs.endpoints"},
+		"empty path":             {[]api.Volume{{Name: "badpath", VolumeSource: emptyPath}}, errors.ValidationErrorTypeRequired, "[0].source.glusterfs.path"},
+		"empty mon":              {[]api.Volume{{Name: "badmon", VolumeSource: emptyMon}}, errors.ValidationErrorTypeRequired, "[0].source.rbd.monitors"},
+		"empty image":            {[]api.Volume{{Name: "badimage", VolumeSource: emptyImage}}, errors.ValidationErrorTypeRequired, "[0].source.rbd.image"},
+		"empty configMap name":   {[]api.Volume{{Name: "badconfigmap", VolumeSource: emptyConfigMap}}, errors.ValidationErrorTypeRequired, "[0].source.configMap.name"},
+		"invalid configMap name": {[]api.Volume{{Name: "badconfigmap", VolumeSource: invalidConfigMap}}, errors.ValidationErrorTypeInvalid, "[0].source.configMap.name"},
+
+		"empty downwardAPI path": {[]api.Volume{{Name: "baddownwardapi", VolumeSource: emptyDownwardAPIPath}}, errors.ValidationErrorTypeRequired, "[0].source.downwardAPI.items[0].path"},
 	}
 	for k, v := range errorCases {
 		_, errs := validateVolumes(v.V)
@@ -480,8 +496,44 @@ func TestValidateVolumes(t *testing.T) {
 				t.Errorf("%s: expected errors to have field %s: %v", k, v.F, errs[i])
 			}
 			detail := errs[i].(*errors.ValidationError).Detail
-			if detail != "" && detail != dns1123LabelErrorMsg {
-				t.Errorf("%s: expected error detail either empty or %s, got %s", k, dns1123LabelErrorMsg, detail)
+			if detail != "" && detail != dns1123LabelErrorMsg && detail != dnsSubdomainErrorMsg {
+				t.Errorf("%s: expected error detail either empty, %s or %s, got %s", k, dns1123LabelErrorMsg, dnsSubdomainErrorMsg, detail)
+			}
+		}
+	}
+}
+
+func TestValidateDownwardAPIVolumeSource(t *testing.T) {
+	file := func(path, fieldPath string) api.DownwardAPIVolumeFile {
+		return api.DownwardAPIVolumeFile{Path: path, FieldRef: api.ObjectFieldSelector{APIVersion: "v1", FieldPath: fieldPath}}
+	}
+	errorCases := map[string]struct {
+		Items []api.DownwardAPIVolumeFile
+		T     errors.ValidationErrorType
+		F     string
+	}{
+		"absolute path":       {[]api.DownwardAPIVolumeFile{file("/labels", "metadata.labels")}, errors.ValidationErrorTypeInvalid, "items[0].path"},
+		"path starts with ..": {[]api.DownwardAPIVolumeFile{file("..labels", "metadata.labels")}, errors.ValidationErrorTypeInvalid, "items[0].path"},
+		"path contains ..":    {[]api.DownwardAPIVolumeFile{file("a/../labels", "metadata.labels")}, errors.ValidationErrorTypeInvalid, "items[0].path"},
+		"path is .":           {[]api.DownwardAPIVolumeFile{file(".", "metadata.labels")}, errors.ValidationErrorTypeInvalid, "items[0].path"},
+		"path contains .":     {[]api.DownwardAPIVolumeFile{file("a/./labels", "metadata.labels")}, errors.ValidationErrorTypeInvalid, "items[0].path"},
+		"duplicate path":      {[]api.DownwardAPIVolumeFile{file("labels", "metadata.labels"), file("labels", "metadata.name")}, errors.ValidationErrorTypeDuplicate, "items[1].path"},
+		"unsupported field":   {[]api.DownwardAPIVolumeFile{file("phase", "status.phase")}, errors.ValidationErrorTypeNotSupported, "items[0].fieldRef.fieldPath"},
+		"missing apiVersion": {[]api.DownwardAPIVolumeFile{{Path: "labels", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.labels"}}},
+			errors.ValidationErrorTypeRequired, "items[0].fieldRef.apiVersion"},
+	}
+	for k, v := range errorCases {
+		errs := validateDownwardAPIVolumeSource(&api.DownwardAPIVolumeSource{Items: v.Items})
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+			continue
+		}
+		for i := range errs {
+			if errs[i].(*errors.ValidationError).Type != v.T {
+				t.Errorf("%s: expected errors to have type %s: %v", k, v.T, errs[i])
+			}
+			if errs[i].(*errors.ValidationError).Field != v.F {
+				t.Errorf("%s: expected errors to have field %s: %v", k, v.F, errs[i])
 			}
 		}
 	}
@@ -561,6 +613,15 @@ func TestValidateEnv(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "abc",
+			ValueFrom: &api.EnvVarSource{
+				ConfigMapKeyRef: &api.ConfigMapKeySelector{
+					Name: "my-config",
+					Key:  "some.key",
+				},
+			},
+		},
 	}
 	if errs := validateEnv(successCase); len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
@@ -645,6 +706,60 @@ func TestValidateEnv(t *testing.T) {
 			}},
 			expectedError: "[0].valueFrom.fieldRef.fieldPath: unsupported value 'status.phase': supported values: metadata.name, metadata.namespace",
 		},
+		{
+			name: "missing name on ConfigMapKeySelector",
+			envs: []api.EnvVar{{
+				Name: "abc",
+				ValueFrom: &api.EnvVarSource{
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{
+						Key: "some.key",
+					},
+				},
+			}},
+			expectedError: "[0].valueFrom.configMapKeyRef.name: required value",
+		},
+		{
+			name: "missing key on ConfigMapKeySelector",
+			envs: []api.EnvVar{{
+				Name: "abc",
+				ValueFrom: &api.EnvVarSource{
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{
+						Name: "my-config",
+					},
+				},
+			}},
+			expectedError: "[0].valueFrom.configMapKeyRef.key: required value",
+		},
+		{
+			name: "invalid key on ConfigMapKeySelector",
+			envs: []api.EnvVar{{
+				Name: "abc",
+				ValueFrom: &api.EnvVarSource{
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{
+						Name: "my-config",
+						Key:  "a..b",
+					},
+				},
+			}},
+			expectedError: "[0].valueFrom.configMapKeyRef.key: invalid value 'a..b': must have at most 253 characters and match regex \\.?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*",
+		},
+		{
+			name: "fieldRef and configMapKeyRef specified",
+			envs: []api.EnvVar{{
+				Name: "abc",
+				ValueFrom: &api.EnvVarSource{
+					FieldRef: &api.ObjectFieldSelector{
+						APIVersion: "v1beta3",
+						FieldPath:  "metadata.name",
+					},
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{
+						Name: "my-config",
+						Key:  "some.key",
+					},
+				},
+			}},
+			expectedError: "[0].valueFrom: invalid value '': may not have more than one source specified",
+		},
 	}
 	for _, tc := range errorCases {
 		if errs := validateEnv(tc.envs); len(errs) == 0 {
@@ -2226,6 +2341,387 @@ func TestValidateReplicationController(t *testing.T) {
 	}
 }
 
+func newInt(val int) *int {
+	p := new(int)
+	*p = val
+	return p
+}
+
+func newJobTemplate(selector map[string]string, restartPolicy api.RestartPolicy) *api.PodTemplateSpec {
+	return &api.PodTemplateSpec{
+		ObjectMeta: api.ObjectMeta{
+			Labels: selector,
+		},
+		Spec: api.PodSpec{
+			RestartPolicy: restartPolicy,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "abc", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+		},
+	}
+}
+
+func TestValidateJob(t *testing.T) {
+	validSelector := map[string]string{"a": "b"}
+	validTemplate := newJobTemplate(validSelector, api.RestartPolicyOnFailure)
+	successCases := []api.Job{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Selector: validSelector,
+				Template: validTemplate,
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc-123", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: newInt(5),
+				Parallelism: newInt(2),
+				Selector:    validSelector,
+				Template:    newJobTemplate(validSelector, api.RestartPolicyNever),
+			},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateJob(&successCase); len(errs) != 0 {
+			t.Errorf("expected success for %s: %v", successCase.Name, errs)
+		}
+	}
+
+	negative := -1
+	negative64 := int64(-1)
+	errorCases := map[string]api.Job{
+		"spec.parallelism": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Parallelism: &negative,
+				Selector:    validSelector,
+				Template:    validTemplate,
+			},
+		},
+		"spec.completions": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: &negative,
+				Selector:    validSelector,
+				Template:    validTemplate,
+			},
+		},
+		"spec.activeDeadlineSeconds": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				ActiveDeadlineSeconds: &negative64,
+				Selector:              validSelector,
+				Template:              validTemplate,
+			},
+		},
+		"spec.selector": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Template: validTemplate,
+			},
+		},
+		"spec.template": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Selector: validSelector,
+			},
+		},
+		"spec.template.labels": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Selector: map[string]string{"y": "z"},
+				Template: validTemplate,
+			},
+		},
+		"spec.template.spec.restartPolicy": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Selector: validSelector,
+				Template: newJobTemplate(validSelector, api.RestartPolicyAlways),
+			},
+		},
+	}
+	for field, errorCase := range errorCases {
+		errs := ValidateJob(&errorCase)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", field)
+			continue
+		}
+		if errs[0].(*errors.ValidationError).Field != field {
+			t.Errorf("expected error for field %s, got %v", field, errs[0])
+		}
+	}
+}
+
+func TestValidateJobUpdate(t *testing.T) {
+	validSelector := map[string]string{"a": "b"}
+	oldJob := api.Job{
+		ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+		Spec: api.JobSpec{
+			Completions: newInt(2),
+			Parallelism: newInt(1),
+			Selector:    validSelector,
+			Template:    newJobTemplate(validSelector, api.RestartPolicyOnFailure),
+		},
+	}
+
+	update := oldJob
+	update.Spec.Parallelism = newInt(2)
+	if errs := ValidateJobUpdate(&oldJob, &update); len(errs) != 0 {
+		t.Errorf("expected parallelism to be mutable: %v", errs)
+	}
+
+	update = oldJob
+	update.Spec.Completions = newInt(3)
+	if errs := ValidateJobUpdate(&oldJob, &update); len(errs) == 0 {
+		t.Errorf("expected completions to be immutable")
+	}
+
+	update = oldJob
+	update.Spec.Template = newJobTemplate(validSelector, api.RestartPolicyNever)
+	if errs := ValidateJobUpdate(&oldJob, &update); len(errs) == 0 {
+		t.Errorf("expected template to be immutable")
+	}
+}
+
+func TestValidateDaemonSet(t *testing.T) {
+	validSelector := map[string]string{"a": "b"}
+	validTemplate := newJobTemplate(validSelector, api.RestartPolicyAlways)
+	successCases := []api.DaemonSet{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: validTemplate,
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc-123", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: &api.PodTemplateSpec{
+					ObjectMeta: validTemplate.ObjectMeta,
+					Spec: api.PodSpec{
+						RestartPolicy: api.RestartPolicyAlways,
+						DNSPolicy:     api.DNSClusterFirst,
+						Containers:    validTemplate.Spec.Containers,
+						NodeSelector:  map[string]string{"logging": "enabled"},
+					},
+				},
+			},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateDaemonSet(&successCase); len(errs) != 0 {
+			t.Errorf("expected success for %s: %v", successCase.Name, errs)
+		}
+	}
+
+	withNodeName := newJobTemplate(validSelector, api.RestartPolicyAlways)
+	withNodeName.Spec.NodeName = "node1"
+	errorCases := map[string]api.DaemonSet{
+		"metadata.name": {
+			ObjectMeta: api.ObjectMeta{Name: "", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: validTemplate,
+			},
+		},
+		"spec.selector": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Template: validTemplate,
+			},
+		},
+		"spec.template": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+			},
+		},
+		"spec.template.labels": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: map[string]string{"y": "z"},
+				Template: validTemplate,
+			},
+		},
+		"spec.template.spec.restartPolicy": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: newJobTemplate(validSelector, api.RestartPolicyOnFailure),
+			},
+		},
+		"spec.template.spec.nodeName": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: withNodeName,
+			},
+		},
+	}
+	for field, errorCase := range errorCases {
+		errs := ValidateDaemonSet(&errorCase)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", field)
+			continue
+		}
+		if errs[0].(*errors.ValidationError).Field != field {
+			t.Errorf("expected error for field %s, got %v", field, errs[0])
+		}
+	}
+}
+
+func validDeployment() *api.Deployment {
+	selector := map[string]string{"a": "b"}
+	return &api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+		Spec: api.DeploymentSpec{
+			Replicas: 3,
+			Selector: selector,
+			Template: newJobTemplate(selector, api.RestartPolicyAlways),
+			Strategy: api.DeploymentStrategy{
+				Type: api.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &api.RollingUpdateDeployment{
+					MaxUnavailable: util.NewIntOrStringFromInt(1),
+					MaxSurge:       util.NewIntOrStringFromString("25%"),
+				},
+			},
+			UniqueLabelKey: api.DefaultDeploymentUniqueLabelKey,
+		},
+	}
+}
+
+func TestValidateDeployment(t *testing.T) {
+	successCases := []func(*api.Deployment){
+		func(d *api.Deployment) {},
+		func(d *api.Deployment) {
+			d.Spec.Strategy = api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType}
+		},
+		func(d *api.Deployment) {
+			d.Spec.Strategy.RollingUpdate.MaxUnavailable = util.NewIntOrStringFromString("0%")
+			d.Spec.Strategy.RollingUpdate.MaxSurge = util.NewIntOrStringFromString("100%")
+		},
+		func(d *api.Deployment) {
+			limit := 0
+			d.Spec.RevisionHistoryLimit = &limit
+			d.Spec.RollbackTo = &api.RollbackConfig{Revision: 2}
+		},
+	}
+	for i, mutate := range successCases {
+		d := validDeployment()
+		mutate(d)
+		if errs := ValidateDeployment(d); len(errs) != 0 {
+			t.Errorf("%d: expected success: %v", i, errs)
+		}
+	}
+
+	errorCases := map[string]func(*api.Deployment){
+		"metadata.name": func(d *api.Deployment) { d.Name = "" },
+		"spec.replicas": func(d *api.Deployment) { d.Spec.Replicas = -1 },
+		"spec.revisionHistoryLimit": func(d *api.Deployment) {
+			limit := -1
+			d.Spec.RevisionHistoryLimit = &limit
+		},
+		"spec.rollbackTo.revision": func(d *api.Deployment) { d.Spec.RollbackTo = &api.RollbackConfig{Revision: -1} },
+		"spec.uniqueLabelKey":      func(d *api.Deployment) { d.Spec.UniqueLabelKey = "" },
+		"spec.selector":            func(d *api.Deployment) { d.Spec.Selector = nil },
+		"spec.template":            func(d *api.Deployment) { d.Spec.Template = nil },
+		"spec.template.labels":     func(d *api.Deployment) { d.Spec.Selector = map[string]string{"y": "z"} },
+		"spec.template.spec.restartPolicy": func(d *api.Deployment) {
+			d.Spec.Template = newJobTemplate(d.Spec.Selector, api.RestartPolicyNever)
+		},
+		"spec.strategy.type":          func(d *api.Deployment) { d.Spec.Strategy.Type = "Blue" },
+		"spec.strategy.rollingUpdate": func(d *api.Deployment) { d.Spec.Strategy.Type = api.RecreateDeploymentStrategyType },
+		"spec.strategy.rollingUpdate.maxUnavailable": func(d *api.Deployment) {
+			d.Spec.Strategy.RollingUpdate.MaxUnavailable = util.NewIntOrStringFromString("110%")
+		},
+		"spec.strategy.rollingUpdate.maxSurge": func(d *api.Deployment) {
+			d.Spec.Strategy.RollingUpdate.MaxSurge = util.NewIntOrStringFromInt(-1)
+		},
+		"spec.strategy.rollingUpdate.minReadySeconds": func(d *api.Deployment) {
+			d.Spec.Strategy.RollingUpdate.MinReadySeconds = -1
+		},
+	}
+	for field, mutate := range errorCases {
+		d := validDeployment()
+		mutate(d)
+		errs := ValidateDeployment(d)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", field)
+			continue
+		}
+		if errs[0].(*errors.ValidationError).Field != field {
+			t.Errorf("expected error for field %s, got %v", field, errs[0])
+		}
+	}
+
+	d := validDeployment()
+	d.Spec.Strategy.RollingUpdate.MaxUnavailable = util.NewIntOrStringFromInt(0)
+	d.Spec.Strategy.RollingUpdate.MaxSurge = util.NewIntOrStringFromString("0%")
+	if errs := ValidateDeployment(d); len(errs) == 0 {
+		t.Errorf("expected failure when both maxUnavailable and maxSurge are 0")
+	}
+}
+
+func validHorizontalPodAutoscaler() *api.HorizontalPodAutoscaler {
+	minReplicas := 1
+	return &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: api.NamespaceDefault},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:       api.ScaleReference{Kind: "ReplicationController", Name: "frontend"},
+			MinReplicas:    &minReplicas,
+			MaxReplicas:    5,
+			CPUUtilization: &api.CPUTargetUtilization{TargetPercentage: 70},
+		},
+	}
+}
+
+func TestValidateHorizontalPodAutoscaler(t *testing.T) {
+	successCases := []func(*api.HorizontalPodAutoscaler){
+		func(a *api.HorizontalPodAutoscaler) {},
+		func(a *api.HorizontalPodAutoscaler) {
+			a.Spec.MinReplicas = nil
+			a.Spec.CPUUtilization = nil
+		},
+		func(a *api.HorizontalPodAutoscaler) { a.Spec.MaxReplicas = 1 },
+	}
+	for i, mutate := range successCases {
+		a := validHorizontalPodAutoscaler()
+		mutate(a)
+		if errs := ValidateHorizontalPodAutoscaler(a); len(errs) != 0 {
+			t.Errorf("%d: expected success: %v", i, errs)
+		}
+	}
+
+	errorCases := map[string]func(*api.HorizontalPodAutoscaler){
+		"metadata.name":      func(a *api.HorizontalPodAutoscaler) { a.Name = "" },
+		"spec.scaleRef.kind": func(a *api.HorizontalPodAutoscaler) { a.Spec.ScaleRef.Kind = "Pod" },
+		"spec.scaleRef.name": func(a *api.HorizontalPodAutoscaler) { a.Spec.ScaleRef.Name = "" },
+		"spec.minReplicas": func(a *api.HorizontalPodAutoscaler) {
+			minReplicas := 0
+			a.Spec.MinReplicas = &minReplicas
+		},
+		"spec.maxReplicas": func(a *api.HorizontalPodAutoscaler) { a.Spec.MaxReplicas = 0 },
+		"spec.cpuUtilization.targetPercentage": func(a *api.HorizontalPodAutoscaler) {
+			a.Spec.CPUUtilization.TargetPercentage = 0
+		},
+	}
+	for field, mutate := range errorCases {
+		a := validHorizontalPodAutoscaler()
+		mutate(a)
+		errs := ValidateHorizontalPodAutoscaler(a)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", field)
+			continue
+		}
+		if errs[0].(*errors.ValidationError).Field != field {
+			t.Errorf("expected error for field %s, got %v", field, errs[0])
+		}
+	}
+}
+
 func TestValidateNode(t *testing.T) {
 	validSelector := map[string]string{"a": "b"}
 	invalidSelector := map[string]string{"NoUppercaseOrSpecialCharsLike=Equals": "b"}
@@ -3256,6 +3752,64 @@ func TestValidateDockerConfigSecret(t *testing.T) {
 	}
 }
 
+func TestValidateConfigMap(t *testing.T) {
+	validConfigMap := func() api.ConfigMap {
+		return api.ConfigMap{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+			Data: map[string]string{
+				"data-1": "bar",
+			},
+		}
+	}
+
+	var (
+		emptyName     = validConfigMap()
+		invalidName   = validConfigMap()
+		emptyNs       = validConfigMap()
+		invalidNs     = validConfigMap()
+		overSecretMax = validConfigMap()
+		invalidKey    = validConfigMap()
+		leadingDotKey = validConfigMap()
+		dotKey        = validConfigMap()
+	)
+
+	emptyName.Name = ""
+	invalidName.Name = "NoUppercaseOrSpecialCharsLike=Equals"
+	emptyNs.Namespace = ""
+	invalidNs.Namespace = "NoUppercaseOrSpecialCharsLike=Equals"
+	overSecretMax.Data = map[string]string{
+		"big": strings.Repeat("a", api.MaxSecretSize+1),
+	}
+	invalidKey.Data["a..b"] = "whoops"
+	leadingDotKey.Data[".key"] = "bar"
+	dotKey.Data["."] = "bar"
+
+	tests := map[string]struct {
+		configMap api.ConfigMap
+		valid     bool
+	}{
+		"valid":                    {validConfigMap(), true},
+		"empty name":               {emptyName, false},
+		"invalid name":             {invalidName, false},
+		"empty namespace":          {emptyNs, false},
+		"invalid namespace":        {invalidNs, false},
+		"larger than secret limit": {overSecretMax, true},
+		"invalid key":              {invalidKey, false},
+		"leading dot key":          {leadingDotKey, true},
+		"dot key":                  {dotKey, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateConfigMap(&tc.configMap)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
 func TestValidateEndpoints(t *testing.T) {
 	successCases := map[string]api.Endpoints{
 		"simple endpoint": {
@@ -3531,3 +4085,30 @@ func fakeValidSecurityContext(priv bool) *api.SecurityContext {
 		Privileged: &priv,
 	}
 }
+
+func TestValidatePodLogOptions(t *testing.T) {
+	negative, zero, positive := int64(-1), int64(0), int64(10)
+	since := util.Now()
+	successCases := []api.PodLogOptions{
+		{},
+		{Follow: true, Timestamps: true},
+		{SinceSeconds: &positive},
+		{SinceTime: &since},
+	}
+	for _, opts := range successCases {
+		if errs := ValidatePodLogOptions(&opts); len(errs) != 0 {
+			t.Errorf("expected success for %#v: %v", opts, errs)
+		}
+	}
+
+	errorCases := map[string]api.PodLogOptions{
+		"negative sinceSeconds": {SinceSeconds: &negative},
+		"zero sinceSeconds":     {SinceSeconds: &zero},
+		"both since options":    {SinceSeconds: &positive, SinceTime: &since},
+	}
+	for k, opts := range errorCases {
+		if errs := ValidatePodLogOptions(&opts); len(errs) == 0 {
+			t.Errorf("%s: expected failure", k)
+		}
+	}
+}
@@ -315,6 +315,7 @@ type SimpleRESTStorage struct {
 
 	actualNamespace  string
 	namespacePresent bool
+	dryRun           bool
 
 	// These are set when Watch is called
 	fakeWatch                  *watch.FakeWatcher
@@ -389,6 +390,7 @@ func (storage *SimpleRESTStorage) Get(ctx api.Context, id string) (runtime.Objec
 
 func (storage *SimpleRESTStorage) checkContext(ctx api.Context) {
 	storage.actualNamespace, storage.namespacePresent = api.NamespaceFrom(ctx)
+	storage.dryRun = api.IsDryRun(ctx)
 }
 
 func (storage *SimpleRESTStorage) Delete(ctx api.Context, id string, options *api.DeleteOptions) (runtime.Object, error) {
@@ -568,6 +570,25 @@ func (storage *NamedCreaterRESTStorage) Create(ctx api.Context, name string, obj
 	return obj, err
 }
 
+// DryRunRESTStorage is a SimpleRESTStorage that accepts dry run requests.
+type DryRunRESTStorage struct {
+	*SimpleRESTStorage
+}
+
+func (DryRunRESTStorage) SupportsDryRun() bool {
+	return true
+}
+
+type VersionedListRESTStorage struct {
+	*SimpleRESTStorage
+	listedResourceVersion string
+}
+
+func (storage *VersionedListRESTStorage) ListFromVersion(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (runtime.Object, error) {
+	storage.listedResourceVersion = resourceVersion
+	return storage.List(ctx, label, field)
+}
+
 type SimpleTypedStorage struct {
 	errors   map[string]error
 	item     runtime.Object
@@ -942,6 +963,51 @@ func TestNonEmptyList(t *testing.T) {
 	}
 }
 
+func TestListFromVersion(t *testing.T) {
+	storage := map[string]rest.Storage{}
+	simpleStorage := VersionedListRESTStorage{
+		SimpleRESTStorage: &SimpleRESTStorage{
+			list: []Simple{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "something", Namespace: "other"},
+					Other:      "foo",
+				},
+			},
+		},
+	}
+	storage["simple"] = &simpleStorage
+	handler := handle(storage)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	for _, resourceVersion := range []string{"", "1234"} {
+		simpleStorage.listedResourceVersion = "unset"
+		resp, err := http.Get(server.URL + "/api/version/simple?resourceVersion=" + resourceVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Unexpected status: %d, Expected: %d, %#v", resp.StatusCode, http.StatusOK, resp)
+		}
+		var listOut SimpleList
+		if _, err := extractBody(resp, &listOut); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(listOut.Items) != 1 {
+			t.Errorf("Unexpected response: %#v", listOut)
+		}
+
+		expected := resourceVersion
+		if len(resourceVersion) == 0 {
+			// Lists without a resource version use List.
+			expected = "unset"
+		}
+		if simpleStorage.listedResourceVersion != expected {
+			t.Errorf("Expected list from version %q, got %q", expected, simpleStorage.listedResourceVersion)
+		}
+	}
+}
+
 func TestSelfLinkSkipsEmptyName(t *testing.T) {
 	storage := map[string]rest.Storage{}
 	simpleStorage := SimpleRESTStorage{
@@ -1410,6 +1476,55 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestDryRun(t *testing.T) {
+	data, _ := codec.Encode(&Simple{ObjectMeta: api.ObjectMeta{Name: "id", Namespace: "default"}})
+	testCases := []struct {
+		method       string
+		path         string
+		supported    bool
+		expectStatus int
+		expectDryRun bool
+	}{
+		{"POST", "/api/version/namespaces/default/simple?dryRun=true", true, http.StatusCreated, true},
+		{"PUT", "/api/version/namespaces/default/simple/id?dryRun=true", true, http.StatusOK, true},
+		{"DELETE", "/api/version/namespaces/default/simple/id?dryRun=true", true, http.StatusOK, true},
+		{"POST", "/api/version/namespaces/default/simple?dryRun=false", true, http.StatusCreated, false},
+		{"POST", "/api/version/namespaces/default/simple?dryRun=yes", true, http.StatusBadRequest, false},
+		{"POST", "/api/version/namespaces/default/simple?dryRun=true", false, http.StatusBadRequest, false},
+		{"PUT", "/api/version/namespaces/default/simple/id?dryRun=true", false, http.StatusBadRequest, false},
+		{"DELETE", "/api/version/namespaces/default/simple/id?dryRun=true", false, http.StatusBadRequest, false},
+	}
+	for i, testCase := range testCases {
+		simpleStorage := &SimpleRESTStorage{}
+		var storage rest.Storage = simpleStorage
+		if testCase.supported {
+			storage = DryRunRESTStorage{simpleStorage}
+		}
+		server := httptest.NewServer(handle(map[string]rest.Storage{"simple": storage}))
+
+		body := data
+		if testCase.method == "DELETE" {
+			body = nil
+		}
+		client := http.Client{}
+		request, err := http.NewRequest(testCase.method, server.URL+testCase.path, bytes.NewBuffer(body))
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+		if response.StatusCode != testCase.expectStatus {
+			t.Errorf("%d: expected status %d, got %d", i, testCase.expectStatus, response.StatusCode)
+		}
+		if simpleStorage.dryRun != testCase.expectDryRun {
+			t.Errorf("%d: expected dry run %t, got %t", i, testCase.expectDryRun, simpleStorage.dryRun)
+		}
+		server.Close()
+	}
+}
+
 func TestDeleteWithOptions(t *testing.T) {
 	storage := map[string]rest.Storage{}
 	simpleStorage := SimpleRESTStorage{}
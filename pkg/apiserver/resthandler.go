@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"net/url"
 	gpath "path"
+	"strconv"
 	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
@@ -258,7 +259,12 @@ func ListResource(r rest.Lister, rw rest.Watcher, scope RequestScope, forceWatch
 			return
 		}
 
-		result, err := r.List(ctx, opts.LabelSelector, opts.FieldSelector)
+		var result runtime.Object
+		if vl, ok := r.(rest.VersionedLister); ok && len(opts.ResourceVersion) > 0 {
+			result, err = vl.ListFromVersion(ctx, opts.LabelSelector, opts.FieldSelector, opts.ResourceVersion)
+		} else {
+			result, err = r.List(ctx, opts.LabelSelector, opts.FieldSelector)
+		}
 		if err != nil {
 			errorJSON(err, scope.Codec, w)
 			return
@@ -295,6 +301,16 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
 
+		var storage interface{} = r
+		if adapter, ok := r.(*namedCreaterAdapter); ok {
+			storage = adapter.Creater
+		}
+		ctx, err = dryRunContext(ctx, req, storage)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
+
 		body, err := readBody(req.Request)
 		if err != nil {
 			errorJSON(err, scope.Codec, w)
@@ -309,9 +325,7 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 		}
 
 		if admit.Handles(admission.Create) {
-			userInfo, _ := api.UserFrom(ctx)
-
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Create, userInfo))
+			err = admit.Admit(admissionAttributes(ctx, obj, scope, namespace, name, admission.Create))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -377,12 +391,15 @@ func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper
 		obj := r.New()
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, err = dryRunContext(ctx, req, r)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		// PATCH requires same permission as UPDATE
 		if admit.Handles(admission.Update) {
-			userInfo, _ := api.UserFrom(ctx)
-
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo))
+			err = admit.Admit(admissionAttributes(ctx, obj, scope, namespace, name, admission.Update))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -461,6 +478,11 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		}
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, err = dryRunContext(ctx, req, r)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		body, err := readBody(req.Request)
 		if err != nil {
@@ -481,9 +503,7 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		}
 
 		if admit.Handles(admission.Update) {
-			userInfo, _ := api.UserFrom(ctx)
-
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo))
+			err = admit.Admit(admissionAttributes(ctx, obj, scope, namespace, name, admission.Update))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -529,6 +549,11 @@ func DeleteResource(r rest.GracefulDeleter, checkBody bool, scope RequestScope,
 		}
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, err = dryRunContext(ctx, req, r)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		options := &api.DeleteOptions{}
 		if checkBody {
@@ -546,9 +571,7 @@ func DeleteResource(r rest.GracefulDeleter, checkBody bool, scope RequestScope,
 		}
 
 		if admit.Handles(admission.Delete) {
-			userInfo, _ := api.UserFrom(ctx)
-
-			err = admit.Admit(admission.NewAttributesRecord(nil, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Delete, userInfo))
+			err = admit.Admit(admissionAttributes(ctx, nil, scope, namespace, name, admission.Delete))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -587,6 +610,36 @@ func DeleteResource(r rest.GracefulDeleter, checkBody bool, scope RequestScope,
 	}
 }
 
+// dryRunContext marks ctx as a dry run if the request sets the dryRun query parameter. A bad
+// request error is returned if the parameter is invalid or the storage cannot honor it.
+func dryRunContext(ctx api.Context, req *restful.Request, storage interface{}) (api.Context, error) {
+	value := req.Request.URL.Query().Get("dryRun")
+	if len(value) == 0 {
+		return ctx, nil
+	}
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid value for dryRun %q: %v", value, err))
+	}
+	if !dryRun {
+		return ctx, nil
+	}
+	if dryRunner, ok := storage.(rest.DryRunner); !ok || !dryRunner.SupportsDryRun() {
+		return nil, errors.NewBadRequest("dryRun is not supported for this resource")
+	}
+	return api.WithDryRun(ctx), nil
+}
+
+// admissionAttributes returns the admission attributes of a request with the given context.
+func admissionAttributes(ctx api.Context, obj runtime.Object, scope RequestScope, namespace, name string, operation admission.Operation) admission.Attributes {
+	userInfo, _ := api.UserFrom(ctx)
+	attributes := admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, operation, userInfo)
+	if api.IsDryRun(ctx) {
+		return admission.NewDryRunAttributes(attributes)
+	}
+	return attributes
+}
+
 // queryToObject converts query parameters into a structured internal object by
 // kind. The caller must cast the returned object to the matching internal Kind
 // to use it.
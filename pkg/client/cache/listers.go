@@ -225,6 +225,176 @@ func (s *StoreToControllerLister) GetPodControllers(pod *api.Pod) (controllers [
 	return
 }
 
+// StoreToJobLister gives a store List and Exists methods. The store must contain only Jobs.
+type StoreToJobLister struct {
+	Store
+}
+
+// Exists checks if the given job exists in the store.
+func (s *StoreToJobLister) Exists(job *api.Job) (bool, error) {
+	_, exists, err := s.Store.Get(job)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// List lists all jobs in the store.
+func (s *StoreToJobLister) List() (jobs api.JobList, err error) {
+	for _, c := range s.Store.List() {
+		jobs.Items = append(jobs.Items, *(c.(*api.Job)))
+	}
+	return jobs, nil
+}
+
+// GetPodJobs returns a list of jobs managing a pod. Returns an error only if no matching jobs are found.
+func (s *StoreToJobLister) GetPodJobs(pod *api.Pod) (jobs []api.Job, err error) {
+	var selector labels.Selector
+	var job api.Job
+
+	if len(pod.Labels) == 0 {
+		err = fmt.Errorf("No jobs found for pod %v because it has no labels", pod.Name)
+		return
+	}
+
+	for _, m := range s.Store.List() {
+		job = *m.(*api.Job)
+		if job.Namespace != pod.Namespace {
+			continue
+		}
+		labelSet := labels.Set(job.Spec.Selector)
+		selector = labels.Set(job.Spec.Selector).AsSelector()
+
+		// A job with a nil or empty selector should match nothing, not everything.
+		if labelSet.AsSelector().Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	if len(jobs) == 0 {
+		err = fmt.Errorf("Could not find jobs for pod %s in namespace %s with labels: %v", pod.Name, pod.Namespace, pod.Labels)
+	}
+	return
+}
+
+// StoreToDaemonSetLister gives a store List and Exists methods. The store must contain only DaemonSets.
+type StoreToDaemonSetLister struct {
+	Store
+}
+
+// Exists checks if the given daemon set exists in the store.
+func (s *StoreToDaemonSetLister) Exists(daemonSet *api.DaemonSet) (bool, error) {
+	_, exists, err := s.Store.Get(daemonSet)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// List lists all daemon sets in the store.
+func (s *StoreToDaemonSetLister) List() (daemonSets api.DaemonSetList, err error) {
+	for _, c := range s.Store.List() {
+		daemonSets.Items = append(daemonSets.Items, *(c.(*api.DaemonSet)))
+	}
+	return daemonSets, nil
+}
+
+// GetPodDaemonSets returns a list of daemon sets managing a pod. Returns an error only if no matching daemon sets are found.
+func (s *StoreToDaemonSetLister) GetPodDaemonSets(pod *api.Pod) (daemonSets []api.DaemonSet, err error) {
+	var selector labels.Selector
+	var daemonSet api.DaemonSet
+
+	if len(pod.Labels) == 0 {
+		err = fmt.Errorf("No daemon sets found for pod %v because it has no labels", pod.Name)
+		return
+	}
+
+	for _, m := range s.Store.List() {
+		daemonSet = *m.(*api.DaemonSet)
+		if daemonSet.Namespace != pod.Namespace {
+			continue
+		}
+		labelSet := labels.Set(daemonSet.Spec.Selector)
+		selector = labels.Set(daemonSet.Spec.Selector).AsSelector()
+
+		// A daemon set with a nil or empty selector should match nothing, not everything.
+		if labelSet.AsSelector().Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		daemonSets = append(daemonSets, daemonSet)
+	}
+	if len(daemonSets) == 0 {
+		err = fmt.Errorf("Could not find daemon sets for pod %s in namespace %s with labels: %v", pod.Name, pod.Namespace, pod.Labels)
+	}
+	return
+}
+
+// StoreToDeploymentLister gives a store List and Exists methods. The store must contain only Deployments.
+type StoreToDeploymentLister struct {
+	Store
+}
+
+// Exists checks if the given deployment exists in the store.
+func (s *StoreToDeploymentLister) Exists(deployment *api.Deployment) (bool, error) {
+	_, exists, err := s.Store.Get(deployment)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// List lists all deployments in the store.
+func (s *StoreToDeploymentLister) List() (deployments []api.Deployment, err error) {
+	for _, c := range s.Store.List() {
+		deployments = append(deployments, *(c.(*api.Deployment)))
+	}
+	return deployments, nil
+}
+
+// GetDeploymentsForRC returns a list of deployments whose selector matches the
+// pod template of a replication controller. Returns an error only if no matching deployments are found.
+func (s *StoreToDeploymentLister) GetDeploymentsForRC(rc *api.ReplicationController) (deployments []api.Deployment, err error) {
+	if rc.Spec.Template == nil || len(rc.Spec.Template.Labels) == 0 {
+		err = fmt.Errorf("No deployments found for replication controller %v because its template has no labels", rc.Name)
+		return
+	}
+	deployments = s.getDeploymentsForLabels(rc.Namespace, rc.Spec.Template.Labels)
+	if len(deployments) == 0 {
+		err = fmt.Errorf("Could not find deployments for replication controller %s in namespace %s with template labels: %v", rc.Name, rc.Namespace, rc.Spec.Template.Labels)
+	}
+	return
+}
+
+// GetPodDeployments returns a list of deployments whose pods include the given pod. Returns an error only if no matching deployments are found.
+func (s *StoreToDeploymentLister) GetPodDeployments(pod *api.Pod) (deployments []api.Deployment, err error) {
+	if len(pod.Labels) == 0 {
+		err = fmt.Errorf("No deployments found for pod %v because it has no labels", pod.Name)
+		return
+	}
+	deployments = s.getDeploymentsForLabels(pod.Namespace, pod.Labels)
+	if len(deployments) == 0 {
+		err = fmt.Errorf("Could not find deployments for pod %s in namespace %s with labels: %v", pod.Name, pod.Namespace, pod.Labels)
+	}
+	return
+}
+
+func (s *StoreToDeploymentLister) getDeploymentsForLabels(namespace string, objLabels map[string]string) (deployments []api.Deployment) {
+	for _, m := range s.Store.List() {
+		deployment := *m.(*api.Deployment)
+		if deployment.Namespace != namespace {
+			continue
+		}
+		selector := labels.Set(deployment.Spec.Selector).AsSelector()
+
+		// A deployment with a nil or empty selector should match nothing, not everything.
+		if selector.Empty() || !selector.Matches(labels.Set(objLabels)) {
+			continue
+		}
+		deployments = append(deployments, deployment)
+	}
+	return
+}
+
 // StoreToServiceLister makes a Store that has the List method of the client.ServiceInterface
 // The Store must contain (only) Services.
 type StoreToServiceLister struct {
@@ -155,6 +155,222 @@ func TestStoreToControllerLister(t *testing.T) {
 	}
 }
 
+func TestStoreToJobLister(t *testing.T) {
+	testCases := []struct {
+		inJobs      []*api.Job
+		pod         *api.Pod
+		outJobNames util.StringSet
+		expectErr   bool
+	}{
+		// No pod labels
+		{
+			inJobs: []*api.Job{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "basic", Namespace: "ns"},
+					Spec:       api.JobSpec{Selector: map[string]string{"foo": "baz"}},
+				},
+			},
+			pod:       &api.Pod{ObjectMeta: api.ObjectMeta{Name: "pod1", Namespace: "ns"}},
+			expectErr: true,
+		},
+		// No job selectors
+		{
+			inJobs: []*api.Job{
+				{ObjectMeta: api.ObjectMeta{Name: "basic", Namespace: "ns"}},
+			},
+			pod: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: "pod1", Namespace: "ns", Labels: map[string]string{"foo": "bar"}},
+			},
+			expectErr: true,
+		},
+		// Matching labels to selectors and namespace
+		{
+			inJobs: []*api.Job{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "foo"},
+					Spec:       api.JobSpec{Selector: map[string]string{"foo": "bar"}},
+				},
+				{
+					ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "ns"},
+					Spec:       api.JobSpec{Selector: map[string]string{"foo": "bar"}},
+				},
+			},
+			pod: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: "pod1", Namespace: "ns", Labels: map[string]string{"foo": "bar"}},
+			},
+			outJobNames: util.NewStringSet("bar"),
+		},
+	}
+	for i, c := range testCases {
+		lister := StoreToJobLister{NewStore(MetaNamespaceKeyFunc)}
+		for _, j := range c.inJobs {
+			lister.Add(j)
+		}
+
+		gotJobs, err := lister.GetPodJobs(c.pod)
+		if err != nil && c.expectErr {
+			continue
+		} else if c.expectErr {
+			t.Fatalf("%d: expected error, got none", i)
+		} else if err != nil {
+			t.Fatalf("%d: unexpected error %#v", i, err)
+		}
+		gotNames := make([]string, len(gotJobs))
+		for ix := range gotJobs {
+			gotNames[ix] = gotJobs[ix].Name
+		}
+		if !c.outJobNames.HasAll(gotNames...) || len(gotNames) != len(c.outJobNames) {
+			t.Errorf("%d: unexpected got jobs %+v expected %+v", i, gotNames, c.outJobNames)
+		}
+	}
+}
+
+func TestStoreToDaemonSetLister(t *testing.T) {
+	testCases := []struct {
+		inDaemonSets      []*api.DaemonSet
+		pod               *api.Pod
+		outDaemonSetNames util.StringSet
+		expectErr         bool
+	}{
+		// No pod labels
+		{
+			inDaemonSets: []*api.DaemonSet{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "basic", Namespace: "ns"},
+					Spec:       api.DaemonSetSpec{Selector: map[string]string{"foo": "baz"}},
+				},
+			},
+			pod:       &api.Pod{ObjectMeta: api.ObjectMeta{Name: "pod1", Namespace: "ns"}},
+			expectErr: true,
+		},
+		// No daemon set selectors
+		{
+			inDaemonSets: []*api.DaemonSet{
+				{ObjectMeta: api.ObjectMeta{Name: "basic", Namespace: "ns"}},
+			},
+			pod: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: "pod1", Namespace: "ns", Labels: map[string]string{"foo": "bar"}},
+			},
+			expectErr: true,
+		},
+		// Matching labels to selectors and namespace
+		{
+			inDaemonSets: []*api.DaemonSet{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "foo"},
+					Spec:       api.DaemonSetSpec{Selector: map[string]string{"foo": "bar"}},
+				},
+				{
+					ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "ns"},
+					Spec:       api.DaemonSetSpec{Selector: map[string]string{"foo": "bar"}},
+				},
+			},
+			pod: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: "pod1", Namespace: "ns", Labels: map[string]string{"foo": "bar"}},
+			},
+			outDaemonSetNames: util.NewStringSet("bar"),
+		},
+	}
+	for i, c := range testCases {
+		lister := StoreToDaemonSetLister{NewStore(MetaNamespaceKeyFunc)}
+		for _, j := range c.inDaemonSets {
+			lister.Add(j)
+		}
+
+		gotDaemonSets, err := lister.GetPodDaemonSets(c.pod)
+		if err != nil && c.expectErr {
+			continue
+		} else if c.expectErr {
+			t.Fatalf("%d: expected error, got none", i)
+		} else if err != nil {
+			t.Fatalf("%d: unexpected error %#v", i, err)
+		}
+		gotNames := make([]string, len(gotDaemonSets))
+		for ix := range gotDaemonSets {
+			gotNames[ix] = gotDaemonSets[ix].Name
+		}
+		if !c.outDaemonSetNames.HasAll(gotNames...) || len(gotNames) != len(c.outDaemonSetNames) {
+			t.Errorf("%d: unexpected got daemon sets %+v expected %+v", i, gotNames, c.outDaemonSetNames)
+		}
+	}
+}
+
+func TestStoreToDeploymentLister(t *testing.T) {
+	testCases := []struct {
+		inDeployments      []*api.Deployment
+		rc                 *api.ReplicationController
+		outDeploymentNames util.StringSet
+		expectErr          bool
+	}{
+		// No replication controller template labels
+		{
+			inDeployments: []*api.Deployment{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "basic", Namespace: "ns"},
+					Spec:       api.DeploymentSpec{Selector: map[string]string{"foo": "baz"}},
+				},
+			},
+			rc:        &api.ReplicationController{ObjectMeta: api.ObjectMeta{Name: "rc1", Namespace: "ns"}},
+			expectErr: true,
+		},
+		// No deployment selectors
+		{
+			inDeployments: []*api.Deployment{
+				{ObjectMeta: api.ObjectMeta{Name: "basic", Namespace: "ns"}},
+			},
+			rc: &api.ReplicationController{
+				ObjectMeta: api.ObjectMeta{Name: "rc1", Namespace: "ns"},
+				Spec: api.ReplicationControllerSpec{
+					Template: &api.PodTemplateSpec{ObjectMeta: api.ObjectMeta{Labels: map[string]string{"foo": "bar"}}},
+				},
+			},
+			expectErr: true,
+		},
+		// Matching labels to selectors and namespace
+		{
+			inDeployments: []*api.Deployment{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "foo"},
+					Spec:       api.DeploymentSpec{Selector: map[string]string{"foo": "bar"}},
+				},
+				{
+					ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "ns"},
+					Spec:       api.DeploymentSpec{Selector: map[string]string{"foo": "bar"}},
+				},
+			},
+			rc: &api.ReplicationController{
+				ObjectMeta: api.ObjectMeta{Name: "rc1", Namespace: "ns"},
+				Spec: api.ReplicationControllerSpec{
+					Template: &api.PodTemplateSpec{ObjectMeta: api.ObjectMeta{Labels: map[string]string{"foo": "bar", "hash": "1234"}}},
+				},
+			},
+			outDeploymentNames: util.NewStringSet("bar"),
+		},
+	}
+	for i, c := range testCases {
+		lister := StoreToDeploymentLister{NewStore(MetaNamespaceKeyFunc)}
+		for _, d := range c.inDeployments {
+			lister.Add(d)
+		}
+
+		gotDeployments, err := lister.GetDeploymentsForRC(c.rc)
+		if err != nil && c.expectErr {
+			continue
+		} else if c.expectErr {
+			t.Fatalf("%d: expected error, got none", i)
+		} else if err != nil {
+			t.Fatalf("%d: unexpected error %#v", i, err)
+		}
+		gotNames := make([]string, len(gotDeployments))
+		for ix := range gotDeployments {
+			gotNames[ix] = gotDeployments[ix].Name
+		}
+		if !c.outDeploymentNames.HasAll(gotNames...) || len(gotNames) != len(c.outDeploymentNames) {
+			t.Errorf("%d: unexpected got deployments %+v expected %+v", i, gotNames, c.outDeploymentNames)
+		}
+	}
+}
+
 func TestStoreToPodLister(t *testing.T) {
 	store := NewStore(MetaNamespaceKeyFunc)
 	ids := []string{"foo", "bar", "baz"}
@@ -33,6 +33,10 @@ type Interface interface {
 	PodsNamespacer
 	PodTemplatesNamespacer
 	ReplicationControllersNamespacer
+	JobsNamespacer
+	DaemonSetsNamespacer
+	DeploymentsNamespacer
+	HorizontalPodAutoscalersNamespacer
 	ServicesNamespacer
 	EndpointsNamespacer
 	VersionInterface
@@ -42,6 +46,7 @@ type Interface interface {
 	ResourceQuotasNamespacer
 	ServiceAccountsNamespacer
 	SecretsNamespacer
+	ConfigMapsNamespacer
 	NamespacesInterface
 	PersistentVolumesInterface
 	PersistentVolumeClaimsNamespacer
@@ -52,6 +57,22 @@ func (c *Client) ReplicationControllers(namespace string) ReplicationControllerI
 	return newReplicationControllers(c, namespace)
 }
 
+func (c *Client) Jobs(namespace string) JobInterface {
+	return newJobs(c, namespace)
+}
+
+func (c *Client) DaemonSets(namespace string) DaemonSetInterface {
+	return newDaemonSets(c, namespace)
+}
+
+func (c *Client) Deployments(namespace string) DeploymentInterface {
+	return newDeployments(c, namespace)
+}
+
+func (c *Client) HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface {
+	return newHorizontalPodAutoscalers(c, namespace)
+}
+
 func (c *Client) Nodes() NodeInterface {
 	return newNodes(c)
 }
@@ -91,6 +112,10 @@ func (c *Client) Secrets(namespace string) SecretsInterface {
 	return newSecrets(c, namespace)
 }
 
+func (c *Client) ConfigMaps(namespace string) ConfigMapsInterface {
+	return newConfigMaps(c, namespace)
+}
+
 func (c *Client) Namespaces() NamespaceInterface {
 	return newNamespaces(c)
 }
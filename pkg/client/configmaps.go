@@ -0,0 +1,125 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type ConfigMapsNamespacer interface {
+	ConfigMaps(namespace string) ConfigMapsInterface
+}
+
+type ConfigMapsInterface interface {
+	Create(configMap *api.ConfigMap) (*api.ConfigMap, error)
+	Update(configMap *api.ConfigMap) (*api.ConfigMap, error)
+	Delete(name string) error
+	List(label labels.Selector, field fields.Selector) (*api.ConfigMapList, error)
+	Get(name string) (*api.ConfigMap, error)
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// configMaps implements ConfigMapsInterface
+type configMaps struct {
+	client    *Client
+	namespace string
+}
+
+// newConfigMaps returns a new configMaps object.
+func newConfigMaps(c *Client, ns string) *configMaps {
+	return &configMaps{
+		client:    c,
+		namespace: ns,
+	}
+}
+
+func (c *configMaps) Create(configMap *api.ConfigMap) (*api.ConfigMap, error) {
+	result := &api.ConfigMap{}
+	err := c.client.Post().
+		Namespace(c.namespace).
+		Resource("configMaps").
+		Body(configMap).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// List returns a list of ConfigMaps matching the selectors.
+func (c *configMaps) List(label labels.Selector, field fields.Selector) (*api.ConfigMapList, error) {
+	result := &api.ConfigMapList{}
+
+	err := c.client.Get().
+		Namespace(c.namespace).
+		Resource("configMaps").
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Get returns the given ConfigMap, or an error.
+func (c *configMaps) Get(name string) (*api.ConfigMap, error) {
+	result := &api.ConfigMap{}
+	err := c.client.Get().
+		Namespace(c.namespace).
+		Resource("configMaps").
+		Name(name).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Watch starts watching for ConfigMaps matching the given selectors.
+func (c *configMaps) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.client.Get().
+		Prefix("watch").
+		Namespace(c.namespace).
+		Resource("configMaps").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
+
+func (c *configMaps) Delete(name string) error {
+	return c.client.Delete().
+		Namespace(c.namespace).
+		Resource("configMaps").
+		Name(name).
+		Do().
+		Error()
+}
+
+func (c *configMaps) Update(configMap *api.ConfigMap) (result *api.ConfigMap, err error) {
+	result = &api.ConfigMap{}
+	err = c.client.Put().
+		Namespace(c.namespace).
+		Resource("configMaps").
+		Name(configMap.Name).
+		Body(configMap).
+		Do().
+		Into(result)
+
+	return
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func getConfigMapsResourceName() string {
+	return "configmaps"
+}
+
+func newTestConfigMap(name, namespace string) *api.ConfigMap {
+	return &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			"app.properties": "color=blue",
+		},
+	}
+}
+
+func TestConfigMapCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	configMap := newTestConfigMap("abc", ns)
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   testapi.ResourcePath(getConfigMapsResourceName(), ns, ""),
+			Query:  buildQueryValues(ns, nil),
+			Body:   configMap,
+		},
+		Response: Response{StatusCode: 200, Body: configMap},
+	}
+
+	response, err := c.Setup().ConfigMaps(ns).Create(configMap)
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapGet(t *testing.T) {
+	ns := api.NamespaceDefault
+	configMap := newTestConfigMap("abc", ns)
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.ResourcePath(getConfigMapsResourceName(), ns, "abc"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: configMap},
+	}
+
+	response, err := c.Setup().ConfigMaps(ns).Get("abc")
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapList(t *testing.T) {
+	ns := api.NamespaceDefault
+	configMapList := &api.ConfigMapList{
+		Items: []api.ConfigMap{*newTestConfigMap("foo", ns)},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.ResourcePath(getConfigMapsResourceName(), ns, ""),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: configMapList},
+	}
+	response, err := c.Setup().ConfigMaps(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	configMap := newTestConfigMap("abc", ns)
+	configMap.ResourceVersion = "1"
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: testapi.ResourcePath(getConfigMapsResourceName(), ns, "abc"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: configMap},
+	}
+	response, err := c.Setup().ConfigMaps(ns).Update(configMap)
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapDelete(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: testapi.ResourcePath(getConfigMapsResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().ConfigMaps(ns).Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestConfigMapWatch(t *testing.T) {
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   "/api/" + testapi.Version() + "/watch/" + getConfigMapsResourceName(),
+			Query:  url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().ConfigMaps(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), "")
+	c.Validate(t, nil, err)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func getHorizontalPodAutoscalersResourceName() string {
+	return "horizontalpodautoscalers"
+}
+
+func newTestHorizontalPodAutoscaler(name, namespace string) *api.HorizontalPodAutoscaler {
+	return &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:    api.ScaleReference{Kind: "ReplicationController", Name: "foo"},
+			MaxReplicas: 3,
+		},
+	}
+}
+
+func TestHorizontalPodAutoscalerCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscaler := newTestHorizontalPodAutoscaler("abc", ns)
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, ""),
+			Query:  buildQueryValues(ns, nil),
+			Body:   autoscaler,
+		},
+		Response: Response{StatusCode: 200, Body: autoscaler},
+	}
+
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).Create(autoscaler)
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerGet(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscaler := newTestHorizontalPodAutoscaler("abc", ns)
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, "abc"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: autoscaler},
+	}
+
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).Get("abc")
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerList(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscalerList := &api.HorizontalPodAutoscalerList{
+		Items: []api.HorizontalPodAutoscaler{*newTestHorizontalPodAutoscaler("foo", ns)},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, ""),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: autoscalerList},
+	}
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscaler := newTestHorizontalPodAutoscaler("abc", ns)
+	autoscaler.ResourceVersion = "1"
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, "abc"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: autoscaler},
+	}
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).Update(autoscaler)
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerUpdateStatus(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscaler := newTestHorizontalPodAutoscaler("abc", ns)
+	autoscaler.ResourceVersion = "1"
+	autoscaler.Status = api.HorizontalPodAutoscalerStatus{CurrentReplicas: 2, DesiredReplicas: 3}
+	c := &testClient{
+		Request: testRequest{
+			Method: "PUT",
+			Path:   testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, "abc") + "/status",
+			Query:  buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: autoscaler},
+	}
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).UpdateStatus(autoscaler)
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerDelete(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: testapi.ResourcePath(getHorizontalPodAutoscalersResourceName(), ns, "foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().HorizontalPodAutoscalers(ns).Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestHorizontalPodAutoscalerWatch(t *testing.T) {
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   "/api/" + testapi.Version() + "/watch/" + getHorizontalPodAutoscalersResourceName(),
+			Query:  url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().HorizontalPodAutoscalers(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), "")
+	c.Validate(t, nil, err)
+}
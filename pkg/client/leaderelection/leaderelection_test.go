@@ -0,0 +1,181 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func endpointsWithRecord(t *testing.T, holder string, renewTime time.Time) *api.Endpoints {
+	record := LeaderElectionRecord{
+		HolderIdentity:       holder,
+		LeaseDurationSeconds: 10,
+		AcquireTime:          util.NewTime(renewTime),
+		RenewTime:            util.NewTime(renewTime),
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &api.Endpoints{
+		ObjectMeta: api.ObjectMeta{
+			Namespace:   "foo",
+			Name:        "bar",
+			Annotations: map[string]string{LeaderElectionRecordAnnotationKey: string(data)},
+		},
+	}
+}
+
+func TestTryAcquireOrRenew(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name          string
+		existing      *api.Endpoints
+		observedTime  time.Time
+		expectSuccess bool
+		expectAction  string
+	}{
+		{
+			name:          "acquire from no endpoints",
+			existing:      nil,
+			expectSuccess: true,
+			expectAction:  "create-endpoints",
+		},
+		{
+			name:          "acquire from endpoints without annotations",
+			existing:      &api.Endpoints{ObjectMeta: api.ObjectMeta{Namespace: "foo", Name: "bar"}},
+			expectSuccess: true,
+			expectAction:  "update-endpoints",
+		},
+		{
+			name:          "acquire from expired lease held by another",
+			existing:      endpointsWithRecord(t, "bing", now),
+			observedTime:  now.Add(-time.Hour),
+			expectSuccess: true,
+			expectAction:  "update-endpoints",
+		},
+		{
+			name:          "don't acquire from unexpired lease held by another",
+			existing:      endpointsWithRecord(t, "bing", now),
+			observedTime:  now,
+			expectSuccess: false,
+			expectAction:  "get-endpoints",
+		},
+		{
+			name:          "renew own lease",
+			existing:      endpointsWithRecord(t, "baz", now),
+			observedTime:  now,
+			expectSuccess: true,
+			expectAction:  "update-endpoints",
+		},
+	}
+
+	for _, test := range tests {
+		existing := test.existing
+		c := &testclient.Fake{
+			ReactFn: func(action testclient.FakeAction) (runtime.Object, error) {
+				switch action.Action {
+				case "get-endpoints":
+					if existing == nil {
+						return &api.Endpoints{}, errors.NewNotFound("endpoints", "bar")
+					}
+					return existing, nil
+				case "create-endpoints", "update-endpoints":
+					return &api.Endpoints{}, nil
+				}
+				return nil, fmt.Errorf("unexpected action %q", action.Action)
+			},
+		}
+		le, err := NewLeaderElector(LeaderElectionConfig{
+			EndpointsMeta: api.ObjectMeta{Namespace: "foo", Name: "bar"},
+			Identity:      "baz",
+			Client:        c,
+			EventRecorder: &record.FakeRecorder{},
+			LeaseDuration: 10 * time.Second,
+			RenewDeadline: 5 * time.Second,
+			RetryPeriod:   time.Second,
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		le.now = func() time.Time { return now }
+		if existing != nil {
+			// Pretend we have already seen the current record at observedTime.
+			if data, found := existing.Annotations[LeaderElectionRecordAnnotationKey]; found {
+				if err := json.Unmarshal([]byte(data), &le.observedRecord); err != nil {
+					t.Fatalf("%s: unexpected error: %v", test.name, err)
+				}
+				le.observedTime = test.observedTime
+			}
+		}
+
+		if succeeded := le.tryAcquireOrRenew(); succeeded != test.expectSuccess {
+			t.Errorf("%s: expected success %v, got %v", test.name, test.expectSuccess, succeeded)
+		}
+		lastAction := c.Actions[len(c.Actions)-1].Action
+		if lastAction != test.expectAction {
+			t.Errorf("%s: expected last action %q, got %q", test.name, test.expectAction, lastAction)
+		}
+		if test.expectSuccess && !le.IsLeader() {
+			t.Errorf("%s: expected to be leader, leader is %q", test.name, le.GetLeader())
+		}
+		if !test.expectSuccess && le.IsLeader() {
+			t.Errorf("%s: expected not to be leader", test.name)
+		}
+	}
+}
+
+func TestNewLeaderElectorValidation(t *testing.T) {
+	valid := LeaderElectionConfig{
+		EndpointsMeta: api.ObjectMeta{Namespace: "foo", Name: "bar"},
+		Identity:      "baz",
+		Client:        &testclient.Fake{},
+		EventRecorder: &record.FakeRecorder{},
+		LeaseDuration: DefaultLeaseDuration,
+		RenewDeadline: DefaultRenewDeadline,
+		RetryPeriod:   DefaultRetryPeriod,
+	}
+	if _, err := NewLeaderElector(valid); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	invalid := []func(*LeaderElectionConfig){
+		func(c *LeaderElectionConfig) { c.LeaseDuration = c.RenewDeadline },
+		func(c *LeaderElectionConfig) { c.RenewDeadline = c.RetryPeriod },
+		func(c *LeaderElectionConfig) { c.Client = nil },
+		func(c *LeaderElectionConfig) { c.EventRecorder = nil },
+		func(c *LeaderElectionConfig) { c.Identity = "" },
+		func(c *LeaderElectionConfig) { c.EndpointsMeta.Namespace = "" },
+	}
+	for i, mutate := range invalid {
+		config := valid
+		mutate(&config)
+		if _, err := NewLeaderElector(config); err == nil {
+			t.Errorf("%d: expected error", i)
+		}
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion/queryparams"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/httpstream"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/httpstream/spdy"
 	"github.com/golang/glog"
@@ -40,29 +41,50 @@ func (u *defaultUpgrader) upgrade(req *client.Request, config *client.Config) (h
 	return req.Upgrade(config, spdy.NewRoundTripper)
 }
 
+// Streamer copies data between local streams and the streams of a remote
+// process in a pod container.
+type Streamer struct {
+	req    *client.Request
+	config *client.Config
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	tty    bool
+
+	upgrader upgrader
+}
+
 // Executor executes a command on a pod container
 type Executor struct {
-	req     *client.Request
-	config  *client.Config
+	Streamer
 	command []string
-	stdin   io.Reader
-	stdout  io.Writer
-	stderr  io.Writer
-	tty     bool
-
-	upgrader upgrader
 }
 
 // New creates a new RemoteCommandExecutor
 func New(req *client.Request, config *client.Config, command []string, stdin io.Reader, stdout, stderr io.Writer, tty bool) *Executor {
 	return &Executor{
-		req:     req,
-		config:  config,
 		command: command,
-		stdin:   stdin,
-		stdout:  stdout,
-		stderr:  stderr,
-		tty:     tty,
+		Streamer: Streamer{
+			req:    req,
+			config: config,
+			stdin:  stdin,
+			stdout: stdout,
+			stderr: stderr,
+			tty:    tty,
+		},
+	}
+}
+
+// NewAttach creates a new Streamer that attaches to the main process of a
+// pod container.
+func NewAttach(req *client.Request, config *client.Config, stdin io.Reader, stdout, stderr io.Writer, tty bool) *Streamer {
+	return &Streamer{
+		req:    req,
+		config: config,
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+		tty:    tty,
 	}
 }
 
@@ -77,8 +99,32 @@ func (e *Executor) Execute() error {
 		TTY:     e.tty,
 		Command: e.command,
 	}
+	if err := e.setupRequestParameters(&opts); err != nil {
+		return err
+	}
+	return e.doStream()
+}
 
-	versioned, err := api.Scheme.ConvertToVersion(&opts, e.config.Version)
+// Stream sends a remote attach request, upgrading the connection and creating
+// streams to represent stdin/stdout/stderr. Data is copied between these
+// streams and the supplied stdin/stdout/stderr parameters.
+func (e *Streamer) Stream() error {
+	opts := api.PodAttachOptions{
+		Stdin:  (e.stdin != nil),
+		Stdout: (e.stdout != nil),
+		Stderr: (!e.tty && e.stderr != nil),
+		TTY:    e.tty,
+	}
+	if err := e.setupRequestParameters(&opts); err != nil {
+		return err
+	}
+	return e.doStream()
+}
+
+// setupRequestParameters adds the versioned options to the request as query
+// parameters.
+func (e *Streamer) setupRequestParameters(obj runtime.Object) error {
+	versioned, err := api.Scheme.ConvertToVersion(obj, e.config.Version)
 	if err != nil {
 		return err
 	}
@@ -91,7 +137,12 @@ func (e *Executor) Execute() error {
 			e.req.Param(k, vv)
 		}
 	}
+	return nil
+}
 
+// doStream upgrades the connection and copies data between the local and
+// remote streams until the remote process exits.
+func (e *Streamer) doStream() error {
 	if e.upgrader == nil {
 		e.upgrader = &defaultUpgrader{}
 	}
@@ -134,7 +185,7 @@ func (e *Executor) Execute() error {
 	}()
 	defer errorStream.Reset()
 
-	if opts.Stdin {
+	if e.stdin != nil {
 		headers.Set(api.StreamType, api.StreamTypeStdin)
 		remoteStdin, err := conn.CreateStream(headers)
 		if err != nil {
@@ -151,7 +202,7 @@ func (e *Executor) Execute() error {
 	waitCount := 0
 	completedStreams := 0
 
-	if opts.Stdout {
+	if e.stdout != nil {
 		waitCount++
 		headers.Set(api.StreamType, api.StreamTypeStdout)
 		remoteStdout, err := conn.CreateStream(headers)
@@ -162,7 +213,7 @@ func (e *Executor) Execute() error {
 		go cp(api.StreamTypeStdout, e.stdout, remoteStdout)
 	}
 
-	if opts.Stderr && !e.tty {
+	if e.stderr != nil && !e.tty {
 		waitCount++
 		headers.Set(api.StreamType, api.StreamTypeStderr)
 		remoteStderr, err := conn.CreateStream(headers)
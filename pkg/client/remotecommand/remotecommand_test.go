@@ -286,3 +286,74 @@ func TestRequestExecuteRemoteCommand(t *testing.T) {
 		}
 	}
 }
+
+func TestRequestAttachRemoteCommand(t *testing.T) {
+	testCases := []struct {
+		Upgrader    *fakeUpgrader
+		Stdout      string
+		Stderr      string
+		Error       string
+		Tty         bool
+		ShouldError bool
+	}{
+		{
+			Upgrader:    &fakeUpgrader{err: errors.New("bail")},
+			ShouldError: true,
+		},
+		{
+			Upgrader:    &fakeUpgrader{conn: newFakeUpgradeConnection()},
+			Stdout:      "b",
+			Stderr:      "c",
+			Error:       "bail",
+			ShouldError: true,
+		},
+		{
+			Upgrader: &fakeUpgrader{conn: newFakeUpgradeConnection()},
+			Stdout:   "b",
+			Stderr:   "c",
+		},
+		{
+			Upgrader: &fakeUpgrader{conn: newFakeUpgradeConnection()},
+			Stdout:   "b",
+			Stderr:   "c",
+			Tty:      true,
+		},
+	}
+
+	for i, testCase := range testCases {
+		if testCase.Upgrader.conn != nil {
+			testCase.Upgrader.conn.errorData = testCase.Error
+			testCase.Upgrader.conn.stdoutData = testCase.Stdout
+			testCase.Upgrader.conn.stderrData = testCase.Stderr
+		}
+		localOut, localErr := &bytes.Buffer{}, &bytes.Buffer{}
+		e := NewAttach(&client.Request{}, &client.Config{}, nil, localOut, localErr, testCase.Tty)
+		e.upgrader = testCase.Upgrader
+		err := e.Stream()
+		hasErr := err != nil
+		if hasErr != testCase.ShouldError {
+			t.Fatalf("%d: expected %t, got %t: %v", i, testCase.ShouldError, hasErr, err)
+		}
+		if testCase.ShouldError {
+			continue
+		}
+
+		conn := testCase.Upgrader.conn
+		if conn.stdin != nil {
+			t.Fatalf("%d: unexpected stdin stream creation", i)
+		}
+		if e, a := testCase.Stdout, localOut.String(); e != a {
+			t.Fatalf("%d: expected stdout data '%s', got '%s'", i, e, a)
+		}
+		if testCase.Tty {
+			if conn.stderr != nil {
+				t.Fatalf("%d: unexpected stderr stream creation", i)
+			}
+		} else if e, a := testCase.Stderr, localErr.String(); e != a {
+			t.Fatalf("%d: expected stderr data '%s', got '%s'", i, e, a)
+		}
+		if !conn.closeCalled {
+			t.Fatalf("%d: expected upgraded connection to get closed", i)
+		}
+	}
+}
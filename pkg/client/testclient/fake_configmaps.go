@@ -0,0 +1,61 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testclient
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Fake implements ConfigMapsInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeConfigMaps struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeConfigMaps) List(labels labels.Selector, field fields.Selector) (*api.ConfigMapList, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "list-configmaps"}, &api.ConfigMapList{})
+	return obj.(*api.ConfigMapList), err
+}
+
+func (c *FakeConfigMaps) Get(name string) (*api.ConfigMap, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "get-configmap", Value: name}, &api.ConfigMap{})
+	return obj.(*api.ConfigMap), err
+}
+
+func (c *FakeConfigMaps) Create(configMap *api.ConfigMap) (*api.ConfigMap, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "create-configmap", Value: configMap}, &api.ConfigMap{})
+	return obj.(*api.ConfigMap), err
+}
+
+func (c *FakeConfigMaps) Update(configMap *api.ConfigMap) (*api.ConfigMap, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "update-configmap", Value: configMap}, &api.ConfigMap{})
+	return obj.(*api.ConfigMap), err
+}
+
+func (c *FakeConfigMaps) Delete(name string) error {
+	_, err := c.Fake.Invokes(FakeAction{Action: "delete-configmap", Value: name}, &api.ConfigMap{})
+	return err
+}
+
+func (c *FakeConfigMaps) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-configmaps", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
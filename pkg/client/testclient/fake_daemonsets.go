@@ -0,0 +1,66 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testclient
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeDaemonSets implements DaemonSetInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeDaemonSets struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeDaemonSets) List(label labels.Selector, field fields.Selector) (*api.DaemonSetList, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "list-daemonsets"}, &api.DaemonSetList{})
+	return obj.(*api.DaemonSetList), err
+}
+
+func (c *FakeDaemonSets) Get(name string) (*api.DaemonSet, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "get-daemonset", Value: name}, &api.DaemonSet{})
+	return obj.(*api.DaemonSet), err
+}
+
+func (c *FakeDaemonSets) Create(ds *api.DaemonSet) (*api.DaemonSet, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "create-daemonset", Value: ds}, &api.DaemonSet{})
+	return obj.(*api.DaemonSet), err
+}
+
+func (c *FakeDaemonSets) Update(ds *api.DaemonSet) (*api.DaemonSet, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "update-daemonset", Value: ds}, &api.DaemonSet{})
+	return obj.(*api.DaemonSet), err
+}
+
+func (c *FakeDaemonSets) UpdateStatus(ds *api.DaemonSet) (*api.DaemonSet, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "update-status-daemonset", Value: ds}, &api.DaemonSet{})
+	return obj.(*api.DaemonSet), err
+}
+
+func (c *FakeDaemonSets) Delete(name string) error {
+	_, err := c.Fake.Invokes(FakeAction{Action: "delete-daemonset", Value: name}, &api.DaemonSet{})
+	return err
+}
+
+func (c *FakeDaemonSets) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-daemonsets", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
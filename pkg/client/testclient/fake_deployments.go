@@ -0,0 +1,66 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testclient
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeDeployments implements DeploymentInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeDeployments struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeDeployments) List(label labels.Selector, field fields.Selector) (*api.DeploymentList, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "list-deployments"}, &api.DeploymentList{})
+	return obj.(*api.DeploymentList), err
+}
+
+func (c *FakeDeployments) Get(name string) (*api.Deployment, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "get-deployment", Value: name}, &api.Deployment{})
+	return obj.(*api.Deployment), err
+}
+
+func (c *FakeDeployments) Create(deployment *api.Deployment) (*api.Deployment, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "create-deployment", Value: deployment}, &api.Deployment{})
+	return obj.(*api.Deployment), err
+}
+
+func (c *FakeDeployments) Update(deployment *api.Deployment) (*api.Deployment, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "update-deployment", Value: deployment}, &api.Deployment{})
+	return obj.(*api.Deployment), err
+}
+
+func (c *FakeDeployments) UpdateStatus(deployment *api.Deployment) (*api.Deployment, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "update-status-deployment", Value: deployment}, &api.Deployment{})
+	return obj.(*api.Deployment), err
+}
+
+func (c *FakeDeployments) Delete(name string) error {
+	_, err := c.Fake.Invokes(FakeAction{Action: "delete-deployment", Value: name}, &api.Deployment{})
+	return err
+}
+
+func (c *FakeDeployments) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-deployments", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
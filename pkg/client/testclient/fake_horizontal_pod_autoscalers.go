@@ -0,0 +1,66 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testclient
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeHorizontalPodAutoscalers implements HorizontalPodAutoscalerInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeHorizontalPodAutoscalers struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeHorizontalPodAutoscalers) List(label labels.Selector, field fields.Selector) (*api.HorizontalPodAutoscalerList, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "list-horizontalpodautoscalers"}, &api.HorizontalPodAutoscalerList{})
+	return obj.(*api.HorizontalPodAutoscalerList), err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Get(name string) (*api.HorizontalPodAutoscaler, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "get-horizontalpodautoscaler", Value: name}, &api.HorizontalPodAutoscaler{})
+	return obj.(*api.HorizontalPodAutoscaler), err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Create(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "create-horizontalpodautoscaler", Value: autoscaler}, &api.HorizontalPodAutoscaler{})
+	return obj.(*api.HorizontalPodAutoscaler), err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Update(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "update-horizontalpodautoscaler", Value: autoscaler}, &api.HorizontalPodAutoscaler{})
+	return obj.(*api.HorizontalPodAutoscaler), err
+}
+
+func (c *FakeHorizontalPodAutoscalers) UpdateStatus(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	obj, err := c.Fake.Invokes(FakeAction{Action: "update-status-horizontalpodautoscaler", Value: autoscaler}, &api.HorizontalPodAutoscaler{})
+	return obj.(*api.HorizontalPodAutoscaler), err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Delete(name string) error {
+	_, err := c.Fake.Invokes(FakeAction{Action: "delete-horizontalpodautoscaler", Value: name}, &api.HorizontalPodAutoscaler{})
+	return err
+}
+
+func (c *FakeHorizontalPodAutoscalers) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-horizontalpodautoscalers", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
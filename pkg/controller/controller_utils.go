@@ -26,6 +26,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/golang/glog"
 	"sync/atomic"
 )
@@ -81,7 +82,12 @@ func (r *RCExpectations) GetExpectations(rc *api.ReplicationController) (*PodExp
 	if err != nil {
 		return nil, false, err
 	}
-	if podExp, exists, err := r.GetByKey(rcKey); err == nil && exists {
+	return r.getExpectationsForKey(rcKey)
+}
+
+// getExpectationsForKey returns the PodExpectations stored under the given key.
+func (r *RCExpectations) getExpectationsForKey(key string) (*PodExpectations, bool, error) {
+	if podExp, exists, err := r.GetByKey(key); err == nil && exists {
 		return podExp.(*PodExpectations), true, nil
 	} else {
 		return nil, false, err
@@ -101,7 +107,18 @@ func (r *RCExpectations) DeleteExpectations(rcKey string) {
 // for the given rc. Add/del counts are established by the rc at sync time, and updated as pods
 // are observed by the replication manager's podController.
 func (r *RCExpectations) SatisfiedExpectations(rc *api.ReplicationController) bool {
-	if podExp, exists, err := r.GetExpectations(rc); exists {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		glog.V(2).Infof("Error encountered while checking expectations %#v, forcing sync", err)
+		return true
+	}
+	return r.satisfiedExpectationsForKey(rcKey)
+}
+
+// satisfiedExpectationsForKey returns true if the expectations stored under the
+// given key have been fulfilled, or if there are no such expectations.
+func (r *RCExpectations) satisfiedExpectationsForKey(key string) bool {
+	if podExp, exists, err := r.getExpectationsForKey(key); exists {
 		if podExp.Fulfilled() {
 			return true
 		} else {
@@ -116,7 +133,7 @@ func (r *RCExpectations) SatisfiedExpectations(rc *api.ReplicationController) bo
 		//	- In this case it wakes up, creates/deletes pods, and sets expectations again.
 		// When it has satisfied expectations and no pods need to be created/destroyed > TTL, the expectations expire.
 		//	- In this case it continues without setting expectations till it needs to create/delete pods.
-		glog.V(4).Infof("Controller %v either never recorded expectations, or the ttl expired.", rc.Name)
+		glog.V(4).Infof("Controller %v either never recorded expectations, or the ttl expired.", key)
 	}
 	// Trigger a sync if we either encountered and error (which shouldn't happen since we're
 	// getting from local store) or this rc hasn't established expectations.
@@ -129,7 +146,12 @@ func (r *RCExpectations) setExpectations(rc *api.ReplicationController, add, del
 	if err != nil {
 		return err
 	}
-	podExp := &PodExpectations{add: int64(add), del: int64(del), key: rcKey}
+	return r.setExpectationsForKey(rcKey, add, del)
+}
+
+// setExpectationsForKey registers new expectations under the given key. Forgets existing expectations.
+func (r *RCExpectations) setExpectationsForKey(key string, add, del int) error {
+	podExp := &PodExpectations{add: int64(add), del: int64(del), key: key}
 	glog.V(4).Infof("Setting expectations %+v", podExp)
 	return r.Add(podExp)
 }
@@ -144,7 +166,17 @@ func (r *RCExpectations) ExpectDeletions(rc *api.ReplicationController, dels int
 
 // Decrements the expectation counts of the given rc.
 func (r *RCExpectations) lowerExpectations(rc *api.ReplicationController, add, del int) {
-	if podExp, exists, err := r.GetExpectations(rc); err == nil && exists {
+	rcKey, err := rcKeyFunc(rc)
+	if err != nil {
+		glog.V(2).Infof("Error lowering expectations for rc %v: %v", rc.Name, err)
+		return
+	}
+	r.lowerExpectationsForKey(rcKey, add, del)
+}
+
+// Decrements the expectation counts stored under the given key.
+func (r *RCExpectations) lowerExpectationsForKey(key string, add, del int) {
+	if podExp, exists, err := r.getExpectationsForKey(key); err == nil && exists {
 		podExp.Seen(int64(add), int64(del))
 		// The expectations might've been modified since the update on the previous line.
 		glog.V(4).Infof("Lowering expectations %+v", podExp)
@@ -211,12 +243,19 @@ type RealPodControl struct {
 }
 
 func (r RealPodControl) createReplica(namespace string, controller *api.ReplicationController) error {
+	return r.createPodFromTemplate(namespace, controller.Spec.Template, controller, controller.Name, "")
+}
+
+// createPodFromTemplate creates a pod from the given template in the given
+// namespace, annotated as created by the given controller object. If nodeName
+// is not empty the pod is bound to that node directly, bypassing the scheduler.
+func (r RealPodControl) createPodFromTemplate(namespace string, template *api.PodTemplateSpec, controller runtime.Object, controllerName, nodeName string) error {
 	desiredLabels := make(labels.Set)
-	for k, v := range controller.Spec.Template.Labels {
+	for k, v := range template.Labels {
 		desiredLabels[k] = v
 	}
 	desiredAnnotations := make(labels.Set)
-	for k, v := range controller.Spec.Template.Annotations {
+	for k, v := range template.Annotations {
 		desiredAnnotations[k] = v
 	}
 
@@ -234,9 +273,9 @@ func (r RealPodControl) createReplica(namespace string, controller *api.Replicat
 	desiredAnnotations[CreatedByAnnotation] = string(createdByRefJson)
 
 	// use the dash (if the name isn't too long) to make the pod name a bit prettier
-	prefix := fmt.Sprintf("%s-", controller.Name)
+	prefix := fmt.Sprintf("%s-", controllerName)
 	if ok, _ := validation.ValidatePodName(prefix, true); !ok {
-		prefix = controller.Name
+		prefix = controllerName
 	}
 
 	pod := &api.Pod{
@@ -246,9 +285,12 @@ func (r RealPodControl) createReplica(namespace string, controller *api.Replicat
 			GenerateName: prefix,
 		},
 	}
-	if err := api.Scheme.Convert(&controller.Spec.Template.Spec, &pod.Spec); err != nil {
+	if err := api.Scheme.Convert(&template.Spec, &pod.Spec); err != nil {
 		return fmt.Errorf("unable to convert pod template: %v", err)
 	}
+	if len(nodeName) != 0 {
+		pod.Spec.NodeName = nodeName
+	}
 	if labels.Set(pod.Labels).AsSelector().Empty() {
 		return fmt.Errorf("unable to create pod replica, no labels")
 	}
@@ -256,12 +298,20 @@ func (r RealPodControl) createReplica(namespace string, controller *api.Replicat
 		r.recorder.Eventf(controller, "failedCreate", "Error creating: %v", err)
 		return fmt.Errorf("unable to create pod replica: %v", err)
 	} else {
-		glog.V(4).Infof("Controller %v created pod %v", controller.Name, newPod.Name)
+		glog.V(4).Infof("Controller %v created pod %v", controllerName, newPod.Name)
 		r.recorder.Eventf(controller, "successfulCreate", "Created pod: %v", newPod.Name)
 	}
 	return nil
 }
 
+func (r RealPodControl) createJobPod(namespace string, job *api.Job) error {
+	return r.createPodFromTemplate(namespace, job.Spec.Template, job, job.Name, "")
+}
+
+func (r RealPodControl) createDaemonPod(namespace string, ds *api.DaemonSet, nodeName string) error {
+	return r.createPodFromTemplate(namespace, ds.Spec.Template, ds, ds.Name, nodeName)
+}
+
 func (r RealPodControl) deletePod(namespace, podID string) error {
 	return r.kubeClient.Pods(namespace).Delete(podID, nil)
 }
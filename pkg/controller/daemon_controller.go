@@ -0,0 +1,531 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/framework"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/workqueue"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+	"github.com/golang/glog"
+)
+
+const (
+	// We'll attempt to recompute the status of all daemon sets at least this often.
+	FullDaemonSetResyncPeriod = 30 * time.Second
+
+	// Period at which the node store is relisted.
+	NodeRelistPeriod = 5 * time.Minute
+)
+
+// daemonPodControlInterface is an interface that knows how to add or delete
+// daemon pods, created as an interface to allow testing.
+type daemonPodControlInterface interface {
+	// createDaemonPod creates a new pod according to the daemon set's
+	// template, bound to the given node.
+	createDaemonPod(namespace string, ds *api.DaemonSet, nodeName string) error
+	// deletePod deletes the pod identified by podID.
+	deletePod(namespace string, podID string) error
+}
+
+// DaemonSetsController is responsible for synchronizing DaemonSet objects stored
+// in the system with actual running pods, one per eligible node.
+type DaemonSetsController struct {
+	kubeClient client.Interface
+	podControl daemonPodControlInterface
+
+	// To allow injection of syncDaemonSet for testing.
+	syncHandler func(dsKey string) error
+	// To allow injection of updateDaemonSetStatus for testing.
+	updateHandler func(ds *api.DaemonSet) error
+
+	// podStoreSynced and nodeStoreSynced return true if the respective stores
+	// have been synced at least once. Added as members to the struct to allow
+	// injection for testing.
+	podStoreSynced  func() bool
+	nodeStoreSynced func() bool
+
+	// A TTLCache of pod creates/deletes each daemon set expects to see
+	expectations *RCExpectations
+	// A store of daemon sets, populated by the dsController
+	dsStore cache.StoreToDaemonSetLister
+	// A store of pods, populated by the podController
+	podStore cache.StoreToPodLister
+	// A store of nodes, populated by the nodeController
+	nodeStore cache.StoreToNodeLister
+	// Watches changes to all daemon sets
+	dsController *framework.Controller
+	// Watches changes to all pods
+	podController *framework.Controller
+	// Watches changes to all nodes
+	nodeController *framework.Controller
+	// Daemon sets that need to be synced
+	queue *workqueue.Type
+}
+
+// NewDaemonSetsController creates a new DaemonSetsController.
+func NewDaemonSetsController(kubeClient client.Interface) *DaemonSetsController {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(kubeClient.Events(""))
+
+	dsc := &DaemonSetsController{
+		kubeClient: kubeClient,
+		podControl: RealPodControl{
+			kubeClient: kubeClient,
+			recorder:   eventBroadcaster.NewRecorder(api.EventSource{Component: "daemon-set"}),
+		},
+		expectations: NewRCExpectations(),
+		queue:        workqueue.New(),
+	}
+
+	dsc.dsStore.Store, dsc.dsController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dsc.kubeClient.DaemonSets(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dsc.kubeClient.DaemonSets(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.DaemonSet{},
+		FullDaemonSetResyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: dsc.enqueueDaemonSet,
+			UpdateFunc: func(old, cur interface{}) {
+				dsc.enqueueDaemonSet(cur)
+			},
+			DeleteFunc: dsc.enqueueDaemonSet,
+		},
+	)
+
+	dsc.podStore.Store, dsc.podController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dsc.kubeClient.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dsc.kubeClient.Pods(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Pod{},
+		PodRelistPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    dsc.addPod,
+			UpdateFunc: dsc.updatePod,
+			DeleteFunc: dsc.deletePod,
+		},
+	)
+
+	dsc.nodeStore.Store, dsc.nodeController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dsc.kubeClient.Nodes().List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dsc.kubeClient.Nodes().Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Node{},
+		NodeRelistPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    dsc.addNode,
+			UpdateFunc: dsc.updateNode,
+		},
+	)
+
+	dsc.syncHandler = dsc.syncDaemonSet
+	dsc.updateHandler = dsc.updateDaemonSetStatus
+	dsc.podStoreSynced = dsc.podController.HasSynced
+	dsc.nodeStoreSynced = dsc.nodeController.HasSynced
+	return dsc
+}
+
+// Run begins watching and syncing daemon sets.
+func (dsc *DaemonSetsController) Run(workers int, stopCh <-chan struct{}) {
+	defer util.HandleCrash()
+	go dsc.dsController.Run(stopCh)
+	go dsc.podController.Run(stopCh)
+	go dsc.nodeController.Run(stopCh)
+	for i := 0; i < workers; i++ {
+		go util.Until(dsc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+	glog.Infof("Shutting down Daemon Set Controller")
+	dsc.queue.ShutDown()
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (dsc *DaemonSetsController) worker() {
+	for {
+		func() {
+			key, quit := dsc.queue.Get()
+			if quit {
+				return
+			}
+			defer dsc.queue.Done(key)
+			err := dsc.syncHandler(key.(string))
+			if err != nil {
+				glog.Errorf("Error syncing daemon set: %v", err)
+			}
+		}()
+	}
+}
+
+// enqueueAllDaemonSets wakes up every known daemon set, e.g. because the set of
+// nodes they could run on has changed.
+func (dsc *DaemonSetsController) enqueueAllDaemonSets() {
+	glog.V(4).Infof("Enqueueing all daemon sets")
+	for _, ds := range dsc.dsStore.Store.List() {
+		dsc.enqueueDaemonSet(ds)
+	}
+}
+
+// obj could be an *api.DaemonSet, or a DeletionFinalStateUnknown marker item.
+func (dsc *DaemonSetsController) enqueueDaemonSet(obj interface{}) {
+	key, err := rcKeyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	dsc.queue.Add(key)
+}
+
+// getPodDaemonSet returns the daemon set managing the given pod.
+func (dsc *DaemonSetsController) getPodDaemonSet(pod *api.Pod) *api.DaemonSet {
+	sets, err := dsc.dsStore.GetPodDaemonSets(pod)
+	if err != nil {
+		glog.V(4).Infof("No daemon sets found for pod %v, daemon set controller will avoid syncing", pod.Name)
+		return nil
+	}
+	return &sets[0]
+}
+
+// When a pod is created, enqueue the daemon set that manages it and update its expectations.
+func (dsc *DaemonSetsController) addPod(obj interface{}) {
+	pod := obj.(*api.Pod)
+	if ds := dsc.getPodDaemonSet(pod); ds != nil {
+		dsKey, err := rcKeyFunc(ds)
+		if err != nil {
+			glog.Errorf("Couldn't get key for daemon set %#v: %v", ds, err)
+			return
+		}
+		dsc.expectations.lowerExpectationsForKey(dsKey, 1, 0)
+		dsc.enqueueDaemonSet(ds)
+	}
+}
+
+// When a pod is updated, figure out what daemon set manages it and wake it up.
+// If the labels of the pod have changed we need to awaken both the old and new
+// daemon set. old and cur must be *api.Pod types.
+func (dsc *DaemonSetsController) updatePod(old, cur interface{}) {
+	if api.Semantic.DeepEqual(old, cur) {
+		// A periodic relist will send update events for all known pods.
+		return
+	}
+	curPod := cur.(*api.Pod)
+	if ds := dsc.getPodDaemonSet(curPod); ds != nil {
+		dsc.enqueueDaemonSet(ds)
+	}
+	oldPod := old.(*api.Pod)
+	// Only need to get the old daemon set if the labels changed.
+	if !reflect.DeepEqual(curPod.Labels, oldPod.Labels) {
+		if oldDS := dsc.getPodDaemonSet(oldPod); oldDS != nil {
+			dsc.enqueueDaemonSet(oldDS)
+		}
+	}
+}
+
+// When a pod is deleted, enqueue the daemon set that manages the pod and update its expectations.
+// obj could be an *api.Pod, or a DeletionFinalStateUnknown marker item.
+func (dsc *DaemonSetsController) deletePod(obj interface{}) {
+	pod, ok := obj.(*api.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			glog.Errorf("Couldn't get object from tombstone %+v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*api.Pod)
+		if !ok {
+			glog.Errorf("Tombstone contained object that is not a pod %+v", obj)
+			return
+		}
+	}
+	if ds := dsc.getPodDaemonSet(pod); ds != nil {
+		dsKey, err := rcKeyFunc(ds)
+		if err != nil {
+			glog.Errorf("Couldn't get key for daemon set %#v: %v", ds, err)
+			return
+		}
+		dsc.expectations.lowerExpectationsForKey(dsKey, 0, 1)
+		dsc.enqueueDaemonSet(ds)
+	}
+}
+
+func (dsc *DaemonSetsController) addNode(obj interface{}) {
+	dsc.enqueueAllDaemonSets()
+}
+
+func (dsc *DaemonSetsController) updateNode(old, cur interface{}) {
+	oldNode := old.(*api.Node)
+	curNode := cur.(*api.Node)
+	if reflect.DeepEqual(oldNode.Labels, curNode.Labels) &&
+		oldNode.Spec.Unschedulable == curNode.Spec.Unschedulable &&
+		api.Semantic.DeepEqual(oldNode.Status.Capacity, curNode.Status.Capacity) {
+		// None of the node properties daemon sets care about have changed.
+		return
+	}
+	dsc.enqueueAllDaemonSets()
+}
+
+// getNodesToDaemonPods returns a map from node name to the active daemon pods
+// of the given daemon set running on that node.
+func (dsc *DaemonSetsController) getNodesToDaemonPods(ds *api.DaemonSet) (map[string][]*api.Pod, error) {
+	nodeToDaemonPods := make(map[string][]*api.Pod)
+	daemonPods, err := dsc.podStore.Pods(ds.Namespace).List(labels.Set(ds.Spec.Selector).AsSelector())
+	if err != nil {
+		return nodeToDaemonPods, err
+	}
+	for _, pod := range filterActivePods(daemonPods.Items) {
+		nodeName := pod.Spec.NodeName
+		nodeToDaemonPods[nodeName] = append(nodeToDaemonPods[nodeName], pod)
+	}
+	return nodeToDaemonPods, nil
+}
+
+// getNodesToPods returns a map from node name to all active pods bound to that node.
+func (dsc *DaemonSetsController) getNodesToPods() (map[string][]*api.Pod, error) {
+	nodeToPods := make(map[string][]*api.Pod)
+	pods, err := dsc.podStore.List(labels.Everything())
+	if err != nil {
+		return nodeToPods, err
+	}
+	for _, pod := range pods {
+		if pod.Status.Phase == api.PodSucceeded || pod.Status.Phase == api.PodFailed || len(pod.Spec.NodeName) == 0 {
+			continue
+		}
+		nodeToPods[pod.Spec.NodeName] = append(nodeToPods[pod.Spec.NodeName], pod)
+	}
+	return nodeToPods, nil
+}
+
+// nodeShouldRunDaemonPod returns true if the daemon set should have a pod on
+// the given node. A node is eligible if it matches the node selector of the
+// template and either already runs a daemon pod, or is schedulable and has
+// room for a new one according to the scheduler's fit predicates.
+func nodeShouldRunDaemonPod(node *api.Node, ds *api.DaemonSet, daemonPods, nodePods []*api.Pod) bool {
+	newPod := &api.Pod{Spec: ds.Spec.Template.Spec}
+	newPod.Spec.NodeName = node.Name
+	if !predicates.PodMatchesNodeLabels(newPod, node) {
+		return false
+	}
+	if len(daemonPods) > 0 {
+		// Leave pods that are already running alone, even if the node has since
+		// been marked unschedulable, the same way the scheduler does.
+		return true
+	}
+	if node.Spec.Unschedulable {
+		return false
+	}
+	if fits, err := predicates.PodFitsPorts(newPod, nodePods, node.Name); err != nil || !fits {
+		glog.V(4).Infof("Daemon set %s does not fit the ports of node %s", ds.Name, node.Name)
+		return false
+	}
+	fitsResources := predicates.NewResourceFitPredicate(predicates.StaticNodeInfo{NodeList: &api.NodeList{Items: []api.Node{*node}}})
+	if fits, err := fitsResources(newPod, nodePods, node.Name); err != nil || !fits {
+		glog.V(4).Infof("Daemon set %s does not fit the resources of node %s", ds.Name, node.Name)
+		return false
+	}
+	return true
+}
+
+// manage creates daemon pods on eligible nodes that lack one, and deletes
+// daemon pods from nodes that should not run one or run more than one.
+func (dsc *DaemonSetsController) manage(ds *api.DaemonSet, dsKey string) {
+	nodeToDaemonPods, err := dsc.getNodesToDaemonPods(ds)
+	if err != nil {
+		glog.Errorf("Error getting node to daemon pod mapping for daemon set %q: %v", dsKey, err)
+		return
+	}
+	nodeToPods, err := dsc.getNodesToPods()
+	if err != nil {
+		glog.Errorf("Error getting node to pod mapping for daemon set %q: %v", dsKey, err)
+		return
+	}
+	nodeList, err := dsc.nodeStore.List()
+	if err != nil {
+		glog.Errorf("Couldn't get list of nodes when syncing daemon set %q: %v", dsKey, err)
+		return
+	}
+
+	var nodesNeedingDaemonPods, podsToDelete []string
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		daemonPods := nodeToDaemonPods[node.Name]
+		shouldRun := nodeShouldRunDaemonPod(node, ds, daemonPods, nodeToPods[node.Name])
+		switch {
+		case shouldRun && len(daemonPods) == 0:
+			nodesNeedingDaemonPods = append(nodesNeedingDaemonPods, node.Name)
+		case shouldRun && len(daemonPods) > 1:
+			// Sort the daemon pods so that we keep the one furthest along.
+			sort.Sort(activePods(daemonPods))
+			for _, pod := range daemonPods[:len(daemonPods)-1] {
+				podsToDelete = append(podsToDelete, pod.Name)
+			}
+		case !shouldRun && len(daemonPods) > 0:
+			for _, pod := range daemonPods {
+				podsToDelete = append(podsToDelete, pod.Name)
+			}
+		}
+	}
+
+	dsc.expectations.setExpectationsForKey(dsKey, len(nodesNeedingDaemonPods), len(podsToDelete))
+
+	glog.V(4).Infof("Nodes needing daemon pods for daemon set %s: %+v", ds.Name, nodesNeedingDaemonPods)
+	wait := sync.WaitGroup{}
+	wait.Add(len(nodesNeedingDaemonPods) + len(podsToDelete))
+	for i := range nodesNeedingDaemonPods {
+		go func(ix int) {
+			defer wait.Done()
+			if err := dsc.podControl.createDaemonPod(ds.Namespace, ds, nodesNeedingDaemonPods[ix]); err != nil {
+				// Decrement the expected number of creates because the informer won't observe this pod
+				glog.V(2).Infof("Failed creation, decrementing expectations for daemon set %q", dsKey)
+				dsc.expectations.lowerExpectationsForKey(dsKey, 1, 0)
+				util.HandleError(err)
+			}
+		}(i)
+	}
+	glog.V(4).Infof("Pods to delete for daemon set %s: %+v", ds.Name, podsToDelete)
+	for i := range podsToDelete {
+		go func(ix int) {
+			defer wait.Done()
+			if err := dsc.podControl.deletePod(ds.Namespace, podsToDelete[ix]); err != nil {
+				// Decrement the expected number of deletes because the informer won't observe this deletion
+				glog.V(2).Infof("Failed deletion, decrementing expectations for daemon set %q", dsKey)
+				dsc.expectations.lowerExpectationsForKey(dsKey, 0, 1)
+				util.HandleError(err)
+			}
+		}(i)
+	}
+	wait.Wait()
+}
+
+// calculateStatus returns the status of the daemon set as observed in the local stores.
+func (dsc *DaemonSetsController) calculateStatus(ds *api.DaemonSet) (api.DaemonSetStatus, error) {
+	status := api.DaemonSetStatus{}
+	nodeToDaemonPods, err := dsc.getNodesToDaemonPods(ds)
+	if err != nil {
+		return status, err
+	}
+	nodeToPods, err := dsc.getNodesToPods()
+	if err != nil {
+		return status, err
+	}
+	nodeList, err := dsc.nodeStore.List()
+	if err != nil {
+		return status, err
+	}
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		daemonPods := nodeToDaemonPods[node.Name]
+		if nodeShouldRunDaemonPod(node, ds, daemonPods, nodeToPods[node.Name]) {
+			status.DesiredNumberScheduled++
+			if len(daemonPods) > 0 {
+				status.CurrentNumberScheduled++
+			}
+		} else if len(daemonPods) > 0 {
+			status.NumberMisscheduled++
+		}
+	}
+	return status, nil
+}
+
+// updateDaemonSetStatus writes the status of the given daemon set to the apiserver.
+func (dsc *DaemonSetsController) updateDaemonSetStatus(ds *api.DaemonSet) error {
+	_, err := dsc.kubeClient.DaemonSets(ds.Namespace).UpdateStatus(ds)
+	return err
+}
+
+// syncDaemonSet will sync the daemon set with the given key if it has had its
+// expectations fulfilled, meaning it did not expect to see any more of its pods
+// created or deleted, and then records its status. This function is not meant
+// to be invoked concurrently with the same key.
+func (dsc *DaemonSetsController) syncDaemonSet(key string) error {
+	startTime := time.Now()
+	defer func() {
+		glog.V(4).Infof("Finished syncing daemon set %q (%v)", key, time.Now().Sub(startTime))
+	}()
+
+	obj, exists, err := dsc.dsStore.Store.GetByKey(key)
+	if err != nil {
+		glog.Infof("Unable to retrieve daemon set %v from store: %v", key, err)
+		dsc.queue.Add(key)
+		return err
+	}
+	if !exists {
+		glog.V(4).Infof("Daemon set has been deleted: %v", key)
+		dsc.expectations.DeleteExpectations(key)
+		return nil
+	}
+	ds := *obj.(*api.DaemonSet)
+	if !dsc.podStoreSynced() || !dsc.nodeStoreSynced() {
+		// Sleep so we give the pod and node reflector goroutines a chance to run.
+		time.Sleep(PodStoreSyncedPollPeriod)
+		glog.Infof("Waiting for pods and nodes controllers to sync, requeuing daemon set %v", ds.Name)
+		dsc.enqueueDaemonSet(&ds)
+		return nil
+	}
+
+	// Don't process a daemon set until all its creations and deletions have
+	// been processed. For example if daemon set foo asked for 3 new daemon pods
+	// in the previous call to manage, then we do not want to call manage on
+	// foo until the daemon pods have been created.
+	if dsc.expectations.satisfiedExpectationsForKey(key) {
+		dsc.manage(&ds, key)
+	}
+
+	status, err := dsc.calculateStatus(&ds)
+	if err != nil {
+		glog.Errorf("Error calculating status for daemon set %q: %v", key, err)
+		dsc.queue.Add(key)
+		return err
+	}
+	if !api.Semantic.DeepEqual(ds.Status, status) {
+		ds.Status = status
+		if err := dsc.updateHandler(&ds); err != nil {
+			glog.V(2).Infof("Failed to update status for daemon set %v, requeuing: %v", ds.Name, err)
+			dsc.enqueueDaemonSet(&ds)
+			return err
+		}
+	}
+	return nil
+}
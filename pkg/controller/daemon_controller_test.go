@@ -0,0 +1,337 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+)
+
+var (
+	simpleDaemonSetLabel  = map[string]string{"name": "simple-daemon", "type": "production"}
+	simpleDaemonSetLabel2 = map[string]string{"name": "simple-daemon", "type": "test"}
+	simpleNodeLabel       = map[string]string{"color": "blue", "speed": "fast"}
+)
+
+// FakeDaemonPodControl records the daemon pods created and deleted.
+type FakeDaemonPodControl struct {
+	nodeNames     []string
+	deletePodName []string
+	lock          sync.Mutex
+	err           error
+}
+
+func (f *FakeDaemonPodControl) createDaemonPod(namespace string, ds *api.DaemonSet, nodeName string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.nodeNames = append(f.nodeNames, nodeName)
+	return nil
+}
+
+func (f *FakeDaemonPodControl) deletePod(namespace string, podName string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.deletePodName = append(f.deletePodName, podName)
+	return nil
+}
+
+func newDaemonSet(name string) *api.DaemonSet {
+	return &api.DaemonSet{
+		TypeMeta: api.TypeMeta{APIVersion: testapi.Version()},
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: api.NamespaceDefault,
+		},
+		Spec: api.DaemonSetSpec{
+			Selector: simpleDaemonSetLabel,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: simpleDaemonSetLabel,
+				},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Image:                  "foo/bar",
+							TerminationMessagePath: api.TerminationMessagePathDefault,
+							ImagePullPolicy:        api.PullIfNotPresent,
+						},
+					},
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSDefault,
+				},
+			},
+		},
+	}
+}
+
+func newNode(name string, label map[string]string) *api.Node {
+	return &api.Node{
+		TypeMeta: api.TypeMeta{APIVersion: testapi.Version()},
+		ObjectMeta: api.ObjectMeta{
+			Name:   name,
+			Labels: label,
+		},
+		Status: api.NodeStatus{
+			Capacity: api.ResourceList{
+				api.ResourcePods: resource.MustParse("100"),
+			},
+		},
+	}
+}
+
+func addNodes(nodeStore cache.Store, startIndex, numNodes int, label map[string]string) {
+	for i := startIndex; i < startIndex+numNodes; i++ {
+		nodeStore.Add(newNode(fmt.Sprintf("node-%d", i), label))
+	}
+}
+
+func newDaemonPod(podName string, nodeName string, label map[string]string) *api.Pod {
+	return &api.Pod{
+		TypeMeta: api.TypeMeta{APIVersion: testapi.Version()},
+		ObjectMeta: api.ObjectMeta{
+			GenerateName: podName,
+			Name:         podName,
+			Labels:       label,
+			Namespace:    api.NamespaceDefault,
+		},
+		Spec: api.PodSpec{
+			NodeName: nodeName,
+			Containers: []api.Container{
+				{
+					Image:                  "foo/bar",
+					TerminationMessagePath: api.TerminationMessagePathDefault,
+					ImagePullPolicy:        api.PullIfNotPresent,
+				},
+			},
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSDefault,
+		},
+		Status: api.PodStatus{Phase: api.PodRunning},
+	}
+}
+
+func addPods(podStore cache.Store, nodeName string, label map[string]string, number int) {
+	for i := 0; i < number; i++ {
+		podStore.Add(newDaemonPod(fmt.Sprintf("%s-%s-%d", nodeName, label["type"], i), nodeName, label))
+	}
+}
+
+func newTestDaemonSetsController() (*DaemonSetsController, *FakeDaemonPodControl, *[]api.DaemonSet) {
+	client := client.NewOrDie(&client.Config{Host: "", Version: testapi.Version()})
+	manager := NewDaemonSetsController(client)
+	manager.podStoreSynced = alwaysReady
+	manager.nodeStoreSynced = alwaysReady
+	podControl := &FakeDaemonPodControl{}
+	manager.podControl = podControl
+	updated := []api.DaemonSet{}
+	manager.updateHandler = func(ds *api.DaemonSet) error {
+		updated = append(updated, *ds)
+		return nil
+	}
+	return manager, podControl, &updated
+}
+
+func validateSyncDaemonSets(t *testing.T, fakePodControl *FakeDaemonPodControl, expectedCreates, expectedDeletes int) {
+	if len(fakePodControl.nodeNames) != expectedCreates {
+		t.Errorf("Unexpected number of creates.  Expected %d, saw %d\n", expectedCreates, len(fakePodControl.nodeNames))
+	}
+	if len(fakePodControl.deletePodName) != expectedDeletes {
+		t.Errorf("Unexpected number of deletes.  Expected %d, saw %d\n", expectedDeletes, len(fakePodControl.deletePodName))
+	}
+}
+
+func syncAndValidateDaemonSets(t *testing.T, manager *DaemonSetsController, ds *api.DaemonSet, podControl *FakeDaemonPodControl, expectedCreates, expectedDeletes int) {
+	key, err := rcKeyFunc(ds)
+	if err != nil {
+		t.Errorf("Could not get key for daemon set %#v: %v", ds, err)
+	}
+	manager.syncHandler(key)
+	validateSyncDaemonSets(t, podControl, expectedCreates, expectedDeletes)
+}
+
+// DaemonSets without node selectors should launch pods on every node.
+func TestSimpleDaemonSetLaunchesPods(t *testing.T) {
+	manager, podControl, updated := newTestDaemonSetsController()
+	addNodes(manager.nodeStore.Store, 0, 5, nil)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 5, 0)
+
+	if len(*updated) != 1 {
+		t.Fatalf("expected one status update, got %d", len(*updated))
+	}
+	if status := (*updated)[0].Status; status.DesiredNumberScheduled != 5 || status.CurrentNumberScheduled != 0 {
+		t.Errorf("unexpected status %#v", status)
+	}
+}
+
+// DaemonSets should do nothing if there aren't any nodes.
+func TestNoNodesDoesNothing(t *testing.T) {
+	manager, podControl, _ := newTestDaemonSetsController()
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 0, 0)
+}
+
+// DaemonSets should not place onto unschedulable nodes, but should leave
+// pods already running there alone.
+func TestUnschedulableNodeDaemonDoesNotLaunchPod(t *testing.T) {
+	manager, podControl, updated := newTestDaemonSetsController()
+	node := newNode("not-ready", nil)
+	node.Spec.Unschedulable = true
+	manager.nodeStore.Add(node)
+	cordoned := newNode("cordoned", nil)
+	cordoned.Spec.Unschedulable = true
+	manager.nodeStore.Add(cordoned)
+	addPods(manager.podStore.Store, "cordoned", simpleDaemonSetLabel, 1)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 0, 0)
+
+	if len(*updated) != 1 {
+		t.Fatalf("expected one status update, got %d", len(*updated))
+	}
+	if status := (*updated)[0].Status; status.DesiredNumberScheduled != 1 || status.CurrentNumberScheduled != 1 {
+		t.Errorf("unexpected status %#v", status)
+	}
+}
+
+// DaemonSets should not place onto nodes that don't have room for the pod.
+func TestInsufficientCapacityNodeDaemonDoesNotLaunchPod(t *testing.T) {
+	manager, podControl, _ := newTestDaemonSetsController()
+	node := newNode("too-much-mem", nil)
+	node.Status.Capacity = api.ResourceList{
+		api.ResourceMemory: resource.MustParse("75M"),
+		api.ResourcePods:   resource.MustParse("100"),
+	}
+	manager.nodeStore.Add(node)
+	ds := newDaemonSet("foo")
+	ds.Spec.Template.Spec.Containers[0].Resources.Limits = api.ResourceList{
+		api.ResourceMemory: resource.MustParse("100M"),
+	}
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 0, 0)
+}
+
+// DaemonSets should not place onto nodes where the host port is already taken.
+func TestPortConflictNodeDaemonDoesNotLaunchPod(t *testing.T) {
+	manager, podControl, _ := newTestDaemonSetsController()
+	manager.nodeStore.Add(newNode("port-conflict", nil))
+	pod := newDaemonPod("other", "port-conflict", map[string]string{"app": "other"})
+	pod.Spec.Containers[0].Ports = []api.ContainerPort{{HostPort: 666}}
+	manager.podStore.Add(pod)
+	ds := newDaemonSet("foo")
+	ds.Spec.Template.Spec.Containers[0].Ports = []api.ContainerPort{{HostPort: 666}}
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 0, 0)
+}
+
+// Controller should not create pods on nodes which have daemon pods, and should
+// remove excess pods from nodes that have extra daemon pods.
+func TestDealsWithExistingPods(t *testing.T) {
+	manager, podControl, _ := newTestDaemonSetsController()
+	addNodes(manager.nodeStore.Store, 0, 5, nil)
+	addPods(manager.podStore.Store, "node-1", simpleDaemonSetLabel, 1)
+	addPods(manager.podStore.Store, "node-2", simpleDaemonSetLabel, 2)
+	addPods(manager.podStore.Store, "node-3", simpleDaemonSetLabel, 5)
+	addPods(manager.podStore.Store, "node-4", simpleDaemonSetLabel2, 2)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 2, 5)
+}
+
+// DaemonSets with node selectors should launch pods only on matching nodes.
+func TestSelectorDaemonLaunchesPods(t *testing.T) {
+	manager, podControl, _ := newTestDaemonSetsController()
+	addNodes(manager.nodeStore.Store, 0, 4, nil)
+	addNodes(manager.nodeStore.Store, 4, 3, simpleNodeLabel)
+	daemon := newDaemonSet("foo")
+	daemon.Spec.Template.Spec.NodeSelector = simpleNodeLabel
+	manager.dsStore.Add(daemon)
+	syncAndValidateDaemonSets(t, manager, daemon, podControl, 3, 0)
+}
+
+// DaemonSets with node selectors should delete pods from nodes that do not
+// satisfy the selector.
+func TestSelectorDaemonDeletesUnselectedPods(t *testing.T) {
+	manager, podControl, updated := newTestDaemonSetsController()
+	addNodes(manager.nodeStore.Store, 0, 5, nil)
+	addNodes(manager.nodeStore.Store, 5, 5, simpleNodeLabel)
+	addPods(manager.podStore.Store, "node-0", simpleDaemonSetLabel2, 2)
+	addPods(manager.podStore.Store, "node-1", simpleDaemonSetLabel, 3)
+	addPods(manager.podStore.Store, "node-1", simpleDaemonSetLabel2, 1)
+	addPods(manager.podStore.Store, "node-4", simpleDaemonSetLabel, 1)
+	daemon := newDaemonSet("foo")
+	daemon.Spec.Template.Spec.NodeSelector = simpleNodeLabel
+	manager.dsStore.Add(daemon)
+	syncAndValidateDaemonSets(t, manager, daemon, podControl, 5, 4)
+
+	if len(*updated) != 1 {
+		t.Fatalf("expected one status update, got %d", len(*updated))
+	}
+	if status := (*updated)[0].Status; status.DesiredNumberScheduled != 5 || status.NumberMisscheduled != 2 {
+		t.Errorf("unexpected status %#v", status)
+	}
+}
+
+// DaemonSet should not launch more pods while it is still waiting to observe
+// the ones it already created.
+func TestDaemonSetExpectations(t *testing.T) {
+	manager, podControl, _ := newTestDaemonSetsController()
+	addNodes(manager.nodeStore.Store, 0, 5, nil)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 5, 0)
+
+	// None of the created pods have been observed yet, so a second sync is a no-op.
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 5, 0)
+
+	key, _ := rcKeyFunc(ds)
+	for i := 0; i < 5; i++ {
+		manager.addPod(newDaemonPod(fmt.Sprintf("pod-%d", i), fmt.Sprintf("node-%d", i), simpleDaemonSetLabel))
+	}
+	if !manager.expectations.satisfiedExpectationsForKey(key) {
+		t.Errorf("expected expectations to be satisfied after observing all pods")
+	}
+}
+
+func TestDaemonSetDeletedRemovesExpectations(t *testing.T) {
+	manager, podControl, _ := newTestDaemonSetsController()
+	ds := newDaemonSet("foo")
+	key, _ := rcKeyFunc(ds)
+	manager.expectations.setExpectationsForKey(key, 1, 0)
+	if err := manager.syncDaemonSet(key); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	validateSyncDaemonSets(t, podControl, 0, 0)
+	if _, exists, _ := manager.expectations.getExpectationsForKey(key); exists {
+		t.Errorf("expected expectations of a deleted daemon set to be removed")
+	}
+}
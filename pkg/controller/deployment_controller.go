@@ -0,0 +1,797 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/framework"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/workqueue"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/golang/glog"
+)
+
+const (
+	// We'll attempt to recompute the required replicas of all deployments at least this often,
+	// which also bounds how long a pod waits past its minReadySeconds to be counted as available.
+	FullDeploymentResyncPeriod = 30 * time.Second
+)
+
+// DeploymentController is responsible for synchronizing Deployment objects stored
+// in the system with the replication controllers that run their pods. Each
+// distinct pod template of a deployment gets its own replication controller,
+// and a rollout moves replicas from the old controllers to the new one.
+type DeploymentController struct {
+	kubeClient client.Interface
+	recorder   record.EventRecorder
+
+	// To allow injection of syncDeployment for testing.
+	syncHandler func(dKey string) error
+	// To allow injection of updateDeploymentStatus for testing.
+	updateHandler func(d *api.Deployment) error
+
+	// rcStoreSynced and podStoreSynced return true if the respective stores
+	// have been synced at least once. Added as members to the struct to allow
+	// injection for testing.
+	rcStoreSynced  func() bool
+	podStoreSynced func() bool
+
+	// A store of deployments, populated by the dController
+	dStore cache.StoreToDeploymentLister
+	// A store of replication controllers, populated by the rcController
+	rcStore cache.StoreToControllerLister
+	// A store of pods, populated by the podController
+	podStore cache.StoreToPodLister
+	// Watches changes to all deployments
+	dController *framework.Controller
+	// Watches changes to all replication controllers
+	rcController *framework.Controller
+	// Watches changes to all pods
+	podController *framework.Controller
+	// Deployments that need to be synced
+	queue *workqueue.Type
+}
+
+// NewDeploymentController creates a new DeploymentController.
+func NewDeploymentController(kubeClient client.Interface) *DeploymentController {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(kubeClient.Events(""))
+
+	dc := &DeploymentController{
+		kubeClient: kubeClient,
+		recorder:   eventBroadcaster.NewRecorder(api.EventSource{Component: "deployment-controller"}),
+		queue:      workqueue.New(),
+	}
+
+	dc.dStore.Store, dc.dController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dc.kubeClient.Deployments(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dc.kubeClient.Deployments(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Deployment{},
+		FullDeploymentResyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: dc.enqueueDeployment,
+			UpdateFunc: func(old, cur interface{}) {
+				dc.enqueueDeployment(cur)
+			},
+			DeleteFunc: dc.enqueueDeployment,
+		},
+	)
+
+	dc.rcStore.Store, dc.rcController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dc.kubeClient.ReplicationControllers(api.NamespaceAll).List(labels.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dc.kubeClient.ReplicationControllers(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.ReplicationController{},
+		FullDeploymentResyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    dc.addRC,
+			UpdateFunc: dc.updateRC,
+			DeleteFunc: dc.deleteRC,
+		},
+	)
+
+	dc.podStore.Store, dc.podController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dc.kubeClient.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return dc.kubeClient.Pods(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Pod{},
+		PodRelistPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    dc.addPod,
+			UpdateFunc: dc.updatePod,
+			DeleteFunc: dc.deletePod,
+		},
+	)
+
+	dc.syncHandler = dc.syncDeployment
+	dc.updateHandler = dc.updateDeploymentStatus
+	dc.rcStoreSynced = dc.rcController.HasSynced
+	dc.podStoreSynced = dc.podController.HasSynced
+	return dc
+}
+
+// Run begins watching and syncing deployments.
+func (dc *DeploymentController) Run(workers int, stopCh <-chan struct{}) {
+	defer util.HandleCrash()
+	go dc.dController.Run(stopCh)
+	go dc.rcController.Run(stopCh)
+	go dc.podController.Run(stopCh)
+	for i := 0; i < workers; i++ {
+		go util.Until(dc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+	glog.Infof("Shutting down Deployment Controller")
+	dc.queue.ShutDown()
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (dc *DeploymentController) worker() {
+	for {
+		func() {
+			key, quit := dc.queue.Get()
+			if quit {
+				return
+			}
+			defer dc.queue.Done(key)
+			err := dc.syncHandler(key.(string))
+			if err != nil {
+				glog.Errorf("Error syncing deployment: %v", err)
+			}
+		}()
+	}
+}
+
+// obj could be an *api.Deployment, or a DeletionFinalStateUnknown marker item.
+func (dc *DeploymentController) enqueueDeployment(obj interface{}) {
+	key, err := rcKeyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	dc.queue.Add(key)
+}
+
+// getDeploymentForRC returns the deployment managing the given replication controller.
+func (dc *DeploymentController) getDeploymentForRC(rc *api.ReplicationController) *api.Deployment {
+	deployments, err := dc.dStore.GetDeploymentsForRC(rc)
+	if err != nil {
+		glog.V(4).Infof("No deployments found for replication controller %v, deployment controller will avoid syncing", rc.Name)
+		return nil
+	}
+	return &deployments[0]
+}
+
+// getDeploymentForPod returns the deployment whose selector matches the given pod.
+func (dc *DeploymentController) getDeploymentForPod(pod *api.Pod) *api.Deployment {
+	deployments, err := dc.dStore.GetPodDeployments(pod)
+	if err != nil {
+		glog.V(4).Infof("No deployments found for pod %v, deployment controller will avoid syncing", pod.Name)
+		return nil
+	}
+	return &deployments[0]
+}
+
+// When a replication controller is created, enqueue the deployment that manages it.
+func (dc *DeploymentController) addRC(obj interface{}) {
+	if d := dc.getDeploymentForRC(obj.(*api.ReplicationController)); d != nil {
+		dc.enqueueDeployment(d)
+	}
+}
+
+// When a replication controller is updated, wake up the deployment that
+// manages it, so that scaling of the other controllers can continue.
+func (dc *DeploymentController) updateRC(old, cur interface{}) {
+	if api.Semantic.DeepEqual(old, cur) {
+		// A periodic relist will send update events for all known controllers.
+		return
+	}
+	if d := dc.getDeploymentForRC(cur.(*api.ReplicationController)); d != nil {
+		dc.enqueueDeployment(d)
+	}
+}
+
+// When a replication controller is deleted, enqueue the deployment that manages it.
+// obj could be an *api.ReplicationController, or a DeletionFinalStateUnknown marker item.
+func (dc *DeploymentController) deleteRC(obj interface{}) {
+	rc, ok := obj.(*api.ReplicationController)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			glog.Errorf("Couldn't get object from tombstone %+v", obj)
+			return
+		}
+		rc, ok = tombstone.Obj.(*api.ReplicationController)
+		if !ok {
+			glog.Errorf("Tombstone contained object that is not a replication controller %+v", obj)
+			return
+		}
+	}
+	if d := dc.getDeploymentForRC(rc); d != nil {
+		dc.enqueueDeployment(d)
+	}
+}
+
+// When a pod is created, enqueue the deployment whose selector matches it.
+func (dc *DeploymentController) addPod(obj interface{}) {
+	if d := dc.getDeploymentForPod(obj.(*api.Pod)); d != nil {
+		dc.enqueueDeployment(d)
+	}
+}
+
+// When a pod is updated, e.g. because it became ready, wake up its deployment.
+// If the labels of the pod have changed we need to awaken both the old and new
+// deployment. old and cur must be *api.Pod types.
+func (dc *DeploymentController) updatePod(old, cur interface{}) {
+	if api.Semantic.DeepEqual(old, cur) {
+		// A periodic relist will send update events for all known pods.
+		return
+	}
+	curPod := cur.(*api.Pod)
+	if d := dc.getDeploymentForPod(curPod); d != nil {
+		dc.enqueueDeployment(d)
+	}
+	oldPod := old.(*api.Pod)
+	// Only need to get the old deployment if the labels changed.
+	if !reflect.DeepEqual(curPod.Labels, oldPod.Labels) {
+		if oldD := dc.getDeploymentForPod(oldPod); oldD != nil {
+			dc.enqueueDeployment(oldD)
+		}
+	}
+}
+
+// When a pod is deleted, enqueue the deployment whose selector matches it.
+// obj could be an *api.Pod, or a DeletionFinalStateUnknown marker item.
+func (dc *DeploymentController) deletePod(obj interface{}) {
+	pod, ok := obj.(*api.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			glog.Errorf("Couldn't get object from tombstone %+v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*api.Pod)
+		if !ok {
+			glog.Errorf("Tombstone contained object that is not a pod %+v", obj)
+			return
+		}
+	}
+	if d := dc.getDeploymentForPod(pod); d != nil {
+		dc.enqueueDeployment(d)
+	}
+}
+
+// getRCsForDeployment returns the replication controllers owned by the given
+// deployment: those whose selector carries the deployment's unique label key
+// and whose pods are selected by the deployment. Controllers created by hand
+// are never adopted, since their selectors would overlap with the deployment's.
+func (dc *DeploymentController) getRCsForDeployment(d *api.Deployment) ([]*api.ReplicationController, error) {
+	controllers, err := dc.rcStore.List()
+	if err != nil {
+		return nil, err
+	}
+	selector := labels.Set(d.Spec.Selector).AsSelector()
+	rcs := []*api.ReplicationController{}
+	for i := range controllers {
+		rc := &controllers[i]
+		if rc.Namespace != d.Namespace || rc.Spec.Template == nil {
+			continue
+		}
+		if _, found := rc.Spec.Selector[d.Spec.UniqueLabelKey]; !found {
+			continue
+		}
+		if selector.Empty() || !selector.Matches(labels.Set(rc.Spec.Template.Labels)) {
+			continue
+		}
+		rcs = append(rcs, rc)
+	}
+	return rcs, nil
+}
+
+// getNewAndOldRCs splits the controllers of a deployment into the one running
+// the current pod template, creating it if it does not exist yet, and the
+// controllers running earlier templates.
+func (dc *DeploymentController) getNewAndOldRCs(d *api.Deployment) (*api.ReplicationController, []*api.ReplicationController, error) {
+	rcs, err := dc.getRCsForDeployment(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	var newRC *api.ReplicationController
+	oldRCs := []*api.ReplicationController{}
+	for _, rc := range rcs {
+		if newRC == nil && templateMatches(rc.Spec.Template, d.Spec.Template, d.Spec.UniqueLabelKey) {
+			newRC = rc
+			continue
+		}
+		oldRCs = append(oldRCs, rc)
+	}
+	nextRevision := maxRevision(oldRCs) + 1
+	if newRC == nil {
+		newRC, err = dc.createNewRC(d, nextRevision)
+		return newRC, oldRCs, err
+	}
+	if revision(newRC) < nextRevision {
+		// The template of an older controller is in use again, e.g. after a
+		// rollback; it becomes the newest revision.
+		updated := *newRC
+		updated.Annotations = cloneAndAddLabel(newRC.Annotations, api.DeploymentRevisionAnnotation, strconv.FormatInt(nextRevision, 10))
+		newRC, err = dc.kubeClient.ReplicationControllers(d.Namespace).Update(&updated)
+	}
+	return newRC, oldRCs, err
+}
+
+// createNewRC creates a replication controller with no replicas for the
+// current pod template of the deployment.
+func (dc *DeploymentController) createNewRC(d *api.Deployment, rev int64) (*api.ReplicationController, error) {
+	template, err := copyPodTemplate(d.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	hash := strconv.FormatUint(uint64(podTemplateSpecHash(d.Spec.Template)), 10)
+	template.Labels = cloneAndAddLabel(template.Labels, d.Spec.UniqueLabelKey, hash)
+	rc := &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%s", d.Name, hash),
+			Namespace:   d.Namespace,
+			Labels:      template.Labels,
+			Annotations: map[string]string{api.DeploymentRevisionAnnotation: strconv.FormatInt(rev, 10)},
+		},
+		Spec: api.ReplicationControllerSpec{
+			Replicas: 0,
+			Selector: cloneAndAddLabel(d.Spec.Selector, d.Spec.UniqueLabelKey, hash),
+			Template: template,
+		},
+	}
+	created, err := dc.kubeClient.ReplicationControllers(d.Namespace).Create(rc)
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			// Our store has not observed the controller yet; its creation will requeue the deployment.
+			glog.V(4).Infof("Replication controller %s for deployment %s already exists", rc.Name, d.Name)
+		}
+		return nil, err
+	}
+	dc.recorder.Eventf(d, "CreatedRC", "Created replication controller %s for revision %d", created.Name, rev)
+	return created, nil
+}
+
+// scaleRC sets the desired replicas of rc and returns the updated controller.
+func (dc *DeploymentController) scaleRC(d *api.Deployment, rc *api.ReplicationController, replicas int) (*api.ReplicationController, error) {
+	if rc.Spec.Replicas == replicas {
+		return rc, nil
+	}
+	direction := "down"
+	if rc.Spec.Replicas < replicas {
+		direction = "up"
+	}
+	updated := *rc
+	updated.Spec.Replicas = replicas
+	result, err := dc.kubeClient.ReplicationControllers(rc.Namespace).Update(&updated)
+	if err != nil {
+		return nil, err
+	}
+	dc.recorder.Eventf(d, "ScalingRC", "Scaled %s replication controller %s to %d", direction, rc.Name, replicas)
+	return result, nil
+}
+
+// rolloutRecreate kills all pods of the old controllers before any pod of the
+// new controller is created.
+func (dc *DeploymentController) rolloutRecreate(d *api.Deployment, newRC *api.ReplicationController, oldRCs []*api.ReplicationController) error {
+	scaledDown := false
+	for _, rc := range oldRCs {
+		if rc.Spec.Replicas == 0 {
+			continue
+		}
+		if _, err := dc.scaleRC(d, rc, 0); err != nil {
+			return err
+		}
+		scaledDown = true
+	}
+	if scaledDown {
+		// Wait for the old pods to go away; their deletion requeues the deployment.
+		return nil
+	}
+	oldPods, err := dc.getActivePods(oldRCs)
+	if err != nil {
+		return err
+	}
+	if len(oldPods) > 0 {
+		glog.V(4).Infof("Waiting for %d old pods of deployment %s to terminate", len(oldPods), d.Name)
+		return nil
+	}
+	_, err = dc.scaleRC(d, newRC, d.Spec.Replicas)
+	return err
+}
+
+// rolloutRolling scales the new controller up and the old controllers down in
+// steps, keeping the total number of replicas at or below replicas+maxSurge
+// and the number of available pods at or above replicas-maxUnavailable.
+func (dc *DeploymentController) rolloutRolling(d *api.Deployment, newRC *api.ReplicationController, oldRCs []*api.ReplicationController) error {
+	maxSurge, maxUnavailable, err := resolveRollingUpdateLimits(d)
+	if err != nil {
+		return err
+	}
+
+	if newRC.Spec.Replicas > d.Spec.Replicas {
+		if newRC, err = dc.scaleRC(d, newRC, d.Spec.Replicas); err != nil {
+			return err
+		}
+	} else if newRC.Spec.Replicas < d.Spec.Replicas {
+		total := newRC.Spec.Replicas
+		for _, rc := range oldRCs {
+			total += rc.Spec.Replicas
+		}
+		if surge := d.Spec.Replicas + maxSurge - total; surge > 0 {
+			replicas := newRC.Spec.Replicas + surge
+			if replicas > d.Spec.Replicas {
+				replicas = d.Spec.Replicas
+			}
+			if newRC, err = dc.scaleRC(d, newRC, replicas); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Count the available pods of every controller, but never more than the
+	// controller asks for: pods it has been scaled down from may still be
+	// running, and are about to go away.
+	available := 0
+	minReadySeconds := d.Spec.Strategy.RollingUpdate.MinReadySeconds
+	for _, rc := range append([]*api.ReplicationController{newRC}, oldRCs...) {
+		pods, err := dc.getActivePods([]*api.ReplicationController{rc})
+		if err != nil {
+			return err
+		}
+		if count := countAvailablePods(pods, minReadySeconds); count < rc.Spec.Replicas {
+			available += count
+		} else {
+			available += rc.Spec.Replicas
+		}
+	}
+	scaleDown := available - (d.Spec.Replicas - maxUnavailable)
+	if scaleDown <= 0 {
+		return nil
+	}
+	// Scale down the oldest controllers first.
+	sort.Sort(rcsByRevision(oldRCs))
+	for _, rc := range oldRCs {
+		if scaleDown <= 0 {
+			break
+		}
+		if rc.Spec.Replicas == 0 {
+			continue
+		}
+		count := rc.Spec.Replicas
+		if count > scaleDown {
+			count = scaleDown
+		}
+		if _, err := dc.scaleRC(d, rc, rc.Spec.Replicas-count); err != nil {
+			return err
+		}
+		scaleDown -= count
+	}
+	return nil
+}
+
+// resolveRollingUpdateLimits returns the maxSurge and maxUnavailable of a
+// rolling update deployment as absolute numbers of pods.
+func resolveRollingUpdateLimits(d *api.Deployment) (int, int, error) {
+	rollingUpdate := d.Spec.Strategy.RollingUpdate
+	if rollingUpdate == nil {
+		return 0, 0, fmt.Errorf("deployment %s has no rolling update parameters", d.Name)
+	}
+	maxSurge, err := util.GetScaledValueFromIntOrPercent(&rollingUpdate.MaxSurge, d.Spec.Replicas, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxUnavailable, err := util.GetScaledValueFromIntOrPercent(&rollingUpdate.MaxUnavailable, d.Spec.Replicas, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	if maxSurge == 0 && maxUnavailable == 0 {
+		// Validation rejects 0 for both, but small percentages can still
+		// round down to 0; allow one pod to be unavailable so the rollout
+		// can make progress.
+		maxUnavailable = 1
+	}
+	return maxSurge, maxUnavailable, nil
+}
+
+// getActivePods returns the active pods of the given controllers.
+func (dc *DeploymentController) getActivePods(rcs []*api.ReplicationController) ([]*api.Pod, error) {
+	pods := []*api.Pod{}
+	for _, rc := range rcs {
+		podList, err := dc.podStore.Pods(rc.Namespace).List(labels.Set(rc.Spec.Selector).AsSelector())
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, filterActivePods(podList.Items)...)
+	}
+	return pods, nil
+}
+
+// countAvailablePods returns the number of pods that are ready and whose
+// containers have all been running for at least minReadySeconds.
+func countAvailablePods(pods []*api.Pod, minReadySeconds int) int {
+	available := 0
+	minStart := time.Now().Add(-time.Duration(minReadySeconds) * time.Second)
+	for _, pod := range pods {
+		if !api.IsPodReady(pod) {
+			continue
+		}
+		if minReadySeconds > 0 && !containersStartedBefore(pod, minStart) {
+			continue
+		}
+		available++
+	}
+	return available
+}
+
+// containersStartedBefore returns true if every container of the pod has been
+// running since before t. Pods report no time for when they became ready, so
+// the container start times are used instead.
+func containersStartedBefore(pod *api.Pod, t time.Time) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		running := status.State.Running
+		if running == nil || running.StartedAt.After(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// cleanupOldRCs deletes the oldest scaled down controllers of a deployment
+// beyond its revision history limit.
+func (dc *DeploymentController) cleanupOldRCs(d *api.Deployment, oldRCs []*api.ReplicationController) error {
+	if d.Spec.RevisionHistoryLimit == nil {
+		return nil
+	}
+	idle := []*api.ReplicationController{}
+	for _, rc := range oldRCs {
+		if rc.Spec.Replicas == 0 && rc.Status.Replicas == 0 {
+			idle = append(idle, rc)
+		}
+	}
+	sort.Sort(rcsByRevision(idle))
+	for i := 0; i < len(idle)-*d.Spec.RevisionHistoryLimit; i++ {
+		if err := dc.kubeClient.ReplicationControllers(d.Namespace).Delete(idle[i].Name); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollback replaces the pod template of the deployment with the template of
+// the requested revision, and clears the rollback request. The updated
+// deployment is then rolled out like any other template change.
+func (dc *DeploymentController) rollback(d *api.Deployment) error {
+	rcs, err := dc.getRCsForDeployment(d)
+	if err != nil {
+		return err
+	}
+	target := d.Spec.RollbackTo.Revision
+	if target == 0 {
+		// Roll back to the revision before the current one.
+		revisions := []int64{}
+		for _, rc := range rcs {
+			revisions = append(revisions, revision(rc))
+		}
+		sort.Sort(sort.Reverse(int64Slice(revisions)))
+		if len(revisions) > 1 {
+			target = revisions[1]
+		}
+	}
+
+	updated := *d
+	updated.Spec.RollbackTo = nil
+	var found *api.ReplicationController
+	for _, rc := range rcs {
+		if target != 0 && revision(rc) == target {
+			found = rc
+			break
+		}
+	}
+	if found == nil {
+		dc.recorder.Eventf(d, "RollbackRevisionNotFound", "Unable to find revision %d to roll back to", d.Spec.RollbackTo.Revision)
+	} else {
+		template, err := copyPodTemplate(found.Spec.Template)
+		if err != nil {
+			return err
+		}
+		delete(template.Labels, d.Spec.UniqueLabelKey)
+		updated.Spec.Template = template
+		dc.recorder.Eventf(d, "RollbackDone", "Rolled back deployment to revision %d", target)
+	}
+	_, err = dc.kubeClient.Deployments(d.Namespace).Update(&updated)
+	return err
+}
+
+// updateDeploymentStatus writes the status of the given deployment to the apiserver.
+func (dc *DeploymentController) updateDeploymentStatus(d *api.Deployment) error {
+	_, err := dc.kubeClient.Deployments(d.Namespace).UpdateStatus(d)
+	return err
+}
+
+// syncDeployment will sync the deployment with the given key: it carries out a
+// pending rollback, drives the rollout of the current pod template with the
+// deployment's strategy, prunes old revisions and records the status. This
+// function is not meant to be invoked concurrently with the same key.
+func (dc *DeploymentController) syncDeployment(key string) error {
+	startTime := time.Now()
+	defer func() {
+		glog.V(4).Infof("Finished syncing deployment %q (%v)", key, time.Now().Sub(startTime))
+	}()
+
+	obj, exists, err := dc.dStore.Store.GetByKey(key)
+	if err != nil {
+		glog.Infof("Unable to retrieve deployment %v from store: %v", key, err)
+		dc.queue.Add(key)
+		return err
+	}
+	if !exists {
+		glog.V(4).Infof("Deployment has been deleted: %v", key)
+		return nil
+	}
+	d := *obj.(*api.Deployment)
+	if !dc.rcStoreSynced() || !dc.podStoreSynced() {
+		// Sleep so we give the replication controller and pod reflector goroutines a chance to run.
+		time.Sleep(PodStoreSyncedPollPeriod)
+		glog.Infof("Waiting for replication controller and pod controllers to sync, requeuing deployment %v", d.Name)
+		dc.enqueueDeployment(&d)
+		return nil
+	}
+
+	if d.Spec.RollbackTo != nil {
+		return dc.rollback(&d)
+	}
+
+	newRC, oldRCs, err := dc.getNewAndOldRCs(&d)
+	if err != nil {
+		return err
+	}
+	switch d.Spec.Strategy.Type {
+	case api.RecreateDeploymentStrategyType:
+		err = dc.rolloutRecreate(&d, newRC, oldRCs)
+	case api.RollingUpdateDeploymentStrategyType:
+		err = dc.rolloutRolling(&d, newRC, oldRCs)
+	default:
+		err = fmt.Errorf("unknown strategy type %q", d.Spec.Strategy.Type)
+	}
+	if err != nil {
+		return err
+	}
+	if err := dc.cleanupOldRCs(&d, oldRCs); err != nil {
+		return err
+	}
+
+	status := api.DeploymentStatus{
+		Replicas:        newRC.Status.Replicas,
+		UpdatedReplicas: newRC.Status.Replicas,
+	}
+	for _, rc := range oldRCs {
+		status.Replicas += rc.Status.Replicas
+	}
+	if api.Semantic.DeepEqual(d.Status, status) {
+		return nil
+	}
+	d.Status = status
+	return dc.updateHandler(&d)
+}
+
+// templateMatches returns true if the template of a controller, minus the
+// unique label the deployment added to it, equals the given template.
+func templateMatches(rcTemplate, template *api.PodTemplateSpec, uniqueLabelKey string) bool {
+	if template == nil {
+		return false
+	}
+	withoutKey := *rcTemplate
+	withoutKey.Labels = map[string]string{}
+	for k, v := range rcTemplate.Labels {
+		if k != uniqueLabelKey {
+			withoutKey.Labels[k] = v
+		}
+	}
+	return api.Semantic.DeepEqual(withoutKey, *template)
+}
+
+// podTemplateSpecHash returns a hash of the template, used to give the
+// controllers of a deployment distinct names and selectors.
+func podTemplateSpecHash(template *api.PodTemplateSpec) uint32 {
+	hasher := fnv.New32a()
+	util.DeepHashObject(hasher, *template)
+	return hasher.Sum32()
+}
+
+func copyPodTemplate(template *api.PodTemplateSpec) (*api.PodTemplateSpec, error) {
+	obj, err := api.Scheme.DeepCopy(template)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.PodTemplateSpec), nil
+}
+
+// cloneAndAddLabel returns a copy of the given map with key set to value.
+func cloneAndAddLabel(m map[string]string, key, value string) map[string]string {
+	result := make(map[string]string, len(m)+1)
+	for k, v := range m {
+		result[k] = v
+	}
+	result[key] = value
+	return result
+}
+
+// revision returns the deployment revision recorded on a controller, or 0.
+func revision(rc *api.ReplicationController) int64 {
+	v, err := strconv.ParseInt(rc.Annotations[api.DeploymentRevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func maxRevision(rcs []*api.ReplicationController) int64 {
+	max := int64(0)
+	for _, rc := range rcs {
+		if v := revision(rc); v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// rcsByRevision sorts controllers from the oldest to the newest revision.
+type rcsByRevision []*api.ReplicationController
+
+func (s rcsByRevision) Len() int           { return len(s) }
+func (s rcsByRevision) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s rcsByRevision) Less(i, j int) bool { return revision(s[i]) < revision(s[j]) }
+
+type int64Slice []int64
+
+func (s int64Slice) Len() int           { return len(s) }
+func (s int64Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s int64Slice) Less(i, j int) bool { return s[i] < s[j] }
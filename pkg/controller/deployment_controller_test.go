@@ -0,0 +1,441 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+var simpleDeploymentLabel = map[string]string{"name": "simple-deployment"}
+
+func newDeployment(replicas int, strategy api.DeploymentStrategy) *api.Deployment {
+	return &api.Deployment{
+		TypeMeta: api.TypeMeta{APIVersion: testapi.Version()},
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foobar",
+			Namespace: api.NamespaceDefault,
+		},
+		Spec: api.DeploymentSpec{
+			Replicas: replicas,
+			Selector: simpleDeploymentLabel,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: simpleDeploymentLabel,
+				},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Image:                  "foo/bar",
+							TerminationMessagePath: api.TerminationMessagePathDefault,
+							ImagePullPolicy:        api.PullIfNotPresent,
+						},
+					},
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSDefault,
+				},
+			},
+			Strategy:       strategy,
+			UniqueLabelKey: api.DefaultDeploymentUniqueLabelKey,
+		},
+	}
+}
+
+func rollingUpdateStrategy(maxUnavailable, maxSurge int) api.DeploymentStrategy {
+	return api.DeploymentStrategy{
+		Type: api.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &api.RollingUpdateDeployment{
+			MaxUnavailable: util.NewIntOrStringFromInt(maxUnavailable),
+			MaxSurge:       util.NewIntOrStringFromInt(maxSurge),
+		},
+	}
+}
+
+// newDeploymentRC returns a controller of the deployment at the given
+// revision, running image with the given number of replicas.
+func newDeploymentRC(d *api.Deployment, image string, rev int64, replicas int) *api.ReplicationController {
+	template, err := copyPodTemplate(d.Spec.Template)
+	if err != nil {
+		panic(err)
+	}
+	template.Spec.Containers[0].Image = image
+	hash := strconv.FormatUint(uint64(podTemplateSpecHash(template)), 10)
+	template.Labels = cloneAndAddLabel(template.Labels, d.Spec.UniqueLabelKey, hash)
+	return &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%s", d.Name, hash),
+			Namespace:   d.Namespace,
+			Labels:      template.Labels,
+			Annotations: map[string]string{api.DeploymentRevisionAnnotation: strconv.FormatInt(rev, 10)},
+		},
+		Spec: api.ReplicationControllerSpec{
+			Replicas: replicas,
+			Selector: cloneAndAddLabel(d.Spec.Selector, d.Spec.UniqueLabelKey, hash),
+			Template: template,
+		},
+		Status: api.ReplicationControllerStatus{Replicas: replicas},
+	}
+}
+
+// addReadyPods adds count ready pods of the given controller to the store.
+func addReadyPods(manager *DeploymentController, rc *api.ReplicationController, count int) {
+	for i := 0; i < count; i++ {
+		manager.podStore.Store.Add(&api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%d", rc.Name, i),
+				Namespace: rc.Namespace,
+				Labels:    rc.Spec.Template.Labels,
+			},
+			Status: api.PodStatus{
+				Phase:      api.PodRunning,
+				Conditions: []api.PodCondition{{Type: api.PodReady, Status: api.ConditionTrue}},
+				ContainerStatuses: []api.ContainerStatus{{
+					State: api.ContainerState{Running: &api.ContainerStateRunning{StartedAt: util.NewTime(time.Now().Add(-time.Hour))}},
+				}},
+			},
+		})
+	}
+}
+
+func newTestDeploymentController() (*DeploymentController, *testclient.Fake, *[]api.Deployment) {
+	fake := &testclient.Fake{
+		// Echo back what was written, as the apiserver would.
+		ReactFn: func(action testclient.FakeAction) (runtime.Object, error) {
+			if obj, ok := action.Value.(runtime.Object); ok {
+				return obj, nil
+			}
+			return nil, nil
+		},
+	}
+	manager := NewDeploymentController(fake)
+	manager.recorder = &record.FakeRecorder{}
+	manager.rcStoreSynced = alwaysReady
+	manager.podStoreSynced = alwaysReady
+	updated := []api.Deployment{}
+	manager.updateHandler = func(d *api.Deployment) error {
+		updated = append(updated, *d)
+		return nil
+	}
+	return manager, fake, &updated
+}
+
+// rcActions returns the replication controller writes recorded by the fake client.
+func rcActions(fake *testclient.Fake) (created []*api.ReplicationController, updated []*api.ReplicationController, deleted []string) {
+	for _, action := range fake.Actions {
+		switch action.Action {
+		case testclient.CreateControllerAction:
+			created = append(created, action.Value.(*api.ReplicationController))
+		case testclient.UpdateControllerAction:
+			updated = append(updated, action.Value.(*api.ReplicationController))
+		case testclient.DeleteControllerAction:
+			deleted = append(deleted, action.Value.(string))
+		}
+	}
+	return
+}
+
+func syncDeployment(t *testing.T, manager *DeploymentController, d *api.Deployment) {
+	key, err := rcKeyFunc(d)
+	if err != nil {
+		t.Fatalf("Could not get key for deployment %#v: %v", d, err)
+	}
+	if err := manager.syncHandler(key); err != nil {
+		t.Fatalf("Unexpected error syncing deployment: %v", err)
+	}
+}
+
+func TestDeploymentCreatesRC(t *testing.T) {
+	manager, fake, updated := newTestDeploymentController()
+	d := newDeployment(3, rollingUpdateStrategy(1, 1))
+	manager.dStore.Add(d)
+	syncDeployment(t, manager, d)
+
+	created, scaled, _ := rcActions(fake)
+	if len(created) != 1 {
+		t.Fatalf("Expected 1 replication controller to be created, got %d", len(created))
+	}
+	rc := created[0]
+	hash := rc.Spec.Selector[api.DefaultDeploymentUniqueLabelKey]
+	if hash == "" || rc.Spec.Template.Labels[api.DefaultDeploymentUniqueLabelKey] != hash {
+		t.Errorf("Expected selector and template to carry the unique label, got %v and %v", rc.Spec.Selector, rc.Spec.Template.Labels)
+	}
+	if _, found := d.Spec.Template.Labels[api.DefaultDeploymentUniqueLabelKey]; found {
+		t.Errorf("Deployment template was modified: %v", d.Spec.Template.Labels)
+	}
+	if rc.Annotations[api.DeploymentRevisionAnnotation] != "1" {
+		t.Errorf("Expected revision 1, got %q", rc.Annotations[api.DeploymentRevisionAnnotation])
+	}
+	if rc.Spec.Replicas != 0 {
+		t.Errorf("Expected the controller to be created without replicas, got %d", rc.Spec.Replicas)
+	}
+	// With nothing else running, the surge allows the new controller to scale up at once.
+	if len(scaled) != 1 || scaled[0].Spec.Replicas != 3 {
+		t.Errorf("Expected the new controller to be scaled to 3, got %+v", scaled)
+	}
+	if len(*updated) != 0 {
+		t.Errorf("Expected no status update, got %+v", *updated)
+	}
+}
+
+func TestDeploymentRollingUpdate(t *testing.T) {
+	tests := []struct {
+		name            string
+		maxUnavailable  int
+		maxSurge        int
+		oldReplicas     int
+		oldReady        int
+		newReplicas     int
+		newReady        int
+		expectNewScale  int
+		expectOldScale  int
+		expectedUpdates int
+	}{
+		{
+			name:            "surge only",
+			maxUnavailable:  0,
+			maxSurge:        1,
+			oldReplicas:     3,
+			oldReady:        3,
+			expectNewScale:  1,
+			expectedUpdates: 1,
+		},
+		{
+			name:            "surge and unavailable",
+			maxUnavailable:  1,
+			maxSurge:        1,
+			oldReplicas:     3,
+			oldReady:        3,
+			expectNewScale:  1,
+			expectOldScale:  2,
+			expectedUpdates: 2,
+		},
+		{
+			name:            "new pods ready",
+			maxUnavailable:  0,
+			maxSurge:        1,
+			oldReplicas:     3,
+			oldReady:        3,
+			newReplicas:     1,
+			newReady:        1,
+			expectOldScale:  2,
+			expectedUpdates: 1,
+		},
+		{
+			name:            "new pods not ready",
+			maxUnavailable:  0,
+			maxSurge:        1,
+			oldReplicas:     3,
+			oldReady:        3,
+			newReplicas:     1,
+			expectedUpdates: 0,
+		},
+		{
+			name:            "old pods not ready",
+			maxUnavailable:  1,
+			maxSurge:        0,
+			oldReplicas:     3,
+			oldReady:        1,
+			expectedUpdates: 0,
+		},
+	}
+
+	for _, test := range tests {
+		manager, fake, _ := newTestDeploymentController()
+		d := newDeployment(3, rollingUpdateStrategy(test.maxUnavailable, test.maxSurge))
+		manager.dStore.Add(d)
+		oldRC := newDeploymentRC(d, "foo/old", 1, test.oldReplicas)
+		newRC := newDeploymentRC(d, "foo/bar", 2, test.newReplicas)
+		manager.rcStore.Add(oldRC)
+		manager.rcStore.Add(newRC)
+		addReadyPods(manager, oldRC, test.oldReady)
+		addReadyPods(manager, newRC, test.newReady)
+		syncDeployment(t, manager, d)
+
+		created, scaled, _ := rcActions(fake)
+		if len(created) != 0 {
+			t.Errorf("%s: expected no new controller, got %+v", test.name, created)
+		}
+		if len(scaled) != test.expectedUpdates {
+			t.Errorf("%s: expected %d scaling updates, got %d", test.name, test.expectedUpdates, len(scaled))
+			continue
+		}
+		for _, rc := range scaled {
+			switch rc.Name {
+			case newRC.Name:
+				if rc.Spec.Replicas != test.expectNewScale {
+					t.Errorf("%s: expected new controller to be scaled to %d, got %d", test.name, test.expectNewScale, rc.Spec.Replicas)
+				}
+			case oldRC.Name:
+				if rc.Spec.Replicas != test.expectOldScale {
+					t.Errorf("%s: expected old controller to be scaled to %d, got %d", test.name, test.expectOldScale, rc.Spec.Replicas)
+				}
+			}
+		}
+	}
+}
+
+func TestDeploymentRecreate(t *testing.T) {
+	manager, fake, _ := newTestDeploymentController()
+	d := newDeployment(3, api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType})
+	manager.dStore.Add(d)
+	oldRC := newDeploymentRC(d, "foo/old", 1, 3)
+	newRC := newDeploymentRC(d, "foo/bar", 2, 0)
+	manager.rcStore.Add(oldRC)
+	manager.rcStore.Add(newRC)
+	addReadyPods(manager, oldRC, 3)
+
+	// The old controller is scaled down first.
+	syncDeployment(t, manager, d)
+	_, scaled, _ := rcActions(fake)
+	if len(scaled) != 1 || scaled[0].Name != oldRC.Name || scaled[0].Spec.Replicas != 0 {
+		t.Fatalf("Expected only the old controller to be scaled down, got %+v", scaled)
+	}
+
+	// The new controller is not scaled up while old pods are still running.
+	oldRC.Spec.Replicas = 0
+	manager.rcStore.Update(oldRC)
+	fake.Actions = nil
+	syncDeployment(t, manager, d)
+	if _, scaled, _ = rcActions(fake); len(scaled) != 0 {
+		t.Fatalf("Expected no scaling while old pods are running, got %+v", scaled)
+	}
+
+	// Once the old pods are gone the new controller is scaled up.
+	for _, obj := range manager.podStore.Store.List() {
+		manager.podStore.Store.Delete(obj)
+	}
+	fake.Actions = nil
+	syncDeployment(t, manager, d)
+	if _, scaled, _ = rcActions(fake); len(scaled) != 1 || scaled[0].Name != newRC.Name || scaled[0].Spec.Replicas != 3 {
+		t.Errorf("Expected the new controller to be scaled up to 3, got %+v", scaled)
+	}
+}
+
+func TestDeploymentIgnoresUnmanagedRCs(t *testing.T) {
+	manager, fake, _ := newTestDeploymentController()
+	d := newDeployment(3, rollingUpdateStrategy(1, 1))
+	manager.dStore.Add(d)
+	// A controller created by hand, whose selector lacks the unique label key.
+	rc := newDeploymentRC(d, "foo/old", 1, 3)
+	delete(rc.Spec.Selector, api.DefaultDeploymentUniqueLabelKey)
+	manager.rcStore.Add(rc)
+	addReadyPods(manager, rc, 3)
+	syncDeployment(t, manager, d)
+
+	_, scaled, _ := rcActions(fake)
+	for _, updated := range scaled {
+		if updated.Name == rc.Name {
+			t.Errorf("Expected unmanaged controller to be left alone, got %+v", updated)
+		}
+	}
+}
+
+func TestDeploymentRollback(t *testing.T) {
+	manager, fake, _ := newTestDeploymentController()
+	d := newDeployment(3, rollingUpdateStrategy(1, 1))
+	d.Spec.RollbackTo = &api.RollbackConfig{}
+	manager.dStore.Add(d)
+	oldRC := newDeploymentRC(d, "foo/old", 1, 0)
+	newRC := newDeploymentRC(d, "foo/bar", 2, 3)
+	manager.rcStore.Add(oldRC)
+	manager.rcStore.Add(newRC)
+	syncDeployment(t, manager, d)
+
+	var rolledBack *api.Deployment
+	for _, action := range fake.Actions {
+		if action.Action == "update-deployment" {
+			rolledBack = action.Value.(*api.Deployment)
+		}
+	}
+	if rolledBack == nil {
+		t.Fatalf("Expected the deployment to be updated, got actions %+v", fake.Actions)
+	}
+	if rolledBack.Spec.RollbackTo != nil {
+		t.Errorf("Expected the rollback request to be cleared, got %+v", rolledBack.Spec.RollbackTo)
+	}
+	if image := rolledBack.Spec.Template.Spec.Containers[0].Image; image != "foo/old" {
+		t.Errorf("Expected the template of revision 1, got image %q", image)
+	}
+	if _, found := rolledBack.Spec.Template.Labels[api.DefaultDeploymentUniqueLabelKey]; found {
+		t.Errorf("Expected the unique label to be removed from the template, got %v", rolledBack.Spec.Template.Labels)
+	}
+	if _, scaled, _ := rcActions(fake); len(scaled) != 0 {
+		t.Errorf("Expected no scaling during a rollback, got %+v", scaled)
+	}
+
+	// Rolling out the rolled back template reuses the old controller as the newest revision.
+	fake.Actions = nil
+	manager.dStore.Update(rolledBack)
+	syncDeployment(t, manager, rolledBack)
+	_, updatedRCs, _ := rcActions(fake)
+	if len(updatedRCs) == 0 || updatedRCs[0].Name != oldRC.Name || revision(updatedRCs[0]) != 3 {
+		t.Errorf("Expected controller %s to become revision 3, got %+v", oldRC.Name, updatedRCs)
+	}
+}
+
+func TestDeploymentCleansUpOldRCs(t *testing.T) {
+	manager, fake, _ := newTestDeploymentController()
+	d := newDeployment(3, rollingUpdateStrategy(1, 1))
+	limit := 1
+	d.Spec.RevisionHistoryLimit = &limit
+	manager.dStore.Add(d)
+	for i, image := range []string{"foo/1", "foo/2", "foo/3"} {
+		manager.rcStore.Add(newDeploymentRC(d, image, int64(i+1), 0))
+	}
+	current := newDeploymentRC(d, "foo/bar", 4, 3)
+	manager.rcStore.Add(current)
+	addReadyPods(manager, current, 3)
+	syncDeployment(t, manager, d)
+
+	_, _, deleted := rcActions(fake)
+	if len(deleted) != 2 {
+		t.Fatalf("Expected 2 controllers to be deleted, got %v", deleted)
+	}
+	for _, name := range deleted {
+		if name == newDeploymentRC(d, "foo/3", 3, 0).Name || name == current.Name {
+			t.Errorf("Expected the newest revisions to be kept, deleted %s", name)
+		}
+	}
+}
+
+func TestDeploymentStatus(t *testing.T) {
+	manager, _, updated := newTestDeploymentController()
+	d := newDeployment(3, rollingUpdateStrategy(1, 1))
+	manager.dStore.Add(d)
+	oldRC := newDeploymentRC(d, "foo/old", 1, 1)
+	newRC := newDeploymentRC(d, "foo/bar", 2, 2)
+	manager.rcStore.Add(oldRC)
+	manager.rcStore.Add(newRC)
+	syncDeployment(t, manager, d)
+
+	if len(*updated) != 1 {
+		t.Fatalf("Expected 1 status update, got %d", len(*updated))
+	}
+	status := (*updated)[0].Status
+	if status.Replicas != 3 || status.UpdatedReplicas != 2 {
+		t.Errorf("Expected 3 replicas of which 2 updated, got %+v", status)
+	}
+}
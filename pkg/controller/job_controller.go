@@ -0,0 +1,477 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller/framework"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/workqueue"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/golang/glog"
+)
+
+// We'll attempt to recompute the status of all jobs at least this often.
+const FullJobResyncPeriod = 30 * time.Second
+
+// jobPodControlInterface is an interface that knows how to add or delete pods
+// on behalf of a job, created as an interface to allow testing.
+type jobPodControlInterface interface {
+	// createJobPod creates a new pod according to the job's template.
+	createJobPod(namespace string, job *api.Job) error
+	// deletePod deletes the pod identified by podID.
+	deletePod(namespace string, podID string) error
+}
+
+// JobManager is responsible for synchronizing Job objects stored in the
+// system with the pods that run them.
+type JobManager struct {
+	kubeClient client.Interface
+	podControl jobPodControlInterface
+	recorder   record.EventRecorder
+
+	// To allow injection of syncJob for testing.
+	syncHandler func(jobKey string) error
+	// To allow injection of updateJobStatus for testing.
+	updateHandler func(job *api.Job) error
+
+	// podStoreSynced returns true if the pod store has been synced at least once.
+	// Added as a member to the struct to allow injection for testing.
+	podStoreSynced func() bool
+
+	// A TTLCache of pod creates/deletes each job expects to see
+	expectations *RCExpectations
+	// A store of jobs, populated by the jobController
+	jobStore cache.StoreToJobLister
+	// A store of pods, populated by the podController
+	podStore cache.StoreToPodLister
+	// Watches changes to all jobs
+	jobController *framework.Controller
+	// Watches changes to all pods
+	podController *framework.Controller
+	// Jobs that need to be updated
+	queue *workqueue.Type
+}
+
+// NewJobManager creates a new JobManager.
+func NewJobManager(kubeClient client.Interface) *JobManager {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(kubeClient.Events(""))
+	recorder := eventBroadcaster.NewRecorder(api.EventSource{Component: "job-controller"})
+
+	jm := &JobManager{
+		kubeClient: kubeClient,
+		podControl: RealPodControl{
+			kubeClient: kubeClient,
+			recorder:   recorder,
+		},
+		recorder:     recorder,
+		expectations: NewRCExpectations(),
+		queue:        workqueue.New(),
+	}
+
+	jm.jobStore.Store, jm.jobController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return jm.kubeClient.Jobs(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return jm.kubeClient.Jobs(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Job{},
+		FullJobResyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: jm.enqueueJob,
+			UpdateFunc: func(old, cur interface{}) {
+				jm.enqueueJob(cur)
+			},
+			DeleteFunc: jm.enqueueJob,
+		},
+	)
+
+	jm.podStore.Store, jm.podController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return jm.kubeClient.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return jm.kubeClient.Pods(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		&api.Pod{},
+		PodRelistPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    jm.addPod,
+			UpdateFunc: jm.updatePod,
+			DeleteFunc: jm.deletePod,
+		},
+	)
+
+	jm.syncHandler = jm.syncJob
+	jm.updateHandler = jm.updateJobStatus
+	jm.podStoreSynced = jm.podController.HasSynced
+	return jm
+}
+
+// Run begins watching and syncing.
+func (jm *JobManager) Run(workers int, stopCh <-chan struct{}) {
+	defer util.HandleCrash()
+	go jm.jobController.Run(stopCh)
+	go jm.podController.Run(stopCh)
+	for i := 0; i < workers; i++ {
+		go util.Until(jm.worker, time.Second, stopCh)
+	}
+	<-stopCh
+	glog.Infof("Shutting down Job Manager")
+	jm.queue.ShutDown()
+}
+
+// getPodJob returns the job managing the given pod.
+func (jm *JobManager) getPodJob(pod *api.Pod) *api.Job {
+	jobs, err := jm.jobStore.GetPodJobs(pod)
+	if err != nil {
+		glog.V(4).Infof("No jobs found for pod %v, job manager will avoid syncing", pod.Name)
+		return nil
+	}
+	return &jobs[0]
+}
+
+// When a pod is created, enqueue the job that manages it and update its expectations.
+func (jm *JobManager) addPod(obj interface{}) {
+	pod := obj.(*api.Pod)
+	if job := jm.getPodJob(pod); job != nil {
+		jobKey, err := rcKeyFunc(job)
+		if err != nil {
+			glog.Errorf("Couldn't get key for job %#v: %v", job, err)
+			return
+		}
+		jm.expectations.lowerExpectationsForKey(jobKey, 1, 0)
+		jm.enqueueJob(job)
+	}
+}
+
+// When a pod is updated, figure out what job manages it and wake it up. If
+// the labels of the pod have changed we need to awaken both the old and new
+// job. old and cur must be *api.Pod types.
+func (jm *JobManager) updatePod(old, cur interface{}) {
+	if api.Semantic.DeepEqual(old, cur) {
+		// A periodic relist will send update events for all known pods.
+		return
+	}
+	curPod := cur.(*api.Pod)
+	if job := jm.getPodJob(curPod); job != nil {
+		jm.enqueueJob(job)
+	}
+	oldPod := old.(*api.Pod)
+	// Only need to get the old job if the labels changed.
+	if !reflect.DeepEqual(curPod.Labels, oldPod.Labels) {
+		if oldJob := jm.getPodJob(oldPod); oldJob != nil {
+			jm.enqueueJob(oldJob)
+		}
+	}
+}
+
+// When a pod is deleted, enqueue the job that manages the pod and update its expectations.
+// obj could be an *api.Pod, or a DeletionFinalStateUnknown marker item.
+func (jm *JobManager) deletePod(obj interface{}) {
+	pod, ok := obj.(*api.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			glog.Errorf("Couldn't get object from tombstone %+v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*api.Pod)
+		if !ok {
+			glog.Errorf("Tombstone contained object that is not a pod %+v", obj)
+			return
+		}
+	}
+	if job := jm.getPodJob(pod); job != nil {
+		jobKey, err := rcKeyFunc(job)
+		if err != nil {
+			glog.Errorf("Couldn't get key for job %#v: %v", job, err)
+			return
+		}
+		jm.expectations.lowerExpectationsForKey(jobKey, 0, 1)
+		jm.enqueueJob(job)
+	}
+}
+
+// obj could be an *api.Job, or a DeletionFinalStateUnknown marker item.
+func (jm *JobManager) enqueueJob(obj interface{}) {
+	key, err := rcKeyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	jm.queue.Add(key)
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (jm *JobManager) worker() {
+	for {
+		func() {
+			key, quit := jm.queue.Get()
+			if quit {
+				return
+			}
+			defer jm.queue.Done(key)
+			err := jm.syncHandler(key.(string))
+			if err != nil {
+				glog.Errorf("Error syncing job: %v", err)
+			}
+		}()
+	}
+}
+
+// syncJob will sync the job with the given key if it has had its expectations fulfilled, meaning
+// it did not expect to see any more of its pods created or deleted. This function is not meant to be invoked
+// concurrently with the same key.
+func (jm *JobManager) syncJob(key string) error {
+	startTime := time.Now()
+	defer func() {
+		glog.V(4).Infof("Finished syncing job %q (%v)", key, time.Now().Sub(startTime))
+	}()
+
+	obj, exists, err := jm.jobStore.Store.GetByKey(key)
+	if !exists {
+		glog.V(4).Infof("Job has been deleted: %v", key)
+		jm.expectations.DeleteExpectations(key)
+		return nil
+	}
+	if err != nil {
+		glog.Infof("Unable to retrieve job %v from store: %v", key, err)
+		jm.queue.Add(key)
+		return err
+	}
+	job := *obj.(*api.Job)
+	if !jm.podStoreSynced() {
+		// Sleep so we give the pod reflector goroutine a chance to run.
+		time.Sleep(PodStoreSyncedPollPeriod)
+		glog.Infof("Waiting for pods controller to sync, requeuing job %v", job.Name)
+		jm.enqueueJob(&job)
+		return nil
+	}
+
+	// Check the expectations of the job before counting active pods, otherwise a new pod can sneak in
+	// and update the expectations after we've retrieved active pods from the store. If a new pod enters
+	// the store after we've checked the expectation, the job sync is just deferred till the next relist.
+	jobNeedsSync := jm.expectations.satisfiedExpectationsForKey(key)
+	podList, err := jm.podStore.Pods(job.Namespace).List(labels.Set(job.Spec.Selector).AsSelector())
+	if err != nil {
+		glog.Errorf("Error getting pods for job %q: %v", key, err)
+		jm.queue.Add(key)
+		return err
+	}
+
+	activePods := filterActivePods(podList.Items)
+	active := len(activePods)
+	succeeded, failed := getJobPodStatus(podList.Items)
+
+	// Work on a deep copy so appending conditions never touches the cached job.
+	status := api.JobStatus{}
+	if err := api.Scheme.Convert(&job.Status, &status); err != nil {
+		glog.Errorf("Unable to copy status of job %q: %v", key, err)
+		jm.queue.Add(key)
+		return err
+	}
+	if status.StartTime == nil {
+		now := util.Now()
+		status.StartTime = &now
+	}
+
+	if !isJobFinished(&job) {
+		if pastActiveDeadline(&job, status.StartTime) {
+			// Kill all the remaining pods and mark the job as failed.
+			jm.deleteJobPods(&job, key, activePods)
+			failed += active
+			active = 0
+			status.Conditions = append(status.Conditions, newJobCondition(api.JobFailed, "DeadlineExceeded", "Job was active longer than specified deadline"))
+			jm.recorder.Eventf(&job, "deadlineExceeded", "Job was active longer than specified deadline")
+		} else {
+			if jobNeedsSync {
+				active = jm.manageJob(activePods, succeeded, &job, key)
+			}
+			if succeeded >= jobCompletions(&job) {
+				status.Conditions = append(status.Conditions, newJobCondition(api.JobComplete, "", ""))
+				now := util.Now()
+				status.CompletionTime = &now
+			}
+		}
+	}
+
+	status.Active = active
+	status.Succeeded = succeeded
+	status.Failed = failed
+
+	if !api.Semantic.DeepEqual(job.Status, status) {
+		job.Status = status
+		if err := jm.updateHandler(&job); err != nil {
+			glog.V(2).Infof("Failed to update status for job %v, requeuing: %v", job.Name, err)
+			jm.enqueueJob(&job)
+			return err
+		}
+	}
+	return nil
+}
+
+// manageJob creates or deletes pods so that the number of active pods matches
+// the job's parallelism and remaining completions. It returns the number of
+// active pods the job is expected to have once the changes are observed.
+func (jm *JobManager) manageJob(pods []*api.Pod, succeeded int, job *api.Job, jobKey string) int {
+	active := len(pods)
+	parallelism := jobParallelism(job)
+
+	if active > parallelism {
+		diff := active - parallelism
+		jm.expectations.setExpectationsForKey(jobKey, 0, diff)
+		glog.V(2).Infof("Too many pods running job %q, need %d, deleting %d", jobKey, parallelism, diff)
+		// Sort the pods in the order such that not-ready < ready, unscheduled
+		// < scheduled, and pending < running. This ensures that we delete pods
+		// in the earlier stages whenever possible.
+		sort.Sort(activePods(pods))
+		jm.deleteJobPods(job, jobKey, pods[:diff])
+		return parallelism
+	}
+
+	wantActive := jobCompletions(job) - succeeded
+	if wantActive > parallelism {
+		wantActive = parallelism
+	}
+	diff := wantActive - active
+	if diff <= 0 {
+		return active
+	}
+	jm.expectations.setExpectationsForKey(jobKey, diff, 0)
+	glog.V(2).Infof("Too few pods running job %q, need %d, creating %d", jobKey, wantActive, diff)
+	wait := sync.WaitGroup{}
+	wait.Add(diff)
+	for i := 0; i < diff; i++ {
+		go func() {
+			defer wait.Done()
+			if err := jm.podControl.createJobPod(job.Namespace, job); err != nil {
+				// Decrement the expected number of creates because the informer won't observe this pod
+				glog.V(2).Infof("Failed creation, decrementing expectations for job %q", jobKey)
+				jm.expectations.lowerExpectationsForKey(jobKey, 1, 0)
+				util.HandleError(err)
+			}
+		}()
+	}
+	wait.Wait()
+	return wantActive
+}
+
+// deleteJobPods deletes the given pods of a job in parallel.
+func (jm *JobManager) deleteJobPods(job *api.Job, jobKey string, pods []*api.Pod) {
+	wait := sync.WaitGroup{}
+	wait.Add(len(pods))
+	for i := range pods {
+		go func(ix int) {
+			defer wait.Done()
+			if err := jm.podControl.deletePod(job.Namespace, pods[ix].Name); err != nil {
+				// Decrement the expected number of deletes because the informer won't observe this deletion
+				glog.V(2).Infof("Failed deletion, decrementing expectations for job %q", jobKey)
+				jm.expectations.lowerExpectationsForKey(jobKey, 0, 1)
+				util.HandleError(err)
+			}
+		}(i)
+	}
+	wait.Wait()
+}
+
+// updateJobStatus writes the status of the given job to the apiserver.
+func (jm *JobManager) updateJobStatus(job *api.Job) error {
+	_, err := jm.kubeClient.Jobs(job.Namespace).UpdateStatus(job)
+	return err
+}
+
+// getJobPodStatus returns the number of succeeded and failed pods.
+func getJobPodStatus(pods []api.Pod) (succeeded, failed int) {
+	for i := range pods {
+		switch pods[i].Status.Phase {
+		case api.PodSucceeded:
+			succeeded++
+		case api.PodFailed:
+			failed++
+		}
+	}
+	return
+}
+
+// isJobFinished returns true if the job has a Complete or Failed condition.
+func isJobFinished(job *api.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if (c.Type == api.JobComplete || c.Type == api.JobFailed) && c.Status == api.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// pastActiveDeadline returns true if the job has been active for longer than
+// its ActiveDeadlineSeconds.
+func pastActiveDeadline(job *api.Job, startTime *util.Time) bool {
+	if job.Spec.ActiveDeadlineSeconds == nil || startTime == nil {
+		return false
+	}
+	duration := time.Now().Sub(startTime.Time)
+	allowedDuration := time.Duration(*job.Spec.ActiveDeadlineSeconds) * time.Second
+	return duration >= allowedDuration
+}
+
+func newJobCondition(conditionType api.JobConditionType, reason, message string) api.JobCondition {
+	return api.JobCondition{
+		Type:               conditionType,
+		Status:             api.ConditionTrue,
+		LastProbeTime:      util.Now(),
+		LastTransitionTime: util.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// jobCompletions returns the desired number of completions, defaulting to 1.
+func jobCompletions(job *api.Job) int {
+	if job.Spec.Completions == nil {
+		return 1
+	}
+	return *job.Spec.Completions
+}
+
+// jobParallelism returns the maximum number of concurrently active pods,
+// defaulting to the number of completions.
+func jobParallelism(job *api.Job) int {
+	if job.Spec.Parallelism == nil {
+		return jobCompletions(job)
+	}
+	return *job.Spec.Parallelism
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// FakeJobPodControl records the pods created and deleted on behalf of jobs.
+type FakeJobPodControl struct {
+	createdJobs   []api.Job
+	deletePodName []string
+	lock          sync.Mutex
+	err           error
+}
+
+func (f *FakeJobPodControl) createJobPod(namespace string, job *api.Job) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.createdJobs = append(f.createdJobs, *job)
+	return nil
+}
+
+func (f *FakeJobPodControl) deletePod(namespace string, podName string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.deletePodName = append(f.deletePodName, podName)
+	return nil
+}
+
+func newJob(parallelism, completions int) *api.Job {
+	return &api.Job{
+		ObjectMeta: api.ObjectMeta{
+			UID:       util.NewUUID(),
+			Name:      "foobar",
+			Namespace: api.NamespaceDefault,
+		},
+		Spec: api.JobSpec{
+			Parallelism: &parallelism,
+			Completions: &completions,
+			Selector:    map[string]string{"foo": "bar"},
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: map[string]string{"foo": "bar"},
+				},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{Image: "foo/bar"},
+					},
+					RestartPolicy: api.RestartPolicyOnFailure,
+				},
+			},
+		},
+	}
+}
+
+func getJobKey(job *api.Job, t *testing.T) string {
+	key, err := rcKeyFunc(job)
+	if err != nil {
+		t.Errorf("Unexpected error getting key for job %v: %v", job.Name, err)
+		return ""
+	}
+	return key
+}
+
+// create count pods with the given phase for the given job, and add them to the store.
+func newJobPodList(store cache.Store, count int, phase api.PodPhase, job *api.Job, prefix string) {
+	for i := 0; i < count; i++ {
+		pod := &api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Name:      fmt.Sprintf("%s%d", prefix, i),
+				Labels:    job.Spec.Selector,
+				Namespace: job.Namespace,
+			},
+			Status: api.PodStatus{Phase: phase},
+		}
+		store.Add(pod)
+	}
+}
+
+func newTestJobManager() (*JobManager, *FakeJobPodControl, *[]api.Job) {
+	client := client.NewOrDie(&client.Config{Host: "", Version: testapi.Version()})
+	fakePodControl := &FakeJobPodControl{}
+	manager := NewJobManager(client)
+	manager.podControl = fakePodControl
+	manager.podStoreSynced = alwaysReady
+	updated := []api.Job{}
+	manager.updateHandler = func(job *api.Job) error {
+		updated = append(updated, *job)
+		return nil
+	}
+	return manager, fakePodControl, &updated
+}
+
+func TestSyncJob(t *testing.T) {
+	testCases := map[string]struct {
+		parallelism int
+		completions int
+
+		activePods    int
+		succeededPods int
+		failedPods    int
+
+		expectedCreations int
+		expectedDeletions int
+		expectedActive    int
+		expectedSucceeded int
+		expectedFailed    int
+		expectedComplete  bool
+	}{
+		"job start": {
+			parallelism: 2, completions: 5,
+			expectedCreations: 2, expectedActive: 2,
+		},
+		"correct number of pods": {
+			parallelism: 2, completions: 5, activePods: 2,
+			expectedActive: 2,
+		},
+		"too few active pods": {
+			parallelism: 2, completions: 5, activePods: 1, succeededPods: 1,
+			expectedCreations: 1, expectedActive: 2, expectedSucceeded: 1,
+		},
+		"too many active pods": {
+			parallelism: 2, completions: 5, activePods: 3,
+			expectedDeletions: 1, expectedActive: 2,
+		},
+		"failed pods are replaced": {
+			parallelism: 2, completions: 5, activePods: 1, failedPods: 1,
+			expectedCreations: 1, expectedActive: 2, expectedFailed: 1,
+		},
+		"only remaining completions are started": {
+			parallelism: 2, completions: 5, activePods: 1, succeededPods: 4,
+			expectedActive: 1, expectedSucceeded: 4,
+		},
+		"job finished": {
+			parallelism: 2, completions: 5, succeededPods: 5,
+			expectedSucceeded: 5, expectedComplete: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		manager, fakePodControl, updated := newTestJobManager()
+		job := newJob(tc.parallelism, tc.completions)
+		manager.jobStore.Store.Add(job)
+		newJobPodList(manager.podStore.Store, tc.activePods, api.PodRunning, job, "active")
+		newJobPodList(manager.podStore.Store, tc.succeededPods, api.PodSucceeded, job, "succeeded")
+		newJobPodList(manager.podStore.Store, tc.failedPods, api.PodFailed, job, "failed")
+
+		if err := manager.syncJob(getJobKey(job, t)); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+
+		if len(fakePodControl.createdJobs) != tc.expectedCreations {
+			t.Errorf("%s: expected %d creations, got %d", name, tc.expectedCreations, len(fakePodControl.createdJobs))
+		}
+		if len(fakePodControl.deletePodName) != tc.expectedDeletions {
+			t.Errorf("%s: expected %d deletions, got %d", name, tc.expectedDeletions, len(fakePodControl.deletePodName))
+		}
+		if len(*updated) != 1 {
+			t.Errorf("%s: expected one status update, got %d", name, len(*updated))
+			continue
+		}
+		status := (*updated)[0].Status
+		if status.Active != tc.expectedActive || status.Succeeded != tc.expectedSucceeded || status.Failed != tc.expectedFailed {
+			t.Errorf("%s: expected active/succeeded/failed %d/%d/%d, got %d/%d/%d", name,
+				tc.expectedActive, tc.expectedSucceeded, tc.expectedFailed,
+				status.Active, status.Succeeded, status.Failed)
+		}
+		if status.StartTime == nil {
+			t.Errorf("%s: expected start time to be set", name)
+		}
+		if tc.expectedComplete {
+			if !isJobFinished(&(*updated)[0]) || status.CompletionTime == nil {
+				t.Errorf("%s: expected job to be complete, got %#v", name, status)
+			}
+		} else if isJobFinished(&(*updated)[0]) {
+			t.Errorf("%s: expected job not to be finished, got %#v", name, status)
+		}
+	}
+}
+
+func TestSyncJobPastDeadline(t *testing.T) {
+	manager, fakePodControl, updated := newTestJobManager()
+	job := newJob(2, 5)
+	deadline := int64(10)
+	job.Spec.ActiveDeadlineSeconds = &deadline
+	start := util.NewTime(time.Now().Add(-time.Minute))
+	job.Status.StartTime = &start
+	manager.jobStore.Store.Add(job)
+	newJobPodList(manager.podStore.Store, 2, api.PodRunning, job, "active")
+	newJobPodList(manager.podStore.Store, 1, api.PodSucceeded, job, "succeeded")
+
+	if err := manager.syncJob(getJobKey(job, t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fakePodControl.createdJobs) != 0 {
+		t.Errorf("expected no creations, got %d", len(fakePodControl.createdJobs))
+	}
+	if len(fakePodControl.deletePodName) != 2 {
+		t.Errorf("expected 2 deletions, got %d", len(fakePodControl.deletePodName))
+	}
+	if len(*updated) != 1 {
+		t.Fatalf("expected one status update, got %d", len(*updated))
+	}
+	status := (*updated)[0].Status
+	if status.Active != 0 || status.Succeeded != 1 || status.Failed != 2 {
+		t.Errorf("unexpected status %#v", status)
+	}
+	if len(status.Conditions) != 1 || status.Conditions[0].Type != api.JobFailed || status.Conditions[0].Reason != "DeadlineExceeded" {
+		t.Errorf("expected a DeadlineExceeded failure condition, got %#v", status.Conditions)
+	}
+}
+
+func TestSyncJobFinishedDoesNothing(t *testing.T) {
+	manager, fakePodControl, updated := newTestJobManager()
+	job := newJob(2, 5)
+	start := util.Now()
+	job.Status = api.JobStatus{
+		StartTime:  &start,
+		Conditions: []api.JobCondition{newJobCondition(api.JobComplete, "", "")},
+	}
+	manager.jobStore.Store.Add(job)
+
+	if err := manager.syncJob(getJobKey(job, t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fakePodControl.createdJobs) != 0 || len(fakePodControl.deletePodName) != 0 {
+		t.Errorf("expected no pod changes for a finished job, got %d creations and %d deletions",
+			len(fakePodControl.createdJobs), len(fakePodControl.deletePodName))
+	}
+	if len(*updated) != 0 {
+		t.Errorf("expected no status update, got %d", len(*updated))
+	}
+}
+
+func TestSyncJobDeleted(t *testing.T) {
+	manager, fakePodControl, updated := newTestJobManager()
+	job := newJob(2, 2)
+	if err := manager.syncJob(getJobKey(job, t)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakePodControl.createdJobs) != 0 || len(fakePodControl.deletePodName) != 0 || len(*updated) != 0 {
+		t.Errorf("expected nothing to happen for a deleted job")
+	}
+}
+
+func TestSyncJobExpectations(t *testing.T) {
+	manager, fakePodControl, _ := newTestJobManager()
+	job := newJob(2, 2)
+	manager.jobStore.Store.Add(job)
+	key := getJobKey(job, t)
+
+	// Pretend we are still waiting to observe a pod creation.
+	manager.expectations.setExpectationsForKey(key, 1, 0)
+	newJobPodList(manager.podStore.Store, 1, api.PodRunning, job, "active")
+
+	manager.syncJob(key)
+	if len(fakePodControl.createdJobs) != 0 {
+		t.Errorf("expected no creations while expectations are unsatisfied, got %d", len(fakePodControl.createdJobs))
+	}
+}
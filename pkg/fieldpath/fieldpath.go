@@ -18,6 +18,8 @@ package fieldpath
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
 )
@@ -30,6 +32,8 @@ import (
 //
 // 1.  metadata.name - The name of an API object
 // 2.  metadata.namespace - The namespace of an API object
+// 3.  metadata.labels - The labels of an API object, formatted by FormatMap
+// 4.  metadata.annotations - The annotations of an API object, formatted by FormatMap
 func ExtractFieldPathAsString(obj interface{}, fieldPath string) (string, error) {
 	accessor, err := meta.Accessor(obj)
 	if err != nil {
@@ -41,7 +45,27 @@ func ExtractFieldPathAsString(obj interface{}, fieldPath string) (string, error)
 		return accessor.Name(), nil
 	case "metadata.namespace":
 		return accessor.Namespace(), nil
+	case "metadata.labels":
+		return FormatMap(accessor.Labels()), nil
+	case "metadata.annotations":
+		return FormatMap(accessor.Annotations()), nil
 	}
 
 	return "", fmt.Errorf("Unsupported fieldPath: %v", fieldPath)
 }
+
+// FormatMap formats a map as one key="value" pair per line, sorted by key.
+// Values are quoted as Go string literals so that they may span lines.
+func FormatMap(m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, k := range keys {
+		lines = append(lines, fmt.Sprintf("%s=%q\n", k, m[k]))
+	}
+	return strings.Join(lines, "")
+}
@@ -57,6 +57,26 @@ func TestExtractFieldPathAsString(t *testing.T) {
 			},
 			expectedValue: "object-name",
 		},
+		{
+			name:      "ok - labels",
+			fieldPath: "metadata.labels",
+			obj: &api.Pod{
+				ObjectMeta: api.ObjectMeta{
+					Labels: map[string]string{"tier": "frontend", "app": "guestbook"},
+				},
+			},
+			expectedValue: "app=\"guestbook\"\ntier=\"frontend\"\n",
+		},
+		{
+			name:      "ok - annotations",
+			fieldPath: "metadata.annotations",
+			obj: &api.Pod{
+				ObjectMeta: api.ObjectMeta{
+					Annotations: map[string]string{"builder": "john-doe", "description": "line one\nline two"},
+				},
+			},
+			expectedValue: "builder=\"john-doe\"\ndescription=\"line one\\nline two\"\n",
+		},
 		{
 			name:      "invalid expression",
 			fieldPath: "metadata.whoops",
@@ -0,0 +1,93 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
+)
+
+// LastAppliedConfigAnnotation is the annotation in which kubectl apply stores
+// the configuration an object was last applied with.
+const LastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// GetOriginalConfiguration returns the configuration stored in the annotation
+// of the object in info, or nil if the object was never applied.
+func GetOriginalConfiguration(info *resource.Info) ([]byte, error) {
+	annotations, err := info.Mapping.MetadataAccessor.Annotations(info.Object)
+	if err != nil {
+		return nil, err
+	}
+	original, ok := annotations[LastAppliedConfigAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	return []byte(original), nil
+}
+
+// SetOriginalConfiguration stores the given configuration in the annotation
+// of the object in info.
+func SetOriginalConfiguration(info *resource.Info, original []byte) error {
+	accessor := info.Mapping.MetadataAccessor
+	annotations, err := accessor.Annotations(info.Object)
+	if err != nil {
+		return err
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[LastAppliedConfigAnnotation] = string(original)
+	return accessor.SetAnnotations(info.Object, annotations)
+}
+
+// GetModifiedConfiguration returns the serialized configuration of the object
+// in info. If annotate is true, the annotation of the returned configuration
+// is set to the configuration itself, so that applying it records what was
+// applied. The annotation never contains itself.
+func GetModifiedConfiguration(info *resource.Info, annotate bool) ([]byte, error) {
+	accessor := info.Mapping.MetadataAccessor
+	annotations, err := accessor.Annotations(info.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	// Serialize the object without the annotation, then put back whatever
+	// was there before.
+	original, hasOriginal := annotations[LastAppliedConfigAnnotation]
+	if hasOriginal {
+		delete(annotations, LastAppliedConfigAnnotation)
+		if err := accessor.SetAnnotations(info.Object, annotations); err != nil {
+			return nil, err
+		}
+	}
+	modified, err := info.Mapping.Codec.Encode(info.Object)
+	if err != nil {
+		return nil, err
+	}
+	if hasOriginal {
+		if err := SetOriginalConfiguration(info, []byte(original)); err != nil {
+			return nil, err
+		}
+	}
+	if !annotate {
+		return modified, nil
+	}
+
+	if err := SetOriginalConfiguration(info, modified); err != nil {
+		return nil, err
+	}
+	return info.Mapping.Codec.Encode(info.Object)
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+const (
+	annotate_long = `Update the annotations on one or more resources.
+
+An annotation is a key/value pair that can hold larger (compared to a label), and possibly not human-readable, data.
+It is intended to store non-identifying auxiliary data, especially data manipulated by tools and system extensions.
+If --overwrite is true, then existing annotations can be overwritten, otherwise attempting to overwrite an annotation will result in an error.
+If --resource-version is specified, then updates will use this resource version, otherwise the existing resource-version will be used.`
+	annotate_example = `// Update pod 'foo' with the annotation 'description' and the value 'my frontend'.
+// If the same annotation is set multiple times, only the last value will be applied
+$ kubectl annotate pods foo description='my frontend'
+
+// Update a pod identified by type and name in "pod.json"
+$ kubectl annotate -f pod.json description='my frontend'
+
+// Update pod 'foo' with the annotation 'description' and the value 'my frontend running nginx', overwriting any existing value.
+$ kubectl annotate --overwrite pods foo description='my frontend running nginx'
+
+// Update all pods in the namespace
+$ kubectl annotate pods --all description='my frontend running nginx'
+
+// Update pod 'foo' only if the resource is unchanged from version 1.
+$ kubectl annotate pods foo description='my frontend running nginx' --resource-version=1
+
+// Update pod 'foo' by removing an annotation named 'description' if it exists.
+// Does not require the --overwrite flag.
+$ kubectl annotate pods foo description-`
+)
+
+func NewCmdAnnotate(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	var filenames util.StringList
+	cmd := &cobra.Command{
+		Use:     "annotate [--overwrite] (-f FILENAME | RESOURCE NAME) KEY_1=VAL_1 ... KEY_N=VAL_N [--resource-version=version]",
+		Short:   "Update the annotations on a resource",
+		Long:    annotate_long,
+		Example: annotate_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunAnnotate(f, out, cmd, args, filenames)
+			cmdutil.CheckErr(err)
+		},
+	}
+	cmdutil.AddPrinterFlags(cmd)
+	usage := "Filename, directory, or URL to a file identifying the resource to update the annotation"
+	kubectl.AddJsonFilenameFlag(cmd, &filenames, usage)
+	cmd.Flags().Bool("overwrite", false, "If true, allow annotations to be overwritten, otherwise reject annotation updates that overwrite existing annotations.")
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
+	cmd.Flags().Bool("all", false, "select all resources in the namespace of the specified resource types")
+	cmd.Flags().String("resource-version", "", "If non-empty, the annotation update will only succeed if this is the current resource-version for the object. Only valid when specifying a single resource.")
+	return cmd
+}
+
+func validateNoAnnotationOverwrites(meta *api.ObjectMeta, annotations map[string]string) error {
+	for key := range annotations {
+		if value, found := meta.Annotations[key]; found {
+			return fmt.Errorf("'%s' already has a value (%s), and --overwrite is false", key, value)
+		}
+	}
+	return nil
+}
+
+func parseAnnotations(spec []string) (map[string]string, []string, error) {
+	annotations := map[string]string{}
+	var remove []string
+	for _, annotationSpec := range spec {
+		if strings.Index(annotationSpec, "=") != -1 {
+			// Unlike label values, annotation values may contain '='.
+			parts := strings.SplitN(annotationSpec, "=", 2)
+			if len(parts[0]) == 0 || len(parts[1]) == 0 || !util.IsQualifiedName(strings.ToLower(parts[0])) {
+				return nil, nil, fmt.Errorf("invalid annotation spec: %v", annotationSpec)
+			}
+			annotations[parts[0]] = parts[1]
+		} else if strings.HasSuffix(annotationSpec, "-") {
+			remove = append(remove, annotationSpec[:len(annotationSpec)-1])
+		} else {
+			return nil, nil, fmt.Errorf("unknown annotation spec: %v", annotationSpec)
+		}
+	}
+	for _, removeAnnotation := range remove {
+		if _, found := annotations[removeAnnotation]; found {
+			return nil, nil, fmt.Errorf("can not both modify and remove an annotation in the same command")
+		}
+	}
+	return annotations, remove, nil
+}
+
+func annotateFunc(obj runtime.Object, overwrite bool, resourceVersion string, annotations map[string]string, remove []string) (runtime.Object, error) {
+	meta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if !overwrite {
+		if err := validateNoAnnotationOverwrites(meta, annotations); err != nil {
+			return nil, err
+		}
+	}
+
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+
+	for key, value := range annotations {
+		meta.Annotations[key] = value
+	}
+	for _, annotation := range remove {
+		delete(meta.Annotations, annotation)
+	}
+
+	if len(resourceVersion) != 0 {
+		meta.ResourceVersion = resourceVersion
+	}
+	return obj, nil
+}
+
+func RunAnnotate(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string, filenames util.StringList) error {
+	resources, annotationArgs := []string{}, []string{}
+	first := true
+	for _, s := range args {
+		isAnnotation := strings.Contains(s, "=") || strings.HasSuffix(s, "-")
+		switch {
+		case first && isAnnotation:
+			first = false
+			fallthrough
+		case !first && isAnnotation:
+			annotationArgs = append(annotationArgs, s)
+		case first && !isAnnotation:
+			resources = append(resources, s)
+		case !first && !isAnnotation:
+			return cmdutil.UsageError(cmd, "all resources must be specified before annotation changes: %s", s)
+		}
+	}
+	if len(resources) < 1 && len(filenames) == 0 {
+		return cmdutil.UsageError(cmd, "one or more resources must be specified as <resource> <name> or <resource>/<name>")
+	}
+	if len(annotationArgs) < 1 {
+		return cmdutil.UsageError(cmd, "at least one annotation update is required")
+	}
+
+	selector := cmdutil.GetFlagString(cmd, "selector")
+	all := cmdutil.GetFlagBool(cmd, "all")
+	overwrite := cmdutil.GetFlagBool(cmd, "overwrite")
+	resourceVersion := cmdutil.GetFlagString(cmd, "resource-version")
+
+	cmdNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	annotations, remove, err := parseAnnotations(annotationArgs)
+	if err != nil {
+		return cmdutil.UsageError(cmd, "%v", err)
+	}
+
+	mapper, typer := f.Object()
+	b := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(false, filenames...).
+		SelectorParam(selector).
+		ResourceTypeOrNameArgs(all, resources...).
+		Flatten().
+		Latest()
+
+	one := false
+	r := b.Do().IntoSingular(&one)
+	if err := r.Err(); err != nil {
+		return err
+	}
+	// only apply resource version locking on a single resource
+	if !one && len(resourceVersion) > 0 {
+		return cmdutil.UsageError(cmd, "--resource-version may only be used with a single resource")
+	}
+
+	return r.Visit(func(info *resource.Info) error {
+		obj, err := updateObject(info, false, func(obj runtime.Object) (runtime.Object, error) {
+			return annotateFunc(obj, overwrite, resourceVersion, annotations, remove)
+		})
+		if err != nil {
+			return err
+		}
+
+		printer, err := f.PrinterForMapping(cmd, info.Mapping, false)
+		if err != nil {
+			return err
+		}
+		return printer.PrintObj(obj, out)
+	})
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+func TestParseAnnotations(t *testing.T) {
+	tests := []struct {
+		annotations    []string
+		expected       map[string]string
+		expectedRemove []string
+		expectErr      bool
+	}{
+		{
+			annotations: []string{"a=b", "c=d"},
+			expected:    map[string]string{"a": "b", "c": "d"},
+		},
+		{
+			annotations: []string{"description=my frontend", "url=http://example.com/?a=b"},
+			expected:    map[string]string{"description": "my frontend", "url": "http://example.com/?a=b"},
+		},
+		{
+			annotations:    []string{"a=b", "c-"},
+			expected:       map[string]string{"a": "b"},
+			expectedRemove: []string{"c"},
+		},
+		{
+			annotations: []string{"a="},
+			expectErr:   true,
+		},
+		{
+			annotations: []string{"=b"},
+			expectErr:   true,
+		},
+		{
+			annotations: []string{"a b=c"},
+			expectErr:   true,
+		},
+		{
+			annotations: []string{"a"},
+			expectErr:   true,
+		},
+		{
+			annotations: []string{"a=b", "a-"},
+			expectErr:   true,
+		},
+	}
+	for _, test := range tests {
+		annotations, remove, err := parseAnnotations(test.annotations)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("unexpected non-error for %v", test.annotations)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %v: %v", test.annotations, err)
+			continue
+		}
+		if !reflect.DeepEqual(annotations, test.expected) {
+			t.Errorf("expected: %v, got %v", test.expected, annotations)
+		}
+		if !reflect.DeepEqual(remove, test.expectedRemove) {
+			t.Errorf("expected: %v, got %v", test.expectedRemove, remove)
+		}
+	}
+}
+
+func TestAnnotateFunc(t *testing.T) {
+	tests := []struct {
+		obj         runtime.Object
+		overwrite   bool
+		version     string
+		annotations map[string]string
+		remove      []string
+		expected    runtime.Object
+		expectErr   bool
+	}{
+		{
+			obj: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Annotations: map[string]string{"a": "b"}},
+			},
+			annotations: map[string]string{"a": "b"},
+			expectErr:   true,
+		},
+		{
+			obj: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Annotations: map[string]string{"a": "b"}},
+			},
+			annotations: map[string]string{"a": "c"},
+			overwrite:   true,
+			expected: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Annotations: map[string]string{"a": "c"}},
+			},
+		},
+		{
+			obj:         &api.Pod{},
+			annotations: map[string]string{"a": "b"},
+			expected: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Annotations: map[string]string{"a": "b"}},
+			},
+		},
+		{
+			obj: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Annotations: map[string]string{"a": "b", "c": "d"}},
+			},
+			remove: []string{"a"},
+			expected: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Annotations: map[string]string{"c": "d"}},
+			},
+		},
+		{
+			obj: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Annotations: map[string]string{"a": "b"}},
+			},
+			annotations: map[string]string{"c": "d"},
+			version:     "2",
+			expected: &api.Pod{
+				ObjectMeta: api.ObjectMeta{
+					Annotations:     map[string]string{"a": "b", "c": "d"},
+					ResourceVersion: "2",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		out, err := annotateFunc(test.obj, test.overwrite, test.version, test.annotations, test.remove)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("unexpected non-error: %v", test)
+			}
+			continue
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("unexpected error: %v %v", err, test)
+		}
+		if !reflect.DeepEqual(out, test.expected) {
+			t.Errorf("expected: %v, got %v", test.expected, out)
+		}
+	}
+}
+
+func TestAnnotateErrors(t *testing.T) {
+	testCases := map[string]struct {
+		args  []string
+		flags map[string]string
+		errFn func(error) bool
+	}{
+		"no args": {
+			args:  []string{},
+			errFn: func(err error) bool { return strings.Contains(err.Error(), "one or more resources must be specified") },
+		},
+		"not enough annotations": {
+			args: []string{"pods"},
+			errFn: func(err error) bool {
+				return strings.Contains(err.Error(), "at least one annotation update is required")
+			},
+		},
+		"resources after annotations": {
+			args:  []string{"a=b", "pods"},
+			errFn: func(err error) bool { return strings.Contains(err.Error(), "all resources must be specified before") },
+		},
+		"resource version with multiple resources": {
+			args:  []string{"pods", "a=b"},
+			flags: map[string]string{"all": "true", "resource-version": "10"},
+			errFn: func(err error) bool {
+				return strings.Contains(err.Error(), "--resource-version may only be used with a single resource")
+			},
+		},
+	}
+
+	pods, _, _ := testData()
+	for k, testCase := range testCases {
+		f, tf, codec := NewAPIFactory()
+		tf.Printer = &testPrinter{}
+		tf.Client = &client.FakeRESTClient{
+			Codec: codec,
+			Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+				if req.Method == "GET" && req.URL.Path == "/namespaces/test/pods" {
+					return &http.Response{StatusCode: 200, Body: objBody(codec, pods)}, nil
+				}
+				t.Fatalf("%s: unexpected request: %s %#v\n%#v", k, req.Method, req.URL, req)
+				return nil, nil
+			}),
+		}
+		tf.Namespace = "test"
+		tf.ClientConfig = &client.Config{Version: testapi.Version()}
+
+		buf := bytes.NewBuffer([]byte{})
+		cmd := NewCmdAnnotate(f, buf)
+		cmd.SetOutput(buf)
+
+		for k, v := range testCase.flags {
+			cmd.Flags().Set(k, v)
+		}
+		err := RunAnnotate(f, buf, cmd, testCase.args, nil)
+		if !testCase.errFn(err) {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if tf.Printer.(*testPrinter).Objects != nil {
+			t.Errorf("unexpected print to default printer")
+		}
+		if buf.Len() > 0 {
+			t.Errorf("buffer should be empty: %s", string(buf.Bytes()))
+		}
+	}
+}
+
+func TestAnnotateObject(t *testing.T) {
+	pods, _, _ := testData()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/pods/foo" && (m == "GET" || m == "PUT"):
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: testapi.Version()}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdAnnotate(f, buf)
+	if err := RunAnnotate(f, buf, cmd, []string{"pods", "foo", "a=b"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(tf.Printer.(*testPrinter).Objects[0].(*api.Pod).Annotations, map[string]string{"a": "b"}) {
+		t.Errorf("did not set annotations: %#v", tf.Printer.(*testPrinter).Objects[0])
+	}
+}
+
+func TestAnnotateObjectFromFile(t *testing.T) {
+	_, _, rc := testData()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && (m == "GET" || m == "PUT"):
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: testapi.Version()}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdAnnotate(f, buf)
+	filenames := []string{"../../../examples/guestbook/redis-master-controller.yaml"}
+	if err := RunAnnotate(f, buf, cmd, []string{"a=b"}, filenames); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(tf.Printer.(*testPrinter).Objects[0].(*api.ReplicationController).Annotations, map[string]string{"a": "b"}) {
+		t.Errorf("did not set annotations: %#v", tf.Printer.(*testPrinter).Objects[0])
+	}
+}
+
+func TestAnnotateMultipleObjects(t *testing.T) {
+	pods, _, _ := testData()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch req.Method {
+			case "GET":
+				switch req.URL.Path {
+				case "/namespaces/test/pods":
+					return &http.Response{StatusCode: 200, Body: objBody(codec, pods)}, nil
+				default:
+					t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+					return nil, nil
+				}
+			case "PUT":
+				switch req.URL.Path {
+				case "/namespaces/test/pods/foo":
+					return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+				case "/namespaces/test/pods/bar":
+					return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[1])}, nil
+				default:
+					t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+					return nil, nil
+				}
+			default:
+				t.Fatalf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: testapi.Version()}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdAnnotate(f, buf)
+	cmd.Flags().Set("all", "true")
+	if err := RunAnnotate(f, buf, cmd, []string{"pods", "a=b"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	objects := tf.Printer.(*testPrinter).Objects
+	if len(objects) != 2 {
+		t.Fatalf("expected both pods to be annotated, got %d", len(objects))
+	}
+	for _, obj := range objects {
+		if !reflect.DeepEqual(obj.(*api.Pod).Annotations, map[string]string{"a": "b"}) {
+			t.Errorf("did not set annotations: %#v", obj)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/strategicpatch"
+)
+
+const (
+	apply_long = `Apply a configuration to a resource by filename or stdin.
+
+The resource will be created if it doesn't exist yet. Otherwise, the live object is
+patched with the changes between the configuration it was last applied with, the
+new configuration and its current state, so that fields set by other clients are kept
+and fields removed from the configuration are deleted. The applied configuration is
+recorded in the ` + kubectl.LastAppliedConfigAnnotation + ` annotation.
+
+JSON and YAML formats are accepted.`
+	apply_example = `// Apply the configuration in pod.json to a pod.
+$ kubectl apply -f pod.json
+
+// Apply the JSON passed into stdin to a pod.
+$ cat pod.json | kubectl apply -f -`
+)
+
+func NewCmdApply(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	var filenames util.StringList
+	cmd := &cobra.Command{
+		Use:     "apply -f FILENAME",
+		Short:   "Apply a configuration to a resource by filename or stdin",
+		Long:    apply_long,
+		Example: apply_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(ValidateArgs(cmd, args))
+			cmdutil.CheckErr(RunApply(f, out, filenames))
+		},
+	}
+
+	usage := "Filename, directory, or URL to file that contains the configuration to apply"
+	kubectl.AddJsonFilenameFlag(cmd, &filenames, usage)
+	cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+func RunApply(f *cmdutil.Factory, out io.Writer, filenames util.StringList) error {
+	schema, err := f.Validator()
+	if err != nil {
+		return err
+	}
+
+	cmdNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		Schema(schema).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).RequireNamespace().
+		FilenameParam(false, filenames...).
+		Flatten().
+		Do()
+	err = r.Err()
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	err = r.Visit(func(info *resource.Info) error {
+		// Get the modified configuration of the object, annotated with itself.
+		modified, err := kubectl.GetModifiedConfiguration(info, true)
+		if err != nil {
+			return cmdutil.AddSourceToErr("retrieving modified configuration from", info.Source, err)
+		}
+
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		if err := info.Get(); err != nil {
+			if !errors.IsNotFound(err) {
+				return cmdutil.AddSourceToErr("retrieving current configuration of", info.Source, err)
+			}
+			// The object doesn't exist yet, so create it with the annotation.
+			obj, err := helper.Create(info.Namespace, true, modified)
+			if err != nil {
+				return cmdutil.AddSourceToErr("creating", info.Source, err)
+			}
+			count++
+			info.Refresh(obj, true)
+			printObjectSpecificMessage(info.Object, out)
+			fmt.Fprintf(out, "%s/%s\n", info.Mapping.Resource, info.Name)
+			return nil
+		}
+
+		// info.Object now holds the live object, which carries the configuration
+		// it was last applied with, if any.
+		original, err := kubectl.GetOriginalConfiguration(info)
+		if err != nil {
+			return cmdutil.AddSourceToErr("retrieving original configuration of", info.Source, err)
+		}
+		current, err := info.Mapping.Codec.Encode(info.Object)
+		if err != nil {
+			return cmdutil.AddSourceToErr("serializing current configuration of", info.Source, err)
+		}
+
+		// The patch is computed against the versioned type, since that is what
+		// the server applies it to.
+		versionedObject, err := api.Scheme.New(info.Mapping.APIVersion, info.Mapping.Kind)
+		if err != nil {
+			return cmdutil.AddSourceToErr("applying", info.Source, err)
+		}
+		patch, err := strategicpatch.CreateThreeWayMergePatch(original, modified, current, versionedObject)
+		if err != nil {
+			return cmdutil.AddSourceToErr("computing patch for", info.Source, err)
+		}
+
+		obj, err := helper.Patch(info.Namespace, info.Name, api.StrategicMergePatchType, patch)
+		if err != nil {
+			return cmdutil.AddSourceToErr("applying patch to", info.Source, err)
+		}
+		count++
+		info.Refresh(obj, true)
+		fmt.Fprintf(out, "%s/%s\n", info.Mapping.Resource, info.Name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("no objects passed to apply")
+	}
+	return nil
+}
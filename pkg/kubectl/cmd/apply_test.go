@@ -0,0 +1,166 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+
+	"github.com/ghodss/yaml"
+)
+
+const applyTestFile = "../../../examples/guestbook/redis-master-controller.yaml"
+
+// readApplyTestController returns the replication controller in applyTestFile.
+func readApplyTestController(t *testing.T, codec runtime.Codec) *api.ReplicationController {
+	data, err := ioutil.ReadFile(applyTestFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return obj.(*api.ReplicationController)
+}
+
+func TestApplyCreatesObject(t *testing.T) {
+	_, _, rc := testData()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "GET":
+				return &http.Response{StatusCode: 404, Body: stringBody("")}, nil
+			case p == "/namespaces/test/replicationcontrollers" && m == "POST":
+				obj, err := codec.Decode(readBodyOrDie(t, req))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				created := obj.(*api.ReplicationController)
+				if _, ok := created.Annotations[kubectl.LastAppliedConfigAnnotation]; !ok {
+					t.Errorf("expected the created object to carry the applied configuration, got %#v", created.Annotations)
+				}
+				return &http.Response{StatusCode: 201, Body: objBody(codec, &rc.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdApply(f, buf)
+	cmd.Flags().Set("filename", applyTestFile)
+	cmd.Run(cmd, []string{})
+
+	if buf.String() != "replicationcontrollers/rc1\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestApplyPatchesObject(t *testing.T) {
+	f, tf, codec := NewAPIFactory()
+
+	// The controller was last applied with an extra "tier" label, which has
+	// since been removed from the file. The "owner" label was set by someone
+	// else and must be kept.
+	applied := readApplyTestController(t, codec)
+	applied.Labels["tier"] = "backend"
+	original := runtime.EncodeOrDie(codec, applied)
+
+	current := readApplyTestController(t, codec)
+	current.Namespace = "test"
+	current.ResourceVersion = "10"
+	current.Labels["tier"] = "backend"
+	current.Labels["owner"] = "ops"
+	current.Annotations = map[string]string{kubectl.LastAppliedConfigAnnotation: original}
+
+	patched := false
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, current)}, nil
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "PATCH":
+				var patch map[string]interface{}
+				if err := json.Unmarshal(readBodyOrDie(t, req), &patch); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				metadata := patch["metadata"].(map[string]interface{})
+				labels := metadata["labels"].(map[string]interface{})
+				if value, ok := labels["tier"]; !ok || value != nil {
+					t.Errorf("expected the removed label to be deleted, got %#v", labels)
+				}
+				if _, ok := labels["owner"]; ok {
+					t.Errorf("expected the label set by others to be left alone, got %#v", labels)
+				}
+				annotations := metadata["annotations"].(map[string]interface{})
+				if _, ok := annotations[kubectl.LastAppliedConfigAnnotation]; !ok {
+					t.Errorf("expected the applied configuration to be updated, got %#v", annotations)
+				}
+				if _, ok := patch["spec"]; ok {
+					t.Errorf("expected no changes to the spec, got %#v", patch["spec"])
+				}
+				patched = true
+				return &http.Response{StatusCode: 200, Body: objBody(codec, current)}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdApply(f, buf)
+	cmd.Flags().Set("filename", applyTestFile)
+	cmd.Run(cmd, []string{})
+
+	if !patched {
+		t.Errorf("expected the object to be patched")
+	}
+	if buf.String() != "replicationcontrollers/redis-master\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func readBodyOrDie(t *testing.T, req *http.Request) []byte {
+	data, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return data
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/remotecommand"
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+const (
+	attach_example = `// get output from running pod 123456-7890, using the first container by default
+$ kubectl attach 123456-7890
+
+// get output from ruby-container from pod 123456-7890
+$ kubectl attach 123456-7890 -c ruby-container
+
+// switch to raw terminal mode, sends stdin to 'bash' in ruby-container from pod 123456-7890
+// and sends stdout/stderr from 'bash' back to the client
+$ kubectl attach 123456-7890 -c ruby-container -i -t`
+)
+
+func NewCmdAttach(f *cmdutil.Factory, cmdIn io.Reader, cmdOut, cmdErr io.Writer) *cobra.Command {
+	params := &attachParams{}
+	cmd := &cobra.Command{
+		Use:     "attach POD -c CONTAINER",
+		Short:   "Attach to a running container.",
+		Long:    "Attach to a process that is already running inside an existing container.",
+		Example: attach_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunAttach(f, cmd, cmdIn, cmdOut, cmdErr, params, args, &defaultRemoteAttach{})
+			cmdutil.CheckErr(err)
+		},
+	}
+	// TODO support UID
+	cmd.Flags().StringVarP(&params.containerName, "container", "c", "", "Container name")
+	cmd.Flags().BoolVarP(&params.stdin, "stdin", "i", false, "Pass stdin to the container")
+	cmd.Flags().BoolVarP(&params.tty, "tty", "t", false, "Stdin is a TTY")
+	return cmd
+}
+
+type remoteAttach interface {
+	Attach(req *client.Request, config *client.Config, stdin io.Reader, stdout, stderr io.Writer, tty bool) error
+}
+
+type defaultRemoteAttach struct{}
+
+func (*defaultRemoteAttach) Attach(req *client.Request, config *client.Config, stdin io.Reader, stdout, stderr io.Writer, tty bool) error {
+	attach := remotecommand.NewAttach(req, config, stdin, stdout, stderr, tty)
+	return attach.Stream()
+}
+
+type attachParams struct {
+	containerName string
+	stdin         bool
+	tty           bool
+}
+
+func RunAttach(f *cmdutil.Factory, cmd *cobra.Command, cmdIn io.Reader, cmdOut, cmdErr io.Writer, p *attachParams, args []string, ra remoteAttach) error {
+	if len(args) != 1 {
+		return cmdutil.UsageError(cmd, "POD is required for attach")
+	}
+	podName := args[0]
+
+	namespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	pod, err := client.Pods(namespace).Get(podName)
+	if err != nil {
+		return err
+	}
+
+	if pod.Status.Phase != api.PodRunning {
+		return fmt.Errorf("pod %s is not running and cannot be attached to; current phase is %s", podName, pod.Status.Phase)
+	}
+
+	container, err := containerToAttach(pod, p.containerName)
+	if err != nil {
+		return err
+	}
+
+	// The streams of a container with a TTY are not multiplexed, so the
+	// container spec and not the flag decides whether they are raw.
+	tty := container.TTY
+	if p.tty && !container.TTY {
+		glog.Warningf("Unable to use a TTY - container %s doesn't allocate one", container.Name)
+	}
+
+	var stdin io.Reader
+	if p.stdin {
+		if !container.Stdin {
+			return fmt.Errorf("container %s does not accept stdin; set stdin in the container spec to attach to it", container.Name)
+		}
+		stdin = cmdIn
+		if p.tty && tty {
+			restore, ok := setupRawTerminal(cmdIn)
+			if ok {
+				// this handles a clean exit, where the container process finished
+				defer restore()
+			} else {
+				glog.Warning("Unable to use a TTY")
+			}
+		}
+	}
+
+	config, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+
+	req := client.RESTClient.Get().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(namespace).
+		SubResource("attach").
+		Param("container", container.Name)
+
+	return ra.Attach(req, config, stdin, cmdOut, cmdErr, tty)
+}
+
+// containerToAttach returns the container with the given name, or the first
+// container of the pod if no name is given.
+func containerToAttach(pod *api.Pod, name string) (*api.Container, error) {
+	if len(name) == 0 {
+		glog.V(4).Infof("defaulting container name to %s", pod.Spec.Containers[0].Name)
+		return &pod.Spec.Containers[0], nil
+	}
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == name {
+			return &pod.Spec.Containers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("container %s not found in pod %s", name, pod.Name)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+type fakeRemoteAttach struct {
+	req       *client.Request
+	stdin     io.Reader
+	tty       bool
+	attachErr error
+}
+
+func (f *fakeRemoteAttach) Attach(req *client.Request, config *client.Config, stdin io.Reader, stdout, stderr io.Writer, tty bool) error {
+	f.req = req
+	f.stdin = stdin
+	f.tty = tty
+	return f.attachErr
+}
+
+func TestAttach(t *testing.T) {
+	tests := []struct {
+		name, version, podPath, attachPath, container string
+		pod                                           *api.Pod
+		stdin, tty                                    bool
+		attachErr                                     bool
+		expectErr                                     bool
+		expectTTY                                     bool
+	}{
+		{
+			name:       "v1beta3 - pod attach",
+			version:    "v1beta3",
+			podPath:    "/api/v1beta3/namespaces/test/pods/foo",
+			attachPath: "/api/v1beta3/namespaces/test/pods/foo/attach",
+			pod:        attachPod(),
+		},
+		{
+			name:       "v1 - pod attach",
+			version:    "v1",
+			podPath:    "/api/v1/namespaces/test/pods/foo",
+			attachPath: "/api/v1/namespaces/test/pods/foo/attach",
+			pod:        attachPod(),
+		},
+		{
+			name:       "v1 - pod attach error",
+			version:    "v1",
+			podPath:    "/api/v1/namespaces/test/pods/foo",
+			attachPath: "/api/v1/namespaces/test/pods/foo/attach",
+			pod:        attachPod(),
+			attachErr:  true,
+			expectErr:  true,
+		},
+		{
+			name:       "v1 - pod attach with stdin and tty",
+			version:    "v1",
+			podPath:    "/api/v1/namespaces/test/pods/foo",
+			attachPath: "/api/v1/namespaces/test/pods/foo/attach",
+			container:  "interactive",
+			pod:        attachPod(),
+			stdin:      true,
+			tty:        true,
+			expectTTY:  true,
+		},
+		{
+			name:      "v1 - pod attach stdin to a container without stdin",
+			version:   "v1",
+			podPath:   "/api/v1/namespaces/test/pods/foo",
+			container: "bar",
+			pod:       attachPod(),
+			stdin:     true,
+			expectErr: true,
+		},
+		{
+			name:      "v1 - pod attach to a missing container",
+			version:   "v1",
+			podPath:   "/api/v1/namespaces/test/pods/foo",
+			container: "missing",
+			pod:       attachPod(),
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		f, tf, codec := NewAPIFactory()
+		tf.Client = &client.FakeRESTClient{
+			Codec: codec,
+			Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+				switch p, m := req.URL.Path, req.Method; {
+				case p == test.podPath && m == "GET":
+					body := objBody(codec, test.pod)
+					return &http.Response{StatusCode: 200, Body: body}, nil
+				default:
+					t.Errorf("%s: unexpected request: %#v\n%#v", test.name, req.URL, req)
+					return nil, nil
+				}
+			}),
+		}
+		tf.Namespace = "test"
+		tf.ClientConfig = &client.Config{Version: test.version}
+		bufOut := bytes.NewBuffer([]byte{})
+		bufErr := bytes.NewBuffer([]byte{})
+		bufIn := bytes.NewBuffer([]byte{})
+		remote := &fakeRemoteAttach{}
+		if test.attachErr {
+			remote.attachErr = fmt.Errorf("attach error")
+		}
+		params := &attachParams{
+			containerName: test.container,
+			stdin:         test.stdin,
+			tty:           test.tty,
+		}
+		cmd := &cobra.Command{}
+		err := RunAttach(f, cmd, bufIn, bufOut, bufErr, params, []string{"foo"}, remote)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			if test.attachErr && err != remote.attachErr {
+				t.Errorf("%s: unexpected attach error: %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if remote.req.URL().Path != test.attachPath {
+			t.Errorf("%s: did not get expected path for attach request: %s", test.name, remote.req.URL().Path)
+		}
+		if test.stdin && remote.stdin == nil {
+			t.Errorf("%s: expected stdin to be passed", test.name)
+		}
+		if remote.tty != test.expectTTY {
+			t.Errorf("%s: expected tty %t, got %t", test.name, test.expectTTY, remote.tty)
+		}
+	}
+}
+
+func attachPod() *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test", ResourceVersion: "10"},
+		Spec: api.PodSpec{
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers: []api.Container{
+				{
+					Name: "bar",
+				},
+				{
+					Name:  "interactive",
+					Stdin: true,
+					TTY:   true,
+				},
+			},
+		},
+		Status: api.PodStatus{
+			Phase: api.PodRunning,
+		},
+	}
+}
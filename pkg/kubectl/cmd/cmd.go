@@ -115,6 +115,10 @@ Find more information at https://github.com/GoogleCloudPlatform/kubernetes.`,
 	cmds.AddCommand(NewCmdDescribe(f, out))
 	cmds.AddCommand(NewCmdCreate(f, out))
 	cmds.AddCommand(NewCmdUpdate(f, out))
+	cmds.AddCommand(NewCmdApply(f, out))
+	cmds.AddCommand(NewCmdEdit(f, out))
+	cmds.AddCommand(NewCmdPatch(f, out))
+	cmds.AddCommand(NewCmdDiff(f, out))
 	cmds.AddCommand(NewCmdDelete(f, out))
 
 	cmds.AddCommand(NewCmdNamespace(out))
@@ -123,6 +127,7 @@ Find more information at https://github.com/GoogleCloudPlatform/kubernetes.`,
 	cmds.AddCommand(NewCmdScale(f, out))
 
 	cmds.AddCommand(NewCmdExec(f, in, out, err))
+	cmds.AddCommand(NewCmdAttach(f, in, out, err))
 	cmds.AddCommand(NewCmdPortForward(f))
 	cmds.AddCommand(NewCmdProxy(f, out))
 
@@ -130,7 +135,12 @@ Find more information at https://github.com/GoogleCloudPlatform/kubernetes.`,
 	cmds.AddCommand(NewCmdStop(f, out))
 	cmds.AddCommand(NewCmdExposeService(f, out))
 
+	cmds.AddCommand(NewCmdCordon(f, out))
+	cmds.AddCommand(NewCmdUncordon(f, out))
+	cmds.AddCommand(NewCmdDrain(f, out))
+
 	cmds.AddCommand(NewCmdLabel(f, out))
+	cmds.AddCommand(NewCmdAnnotate(f, out))
 
 	cmds.AddCommand(cmdconfig.NewCmdConfig(cmdconfig.NewDefaultPathOptions(), out))
 	cmds.AddCommand(NewCmdClusterInfo(f, out))
@@ -147,3 +157,11 @@ func runHelp(cmd *cobra.Command, args []string) {
 func printDeprecationWarning(command, alias string) {
 	glog.Warningf("%s is DEPRECATED and will be removed in a future version. Use %s instead.", alias, command)
 }
+
+// dryRunSuffix returns the suffix of the messages printed by commands run with --dry-run.
+func dryRunSuffix(dryRun bool) string {
+	if dryRun {
+		return " (dry run)"
+	}
+	return ""
+}
@@ -51,13 +51,15 @@ func NewCmdCreate(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 		Example: create_example,
 		Run: func(cmd *cobra.Command, args []string) {
 			cmdutil.CheckErr(ValidateArgs(cmd, args))
-			cmdutil.CheckErr(RunCreate(f, out, filenames))
+			cmdutil.CheckErr(RunCreate(f, cmd, out, filenames))
 		},
 	}
 
 	usage := "Filename, directory, or URL to file to use to create the resource"
 	kubectl.AddJsonFilenameFlag(cmd, &filenames, usage)
 	cmd.MarkFlagRequired("filename")
+	cmdutil.AddRecursiveFlag(cmd)
+	cmdutil.AddDryRunFlag(cmd)
 
 	return cmd
 }
@@ -69,7 +71,7 @@ func ValidateArgs(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func RunCreate(f *cmdutil.Factory, out io.Writer, filenames util.StringList) error {
+func RunCreate(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, filenames util.StringList) error {
 	schema, err := f.Validator()
 	if err != nil {
 		return err
@@ -85,7 +87,7 @@ func RunCreate(f *cmdutil.Factory, out io.Writer, filenames util.StringList) err
 		Schema(schema).
 		ContinueOnError().
 		NamespaceParam(cmdNamespace).RequireNamespace().
-		FilenameParam(filenames...).
+		FilenameParam(cmdutil.GetFlagBool(cmd, "recursive"), filenames...).
 		Flatten().
 		Do()
 	err = r.Err()
@@ -93,20 +95,25 @@ func RunCreate(f *cmdutil.Factory, out io.Writer, filenames util.StringList) err
 		return err
 	}
 
+	dryRun := cmdutil.GetFlagBool(cmd, "dry-run")
 	count := 0
 	err = r.Visit(func(info *resource.Info) error {
 		data, err := info.Mapping.Codec.Encode(info.Object)
 		if err != nil {
 			return cmdutil.AddSourceToErr("creating", info.Source, err)
 		}
-		obj, err := resource.NewHelper(info.Client, info.Mapping).Create(info.Namespace, true, data)
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		helper.DryRun = dryRun
+		obj, err := helper.Create(info.Namespace, true, data)
 		if err != nil {
 			return cmdutil.AddSourceToErr("creating", info.Source, err)
 		}
 		count++
 		info.Refresh(obj, true)
-		printObjectSpecificMessage(info.Object, out)
-		fmt.Fprintf(out, "%s/%s\n", info.Mapping.Resource, info.Name)
+		if !dryRun {
+			printObjectSpecificMessage(info.Object, out)
+		}
+		fmt.Fprintf(out, "%s/%s%s\n", info.Mapping.Resource, info.Name, dryRunSuffix(dryRun))
 		return nil
 	})
 	if err != nil {
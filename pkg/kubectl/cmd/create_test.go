@@ -67,6 +67,37 @@ func TestCreateObject(t *testing.T) {
 	}
 }
 
+func TestCreateObjectDryRun(t *testing.T) {
+	_, _, rc := testData()
+	rc.Items[0].Name = "redis-master-controller"
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers" && m == "POST" && req.URL.Query().Get("dryRun") == "true":
+				return &http.Response{StatusCode: 201, Body: objBody(codec, &rc.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdCreate(f, buf)
+	cmd.Flags().Set("filename", "../../../examples/guestbook/redis-master-controller.yaml")
+	cmd.Flags().Set("dry-run", "true")
+	cmd.Run(cmd, []string{})
+
+	if buf.String() != "replicationcontrollers/redis-master-controller (dry run)\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
 func TestCreateMultipleObject(t *testing.T) {
 	_, svc, rc := testData()
 
@@ -72,6 +72,8 @@ func NewCmdDelete(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	}
 	usage := "Filename, directory, or URL to a file containing the resource to delete."
 	kubectl.AddJsonFilenameFlag(cmd, &filenames, usage)
+	cmdutil.AddRecursiveFlag(cmd)
+	cmdutil.AddDryRunFlag(cmd)
 	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on.")
 	cmd.Flags().Bool("all", false, "[-all] to select all the specified resources.")
 	cmd.Flags().Bool("ignore-not-found", false, "Treat \"resource not found\" as a successful delete.")
@@ -90,7 +92,7 @@ func RunDelete(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []str
 	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
 		ContinueOnError().
 		NamespaceParam(cmdNamespace).DefaultNamespace().
-		FilenameParam(filenames...).
+		FilenameParam(cmdutil.GetFlagBool(cmd, "recursive"), filenames...).
 		SelectorParam(cmdutil.GetFlagString(cmd, "selector")).
 		SelectAllParam(cmdutil.GetFlagBool(cmd, "all")).
 		ResourceTypeOrNameArgs(false, args...).RequireObject(false).
@@ -102,6 +104,11 @@ func RunDelete(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []str
 	}
 
 	ignoreNotFound := cmdutil.GetFlagBool(cmd, "ignore-not-found")
+	// A dry run only checks the deletion of the named resources, since reaping
+	// changes the resources they manage before deleting them.
+	if cmdutil.GetFlagBool(cmd, "dry-run") {
+		return deleteResultWith(r, out, ignoreNotFound, true)
+	}
 	// By default use a reaper to delete all related resources.
 	if cmdutil.GetFlagBool(cmd, "cascade") {
 		return ReapResult(r, f, out, cmdutil.GetFlagBool(cmd, "cascade"), ignoreNotFound, cmdutil.GetFlagDuration(cmd, "timeout"), cmdutil.GetFlagInt(cmd, "grace-period"))
@@ -120,7 +127,7 @@ func ReapResult(r *resource.Result, f *cmdutil.Factory, out io.Writer, isDefault
 		if err != nil {
 			// If there is no reaper for this resources and the user didn't explicitly ask for stop.
 			if kubectl.IsNoSuchReaperError(err) && isDefaultDelete {
-				return deleteResource(info, out)
+				return deleteResource(info, out, false)
 			}
 			return cmdutil.AddSourceToErr("reaping", info.Source, err)
 		}
@@ -144,13 +151,17 @@ func ReapResult(r *resource.Result, f *cmdutil.Factory, out io.Writer, isDefault
 }
 
 func DeleteResult(r *resource.Result, out io.Writer, ignoreNotFound bool) error {
+	return deleteResultWith(r, out, ignoreNotFound, false)
+}
+
+func deleteResultWith(r *resource.Result, out io.Writer, ignoreNotFound, dryRun bool) error {
 	found := 0
 	if ignoreNotFound {
 		r = r.IgnoreErrors(errors.IsNotFound)
 	}
 	err := r.Visit(func(info *resource.Info) error {
 		found++
-		return deleteResource(info, out)
+		return deleteResource(info, out, dryRun)
 	})
 	if err != nil {
 		return err
@@ -161,10 +172,12 @@ func DeleteResult(r *resource.Result, out io.Writer, ignoreNotFound bool) error
 	return nil
 }
 
-func deleteResource(info *resource.Info, out io.Writer) error {
-	if err := resource.NewHelper(info.Client, info.Mapping).Delete(info.Namespace, info.Name); err != nil {
+func deleteResource(info *resource.Info, out io.Writer, dryRun bool) error {
+	helper := resource.NewHelper(info.Client, info.Mapping)
+	helper.DryRun = dryRun
+	if err := helper.Delete(info.Namespace, info.Name); err != nil {
 		return cmdutil.AddSourceToErr("deleting", info.Source, err)
 	}
-	fmt.Fprintf(out, "%s/%s\n", info.Mapping.Resource, info.Name)
+	fmt.Fprintf(out, "%s/%s%s\n", info.Mapping.Resource, info.Name, dryRunSuffix(dryRun))
 	return nil
 }
@@ -0,0 +1,144 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+const (
+	diff_long = `Show the differences between the live state of resources and local configuration files.
+
+Each object in the files is compared with the object of the same name on the server. The status,
+the metadata maintained by the server and the fields it allocates, such as the cluster IP of a
+service, are ignored, and defaults are applied to the local configuration before comparing.
+A unified diff is printed for each object that differs.
+
+The exit status is 0 if there are no differences, and 1 if there are differences or an error occurred.`
+	diff_example = `// Show how the live replication controller differs from the one in rc.yaml.
+$ kubectl diff -f rc.yaml
+
+// Show the differences for all the configuration files in the directory 'manifests' and its subdirectories.
+$ kubectl diff -f manifests/ -R`
+)
+
+func NewCmdDiff(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	var filenames util.StringList
+	cmd := &cobra.Command{
+		Use:     "diff -f FILENAME",
+		Short:   "Show the differences between live resources and local configuration files",
+		Long:    diff_long,
+		Example: diff_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(ValidateArgs(cmd, args))
+			changed, err := RunDiff(f, out, cmd, filenames)
+			cmdutil.CheckErr(err)
+			if changed {
+				os.Exit(1)
+			}
+		},
+	}
+
+	usage := "Filename, directory, or URL to file that contains the configuration to compare"
+	kubectl.AddJsonFilenameFlag(cmd, &filenames, usage)
+	cmd.MarkFlagRequired("filename")
+	cmdutil.AddRecursiveFlag(cmd)
+	return cmd
+}
+
+// RunDiff prints the differences between the objects in filenames and their
+// live state, and returns whether there are any.
+func RunDiff(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, filenames util.StringList) (bool, error) {
+	schema, err := f.Validator()
+	if err != nil {
+		return false, err
+	}
+
+	cmdNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return false, err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		Schema(schema).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).RequireNamespace().
+		FilenameParam(cmdutil.GetFlagBool(cmd, "recursive"), filenames...).
+		Flatten().
+		Do()
+	err = r.Err()
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	err = r.Visit(func(info *resource.Info) error {
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		live, err := helper.Get(info.Namespace, info.Name)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return cmdutil.AddSourceToErr("retrieving current configuration of", info.Source, err)
+			}
+			live = nil
+		}
+		if err := kubectl.NormalizeForDiff(info.Object, live); err != nil {
+			return cmdutil.AddSourceToErr("comparing", info.Source, err)
+		}
+
+		liveData := []byte{}
+		if live != nil {
+			if liveData, err = encodeForDiff(info.Mapping.Codec, live); err != nil {
+				return cmdutil.AddSourceToErr("serializing current configuration of", info.Source, err)
+			}
+		}
+		localData, err := encodeForDiff(info.Mapping.Codec, info.Object)
+		if err != nil {
+			return cmdutil.AddSourceToErr("serializing", info.Source, err)
+		}
+
+		liveName := fmt.Sprintf("live/%s/%s", info.Mapping.Resource, info.Name)
+		if diff := util.UnifiedDiff(string(liveData), string(localData), liveName, info.Source); len(diff) > 0 {
+			changed = true
+			fmt.Fprint(out, diff)
+		}
+		return nil
+	})
+	return changed, err
+}
+
+// encodeForDiff serializes obj as YAML, which has one field per line and
+// sorted keys, so that line based diffs of two objects are readable.
+func encodeForDiff(codec runtime.Codec, obj runtime.Object) ([]byte, error) {
+	data, err := codec.Encode(obj)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(data)
+}
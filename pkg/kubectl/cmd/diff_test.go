@@ -0,0 +1,109 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func TestDiff(t *testing.T) {
+	tests := map[string]struct {
+		live     func(*api.ReplicationController) *api.ReplicationController
+		changed  bool
+		contains []string
+	}{
+		"unchanged": {
+			live: func(rc *api.ReplicationController) *api.ReplicationController {
+				rc.Namespace = "test"
+				rc.ResourceVersion = "10"
+				rc.UID = "uid"
+				rc.CreationTimestamp = util.Now()
+				rc.Annotations = map[string]string{kubectl.LastAppliedConfigAnnotation: "{}"}
+				rc.Status.Replicas = 1
+				return rc
+			},
+		},
+		"changed": {
+			live: func(rc *api.ReplicationController) *api.ReplicationController {
+				rc.Namespace = "test"
+				rc.ResourceVersion = "10"
+				rc.Spec.Replicas = 3
+				return rc
+			},
+			changed: true,
+			contains: []string{
+				"--- live/replicationcontrollers/redis-master\n+++ " + applyTestFile + "\n",
+				"\n-  replicas: 3\n+  replicas: 1\n",
+			},
+		},
+		"not found": {
+			changed: true,
+			contains: []string{
+				"@@ -0,0 +1,",
+				"\n+  replicas: 1\n",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		f, tf, codec := NewAPIFactory()
+		tf.Printer = &testPrinter{}
+		tf.Client = &client.FakeRESTClient{
+			Codec: codec,
+			Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+				switch p, m := req.URL.Path, req.Method; {
+				case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "GET":
+					if test.live == nil {
+						return &http.Response{StatusCode: 404, Body: stringBody("")}, nil
+					}
+					return &http.Response{StatusCode: 200, Body: objBody(codec, test.live(readApplyTestController(t, codec)))}, nil
+				default:
+					t.Fatalf("%s: unexpected request: %s %#v\n%#v", name, req.Method, req.URL, req)
+					return nil, nil
+				}
+			}),
+		}
+		tf.Namespace = "test"
+		buf := bytes.NewBuffer([]byte{})
+
+		cmd := NewCmdDiff(f, buf)
+		changed, err := RunDiff(f, buf, cmd, []string{applyTestFile})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if changed != test.changed {
+			t.Errorf("%s: expected changed to be %t, got %t:\n%s", name, test.changed, changed, buf.String())
+		}
+		if !test.changed && buf.Len() != 0 {
+			t.Errorf("%s: unexpected output: %s", name, buf.String())
+		}
+		for _, s := range test.contains {
+			if !strings.Contains(buf.String(), s) {
+				t.Errorf("%s: expected output to contain %q, got:\n%s", name, s, buf.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/wait"
+
+	"github.com/spf13/cobra"
+)
+
+// configMirrorAnnotationKey marks mirror pods, which the kubelet creates in the
+// API server for pods defined by static manifests on the node. It must match
+// kubelet.ConfigMirrorAnnotationKey.
+const configMirrorAnnotationKey = "kubernetes.io/config.mirror"
+
+const (
+	cordon_long = `Mark node as unschedulable.
+
+New pods will not be scheduled onto the node, but pods already running there
+are left alone.`
+	cordon_example = `// Mark node "foo" as unschedulable.
+$ kubectl cordon foo`
+
+	uncordon_long = `Mark node as schedulable.`
+
+	uncordon_example = `// Mark node "foo" as schedulable.
+$ kubectl uncordon foo`
+
+	drain_long = `Drain node in preparation for maintenance.
+
+The given node will be marked unschedulable to prevent new pods from arriving.
+Then drain deletes all pods bound to the node, giving each one a grace period
+to terminate, and waits for them to go away. Mirror pods, which are created
+from static manifests on the node, cannot be deleted through the API server and
+are skipped.
+
+If there are pods on the node that are not managed by a replication controller,
+drain will not delete any pods unless you use --force, since nothing will
+recreate those pods elsewhere.
+
+When you are ready to put the node back into service, use kubectl uncordon,
+which will make the node schedulable again.`
+	drain_example = `// Drain node "foo", even if there are pods not managed by a replication controller on it.
+$ kubectl drain foo --force
+
+// As above, but give each pod 15 minutes to terminate.
+$ kubectl drain foo --force --grace-period=900`
+)
+
+// NewCmdCordon returns a command that marks a node unschedulable.
+func NewCmdCordon(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cordon NODE",
+		Short:   "Mark node as unschedulable",
+		Long:    cordon_long,
+		Example: cordon_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunCordon(f, out, cmd, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	return cmd
+}
+
+// RunCordon marks the node named in args unschedulable.
+func RunCordon(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return cmdutil.UsageError(cmd, "NODE is required")
+	}
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+	return setNodeSchedulable(c, out, args[0], false)
+}
+
+// NewCmdUncordon returns a command that marks a node schedulable.
+func NewCmdUncordon(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "uncordon NODE",
+		Short:   "Mark node as schedulable",
+		Long:    uncordon_long,
+		Example: uncordon_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunUncordon(f, out, cmd, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	return cmd
+}
+
+// RunUncordon marks the node named in args schedulable.
+func RunUncordon(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return cmdutil.UsageError(cmd, "NODE is required")
+	}
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+	return setNodeSchedulable(c, out, args[0], true)
+}
+
+// NewCmdDrain returns a command that cordons a node and deletes its pods.
+func NewCmdDrain(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "drain NODE [--force] [--grace-period=seconds]",
+		Short:   "Drain node in preparation for maintenance",
+		Long:    drain_long,
+		Example: drain_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunDrain(f, out, cmd, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	cmd.Flags().Bool("force", false, "Continue even if there are pods not managed by a replication controller.")
+	cmd.Flags().Int("grace-period", -1, "Period of time in seconds given to each pod to terminate gracefully. If negative, the default value specified in the pod will be used.")
+	cmd.Flags().Duration("timeout", 0, "The length of time to wait for the pods to be deleted, zero means wait forever.")
+	return cmd
+}
+
+// RunDrain cordons the node named in args and deletes the pods bound to it.
+func RunDrain(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return cmdutil.UsageError(cmd, "NODE is required")
+	}
+	nodeName := args[0]
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	// find the pods first, so nothing is changed if drain refuses to proceed
+	pods, unmanaged, err := podsForDeletion(c, nodeName)
+	if err != nil {
+		return err
+	}
+	if len(unmanaged) != 0 {
+		if !cmdutil.GetFlagBool(cmd, "force") {
+			return fmt.Errorf("refusing to continue because the following pods are not managed by a replication controller: %s (use --force to override)", strings.Join(unmanaged, ", "))
+		}
+		fmt.Fprintf(out, "WARNING: deleting pods not managed by a replication controller: %s\n", strings.Join(unmanaged, ", "))
+	}
+
+	if err := setNodeSchedulable(c, out, nodeName, false); err != nil {
+		return err
+	}
+
+	var options *api.DeleteOptions
+	if gracePeriod := cmdutil.GetFlagInt(cmd, "grace-period"); gracePeriod >= 0 {
+		options = api.NewDeleteOptions(int64(gracePeriod))
+	}
+	for _, pod := range pods {
+		if err := c.Pods(pod.Namespace).Delete(pod.Name, options); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if err := waitForPodsDeleted(c, pods, cmdutil.GetFlagDuration(cmd, "timeout")); err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		fmt.Fprintf(out, "pods/%s deleted\n", pod.Name)
+	}
+	fmt.Fprintf(out, "nodes/%s drained\n", nodeName)
+	return nil
+}
+
+// setNodeSchedulable sets the node's Unschedulable field, doing nothing if it
+// is already set as requested.
+func setNodeSchedulable(c client.Interface, out io.Writer, nodeName string, schedulable bool) error {
+	verb := "cordoned"
+	if schedulable {
+		verb = "uncordoned"
+	}
+	node, err := c.Nodes().Get(nodeName)
+	if err != nil {
+		return err
+	}
+	if node.Spec.Unschedulable == !schedulable {
+		fmt.Fprintf(out, "nodes/%s already %s\n", nodeName, verb)
+		return nil
+	}
+	node.Spec.Unschedulable = !schedulable
+	if _, err := c.Nodes().Update(node); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "nodes/%s %s\n", nodeName, verb)
+	return nil
+}
+
+// podsForDeletion returns the pods bound to the node that drain should delete,
+// and the names of those that are not managed by a replication controller.
+// Mirror pods are left out entirely.
+func podsForDeletion(c client.Interface, nodeName string) ([]api.Pod, []string, error) {
+	list, err := c.Pods(api.NamespaceAll).List(labels.Everything(), fields.OneTermEqualSelector(client.PodHost, nodeName))
+	if err != nil {
+		return nil, nil, err
+	}
+	pods := []api.Pod{}
+	unmanaged := []string{}
+	for _, pod := range list.Items {
+		// the field selector may be ignored by older servers
+		if pod.Spec.NodeName != nodeName {
+			continue
+		}
+		if _, found := pod.Annotations[configMirrorAnnotationKey]; found {
+			continue
+		}
+		managed, err := hasReplicationController(c, &pod)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !managed {
+			unmanaged = append(unmanaged, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+		}
+		pods = append(pods, pod)
+	}
+	return pods, unmanaged, nil
+}
+
+// hasReplicationController returns true if the pod was created by a
+// replication controller that still exists.
+func hasReplicationController(c client.Interface, pod *api.Pod) (bool, error) {
+	creatorRef, found := pod.Annotations[controller.CreatedByAnnotation]
+	if !found {
+		return false, nil
+	}
+	obj, err := latest.Codec.Decode([]byte(creatorRef))
+	if err != nil {
+		return false, fmt.Errorf("unable to decode the %s annotation of pod %s/%s: %v", controller.CreatedByAnnotation, pod.Namespace, pod.Name, err)
+	}
+	ref, ok := obj.(*api.SerializedReference)
+	if !ok || ref.Reference.Kind != "ReplicationController" {
+		return false, nil
+	}
+	namespace := ref.Reference.Namespace
+	if len(namespace) == 0 {
+		namespace = pod.Namespace
+	}
+	if _, err := c.ReplicationControllers(namespace).Get(ref.Reference.Name); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// waitForPodsDeleted waits until none of the pods exist, counting a pod that
+// has been replaced by a new one with the same name as deleted. A zero
+// timeout waits forever.
+func waitForPodsDeleted(c client.Interface, pods []api.Pod, timeout time.Duration) error {
+	remaining := pods
+	cond := func() (bool, error) {
+		pending := []api.Pod{}
+		for _, pod := range remaining {
+			current, err := c.Pods(pod.Namespace).Get(pod.Name)
+			if errors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			if current.UID == pod.UID {
+				pending = append(pending, pod)
+			}
+		}
+		remaining = pending
+		return len(remaining) == 0, nil
+	}
+	if done, err := cond(); done || err != nil {
+		return err
+	}
+	if err := wait.Poll(kubectl.Interval, timeout, cond); err != nil {
+		if err == wait.ErrWaitTimeout {
+			names := []string{}
+			for _, pod := range remaining {
+				names = append(names, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+			}
+			return fmt.Errorf("timed out waiting for pods to be deleted: %s", strings.Join(names, ", "))
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/controller"
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+// drainServer fakes the API calls made by cordon, uncordon and drain.
+type drainServer struct {
+	t       *testing.T
+	codec   runtime.Codec
+	node    *api.Node
+	pods    []api.Pod
+	rcs     []api.ReplicationController
+	updated *api.Node
+	deleted []string
+	options []*api.DeleteOptions
+}
+
+func (s *drainServer) handle(req *http.Request) (*http.Response, error) {
+	prefix := "/api/" + testapi.Version()
+	nodePath := prefix + "/nodes/" + s.node.Name
+	podPrefix := prefix + "/namespaces/default/pods/"
+	rcPrefix := prefix + "/namespaces/default/replicationcontrollers/"
+	switch p, m := req.URL.Path, req.Method; {
+	case p == nodePath && m == "GET":
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, s.node)}, nil
+	case p == nodePath && m == "PUT":
+		s.updated = s.decode(req).(*api.Node)
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, s.updated)}, nil
+	case p == prefix+"/pods" && m == "GET":
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, &api.PodList{Items: s.pods})}, nil
+	case strings.HasPrefix(p, rcPrefix) && m == "GET":
+		for i := range s.rcs {
+			if s.rcs[i].Name == strings.TrimPrefix(p, rcPrefix) {
+				return &http.Response{StatusCode: 200, Body: objBody(s.codec, &s.rcs[i])}, nil
+			}
+		}
+		return &http.Response{StatusCode: 404, Body: stringBody("")}, nil
+	case strings.HasPrefix(p, podPrefix) && m == "DELETE":
+		s.deleted = append(s.deleted, strings.TrimPrefix(p, podPrefix))
+		var options *api.DeleteOptions
+		if obj := s.decode(req); obj != nil {
+			options = obj.(*api.DeleteOptions)
+		}
+		s.options = append(s.options, options)
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, &api.Status{Status: api.StatusSuccess})}, nil
+	case strings.HasPrefix(p, podPrefix) && m == "GET":
+		name := strings.TrimPrefix(p, podPrefix)
+		for _, deleted := range s.deleted {
+			if deleted == name {
+				return &http.Response{StatusCode: 404, Body: stringBody("")}, nil
+			}
+		}
+		for i := range s.pods {
+			if s.pods[i].Name == name {
+				return &http.Response{StatusCode: 200, Body: objBody(s.codec, &s.pods[i])}, nil
+			}
+		}
+		return &http.Response{StatusCode: 404, Body: stringBody("")}, nil
+	default:
+		s.t.Fatalf("unexpected request: %s %#v", m, req.URL)
+		return nil, nil
+	}
+}
+
+// decode returns the object in the request body, or nil if there is none.
+func (s *drainServer) decode(req *http.Request) runtime.Object {
+	if req.Body == nil {
+		return nil
+	}
+	data, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	obj, err := s.codec.Decode(data)
+	if err != nil {
+		s.t.Fatalf("unexpected error: %v", err)
+	}
+	return obj
+}
+
+func newDrainFactory(s *drainServer) *cmdutil.Factory {
+	f, tf, codec := NewAPIFactory()
+	s.codec = codec
+	tf.Client = &client.FakeRESTClient{
+		Codec:  codec,
+		Client: client.HTTPClientFunc(s.handle),
+	}
+	tf.Namespace = "default"
+	tf.ClientConfig = &client.Config{Version: testapi.Version()}
+	return f
+}
+
+func drainTestNode(unschedulable bool) *api.Node {
+	return &api.Node{
+		ObjectMeta: api.ObjectMeta{Name: "node", ResourceVersion: "10"},
+		Spec:       api.NodeSpec{Unschedulable: unschedulable},
+	}
+}
+
+func drainTestPod(t *testing.T, name, rc string, annotations map[string]string) api.Pod {
+	pod := api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name), Annotations: annotations},
+		Spec:       api.PodSpec{NodeName: "node", RestartPolicy: api.RestartPolicyAlways, DNSPolicy: api.DNSClusterFirst},
+	}
+	if len(rc) != 0 {
+		data, err := latest.Codec.Encode(&api.SerializedReference{
+			Reference: api.ObjectReference{Kind: "ReplicationController", Namespace: "default", Name: rc},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[controller.CreatedByAnnotation] = string(data)
+	}
+	return pod
+}
+
+func TestCordon(t *testing.T) {
+	tests := []struct {
+		name          string
+		unschedulable bool
+		uncordon      bool
+		expectUpdate  bool
+		expectOut     string
+	}{
+		{name: "cordon", expectUpdate: true, expectOut: "nodes/node cordoned\n"},
+		{name: "cordon cordoned", unschedulable: true, expectOut: "nodes/node already cordoned\n"},
+		{name: "uncordon", unschedulable: true, uncordon: true, expectUpdate: true, expectOut: "nodes/node uncordoned\n"},
+		{name: "uncordon uncordoned", uncordon: true, expectOut: "nodes/node already uncordoned\n"},
+	}
+	for _, test := range tests {
+		server := &drainServer{t: t, node: drainTestNode(test.unschedulable)}
+		f := newDrainFactory(server)
+		buf := bytes.NewBuffer([]byte{})
+		cmd := NewCmdCordon(f, buf)
+		run := RunCordon
+		if test.uncordon {
+			cmd = NewCmdUncordon(f, buf)
+			run = RunUncordon
+		}
+		if err := run(f, buf, cmd, []string{"node"}); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if test.expectUpdate != (server.updated != nil) {
+			t.Errorf("%s: unexpected update: %#v", test.name, server.updated)
+		}
+		if server.updated != nil && server.updated.Spec.Unschedulable != !test.uncordon {
+			t.Errorf("%s: unexpected node: %#v", test.name, server.updated)
+		}
+		if buf.String() != test.expectOut {
+			t.Errorf("%s: unexpected output: %q", test.name, buf.String())
+		}
+	}
+}
+
+func TestCordonRequiresNode(t *testing.T) {
+	server := &drainServer{t: t, node: drainTestNode(false)}
+	f := newDrainFactory(server)
+	buf := bytes.NewBuffer([]byte{})
+	if err := RunCordon(f, buf, NewCmdCordon(f, buf), []string{}); err == nil {
+		t.Errorf("unexpected non-error")
+	}
+}
+
+func TestDrain(t *testing.T) {
+	rc := api.ReplicationController{ObjectMeta: api.ObjectMeta{Name: "rc", Namespace: "default"}}
+	managed := drainTestPod(t, "managed", "rc", nil)
+	orphaned := drainTestPod(t, "orphaned", "gone", nil)
+	unmanaged := drainTestPod(t, "unmanaged", "", nil)
+	mirror := drainTestPod(t, "mirror", "", map[string]string{configMirrorAnnotationKey: "mirror"})
+	elsewhere := drainTestPod(t, "elsewhere", "rc", nil)
+	elsewhere.Spec.NodeName = "other"
+
+	tests := []struct {
+		name          string
+		pods          []api.Pod
+		flags         map[string]string
+		expectErr     string
+		expectDeleted []string
+		expectGrace   *int64
+	}{
+		{
+			name:          "managed pods",
+			pods:          []api.Pod{managed, mirror, elsewhere},
+			expectDeleted: []string{"managed"},
+		},
+		{
+			name:      "unmanaged pods",
+			pods:      []api.Pod{managed, unmanaged},
+			expectErr: "default/unmanaged",
+		},
+		{
+			name:      "pods of deleted controllers",
+			pods:      []api.Pod{orphaned},
+			expectErr: "default/orphaned",
+		},
+		{
+			name:          "forced",
+			pods:          []api.Pod{managed, unmanaged, orphaned, mirror},
+			flags:         map[string]string{"force": "true", "grace-period": "30"},
+			expectDeleted: []string{"managed", "unmanaged", "orphaned"},
+			expectGrace:   api.NewDeleteOptions(30).GracePeriodSeconds,
+		},
+	}
+	for _, test := range tests {
+		server := &drainServer{t: t, node: drainTestNode(false), pods: test.pods, rcs: []api.ReplicationController{rc}}
+		f := newDrainFactory(server)
+		buf := bytes.NewBuffer([]byte{})
+		cmd := NewCmdDrain(f, buf)
+		for name, value := range test.flags {
+			cmd.Flags().Set(name, value)
+		}
+		err := RunDrain(f, buf, cmd, []string{"node"})
+		if len(test.expectErr) != 0 {
+			if err == nil || !strings.Contains(err.Error(), test.expectErr) {
+				t.Errorf("%s: expected error containing %q, got %v", test.name, test.expectErr, err)
+			}
+			if server.updated != nil || len(server.deleted) != 0 {
+				t.Errorf("%s: expected no changes, got %#v %v", test.name, server.updated, server.deleted)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if server.updated == nil || !server.updated.Spec.Unschedulable {
+			t.Errorf("%s: expected node to be cordoned: %#v", test.name, server.updated)
+		}
+		if !reflect.DeepEqual(test.expectDeleted, server.deleted) {
+			t.Errorf("%s: expected deleted %v, got %v", test.name, test.expectDeleted, server.deleted)
+		}
+		for _, options := range server.options {
+			if test.expectGrace == nil {
+				if options != nil && options.GracePeriodSeconds != nil {
+					t.Errorf("%s: unexpected delete options: %#v", test.name, options)
+				}
+				continue
+			}
+			if options == nil || options.GracePeriodSeconds == nil || *options.GracePeriodSeconds != *test.expectGrace {
+				t.Errorf("%s: unexpected delete options: %#v", test.name, options)
+			}
+		}
+		if !strings.HasSuffix(buf.String(), "nodes/node drained\n") {
+			t.Errorf("%s: unexpected output: %q", test.name, buf.String())
+		}
+	}
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util/editor"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/strategicpatch"
+)
+
+const (
+	edit_long = `Edit a resource from the default editor.
+
+The edit command allows you to directly edit any API resource you can retrieve via the
+command line tools. It will open the editor defined by your KUBE_EDITOR or EDITOR
+environment variables, or fall back to 'vi'. You can edit multiple objects at once, in
+which case they are presented as a list. When you save and exit, the changes you made
+to each object are sent to the server as a strategic merge patch, so fields changed by
+others in the meantime are kept.
+
+The objects to edit will be output in the default API version, or a version specified
+by --output-version. The default format is YAML - if you would like to edit in JSON
+pass -o json.
+
+If an object fails validation or conflicts with a concurrent change, the editor is
+reopened with your changes and the errors shown at the top of the file. Saving an
+empty file or exiting without changes aborts the edit.`
+	edit_example = `  // Edit the service named 'docker-registry':
+  $ kubectl edit svc/docker-registry
+
+  // Edit all the replication controllers and services labeled app=nginx:
+  $ kubectl edit rc,svc -l app=nginx
+
+  // Use an alternative editor
+  $ KUBE_EDITOR="nano" kubectl edit svc/docker-registry
+
+  // Edit the service 'docker-registry' in JSON using the v1 API format:
+  $ kubectl edit svc/docker-registry --output-version=v1 -o json`
+)
+
+func NewCmdEdit(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	var filenames util.StringList
+	cmd := &cobra.Command{
+		Use:     "edit (RESOURCE/NAME | -f FILENAME)",
+		Short:   "Edit a resource on the server",
+		Long:    edit_long,
+		Example: edit_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunEdit(f, out, cmd, args, filenames)
+			cmdutil.CheckErr(err)
+		},
+	}
+	usage := "Filename, directory, or URL to file to use to edit the resource"
+	kubectl.AddJsonFilenameFlag(cmd, &filenames, usage)
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
+	cmd.Flags().StringP("output", "o", "yaml", "Output format. One of: yaml|json.")
+	cmd.Flags().String("output-version", "", "Output the formatted object with the given version (default api-version).")
+	return cmd
+}
+
+func RunEdit(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string, filenames util.StringList) error {
+	var printer kubectl.ResourcePrinter
+	var ext string
+	switch format := cmdutil.GetFlagString(cmd, "output"); format {
+	case "json":
+		printer = &kubectl.JSONPrinter{}
+		ext = ".json"
+	case "yaml":
+		printer = &kubectl.YAMLPrinter{}
+		ext = ".yaml"
+	default:
+		return cmdutil.UsageError(cmd, "The flag 'output' must be one of yaml|json")
+	}
+
+	cmdNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(false, filenames...).
+		SelectorParam(cmdutil.GetFlagString(cmd, "selector")).
+		ResourceTypeOrNameArgs(true, args...).
+		Latest().
+		Flatten().
+		Do()
+	infos, err := r.Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) == 0 {
+		return fmt.Errorf("no objects passed to edit")
+	}
+
+	clientConfig, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	version := cmdutil.OutputVersion(cmd, clientConfig.Version)
+	obj, err := resource.AsVersionedObject(infos, false, version)
+	if err != nil {
+		return err
+	}
+
+	// The patches are computed against the objects as they were retrieved,
+	// so that only the changes made in the editor are sent.
+	originals := map[string][]byte{}
+	for _, info := range infos {
+		data, err := info.Mapping.Codec.Encode(info.Object)
+		if err != nil {
+			return err
+		}
+		originals[editKey(info)] = data
+	}
+
+	buf := &bytes.Buffer{}
+	if err := printer.PrintObj(obj, buf); err != nil {
+		return err
+	}
+	content := buf.Bytes()
+
+	edit := editor.NewDefaultEditor("KUBE_EDITOR")
+	failures := []string{}
+	for {
+		buf := &bytes.Buffer{}
+		writeEditHeader(buf, failures)
+		buf.Write(content)
+
+		edited, file, err := edit.LaunchTempFile("kubectl-edit-", ext, buf)
+		if err != nil {
+			if len(file) > 0 {
+				os.Remove(file)
+			}
+			return err
+		}
+
+		edited = stripEditComments(edited)
+		if len(bytes.TrimSpace(edited)) == 0 {
+			os.Remove(file)
+			fmt.Fprintln(out, "Edit cancelled, saved file was empty.")
+			return nil
+		}
+		if bytes.Equal(edited, content) {
+			os.Remove(file)
+			if len(failures) > 0 {
+				return fmt.Errorf("edit cancelled, the changes that failed were not applied")
+			}
+			fmt.Fprintln(out, "Edit cancelled, no changes made.")
+			return nil
+		}
+		content = edited
+
+		updates, err := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+			NamespaceParam(cmdNamespace).DefaultNamespace().
+			Stream(bytes.NewReader(edited), file).
+			Flatten().
+			Do().
+			Infos()
+		if err != nil {
+			os.Remove(file)
+			failures = []string{fmt.Sprintf("The edited file had a syntax error: %v", err)}
+			continue
+		}
+
+		failures = []string{}
+		for _, info := range updates {
+			key := editKey(info)
+			original, ok := originals[key]
+			if !ok {
+				failures = append(failures, fmt.Sprintf("%s %q can't be added while editing, only the retrieved objects can be changed", info.Mapping.Resource, info.Name))
+				continue
+			}
+			modified, err := info.Mapping.Codec.Encode(info.Object)
+			if err != nil {
+				return preserveEditedFile(out, file, err)
+			}
+			versionedObject, err := api.Scheme.New(info.Mapping.APIVersion, info.Mapping.Kind)
+			if err != nil {
+				return preserveEditedFile(out, file, err)
+			}
+			patch, err := strategicpatch.CreateTwoWayMergePatch(original, modified, versionedObject)
+			if err != nil {
+				return preserveEditedFile(out, file, err)
+			}
+			if string(patch) == "{}" {
+				continue
+			}
+
+			_, err = resource.NewHelper(info.Client, info.Mapping).Patch(info.Namespace, info.Name, api.StrategicMergePatchType, patch)
+			if err != nil {
+				if errors.IsInvalid(err) || errors.IsConflict(err) {
+					failures = append(failures, editFailure(info, err)...)
+					continue
+				}
+				return preserveEditedFile(out, file, err)
+			}
+			// The object now matches the edited file, so later passes don't
+			// send the same changes again.
+			originals[key] = modified
+			fmt.Fprintf(out, "%s/%s\n", info.Mapping.Resource, info.Name)
+		}
+		os.Remove(file)
+
+		if len(failures) == 0 {
+			return nil
+		}
+	}
+}
+
+// editKey identifies an object across the retrieved and the edited set.
+func editKey(info *resource.Info) string {
+	return fmt.Sprintf("%s/%s/%s", info.Mapping.Kind, info.Namespace, info.Name)
+}
+
+// writeEditHeader writes the instructions and any failures of the previous
+// edit as comments at the top of the file being edited.
+func writeEditHeader(w io.Writer, failures []string) {
+	fmt.Fprint(w, `# Please edit the object below. Lines beginning with a '#' will be ignored,
+# and an empty file will abort the edit. If an error occurs while saving this file will be
+# reopened with the relevant failures.
+#
+`)
+	for _, failure := range failures {
+		for _, line := range strings.Split(failure, "\n") {
+			fmt.Fprintf(w, "# %s\n", line)
+		}
+	}
+	if len(failures) > 0 {
+		fmt.Fprintln(w, "#")
+	}
+}
+
+// editFailure describes an error returned by the server for an edited object.
+func editFailure(info *resource.Info, err error) []string {
+	var lines []string
+	if errors.IsConflict(err) {
+		lines = append(lines, fmt.Sprintf("%s %q could not be updated:", info.Mapping.Resource, info.Name))
+	} else {
+		lines = append(lines, fmt.Sprintf("%s %q was not valid:", info.Mapping.Resource, info.Name))
+	}
+	if statusErr, ok := err.(*errors.StatusError); ok {
+		if details := statusErr.Status().Details; details != nil && len(details.Causes) > 0 {
+			for _, cause := range details.Causes {
+				lines = append(lines, fmt.Sprintf("* %s: %s", cause.Field, cause.Message))
+			}
+			return lines
+		}
+	}
+	return append(lines, fmt.Sprintf("* %v", err))
+}
+
+// stripEditComments removes the lines starting with '#' from an edited file.
+func stripEditComments(data []byte) []byte {
+	lines := [][]byte{}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if bytes.HasPrefix(bytes.TrimSpace(line), []byte("#")) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return bytes.Join(lines, []byte("\n"))
+}
+
+// preserveEditedFile tells the user where the changes that could not be
+// applied were saved, and returns err.
+func preserveEditedFile(out io.Writer, file string, err error) error {
+	fmt.Fprintf(out, "A copy of your changes has been stored to %q\n", file)
+	return err
+}
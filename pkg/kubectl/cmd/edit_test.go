@@ -0,0 +1,223 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// setTestEditor makes the edit command run a shell script on the file being
+// edited, which also keeps a copy of every version of the file it is given in
+// the returned log. The returned function restores the environment.
+func setTestEditor(t *testing.T, script string) (string, func()) {
+	dir, err := ioutil.TempDir("", "edit-test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	log := filepath.Join(dir, "log")
+	old := os.Getenv("KUBE_EDITOR")
+	os.Setenv("KUBE_EDITOR", fmt.Sprintf(`sh -c 'cat "$1" >> %s; %s' --`, log, script))
+	return log, func() {
+		os.Setenv("KUBE_EDITOR", old)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestEditObject(t *testing.T) {
+	pods, _, _ := testData()
+	_, restore := setTestEditor(t, `sed -i -e "s/restartPolicy: Always/restartPolicy: Never/" "$1"`)
+	defer restore()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/pods/foo" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+			case p == "/namespaces/test/pods/foo" && m == "PATCH":
+				var patch map[string]interface{}
+				if err := json.Unmarshal(readBodyOrDie(t, req), &patch); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				expected := map[string]interface{}{"spec": map[string]interface{}{"restartPolicy": "Never"}}
+				if !reflect.DeepEqual(expected, patch) {
+					t.Errorf("expected patch %#v, got %#v", expected, patch)
+				}
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: "v1"}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdEdit(f, buf)
+	if err := RunEdit(f, buf, cmd, []string{"pods", "foo"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "pods/foo\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestEditNoChanges(t *testing.T) {
+	pods, _, _ := testData()
+	_, restore := setTestEditor(t, "true")
+	defer restore()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/pods/foo" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: "v1"}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdEdit(f, buf)
+	if err := RunEdit(f, buf, cmd, []string{"pods", "foo"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "Edit cancelled, no changes made.\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestEditReopensOnInvalid(t *testing.T) {
+	pods, _, _ := testData()
+	log, restore := setTestEditor(t, `sed -i -e "s/restartPolicy: Always/restartPolicy: Never/" "$1"`)
+	defer restore()
+
+	patches := 0
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/pods/foo" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+			case p == "/namespaces/test/pods/foo" && m == "PATCH":
+				patches++
+				status := errors.NewInvalid("Pod", "foo", fielderrors.ValidationErrorList{fielderrors.NewFieldInvalid("spec.restartPolicy", "Never", "not allowed")}).(*errors.StatusError).Status()
+				return &http.Response{StatusCode: 422, Body: objBody(codec, &status)}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: "v1"}
+	buf := bytes.NewBuffer([]byte{})
+
+	// The editor makes no further changes when it is reopened, which aborts
+	// the edit.
+	cmd := NewCmdEdit(f, buf)
+	if err := RunEdit(f, buf, cmd, []string{"pods", "foo"}, nil); err == nil {
+		t.Errorf("expected an error")
+	}
+	if patches != 1 {
+		t.Errorf("expected one patch, got %d", patches)
+	}
+
+	data, err := ioutil.ReadFile(log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The second time the editor is opened it shows the failure and the
+	// previous changes.
+	edits := strings.SplitAfterN(string(data), "# Please edit the object below.", 3)
+	if len(edits) != 3 {
+		t.Fatalf("expected the editor to be opened twice, got:\n%s", string(data))
+	}
+	reopened := edits[2]
+	if !strings.Contains(reopened, `# pods "foo" was not valid:`) || !strings.Contains(reopened, "# * spec.restartPolicy: ") {
+		t.Errorf("expected the failure to be shown, got:\n%s", reopened)
+	}
+	if !strings.Contains(reopened, "restartPolicy: Never") {
+		t.Errorf("expected the previous changes to be kept, got:\n%s", reopened)
+	}
+}
+
+func TestEditMultipleObjects(t *testing.T) {
+	pods, _, _ := testData()
+	_, restore := setTestEditor(t, `sed -i -e "s/restartPolicy: Always/restartPolicy: Never/" "$1"`)
+	defer restore()
+
+	patched := []string{}
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/pods/foo" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+			case p == "/namespaces/test/pods/bar" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[1])}, nil
+			case strings.HasPrefix(p, "/namespaces/test/pods/") && m == "PATCH":
+				patched = append(patched, p)
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: "v1"}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdEdit(f, buf)
+	if err := RunEdit(f, buf, cmd, []string{"pods", "foo", "bar"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := []string{"/namespaces/test/pods/foo", "/namespaces/test/pods/bar"}, patched; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected patches to %v, got %v", e, a)
+	}
+	if buf.String() != "pods/foo\npods/bar\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
@@ -102,6 +102,38 @@ func extractPodAndContainer(cmd *cobra.Command, argsIn []string, p *execParams)
 	return podName, p.containerName, args, nil
 }
 
+// setupRawTerminal switches the terminal behind in to raw mode and returns a
+// function that restores its previous state. It returns false if in is not a
+// file and so cannot be used as a TTY.
+func setupRawTerminal(in io.Reader) (func(), bool) {
+	file, ok := in.(*os.File)
+	if !ok {
+		return nil, false
+	}
+	inFd := file.Fd()
+	if !term.IsTerminal(inFd) {
+		glog.Warning("Stdin is not a terminal")
+		return func() {}, true
+	}
+	oldState, err := term.SetRawTerminal(inFd)
+	if err != nil {
+		glog.Fatal(err)
+	}
+
+	// SIGINT is handled by term.SetRawTerminal (it runs a goroutine that listens
+	// for SIGINT and restores the terminal before exiting)
+
+	// this handles SIGTERM
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		term.RestoreTerminal(inFd, oldState)
+		os.Exit(0)
+	}()
+	return func() { term.RestoreTerminal(inFd, oldState) }, true
+}
+
 func RunExec(f *cmdutil.Factory, cmd *cobra.Command, cmdIn io.Reader, cmdOut, cmdErr io.Writer, p *execParams, argsIn []string, re remoteExecutor) error {
 	podName, containerName, args, err := extractPodAndContainer(cmd, argsIn, p)
 	namespace, err := f.DefaultNamespace()
@@ -133,30 +165,10 @@ func RunExec(f *cmdutil.Factory, cmd *cobra.Command, cmdIn io.Reader, cmdOut, cm
 	if p.stdin {
 		stdin = cmdIn
 		if tty {
-			if file, ok := cmdIn.(*os.File); ok {
-				inFd := file.Fd()
-				if term.IsTerminal(inFd) {
-					oldState, err := term.SetRawTerminal(inFd)
-					if err != nil {
-						glog.Fatal(err)
-					}
-					// this handles a clean exit, where the command finished
-					defer term.RestoreTerminal(inFd, oldState)
-
-					// SIGINT is handled by term.SetRawTerminal (it runs a goroutine that listens
-					// for SIGINT and restores the terminal before exiting)
-
-					// this handles SIGTERM
-					sigChan := make(chan os.Signal, 1)
-					signal.Notify(sigChan, syscall.SIGTERM)
-					go func() {
-						<-sigChan
-						term.RestoreTerminal(inFd, oldState)
-						os.Exit(0)
-					}()
-				} else {
-					glog.Warning("Stdin is not a terminal")
-				}
+			restore, ok := setupRawTerminal(cmdIn)
+			if ok {
+				// this handles a clean exit, where the command finished
+				defer restore()
 			} else {
 				tty = false
 				glog.Warning("Unable to use a TTY")
@@ -39,7 +39,9 @@ nodes (no), persistent volumes (pv), persistent volume claims (pvc)
 or resource quotas (quota).
 
 By specifying the output as 'template' and providing a Go template as the value
-of the --template flag, you can filter the attributes of the fetched resource(s).`
+of the --template flag, you can filter the attributes of the fetched resource(s).
+The 'jsonpath' output format selects fields with a JSONPath template instead, and
+'custom-columns' prints the given fields as columns of a table.`
 	get_example = `// List all pods in ps output format.
 $ kubectl get pods
 
@@ -49,9 +51,18 @@ $ kubectl get replicationcontroller web
 // List a single pod in JSON output format.
 $ kubectl get -o json pod web-pod-13je7
 
+// List the resources described by the files in the directory 'manifests' and its subdirectories.
+$ kubectl get -f manifests/ -R
+
 // Return only the phase value of the specified pod.
 $ kubectl get -o template web-pod-13je7 --template={{.status.phase}} --api-version=v1
 
+// Return the name and phase of each pod, one per line, using a JSONPath template.
+$ kubectl get pods -o jsonpath='{range .items[*]}{.metadata.name} {.status.phase}{"\n"}{end}'
+
+// List pods with their names and node in columns, sorted by name.
+$ kubectl get pods -o custom-columns=NAME:.metadata.name,NODE:.spec.nodeName --sort-by=.metadata.name
+
 // List all replication controllers and services together in ps output format.
 $ kubectl get rc,services
 
@@ -66,7 +77,7 @@ func NewCmdGet(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	validArgs := p.HandledResources()
 
 	cmd := &cobra.Command{
-		Use:     "get [(-o|--output=)json|yaml|template|...] (RESOURCE [NAME] | RESOURCE/NAME ...)",
+		Use:     "get [(-o|--output=)json|yaml|template|...] (-f FILENAME | RESOURCE [NAME] | RESOURCE/NAME ...)",
 		Short:   "Display one or many resources",
 		Long:    get_long,
 		Example: get_example,
@@ -81,6 +92,10 @@ func NewCmdGet(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().BoolP("watch", "w", false, "After listing/getting the requested object, watch for changes.")
 	cmd.Flags().Bool("watch-only", false, "Watch for changes to the requested object(s), without listing/getting first.")
 	cmd.Flags().Bool("all-namespaces", false, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().String("sort-by", "", "If non-empty, sort list types using this field specification.  The field specification is expressed as a JSONPath expression (e.g. 'metadata.name'). The field in the API resource specified by this JSONPath expression must be an integer or a string.")
+	usage := "Filename, directory, or URL to a file identifying the resource to get from a server."
+	kubectl.AddJsonFilenameFlag(cmd, &util.StringList{}, usage)
+	cmdutil.AddRecursiveFlag(cmd)
 	kubectl.AddLabelsToColumnsFlag(cmd, &util.StringList{}, "Accepts a comma separated list of labels that are going to be presented as columns. Names are case-sensitive. You can also use multiple flag statements like -L label1 -L label2...")
 	return cmd
 }
@@ -90,6 +105,9 @@ func NewCmdGet(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 func RunGet(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
 	selector := cmdutil.GetFlagString(cmd, "selector")
 	allNamespaces := cmdutil.GetFlagBool(cmd, "all-namespaces")
+	sorting := cmdutil.GetFlagString(cmd, "sort-by")
+	filenames := cmdutil.GetFlagStringList(cmd, "filename")
+	recursive := cmdutil.GetFlagBool(cmd, "recursive")
 	mapper, typer := f.Object()
 
 	cmdNamespace, err := f.DefaultNamespace()
@@ -97,15 +115,20 @@ func RunGet(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string
 		return err
 	}
 
-	if len(args) == 0 {
+	if len(args) == 0 && len(filenames) == 0 {
 		fmt.Fprint(out, `
 You must specify the type of resource to get. Valid resource types include:
    * pods (aka 'po')
    * replicationcontrollers (aka 'rc')
+   * jobs
+   * daemonsets (aka 'ds')
+   * deployments
+   * horizontalpodautoscalers (aka 'hpa')
    * services
    * nodes (aka 'no')
    * events (aka 'ev')
    * secrets
+   * configmaps
    * limits
    * persistentVolumes (aka 'pv')
    * persistentVolumeClaims (aka 'pvc')
@@ -119,6 +142,7 @@ You must specify the type of resource to get. Valid resource types include:
 	if isWatch || isWatchOnly {
 		r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
 			NamespaceParam(cmdNamespace).DefaultNamespace().AllNamespaces(allNamespaces).
+			FilenameParam(recursive, filenames...).
 			SelectorParam(selector).
 			ResourceTypeOrNameArgs(true, args...).
 			SingleResourceType().
@@ -168,6 +192,7 @@ You must specify the type of resource to get. Valid resource types include:
 
 	b := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
 		NamespaceParam(cmdNamespace).DefaultNamespace().AllNamespaces(allNamespaces).
+		FilenameParam(recursive, filenames...).
 		SelectorParam(selector).
 		ResourceTypeOrNameArgs(true, args...).
 		ContinueOnError().
@@ -199,6 +224,9 @@ You must specify the type of resource to get. Valid resource types include:
 			return err
 		}
 
+		if len(sorting) != 0 {
+			printer = &kubectl.SortingPrinter{SortField: sorting, Delegate: printer}
+		}
 		return printer.PrintObj(obj, out)
 	}
 
@@ -208,6 +236,9 @@ You must specify the type of resource to get. Valid resource types include:
 		if err != nil {
 			return err
 		}
+		if len(sorting) != 0 {
+			printer = &kubectl.SortingPrinter{SortField: sorting, Delegate: printer}
+		}
 		return printer.PrintObj(r.Object, out)
 	})
 }
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
 	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
@@ -38,6 +39,9 @@ If --resource-version is specified, then updates will use this resource version,
 	label_example = `// Update pod 'foo' with the label 'unhealthy' and the value 'true'.
 $ kubectl label pods foo unhealthy=true
 
+// Update the resources described by the files in the directory 'manifests' and its subdirectories.
+$ kubectl label -f manifests/ -R unhealthy=true
+
 // Update pod 'foo' with the label 'status' and the value 'unhealthy', overwriting any existing value.
 $ kubectl label --overwrite pods foo status=unhealthy
 
@@ -53,17 +57,22 @@ $ kubectl label pods foo bar-`
 )
 
 func NewCmdLabel(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	var filenames util.StringList
 	cmd := &cobra.Command{
-		Use:     "label [--overwrite] RESOURCE NAME KEY_1=VAL_1 ... KEY_N=VAL_N [--resource-version=version]",
+		Use:     "label [--overwrite] (-f FILENAME | RESOURCE NAME) KEY_1=VAL_1 ... KEY_N=VAL_N [--resource-version=version]",
 		Short:   "Update the labels on a resource",
 		Long:    fmt.Sprintf(label_long, util.LabelValueMaxLength),
 		Example: label_example,
 		Run: func(cmd *cobra.Command, args []string) {
-			err := RunLabel(f, out, cmd, args)
+			err := RunLabel(f, out, cmd, args, filenames)
 			cmdutil.CheckErr(err)
 		},
 	}
 	cmdutil.AddPrinterFlags(cmd)
+	usage := "Filename, directory, or URL to a file identifying the resource to update the labels"
+	kubectl.AddJsonFilenameFlag(cmd, &filenames, usage)
+	cmdutil.AddRecursiveFlag(cmd)
+	cmdutil.AddDryRunFlag(cmd)
 	cmd.Flags().Bool("overwrite", false, "If true, allow labels to be overwritten, otherwise reject label updates that overwrite existing labels.")
 	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
 	cmd.Flags().Bool("all", false, "select all resources in the namespace of the specified resource types")
@@ -71,8 +80,9 @@ func NewCmdLabel(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	return cmd
 }
 
-func updateObject(info *resource.Info, updateFn func(runtime.Object) (runtime.Object, error)) (runtime.Object, error) {
+func updateObject(info *resource.Info, dryRun bool, updateFn func(runtime.Object) (runtime.Object, error)) (runtime.Object, error) {
 	helper := resource.NewHelper(info.Client, info.Mapping)
+	helper.DryRun = dryRun
 
 	obj, err := updateFn(info.Object)
 	if err != nil {
@@ -151,7 +161,7 @@ func labelFunc(obj runtime.Object, overwrite bool, resourceVersion string, label
 	return obj, nil
 }
 
-func RunLabel(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+func RunLabel(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string, filenames util.StringList) error {
 	resources, labelArgs := []string{}, []string{}
 	first := true
 	for _, s := range args {
@@ -168,7 +178,7 @@ func RunLabel(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []stri
 			return cmdutil.UsageError(cmd, "all resources must be specified before label changes: %s", s)
 		}
 	}
-	if len(resources) < 1 {
+	if len(resources) < 1 && len(filenames) == 0 {
 		return cmdutil.UsageError(cmd, "one or more resources must be specified as <resource> <name> or <resource>/<name>")
 	}
 	if len(labelArgs) < 1 {
@@ -179,6 +189,7 @@ func RunLabel(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []stri
 	all := cmdutil.GetFlagBool(cmd, "all")
 	overwrite := cmdutil.GetFlagBool(cmd, "overwrite")
 	resourceVersion := cmdutil.GetFlagString(cmd, "resource-version")
+	dryRun := cmdutil.GetFlagBool(cmd, "dry-run")
 
 	cmdNamespace, err := f.DefaultNamespace()
 	if err != nil {
@@ -194,6 +205,7 @@ func RunLabel(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []stri
 	b := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
 		ContinueOnError().
 		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(cmdutil.GetFlagBool(cmd, "recursive"), filenames...).
 		SelectorParam(selector).
 		ResourceTypeOrNameArgs(all, resources...).
 		Flatten().
@@ -211,7 +223,7 @@ func RunLabel(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []stri
 
 	// TODO: support bulk generic output a la Get
 	return r.Visit(func(info *resource.Info) error {
-		obj, err := updateObject(info, func(obj runtime.Object) (runtime.Object, error) {
+		obj, err := updateObject(info, dryRun, func(obj runtime.Object) (runtime.Object, error) {
 			outObj, err := labelFunc(obj, overwrite, resourceVersion, labels, remove)
 			if err != nil {
 				return nil, err
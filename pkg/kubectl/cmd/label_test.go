@@ -309,7 +309,7 @@ func TestLabelErrors(t *testing.T) {
 		for k, v := range testCase.flags {
 			cmd.Flags().Set(k, v)
 		}
-		err := RunLabel(f, buf, cmd, testCase.args)
+		err := RunLabel(f, buf, cmd, testCase.args, nil)
 		if !testCase.errFn(err) {
 			t.Errorf("%s: unexpected error: %v", k, err)
 			continue
@@ -323,6 +323,72 @@ func TestLabelErrors(t *testing.T) {
 	}
 }
 
+func TestLabelForResourceFromFile(t *testing.T) {
+	_, _, rc := testData()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && (m == "GET" || m == "PUT"):
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: testapi.Version()}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdLabel(f, buf)
+	filenames := []string{"../../../examples/guestbook/redis-master-controller.yaml"}
+	if err := RunLabel(f, buf, cmd, []string{"a=b"}, filenames); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(tf.Printer.(*testPrinter).Objects[0].(*api.ReplicationController).Labels, map[string]string{"a": "b"}) {
+		t.Errorf("did not set labels: %#v", tf.Printer.(*testPrinter).Objects[0])
+	}
+}
+
+func TestLabelDryRun(t *testing.T) {
+	pods, _, _ := testData()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/pods/foo" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+			case p == "/namespaces/test/pods/foo" && m == "PUT" && req.URL.Query().Get("dryRun") == "true":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: testapi.Version()}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdLabel(f, buf)
+	cmd.Flags().Set("dry-run", "true")
+	if err := RunLabel(f, buf, cmd, []string{"pods", "foo", "a=b"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(tf.Printer.(*testPrinter).Objects[0].(*api.Pod).Labels, map[string]string{"a": "b"}) {
+		t.Errorf("did not set labels: %#v", tf.Printer.(*testPrinter).Objects[0])
+	}
+}
+
 func TestLabelMultipleObjects(t *testing.T) {
 	pods, _, _ := testData()
 
@@ -363,7 +429,7 @@ func TestLabelMultipleObjects(t *testing.T) {
 	cmd := NewCmdLabel(f, buf)
 
 	cmd.Flags().Set("all", "true")
-	if err := RunLabel(f, buf, cmd, []string{"pods", "a=b"}); err != nil {
+	if err := RunLabel(f, buf, cmd, []string{"pods", "a=b"}, nil); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -17,14 +17,21 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
 	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	libutil "github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -36,7 +43,13 @@ $ kubectl logs 123456-7890 ruby-container
 $ kubectl logs -p 123456-7890 ruby-container
 
 // Starts streaming of ruby-container logs from pod 123456-7890.
-$ kubectl logs -f 123456-7890 ruby-container`
+$ kubectl logs -f 123456-7890 ruby-container
+
+// Returns the ruby-container logs from pod 123456-7890 written in the last 10 minutes, with timestamps.
+$ kubectl logs --since=10m --timestamps 123456-7890 ruby-container
+
+// Streams the logs of every container in the pods labeled app=nginx, prefixing each line with the pod and container.
+$ kubectl logs -f -l app=nginx`
 )
 
 func selectContainer(pod *api.Pod, in io.Reader, out io.Writer) string {
@@ -64,9 +77,9 @@ func selectContainer(pod *api.Pod, in io.Reader, out io.Writer) string {
 // NewCmdLog creates a new pod log command
 func NewCmdLog(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "logs [-f] [-p] POD [CONTAINER]",
+		Use:     "logs [-f] [-p] (POD [CONTAINER] | -l SELECTOR)",
 		Short:   "Print the logs for a container in a pod.",
-		Long:    "Print the logs for a container in a pod. If the pod has only one container, the container name is optional.",
+		Long:    "Print the logs for a container in a pod. If the pod has only one container, the container name is optional. If a selector is given, the logs of all the matching pods are printed, each line prefixed with its pod and container.",
 		Example: log_example,
 		Run: func(cmd *cobra.Command, args []string) {
 			err := RunLog(f, out, cmd, args)
@@ -77,6 +90,11 @@ func NewCmdLog(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().BoolP("follow", "f", false, "Specify if the logs should be streamed.")
 	cmd.Flags().Bool("interactive", true, "If true, prompt the user for input when required. Default true.")
 	cmd.Flags().BoolP("previous", "p", false, "If true, print the logs for the previous instance of the container in a pod if it exists.")
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on. The logs of every matching pod are printed.")
+	cmd.Flags().StringP("container", "c", "", "Print the logs of this container.")
+	cmd.Flags().Duration("since", 0, "Only return logs newer than a relative duration like 5s, 2m, or 3h. Only one of since-time / since may be used.")
+	cmd.Flags().String("since-time", "", "Only return logs after a specific date (RFC3339). Only one of since-time / since may be used.")
+	cmd.Flags().Bool("timestamps", false, "Include timestamps on each line in the log output.")
 	return cmd
 }
 
@@ -86,12 +104,23 @@ func RunLog(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string
 		printDeprecationWarning("logs", "log")
 	}
 
-	if len(args) == 0 {
-		return cmdutil.UsageError(cmd, "POD is required for log")
+	selector := cmdutil.GetFlagString(cmd, "selector")
+	if len(selector) > 0 {
+		if len(args) > 0 {
+			return cmdutil.UsageError(cmd, "POD may not be specified together with a selector")
+		}
+	} else {
+		if len(args) == 0 {
+			return cmdutil.UsageError(cmd, "POD is required for log")
+		}
+		if len(args) > 2 {
+			return cmdutil.UsageError(cmd, "log POD [CONTAINER]")
+		}
 	}
 
-	if len(args) > 2 {
-		return cmdutil.UsageError(cmd, "log POD [CONTAINER]")
+	params, err := logParams(cmd)
+	if err != nil {
+		return err
 	}
 
 	namespace, err := f.DefaultNamespace()
@@ -103,6 +132,11 @@ func RunLog(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string
 		return err
 	}
 
+	container := cmdutil.GetFlagString(cmd, "container")
+	if len(selector) > 0 {
+		return logSelectedPods(client, namespace, selector, container, params, out)
+	}
+
 	podID := args[0]
 
 	pod, err := client.Pods(namespace).Get(podID)
@@ -110,35 +144,109 @@ func RunLog(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string
 		return err
 	}
 
-	var container string
-	if len(args) == 1 {
+	if len(args) == 2 {
+		if len(container) > 0 && container != args[1] {
+			return cmdutil.UsageError(cmd, "only one of CONTAINER or --container may be specified")
+		}
+		container = args[1]
+	}
+	if len(container) == 0 {
 		if len(pod.Spec.Containers) != 1 {
 			return fmt.Errorf("POD %s has more than one container; please specify the container to print logs for", pod.ObjectMeta.Name)
 		}
 		container = pod.Spec.Containers[0].Name
-	} else {
-		container = args[1]
 	}
 
-	follow := false
-	if cmdutil.GetFlagBool(cmd, "follow") {
-		follow = true
+	return streamLog(client, namespace, podID, container, params, out)
+}
+
+// logParams returns the query parameters of a log request, as set by the
+// flags of the logs command.
+func logParams(cmd *cobra.Command) (map[string]string, error) {
+	params := map[string]string{
+		"follow":   strconv.FormatBool(cmdutil.GetFlagBool(cmd, "follow")),
+		"previous": strconv.FormatBool(cmdutil.GetFlagBool(cmd, "previous")),
+	}
+	if cmdutil.GetFlagBool(cmd, "timestamps") {
+		params["timestamps"] = "true"
 	}
 
-	previous := false
-	if cmdutil.GetFlagBool(cmd, "previous") {
-		previous = true
+	since := cmdutil.GetFlagDuration(cmd, "since")
+	sinceTime := cmdutil.GetFlagString(cmd, "since-time")
+	if since != 0 && len(sinceTime) > 0 {
+		return nil, cmdutil.UsageError(cmd, "only one of --since or --since-time may be specified")
+	}
+	if since < 0 {
+		return nil, cmdutil.UsageError(cmd, "--since must be a positive duration")
 	}
+	if since != 0 {
+		// Round up so that a duration below a second still returns recent logs.
+		seconds := int64((since + time.Second - 1) / time.Second)
+		params["sinceSeconds"] = strconv.FormatInt(seconds, 10)
+	}
+	if len(sinceTime) > 0 {
+		t, err := time.Parse(time.RFC3339, sinceTime)
+		if err != nil {
+			return nil, cmdutil.UsageError(cmd, "--since-time must be an RFC3339 timestamp: %v", err)
+		}
+		params["sinceTime"] = t.Format(time.RFC3339)
+	}
+	return params, nil
+}
 
-	readCloser, err := client.RESTClient.Get().
+// logSelectedPods streams the logs of the containers in all the pods that match
+// the selector concurrently. Every line is prefixed with its pod and container.
+func logSelectedPods(c *client.Client, namespace, selector, container string, params map[string]string, out io.Writer) error {
+	labelSelector, err := labels.Parse(selector)
+	if err != nil {
+		return err
+	}
+	pods, err := c.Pods(namespace).List(labelSelector, fields.Everything())
+	if err != nil {
+		return err
+	}
+
+	lock := &sync.Mutex{}
+	wg := sync.WaitGroup{}
+	errs := []error{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		for _, podContainer := range pod.Spec.Containers {
+			if len(container) > 0 && podContainer.Name != container {
+				continue
+			}
+			wg.Add(1)
+			go func(podName, containerName string) {
+				defer wg.Done()
+				w := &prefixWriter{lock: lock, out: out, prefix: fmt.Sprintf("[%s/%s] ", podName, containerName)}
+				err := streamLog(c, namespace, podName, containerName, params, w)
+				if flushErr := w.Flush(); err == nil {
+					err = flushErr
+				}
+				if err != nil {
+					lock.Lock()
+					defer lock.Unlock()
+					errs = append(errs, fmt.Errorf("unable to get logs for container %q in pod %q: %v", containerName, podName, err))
+				}
+			}(pod.Name, podContainer.Name)
+		}
+	}
+	wg.Wait()
+	return errors.NewAggregate(errs)
+}
+
+// streamLog copies the log of a single container to out.
+func streamLog(c *client.Client, namespace, podID, container string, params map[string]string, out io.Writer) error {
+	req := c.RESTClient.Get().
 		Namespace(namespace).
 		Name(podID).
 		Resource("pods").
 		SubResource("log").
-		Param("follow", strconv.FormatBool(follow)).
-		Param("container", container).
-		Param("previous", strconv.FormatBool(previous)).
-		Stream()
+		Param("container", container)
+	for k, v := range params {
+		req.Param(k, v)
+	}
+	readCloser, err := req.Stream()
 	if err != nil {
 		return err
 	}
@@ -147,3 +255,52 @@ func RunLog(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string
 	_, err = io.Copy(out, readCloser)
 	return err
 }
+
+// prefixWriter writes whole lines to out, prefixing each with a fixed string.
+// Writers that share the same lock may be used concurrently without
+// interleaving their lines.
+type prefixWriter struct {
+	lock   *sync.Mutex
+	out    io.Writer
+	prefix string
+	buf    []byte
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	i := bytes.LastIndex(w.buf, []byte("\n"))
+	if i < 0 {
+		return len(p), nil
+	}
+	lines := w.buf[:i+1]
+	w.buf = append([]byte{}, w.buf[i+1:]...)
+	if err := w.writeLines(lines); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush writes out any incomplete line, terminating it with a newline.
+func (w *prefixWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	lines := append(w.buf, '\n')
+	w.buf = nil
+	return w.writeLines(lines)
+}
+
+func (w *prefixWriter) writeLines(lines []byte) error {
+	var buf bytes.Buffer
+	for _, line := range bytes.SplitAfter(lines, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		buf.WriteString(w.prefix)
+		buf.Write(line)
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	_, err := w.out.Write(buf.Bytes())
+	return err
+}
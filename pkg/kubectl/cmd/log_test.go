@@ -20,9 +20,11 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 )
 
@@ -204,6 +206,123 @@ func TestLog(t *testing.T) {
 	}
 }
 
+func TestLogParams(t *testing.T) {
+	f, tf, codec := NewAPIFactory()
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/api/"+testapi.Version()+"/namespaces/test/pods/foo" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, testPod())}, nil
+			case p == "/api/"+testapi.Version()+"/namespaces/test/pods/foo/log" && m == "GET":
+				query := req.URL.Query()
+				for k, v := range map[string]string{"container": "bar", "follow": "false", "previous": "true", "sinceSeconds": "600", "timestamps": "true"} {
+					if query.Get(k) != v {
+						t.Errorf("expected %s=%s, got %q", k, v, query.Get(k))
+					}
+				}
+				if _, ok := query["sinceTime"]; ok {
+					t.Errorf("unexpected sinceTime in %v", query)
+				}
+				return &http.Response{StatusCode: 200, Body: stringBody("log")}, nil
+			default:
+				t.Errorf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: testapi.Version()}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdLog(f, buf)
+	cmd.Flags().Set("previous", "true")
+	cmd.Flags().Set("since", "10m")
+	cmd.Flags().Set("timestamps", "true")
+	if err := RunLog(f, buf, cmd, []string{"foo"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "log" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestLogParamsConflictingSince(t *testing.T) {
+	f, _, _ := NewAPIFactory()
+	cmd := NewCmdLog(f, bytes.NewBuffer([]byte{}))
+	cmd.Flags().Set("since", "10m")
+	cmd.Flags().Set("since-time", "2015-06-01T12:00:00Z")
+	if err := RunLog(f, bytes.NewBuffer([]byte{}), cmd, []string{"foo"}); err == nil {
+		t.Errorf("expected an error when both --since and --since-time are given")
+	}
+}
+
+func TestLogSelector(t *testing.T) {
+	pods := &api.PodList{
+		Items: []api.Pod{*testPod(), *testPod()},
+	}
+	pods.Items[1].Name = "baz"
+	pods.Items[1].Spec.Containers = []api.Container{{Name: "one"}, {Name: "two"}}
+
+	f, tf, codec := NewAPIFactory()
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			prefix := "/api/" + testapi.Version() + "/namespaces/test/pods"
+			switch p, m := req.URL.Path, req.Method; {
+			case p == prefix && m == "GET":
+				if selector := req.URL.Query().Get("labelSelector"); selector != "app=foo" {
+					t.Errorf("unexpected selector: %s", selector)
+				}
+				return &http.Response{StatusCode: 200, Body: objBody(codec, pods)}, nil
+			case strings.HasPrefix(p, prefix+"/") && strings.HasSuffix(p, "/log") && m == "GET":
+				name := strings.TrimSuffix(strings.TrimPrefix(p, prefix+"/"), "/log")
+				container := req.URL.Query().Get("container")
+				if req.URL.Query().Get("follow") != "true" {
+					t.Errorf("expected the logs to be followed")
+				}
+				return &http.Response{StatusCode: 200, Body: stringBody("first " + name + "/" + container + "\nsecond")}, nil
+			default:
+				t.Errorf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{Version: testapi.Version()}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdLog(f, buf)
+	cmd.Flags().Set("selector", "app=foo")
+	cmd.Flags().Set("follow", "true")
+	if err := RunLog(f, buf, cmd, []string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("expected 6 lines, got: %q", buf.String())
+	}
+	for _, expected := range []string{
+		"[foo/bar] first foo/bar",
+		"[foo/bar] second",
+		"[baz/one] first baz/one",
+		"[baz/one] second",
+		"[baz/two] first baz/two",
+		"[baz/two] second",
+	} {
+		found := false
+		for _, line := range lines {
+			if line == expected {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected line %q in output:\n%s", expected, buf.String())
+		}
+	}
+}
+
 func testPod() *api.Pod {
 	return &api.Pod{
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test", ResourceVersion: "10"},
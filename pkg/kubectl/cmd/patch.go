@@ -0,0 +1,133 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/yaml"
+)
+
+// patchTypes maps the values of the --type flag to the patch types the
+// server accepts.
+var patchTypes = map[string]api.PatchType{
+	"json":      api.JSONPatchType,
+	"merge":     api.MergePatchType,
+	"strategic": api.StrategicMergePatchType,
+}
+
+const (
+	patch_long = `Update field(s) of a resource using a patch.
+
+The patch is a JSON or YAML document. By default it is a strategic merge patch, which
+merges lists of objects such as the containers of a pod by name. Use --type=merge for a
+JSON merge patch (RFC 7386) or --type=json for a JSON patch (RFC 6902).`
+	patch_example = `// Partially update a node using strategic merge patch
+$ kubectl patch node k8s-node-1 -p '{"spec":{"unschedulable":true}}'
+
+// Partially update a node identified by the type and name specified in "node.json" using strategic merge patch
+$ kubectl patch -f node.json -p '{"spec":{"unschedulable":true}}'
+
+// Update a container's image; spec.containers[*].name is required because it's a merge key
+$ kubectl patch pod valid-pod -p '{"spec":{"containers":[{"name":"kubernetes-serve-hostname","image":"new image"}]}}'
+
+// Update a container's image using a json patch with positional arrays
+$ kubectl patch pod valid-pod --type=json -p='[{"op": "replace", "path": "/spec/containers/0/image", "value":"new image"}]'`
+)
+
+func NewCmdPatch(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	var filenames util.StringList
+	cmd := &cobra.Command{
+		Use:     "patch (-f FILENAME | RESOURCE NAME) -p PATCH [--type=strategic|merge|json]",
+		Short:   "Update field(s) of a resource using a patch",
+		Long:    patch_long,
+		Example: patch_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunPatch(f, out, cmd, args, filenames)
+			cmdutil.CheckErr(err)
+		},
+	}
+	cmd.Flags().StringP("patch", "p", "", "The patch to be applied to the resource JSON file.")
+	cmd.MarkFlagRequired("patch")
+	cmd.Flags().String("type", "strategic", "The type of patch being provided; one of [json merge strategic]")
+	usage := "Filename, directory, or URL to a file identifying the resource to update"
+	kubectl.AddJsonFilenameFlag(cmd, &filenames, usage)
+	return cmd
+}
+
+func RunPatch(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string, filenames util.StringList) error {
+	typeName := cmdutil.GetFlagString(cmd, "type")
+	patchType, ok := patchTypes[strings.ToLower(typeName)]
+	if !ok {
+		return cmdutil.UsageError(cmd, "--type must be one of [json merge strategic], not %q", typeName)
+	}
+
+	patch := cmdutil.GetFlagString(cmd, "patch")
+	if len(patch) == 0 {
+		return cmdutil.UsageError(cmd, "Must specify -p to patch")
+	}
+	patchBytes, err := yaml.ToJSON([]byte(patch))
+	if err != nil {
+		return fmt.Errorf("unable to parse %q: %v", patch, err)
+	}
+
+	cmdNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(false, filenames...).
+		ResourceTypeOrNameArgs(false, args...).
+		Flatten().
+		Do()
+	err = r.Err()
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	err = r.Visit(func(info *resource.Info) error {
+		obj, err := resource.NewHelper(info.Client, info.Mapping).Patch(info.Namespace, info.Name, patchType, patchBytes)
+		if err != nil {
+			return cmdutil.AddSourceToErr("patching", info.Source, err)
+		}
+		count++
+		info.Refresh(obj, true)
+		fmt.Fprintf(out, "%s/%s\n", info.Mapping.Resource, info.Name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("no objects passed to patch")
+	}
+	return nil
+}
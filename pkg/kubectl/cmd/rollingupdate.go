@@ -157,7 +157,7 @@ func RunRollingUpdate(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, arg
 		request := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
 			Schema(schema).
 			NamespaceParam(cmdNamespace).RequireNamespace().
-			FilenameParam(filename).
+			FilenameParam(false, filename).
 			Do()
 		obj, err := request.Object()
 		if err != nil {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
 	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
@@ -39,7 +40,10 @@ scale is sent to the server.`
 $ kubectl scale --replicas=3 replicationcontrollers foo
 
 // If the replication controller named foo's current size is 2, scale foo to 3.
-$ kubectl scale --current-replicas=2 --replicas=3 replicationcontrollers foo`
+$ kubectl scale --current-replicas=2 --replicas=3 replicationcontrollers foo
+
+// Check that the replication controller named 'foo' may be scaled to 5, without scaling it.
+$ kubectl scale --replicas=5 --dry-run replicationcontrollers foo`
 )
 
 // NewCmdScale returns a cobra command with the appropriate configuration and flags to run scale
@@ -60,6 +64,7 @@ func NewCmdScale(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().Int("current-replicas", -1, "Precondition for current size. Requires that the current size of the replication controller match this value in order to scale.")
 	cmd.Flags().Int("replicas", -1, "The new desired number of replicas. Required.")
 	cmd.MarkFlagRequired("replicas")
+	cmdutil.AddDryRunFlag(cmd)
 	return cmd
 }
 
@@ -104,14 +109,18 @@ func RunScale(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []stri
 	}
 	info := infos[0]
 
+	resourceVersion := cmdutil.GetFlagString(cmd, "resource-version")
+	currentSize := cmdutil.GetFlagInt(cmd, "current-replicas")
+	precondition := &kubectl.ScalePrecondition{currentSize, resourceVersion}
+	if cmdutil.GetFlagBool(cmd, "dry-run") {
+		return dryRunScale(info, uint(count), precondition, out)
+	}
+
 	scaler, err := f.Scaler(mapping)
 	if err != nil {
 		return err
 	}
 
-	resourceVersion := cmdutil.GetFlagString(cmd, "resource-version")
-	currentSize := cmdutil.GetFlagInt(cmd, "current-replicas")
-	precondition := &kubectl.ScalePrecondition{currentSize, resourceVersion}
 	retry := kubectl.NewRetryParams(kubectl.Interval, kubectl.Timeout)
 	waitForReplicas := kubectl.NewRetryParams(kubectl.Interval, kubectl.Timeout)
 	if err := scaler.Scale(info.Namespace, info.Name, uint(count), precondition, retry, waitForReplicas); err != nil {
@@ -120,3 +129,27 @@ func RunScale(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []stri
 	fmt.Fprint(out, "scaled\n")
 	return nil
 }
+
+// dryRunScale sends the scaled replication controller to the server to be validated
+// and admitted, without persisting it or waiting for the new replicas.
+func dryRunScale(info *resource.Info, count uint, precondition *kubectl.ScalePrecondition, out io.Writer) error {
+	controller, ok := info.Object.(*api.ReplicationController)
+	if !ok {
+		return fmt.Errorf("unable to scale %s/%s", info.Mapping.Resource, info.Name)
+	}
+	if err := precondition.Validate(controller); err != nil {
+		return err
+	}
+	controller.Spec.Replicas = int(count)
+	data, err := info.Mapping.Codec.Encode(controller)
+	if err != nil {
+		return err
+	}
+	helper := resource.NewHelper(info.Client, info.Mapping)
+	helper.DryRun = true
+	if _, err := helper.Update(info.Namespace, info.Name, false, data); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "scaled%s\n", dryRunSuffix(true))
+	return nil
+}
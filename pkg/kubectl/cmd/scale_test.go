@@ -0,0 +1,71 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+func TestScaleDryRun(t *testing.T) {
+	_, _, rc := testData()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &client.FakeRESTClient{
+		Codec: codec,
+		Client: client.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers/rc1" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
+			case p == "/namespaces/test/replicationcontrollers/rc1" && m == "PUT" && req.URL.Query().Get("dryRun") == "true":
+				obj, err := codec.Decode(readBodyOrDie(t, req))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if replicas := obj.(*api.ReplicationController).Spec.Replicas; replicas != 3 {
+					t.Errorf("expected 3 replicas, got %d", replicas)
+				}
+				return &http.Response{StatusCode: 200, Body: objBody(codec, obj)}, nil
+			default:
+				t.Fatalf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdScale(f, buf)
+	cmd.Flags().Set("replicas", "3")
+	cmd.Flags().Set("dry-run", "true")
+	if err := RunScale(f, buf, cmd, []string{"replicationcontrollers", "rc1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "scaled (dry run)\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+
+	cmd.Flags().Set("current-replicas", "5")
+	if err := RunScale(f, buf, cmd, []string{"replicationcontrollers", "rc1"}); err == nil {
+		t.Errorf("expected the current replicas precondition to fail")
+	}
+}
@@ -77,7 +77,7 @@ func RunStop(f *cmdutil.Factory, cmd *cobra.Command, args []string, filenames ut
 		ContinueOnError().
 		NamespaceParam(cmdNamespace).RequireNamespace().
 		ResourceTypeOrNameArgs(false, args...).
-		FilenameParam(filenames...).
+		FilenameParam(false, filenames...).
 		SelectorParam(cmdutil.GetFlagString(cmd, "selector")).
 		SelectAllParam(cmdutil.GetFlagBool(cmd, "all")).
 		Flatten().
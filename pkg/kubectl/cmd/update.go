@@ -62,6 +62,8 @@ func NewCmdUpdate(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	usage := "Filename, directory, or URL to file to use to update the resource."
 	kubectl.AddJsonFilenameFlag(cmd, &filenames, usage)
 	cmd.MarkFlagRequired("filename")
+	cmdutil.AddRecursiveFlag(cmd)
+	cmdutil.AddDryRunFlag(cmd)
 	cmd.Flags().String("patch", "", "A JSON document to override the existing resource. The resource is downloaded, patched with the JSON, then updated.")
 	cmd.MarkFlagRequired("patch")
 	cmd.Flags().Bool("force", false, "Delete and re-create the specified resource")
@@ -84,6 +86,7 @@ func RunUpdate(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []str
 
 	force := cmdutil.GetFlagBool(cmd, "force")
 	patch := cmdutil.GetFlagString(cmd, "patch")
+	dryRun := cmdutil.GetFlagBool(cmd, "dry-run")
 	if len(filenames) == 0 && len(patch) == 0 {
 		return cmdutil.UsageError(cmd, "Must specify --filename or --patch to update")
 	}
@@ -93,6 +96,9 @@ func RunUpdate(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []str
 	if len(filenames) == 0 && force {
 		return cmdutil.UsageError(cmd, "--force can only be used with --filename")
 	}
+	if force && dryRun {
+		return cmdutil.UsageError(cmd, "--dry-run can not be used with --force")
+	}
 
 	// TODO: Make patching work with -f, updating with patched JSON input files
 	if len(filenames) == 0 {
@@ -100,7 +106,7 @@ func RunUpdate(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []str
 		if err != nil {
 			return err
 		}
-		fmt.Fprintf(out, "%s\n", name)
+		fmt.Fprintf(out, "%s%s\n", name, dryRunSuffix(dryRun))
 		return nil
 	}
 	if len(filenames) == 0 {
@@ -116,7 +122,7 @@ func RunUpdate(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []str
 		Schema(schema).
 		ContinueOnError().
 		NamespaceParam(cmdNamespace).RequireNamespace().
-		FilenameParam(filenames...).
+		FilenameParam(cmdutil.GetFlagBool(cmd, "recursive"), filenames...).
 		Flatten().
 		Do()
 	err = r.Err()
@@ -129,13 +135,17 @@ func RunUpdate(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []str
 		if err != nil {
 			return cmdutil.AddSourceToErr("updating", info.Source, err)
 		}
-		obj, err := resource.NewHelper(info.Client, info.Mapping).Update(info.Namespace, info.Name, true, data)
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		helper.DryRun = dryRun
+		obj, err := helper.Update(info.Namespace, info.Name, true, data)
 		if err != nil {
 			return cmdutil.AddSourceToErr("updating", info.Source, err)
 		}
 		info.Refresh(obj, true)
-		printObjectSpecificMessage(obj, out)
-		fmt.Fprintf(out, "%s/%s\n", info.Mapping.Resource, info.Name)
+		if !dryRun {
+			printObjectSpecificMessage(obj, out)
+		}
+		fmt.Fprintf(out, "%s/%s%s\n", info.Mapping.Resource, info.Name, dryRunSuffix(dryRun))
 		return nil
 	})
 }
@@ -173,6 +183,7 @@ func updateWithPatch(cmd *cobra.Command, args []string, f *cmdutil.Factory, patc
 	name, namespace := infos[0].Name, infos[0].Namespace
 
 	helper := resource.NewHelper(client, mapping)
+	helper.DryRun = cmdutil.GetFlagBool(cmd, "dry-run")
 	_, err = helper.Patch(namespace, name, api.StrategicMergePatchType, []byte(patch))
 	return name, err
 }
@@ -192,7 +203,7 @@ func forceUpdate(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []s
 	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
 		ContinueOnError().
 		NamespaceParam(cmdNamespace).DefaultNamespace().
-		FilenameParam(filenames...).
+		FilenameParam(cmdutil.GetFlagBool(cmd, "recursive"), filenames...).
 		ResourceTypeOrNameArgs(false, args...).RequireObject(false).
 		Flatten().
 		Do()
@@ -217,7 +228,7 @@ func forceUpdate(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []s
 		Schema(schema).
 		ContinueOnError().
 		NamespaceParam(cmdNamespace).RequireNamespace().
-		FilenameParam(filenames...).
+		FilenameParam(cmdutil.GetFlagBool(cmd, "recursive"), filenames...).
 		Flatten().
 		Do()
 	err = r.Err()
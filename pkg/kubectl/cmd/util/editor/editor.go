@@ -0,0 +1,140 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package editor launches the user's editor on a file.
+package editor
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// The editor used when none is configured in the environment.
+	defaultEditor = "vi"
+	// The shell used to run editors that are configured with arguments.
+	defaultShell = "/bin/bash"
+)
+
+// Editor launches an editor on files.
+type Editor struct {
+	// Args is the command and the arguments used to launch the editor. The
+	// path of the file to edit is appended to them.
+	Args []string
+	// Shell is true if Args runs the editor through a shell, in which case
+	// the path is appended to the last argument, the shell command.
+	Shell bool
+}
+
+// NewDefaultEditor returns an Editor that launches the editor named by
+// the first of the given environment variables that is set, falling back
+// to $EDITOR and then to vi. Editors configured with arguments are run
+// through a shell.
+func NewDefaultEditor(envs ...string) Editor {
+	editor := defaultEditor
+	for _, env := range append(envs, "EDITOR") {
+		if value := os.Getenv(env); len(value) > 0 {
+			editor = value
+			break
+		}
+	}
+	if !strings.Contains(editor, " ") {
+		return Editor{Args: []string{editor}}
+	}
+	shell := os.Getenv("SHELL")
+	if len(shell) == 0 {
+		shell = defaultShell
+	}
+	return Editor{Args: []string{shell, "-c", editor}, Shell: true}
+}
+
+func (e Editor) args(path string) []string {
+	args := make([]string, len(e.Args))
+	copy(args, e.Args)
+	if e.Shell {
+		last := args[len(args)-1]
+		args[len(args)-1] = fmt.Sprintf("%s %q", last, path)
+	} else {
+		args = append(args, path)
+	}
+	return args
+}
+
+// Launch opens the file at the given path in the editor and waits for the
+// editor to exit.
+func (e Editor) Launch(path string) error {
+	if len(e.Args) == 0 {
+		return fmt.Errorf("no editor defined, can't open %s", path)
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	args := e.args(abs)
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+	glog.V(5).Infof("Opening file with editor %v", args)
+	if err := cmd.Run(); err != nil {
+		if err, ok := err.(*exec.Error); ok && err.Err == exec.ErrNotFound {
+			return fmt.Errorf("unable to launch the editor %q", strings.Join(e.Args, " "))
+		}
+		return fmt.Errorf("there was a problem with the editor %q: %v", strings.Join(e.Args, " "), err)
+	}
+	return nil
+}
+
+// LaunchTempFile writes the contents of r to a temporary file with the given
+// prefix and suffix, opens it in the editor and returns the edited contents
+// along with the path of the file. The caller is responsible for removing the
+// file. The suffix lets the editor pick the right syntax highlighting.
+func (e Editor) LaunchTempFile(prefix, suffix string, r io.Reader) ([]byte, string, error) {
+	f, err := ioutil.TempFile("", prefix)
+	if err != nil {
+		return nil, "", err
+	}
+	f.Close()
+	path := f.Name() + suffix
+	if err := os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return nil, "", err
+	}
+
+	f, err = os.OpenFile(path, os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, path, err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return nil, path, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, path, err
+	}
+
+	if err := e.Launch(path); err != nil {
+		return nil, path, err
+	}
+	data, err := ioutil.ReadFile(path)
+	return data, path, err
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package editor
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestArgs(t *testing.T) {
+	if e, a := []string{"vi", "/tmp/file"}, (Editor{Args: []string{"vi"}}).args("/tmp/file"); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if e, a := []string{"/bin/bash", "-c", `emacs -nw "/tmp/file"`}, (Editor{Args: []string{"/bin/bash", "-c", "emacs -nw"}, Shell: true}).args("/tmp/file"); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+}
+
+func TestNewDefaultEditor(t *testing.T) {
+	defer os.Setenv("EDITOR", os.Getenv("EDITOR"))
+	defer os.Setenv("TEST_EDITOR", os.Getenv("TEST_EDITOR"))
+	defer os.Setenv("SHELL", os.Getenv("SHELL"))
+
+	os.Setenv("EDITOR", "")
+	os.Setenv("TEST_EDITOR", "")
+	if e, a := (Editor{Args: []string{"vi"}}), NewDefaultEditor("TEST_EDITOR"); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %#v, got %#v", e, a)
+	}
+
+	os.Setenv("EDITOR", "nano")
+	if e, a := (Editor{Args: []string{"nano"}}), NewDefaultEditor("TEST_EDITOR"); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %#v, got %#v", e, a)
+	}
+
+	os.Setenv("TEST_EDITOR", "emacs -nw")
+	os.Setenv("SHELL", "/bin/sh")
+	if e, a := (Editor{Args: []string{"/bin/sh", "-c", "emacs -nw"}, Shell: true}), NewDefaultEditor("TEST_EDITOR"); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %#v, got %#v", e, a)
+	}
+}
+
+func TestLaunchTempFile(t *testing.T) {
+	edit := Editor{Args: []string{"/bin/sh", "-c", "sed -i -e s/before/after/"}, Shell: true}
+	contents, path, err := edit.LaunchTempFile("test-editor-", ".yaml", bytes.NewBufferString("before\n"))
+	if len(path) > 0 {
+		defer os.Remove(path)
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasSuffix(path, ".yaml") {
+		t.Errorf("expected the file to keep its suffix, got %s", path)
+	}
+	if string(contents) != "after\n" {
+		t.Errorf("unexpected contents: %q", string(contents))
+	}
+}
@@ -275,6 +275,17 @@ func GetFlagStringList(cmd *cobra.Command, flag string) util.StringList {
 	return *f.Value.(*util.StringList)
 }
 
+// AddRecursiveFlag adds the --recursive flag to a command that accepts directories
+// through --filename.
+func AddRecursiveFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolP("recursive", "R", false, "If true, process the directories given in --filename recursively.")
+}
+
+// AddDryRunFlag adds the --dry-run flag to a command that mutates resources.
+func AddDryRunFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("dry-run", false, "If true, only send the request to the server to be validated and admitted, without persisting the result.")
+}
+
 func GetFlagBool(cmd *cobra.Command, flag string) bool {
 	f := getFlag(cmd, flag)
 	result, err := strconv.ParseBool(f.Value.String())
@@ -17,6 +17,8 @@ limitations under the License.
 package util
 
 import (
+	"strings"
+
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
 
 	"github.com/spf13/cobra"
@@ -24,10 +26,10 @@ import (
 
 // AddPrinterFlags adds printing related flags to a command (e.g. output format, no headers, template path)
 func AddPrinterFlags(cmd *cobra.Command) {
-	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml|template|templatefile.")
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml|template=...|templatefile=...|jsonpath=...|custom-columns=...|custom-columns-file=...")
 	cmd.Flags().String("output-version", "", "Output the formatted object with the given version (default api-version).")
 	cmd.Flags().Bool("no-headers", false, "When using the default output, don't print headers.")
-	cmd.Flags().StringP("template", "t", "", "Template string or path to template file to use when -o=template or -o=templatefile.  The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]")
+	cmd.Flags().StringP("template", "t", "", "Template string or path to template file to use when -o=template, -o=templatefile or -o=jsonpath.  The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview], or jsonpath for -o=jsonpath.")
 }
 
 // OutputVersion returns the preferred output version for generic content (JSON, YAML, or templates)
@@ -47,6 +49,10 @@ func PrinterForCommand(cmd *cobra.Command) (kubectl.ResourcePrinter, bool, error
 	if len(outputFormat) == 0 && len(templateFile) != 0 {
 		outputFormat = "template"
 	}
+	// formats that take an argument may be given as -o format=argument
+	if parts := strings.SplitN(outputFormat, "=", 2); len(parts) == 2 {
+		outputFormat, templateFile = parts[0], parts[1]
+	}
 
 	return kubectl.GetPrinter(outputFormat, templateFile)
 }
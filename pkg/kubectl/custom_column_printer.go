@@ -0,0 +1,189 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/jsonpath"
+)
+
+// massageJSONPath accepts the relaxed field paths used in custom columns and
+// sort keys, such as "metadata.name" or ".metadata.name", and returns the
+// equivalent JSONPath template, "{.metadata.name}".
+func massageJSONPath(pathExpression string) (string, error) {
+	if strings.HasPrefix(pathExpression, "{") && strings.HasSuffix(pathExpression, "}") {
+		return pathExpression, nil
+	}
+	if len(pathExpression) == 0 || strings.ContainsAny(pathExpression, "{}") {
+		return "", fmt.Errorf("unexpected path string %q, expected a 'name1.name2' or '.name1.name2' or '{.name1.name2}'", pathExpression)
+	}
+	if strings.HasPrefix(pathExpression, ".") || strings.HasPrefix(pathExpression, "[") || strings.HasPrefix(pathExpression, "$") {
+		return fmt.Sprintf("{%s}", pathExpression), nil
+	}
+	return fmt.Sprintf("{.%s}", pathExpression), nil
+}
+
+// Column represents a user specified column.
+type Column struct {
+	// The header to print above the column, general style is ALL_CAPS
+	Header string
+	// The pointer to the field in the object to print, in JSONPath form,
+	// e.g. {.ObjectMeta.Name}, see pkg/util/jsonpath for more details.
+	FieldSpec string
+}
+
+// CustomColumnsPrinter is a printer that knows how to print arbitrary
+// columns of data from a template specification.
+type CustomColumnsPrinter struct {
+	Columns []Column
+}
+
+// NewCustomColumnsPrinterFromSpec creates a custom columns printer from a
+// comma separated list of <header>:<jsonpath-field-spec> pairs, e.g.
+// NAME:.metadata.name,API_VERSION:.apiVersion
+func NewCustomColumnsPrinterFromSpec(spec string) (*CustomColumnsPrinter, error) {
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+	parts := strings.Split(spec, ",")
+	columns := make([]Column, len(parts))
+	for ix := range parts {
+		colSpec := strings.SplitN(parts[ix], ":", 2)
+		if len(colSpec) != 2 || len(colSpec[0]) == 0 {
+			return nil, fmt.Errorf("unexpected custom-columns spec: %s, expected <header>:<json-path-expr>", parts[ix])
+		}
+		spec, err := massageJSONPath(colSpec[1])
+		if err != nil {
+			return nil, err
+		}
+		columns[ix] = Column{Header: colSpec[0], FieldSpec: spec}
+	}
+	return &CustomColumnsPrinter{Columns: columns}, nil
+}
+
+// NewCustomColumnsPrinterFromTemplate creates a custom columns printer from
+// a template stream. The template is expected to consist of two lines,
+// whitespace separated. The first line is the header line, the second line
+// is the jsonpath field spec. For example the template below:
+//
+//	NAME               API_VERSION
+//	{metadata.name}    {apiVersion}
+func NewCustomColumnsPrinterFromTemplate(templateReader io.Reader) (*CustomColumnsPrinter, error) {
+	scanner := bufio.NewScanner(templateReader)
+	lines := []string{}
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); len(line) != 0 {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) != 2 {
+		return nil, fmt.Errorf("invalid template, expected two lines in template but got %d", len(lines))
+	}
+	headers := strings.Fields(lines[0])
+	specs := strings.Fields(lines[1])
+	if len(headers) != len(specs) {
+		return nil, fmt.Errorf("number of headers (%d) and field specifications (%d) don't match", len(headers), len(specs))
+	}
+	columns := make([]Column, len(headers))
+	for ix := range headers {
+		spec, err := massageJSONPath(specs[ix])
+		if err != nil {
+			return nil, err
+		}
+		columns[ix] = Column{Header: headers[ix], FieldSpec: spec}
+	}
+	return &CustomColumnsPrinter{Columns: columns}, nil
+}
+
+// PrintObj prints a row for obj, or for each of its items if obj is a list.
+func (s *CustomColumnsPrinter) PrintObj(obj runtime.Object, out io.Writer) error {
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	headers := make([]string, len(s.Columns))
+	parsers := make([]*jsonpath.JSONPath, len(s.Columns))
+	for ix := range s.Columns {
+		headers[ix] = s.Columns[ix].Header
+		parsers[ix] = jsonpath.New(fmt.Sprintf("column%d", ix))
+		if err := parsers[ix].Parse(s.Columns[ix].FieldSpec); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, item := range listItemsOrObject(obj) {
+		if err := s.printOneObject(item, parsers, w); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func (s *CustomColumnsPrinter) printOneObject(obj interface{}, parsers []*jsonpath.JSONPath, out io.Writer) error {
+	data, err := jsonPathData(obj)
+	if err != nil {
+		return err
+	}
+	columns := make([]string, len(parsers))
+	for ix := range parsers {
+		results, err := parsers[ix].FindResults(data)
+		if err != nil {
+			return err
+		}
+		values := []string{}
+		for _, result := range results {
+			buf := &bytes.Buffer{}
+			if err := parsers[ix].PrintResults(buf, result); err != nil {
+				return err
+			}
+			if buf.Len() != 0 {
+				values = append(values, buf.String())
+			}
+		}
+		if len(values) == 0 {
+			columns[ix] = "<none>"
+		} else {
+			columns[ix] = strings.Join(values, ",")
+		}
+	}
+	_, err = fmt.Fprintln(out, strings.Join(columns, "\t"))
+	return err
+}
+
+// listItemsOrObject returns the items of obj if it is a list, or obj itself.
+// List items may be objects or raw extensions.
+func listItemsOrObject(obj runtime.Object) []interface{} {
+	itemsPtr, err := runtime.GetItemsPtr(obj)
+	if err != nil {
+		return []interface{}{obj}
+	}
+	items := reflect.ValueOf(itemsPtr).Elem()
+	result := make([]interface{}, items.Len())
+	for i := range result {
+		result[i] = items.Index(i).Interface()
+	}
+	return result
+}
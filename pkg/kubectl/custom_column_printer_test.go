@@ -0,0 +1,276 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+func TestMassageJSONPath(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{input: "foo.bar", expected: "{.foo.bar}"},
+		{input: "{foo.bar}", expected: "{foo.bar}"},
+		{input: ".foo.bar", expected: "{.foo.bar}"},
+		{input: "{.foo.bar}", expected: "{.foo.bar}"},
+		{input: "", expectErr: true},
+		{input: "a{foo.bar", expectErr: true},
+		{input: "a}foo.bar", expectErr: true},
+	}
+	for _, test := range tests {
+		output, err := massageJSONPath(test.input)
+		if err != nil && !test.expectErr {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("expected an error for %q", test.input)
+			}
+			continue
+		}
+		if output != test.expected {
+			t.Errorf("input: %s, expected: %s, saw: %s", test.input, test.expected, output)
+		}
+	}
+}
+
+func TestNewColumnPrinterFromSpec(t *testing.T) {
+	tests := []struct {
+		spec      string
+		expected  []Column
+		expectErr bool
+		name      string
+	}{
+		{
+			spec:      "",
+			expectErr: true,
+			name:      "empty",
+		},
+		{
+			spec:      "invalid",
+			expectErr: true,
+			name:      "invalid1",
+		},
+		{
+			spec:      "invalid=foobar",
+			expectErr: true,
+			name:      "invalid2",
+		},
+		{
+			spec:      "invalid,foobar:blah",
+			expectErr: true,
+			name:      "invalid3",
+		},
+		{
+			spec: "NAME:metadata.name,API_VERSION:apiVersion",
+			name: "ok",
+			expected: []Column{
+				{
+					Header:    "NAME",
+					FieldSpec: "{.metadata.name}",
+				},
+				{
+					Header:    "API_VERSION",
+					FieldSpec: "{.apiVersion}",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		printer, err := NewCustomColumnsPrinterFromSpec(test.spec)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("[%s] unexpected non-error", test.name)
+			}
+			continue
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("[%s] unexpected error: %v", test.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(test.expected, printer.Columns) {
+			t.Errorf("[%s]\nexpected:\n%v\nsaw:\n%v\n", test.name, test.expected, printer.Columns)
+		}
+	}
+}
+
+func TestNewColumnPrinterFromTemplate(t *testing.T) {
+	tests := []struct {
+		spec      string
+		expected  []Column
+		expectErr bool
+		name      string
+	}{
+		{
+			spec:      "",
+			expectErr: true,
+			name:      "empty",
+		},
+		{
+			spec:      "invalid",
+			expectErr: true,
+			name:      "invalid1",
+		},
+		{
+			spec:      "invalid=foobar",
+			expectErr: true,
+			name:      "invalid2",
+		},
+		{
+			spec:      "NAME AGE\n{.metadata.name}",
+			expectErr: true,
+			name:      "mismatched",
+		},
+		{
+			spec: "NAME    API_VERSION\n{metadata.name} {apiVersion}",
+			name: "ok",
+			expected: []Column{
+				{
+					Header:    "NAME",
+					FieldSpec: "{metadata.name}",
+				},
+				{
+					Header:    "API_VERSION",
+					FieldSpec: "{apiVersion}",
+				},
+			},
+		},
+		{
+			spec: "\nNAME    API_VERSION\n\n.metadata.name apiVersion\n",
+			name: "blank lines",
+			expected: []Column{
+				{
+					Header:    "NAME",
+					FieldSpec: "{.metadata.name}",
+				},
+				{
+					Header:    "API_VERSION",
+					FieldSpec: "{.apiVersion}",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		printer, err := NewCustomColumnsPrinterFromTemplate(strings.NewReader(test.spec))
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("[%s] unexpected non-error", test.name)
+			}
+			continue
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("[%s] unexpected error: %v", test.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(test.expected, printer.Columns) {
+			t.Errorf("[%s]\nexpected:\n%v\nsaw:\n%v\n", test.name, test.expected, printer.Columns)
+		}
+	}
+}
+
+func TestColumnPrint(t *testing.T) {
+	tests := []struct {
+		columns        []Column
+		obj            runtime.Object
+		expectedOutput string
+	}{
+		{
+			columns: []Column{
+				{
+					Header:    "NAME",
+					FieldSpec: "{.metadata.name}",
+				},
+			},
+			obj: &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}},
+			expectedOutput: `NAME
+foo
+`,
+		},
+		{
+			columns: []Column{
+				{
+					Header:    "NAME",
+					FieldSpec: "{.metadata.name}",
+				},
+			},
+			obj: &api.PodList{
+				Items: []api.Pod{
+					{ObjectMeta: api.ObjectMeta{Name: "foo"}},
+					{ObjectMeta: api.ObjectMeta{Name: "bar"}},
+				},
+			},
+			expectedOutput: `NAME
+foo
+bar
+`,
+		},
+		{
+			columns: []Column{
+				{
+					Header:    "NAME",
+					FieldSpec: "{.metadata.name}",
+				},
+				{
+					Header:    "IMAGES",
+					FieldSpec: "{.spec.containers[*].image}",
+				},
+				{
+					Header:    "NODE",
+					FieldSpec: "{.spec.nodeName}",
+				},
+			},
+			obj: &api.PodList{
+				Items: []api.Pod{
+					{
+						ObjectMeta: api.ObjectMeta{Name: "foo"},
+						Spec:       api.PodSpec{Containers: []api.Container{{Image: "nginx"}, {Image: "redis"}}},
+					},
+					{ObjectMeta: api.ObjectMeta{Name: "bar"}},
+				},
+			},
+			expectedOutput: `NAME      IMAGES        NODE
+foo       nginx redis   <none>
+bar       <none>        <none>
+`,
+		},
+	}
+
+	for _, test := range tests {
+		printer := &CustomColumnsPrinter{
+			Columns: test.columns,
+		}
+		buffer := &bytes.Buffer{}
+		if err := printer.PrintObj(test.obj, buffer); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if buffer.String() != test.expectedOutput {
+			t.Errorf("\nexpected:\n'%s'\nsaw\n'%s'\n", test.expectedOutput, buffer.String())
+		}
+	}
+}
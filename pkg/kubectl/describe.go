@@ -64,18 +64,23 @@ func (e ErrNoDescriber) Error() string {
 
 func describerMap(c *client.Client) map[string]Describer {
 	m := map[string]Describer{
-		"Pod": &PodDescriber{c},
-		"ReplicationController": &ReplicationControllerDescriber{c},
-		"Secret":                &SecretDescriber{c},
-		"Service":               &ServiceDescriber{c},
-		"ServiceAccount":        &ServiceAccountDescriber{c},
-		"Minion":                &NodeDescriber{c},
-		"Node":                  &NodeDescriber{c},
-		"LimitRange":            &LimitRangeDescriber{c},
-		"ResourceQuota":         &ResourceQuotaDescriber{c},
-		"PersistentVolume":      &PersistentVolumeDescriber{c},
-		"PersistentVolumeClaim": &PersistentVolumeClaimDescriber{c},
-		"Namespace":             &NamespaceDescriber{c},
+		"Pod":                     &PodDescriber{c},
+		"ReplicationController":   &ReplicationControllerDescriber{c},
+		"Job":                     &JobDescriber{c},
+		"DaemonSet":               &DaemonSetDescriber{c},
+		"Deployment":              &DeploymentDescriber{c},
+		"HorizontalPodAutoscaler": &HorizontalPodAutoscalerDescriber{c},
+		"Secret":                  &SecretDescriber{c},
+		"ConfigMap":               &ConfigMapDescriber{c},
+		"Service":                 &ServiceDescriber{c},
+		"ServiceAccount":          &ServiceAccountDescriber{c},
+		"Minion":                  &NodeDescriber{c},
+		"Node":                    &NodeDescriber{c},
+		"LimitRange":              &LimitRangeDescriber{c},
+		"ResourceQuota":           &ResourceQuotaDescriber{c},
+		"PersistentVolume":        &PersistentVolumeDescriber{c},
+		"PersistentVolumeClaim":   &PersistentVolumeClaimDescriber{c},
+		"Namespace":               &NamespaceDescriber{c},
 	}
 	return m
 }
@@ -112,6 +117,10 @@ func init() {
 		describePod,
 		describeService,
 		describeReplicationController,
+		describeJob,
+		describeDaemonSet,
+		describeDeployment,
+		describeHorizontalPodAutoscaler,
 		describeNode,
 		describeNamespace,
 	)
@@ -579,6 +588,191 @@ func describeReplicationController(controller *api.ReplicationController, events
 	})
 }
 
+// JobDescriber generates information about a job and the pods it has created.
+type JobDescriber struct {
+	client.Interface
+}
+
+func (d *JobDescriber) Describe(namespace, name string) (string, error) {
+	job, err := d.Jobs(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(job)
+
+	return describeJob(job, events)
+}
+
+func describeJob(job *api.Job, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", job.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", job.Namespace)
+		if job.Spec.Template != nil {
+			fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&job.Spec.Template.Spec))
+		} else {
+			fmt.Fprintf(out, "Image(s):\t%s\n", "<no template>")
+		}
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(job.Spec.Selector))
+		if job.Spec.Parallelism != nil {
+			fmt.Fprintf(out, "Parallelism:\t%d\n", *job.Spec.Parallelism)
+		}
+		if job.Spec.Completions != nil {
+			fmt.Fprintf(out, "Completions:\t%d\n", *job.Spec.Completions)
+		}
+		if job.Status.StartTime != nil {
+			fmt.Fprintf(out, "Start Time:\t%s\n", job.Status.StartTime.Time.Format(time.RFC1123Z))
+		}
+		if job.Spec.ActiveDeadlineSeconds != nil {
+			fmt.Fprintf(out, "Active Deadline Seconds:\t%ds\n", *job.Spec.ActiveDeadlineSeconds)
+		}
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(job.Labels))
+		fmt.Fprintf(out, "Pods Statuses:\t%d Running / %d Succeeded / %d Failed\n", job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+		if events != nil {
+			DescribeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// DaemonSetDescriber generates information about a daemon set and the pods it has created.
+type DaemonSetDescriber struct {
+	client.Interface
+}
+
+func (d *DaemonSetDescriber) Describe(namespace, name string) (string, error) {
+	dc := d.DaemonSets(namespace)
+	pc := d.Pods(namespace)
+
+	daemon, err := dc.Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	running, waiting, succeeded, failed, err := getPodStatusForSelector(pc, daemon.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(daemon)
+
+	return describeDaemonSet(daemon, events, running, waiting, succeeded, failed)
+}
+
+func describeDaemonSet(daemon *api.DaemonSet, events *api.EventList, running, waiting, succeeded, failed int) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", daemon.Name)
+		if daemon.Spec.Template != nil {
+			fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&daemon.Spec.Template.Spec))
+			fmt.Fprintf(out, "Node-Selector:\t%s\n", formatLabels(daemon.Spec.Template.Spec.NodeSelector))
+		} else {
+			fmt.Fprintf(out, "Image(s):\t%s\n", "<no template>")
+		}
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(daemon.Spec.Selector))
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(daemon.Labels))
+		fmt.Fprintf(out, "Desired Number of Nodes Scheduled:\t%d\n", daemon.Status.DesiredNumberScheduled)
+		fmt.Fprintf(out, "Current Number of Nodes Scheduled:\t%d\n", daemon.Status.CurrentNumberScheduled)
+		fmt.Fprintf(out, "Number of Nodes Misscheduled:\t%d\n", daemon.Status.NumberMisscheduled)
+		fmt.Fprintf(out, "Pods Status:\t%d Running / %d Waiting / %d Succeeded / %d Failed\n", running, waiting, succeeded, failed)
+		if events != nil {
+			DescribeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// DeploymentDescriber generates information about a deployment and the pods it selects.
+type DeploymentDescriber struct {
+	client.Interface
+}
+
+func (d *DeploymentDescriber) Describe(namespace, name string) (string, error) {
+	deployment, err := d.Deployments(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	running, waiting, succeeded, failed, err := getPodStatusForSelector(d.Pods(namespace), deployment.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(deployment)
+
+	return describeDeployment(deployment, events, running, waiting, succeeded, failed)
+}
+
+func describeDeployment(deployment *api.Deployment, events *api.EventList, running, waiting, succeeded, failed int) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", deployment.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", deployment.Namespace)
+		if deployment.Spec.Template != nil {
+			fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&deployment.Spec.Template.Spec))
+		} else {
+			fmt.Fprintf(out, "Image(s):\t%s\n", "<no template>")
+		}
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(deployment.Spec.Selector))
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(deployment.Labels))
+		fmt.Fprintf(out, "Strategy:\t%s\n", deployment.Spec.Strategy.Type)
+		if ru := deployment.Spec.Strategy.RollingUpdate; ru != nil {
+			fmt.Fprintf(out, "Rolling Update:\t%s max unavailable, %s max surge, %d min ready seconds\n", ru.MaxUnavailable.String(), ru.MaxSurge.String(), ru.MinReadySeconds)
+		}
+		fmt.Fprintf(out, "Replicas:\t%d updated / %d total / %d desired\n", deployment.Status.UpdatedReplicas, deployment.Status.Replicas, deployment.Spec.Replicas)
+		fmt.Fprintf(out, "Pods Status:\t%d Running / %d Waiting / %d Succeeded / %d Failed\n", running, waiting, succeeded, failed)
+		if events != nil {
+			DescribeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// HorizontalPodAutoscalerDescriber generates information about a horizontal pod autoscaler.
+type HorizontalPodAutoscalerDescriber struct {
+	client.Interface
+}
+
+func (d *HorizontalPodAutoscalerDescriber) Describe(namespace, name string) (string, error) {
+	hpa, err := d.HorizontalPodAutoscalers(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(hpa)
+
+	return describeHorizontalPodAutoscaler(hpa, events)
+}
+
+func describeHorizontalPodAutoscaler(hpa *api.HorizontalPodAutoscaler, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", hpa.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", hpa.Namespace)
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(hpa.Labels))
+		fmt.Fprintf(out, "Reference:\t%s/%s\n", hpa.Spec.ScaleRef.Kind, hpa.Spec.ScaleRef.Name)
+		if hpa.Spec.CPUUtilization != nil {
+			fmt.Fprintf(out, "Target CPU utilization:\t%d%%\n", hpa.Spec.CPUUtilization.TargetPercentage)
+		} else {
+			fmt.Fprintf(out, "Target CPU utilization:\t<default>\n")
+		}
+		if hpa.Status.CurrentCPUUtilizationPercentage != nil {
+			fmt.Fprintf(out, "Current CPU utilization:\t%d%%\n", *hpa.Status.CurrentCPUUtilizationPercentage)
+		} else {
+			fmt.Fprintf(out, "Current CPU utilization:\t<not available>\n")
+		}
+		if hpa.Spec.MinReplicas != nil {
+			fmt.Fprintf(out, "Min replicas:\t%d\n", *hpa.Spec.MinReplicas)
+		}
+		fmt.Fprintf(out, "Max replicas:\t%d\n", hpa.Spec.MaxReplicas)
+		fmt.Fprintf(out, "Replicas:\t%d current / %d desired\n", hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas)
+		if hpa.Status.LastScaleTime != nil {
+			fmt.Fprintf(out, "Last scale time:\t%s\n", hpa.Status.LastScaleTime.Time.Format(time.RFC1123Z))
+		}
+		if events != nil {
+			DescribeEvents(events, out)
+		}
+		return nil
+	})
+}
+
 // SecretDescriber generates information about a secret
 type SecretDescriber struct {
 	client.Interface
@@ -617,6 +811,41 @@ func describeSecret(secret *api.Secret) (string, error) {
 	})
 }
 
+// ConfigMapDescriber generates information about a ConfigMap
+type ConfigMapDescriber struct {
+	client.Interface
+}
+
+func (d *ConfigMapDescriber) Describe(namespace, name string) (string, error) {
+	configMap, err := d.ConfigMaps(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return describeConfigMap(configMap)
+}
+
+func describeConfigMap(configMap *api.ConfigMap) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", configMap.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", configMap.Namespace)
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(configMap.Labels))
+		fmt.Fprintf(out, "Annotations:\t%s\n", formatLabels(configMap.Annotations))
+
+		fmt.Fprintf(out, "\nData\n====\n")
+		keys := make([]string, 0, len(configMap.Data))
+		for k := range configMap.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(out, "%s:\t%d bytes\n", k, len(configMap.Data[k]))
+		}
+
+		return nil
+	})
+}
+
 // ServiceDescriber generates information about a service.
 type ServiceDescriber struct {
 	client.Interface
@@ -896,7 +1125,12 @@ func printReplicationControllersByLabels(matchingRCs []api.ReplicationController
 }
 
 func getPodStatusForReplicationController(c client.PodInterface, controller *api.ReplicationController) (running, waiting, succeeded, failed int, err error) {
-	rcPods, err := c.List(labels.SelectorFromSet(controller.Spec.Selector), fields.Everything())
+	return getPodStatusForSelector(c, controller.Spec.Selector)
+}
+
+// getPodStatusForSelector counts the pods matching the given selector by phase.
+func getPodStatusForSelector(c client.PodInterface, selector map[string]string) (running, waiting, succeeded, failed int, err error) {
+	rcPods, err := c.List(labels.SelectorFromSet(selector), fields.Everything())
 	if err != nil {
 		return
 	}
@@ -78,6 +78,147 @@ func TestDescribeService(t *testing.T) {
 	}
 }
 
+func TestDescribeJob(t *testing.T) {
+	completions := 5
+	fake := testclient.NewSimpleFake(&api.Job{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Spec: api.JobSpec{
+			Completions: &completions,
+		},
+		Status: api.JobStatus{
+			Succeeded: 3,
+		},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := JobDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "bar") || !strings.Contains(out, "Completions:") || !strings.Contains(out, "3 Succeeded") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribeDaemonSet(t *testing.T) {
+	fake := testclient.NewSimpleFake(&api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Spec: api.DaemonSetSpec{
+			Selector: map[string]string{"app": "logging"},
+			Template: &api.PodTemplateSpec{
+				Spec: api.PodSpec{
+					NodeSelector: map[string]string{"logging": "enabled"},
+				},
+			},
+		},
+		Status: api.DaemonSetStatus{
+			DesiredNumberScheduled: 3,
+		},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := DaemonSetDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "bar") || !strings.Contains(out, "logging=enabled") || !strings.Contains(out, "Desired Number of Nodes Scheduled:") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribeDeployment(t *testing.T) {
+	fake := testclient.NewSimpleFake(&api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Spec: api.DeploymentSpec{
+			Replicas: 3,
+			Selector: map[string]string{"app": "web"},
+			Template: &api.PodTemplateSpec{},
+			Strategy: api.DeploymentStrategy{
+				Type: api.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &api.RollingUpdateDeployment{
+					MaxUnavailable: util.NewIntOrStringFromInt(1),
+					MaxSurge:       util.NewIntOrStringFromString("25%"),
+				},
+			},
+		},
+		Status: api.DeploymentStatus{
+			Replicas:        3,
+			UpdatedReplicas: 1,
+		},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := DeploymentDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "bar") || !strings.Contains(out, "25% max surge") || !strings.Contains(out, "1 updated / 3 total / 3 desired") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribeHorizontalPodAutoscaler(t *testing.T) {
+	minReplicas := 2
+	utilization := 45
+	fake := testclient.NewSimpleFake(&api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:       api.ScaleReference{Kind: "ReplicationController", Name: "frontend"},
+			MinReplicas:    &minReplicas,
+			MaxReplicas:    5,
+			CPUUtilization: &api.CPUTargetUtilization{TargetPercentage: 70},
+		},
+		Status: api.HorizontalPodAutoscalerStatus{
+			CurrentReplicas:                 3,
+			DesiredReplicas:                 2,
+			CurrentCPUUtilizationPercentage: &utilization,
+		},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := HorizontalPodAutoscalerDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "ReplicationController/frontend") || !strings.Contains(out, "45%") || !strings.Contains(out, "3 current / 2 desired") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribeConfigMap(t *testing.T) {
+	fake := testclient.NewSimpleFake(&api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Data: map[string]string{
+			"game.properties": "lives=3",
+			"ui.properties":   "color=blue",
+		},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := ConfigMapDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "game.properties") || !strings.Contains(out, "7 bytes") || !strings.Contains(out, "10 bytes") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
 func TestPodDescribeResultsSorted(t *testing.T) {
 	// Arrange
 	fake := testclient.NewSimpleFake(&api.EventList{
@@ -0,0 +1,98 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"reflect"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// NormalizeForDiff prepares a local configuration and the live object it
+// describes for comparison. The status, the metadata populated by the system
+// and the annotation kubectl apply records the configuration in are cleared
+// from both, and fields the server allocates when the configuration leaves
+// them empty are copied from live into local. Defaults are expected to be
+// applied to local already, as they are when it is decoded. live is nil if
+// the object does not exist yet.
+func NormalizeForDiff(local, live runtime.Object) error {
+	for _, obj := range []runtime.Object{local, live} {
+		if obj == nil {
+			continue
+		}
+		if err := clearSystemFields(obj); err != nil {
+			return err
+		}
+	}
+
+	switch t := local.(type) {
+	case *api.Pod:
+		if pod, ok := live.(*api.Pod); ok {
+			fillAllocated(&t.Spec.NodeName, pod.Spec.NodeName)
+			fillAllocated(&t.Spec.ServiceAccountName, pod.Spec.ServiceAccountName)
+		}
+	case *api.Service:
+		if svc, ok := live.(*api.Service); ok {
+			fillAllocated(&t.Spec.ClusterIP, svc.Spec.ClusterIP)
+			for i := range t.Spec.Ports {
+				if i < len(svc.Spec.Ports) && t.Spec.Ports[i].NodePort == 0 {
+					t.Spec.Ports[i].NodePort = svc.Spec.Ports[i].NodePort
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// clearSystemFields clears the status of obj and the fields of its metadata
+// that are managed by the server.
+func clearSystemFields(obj runtime.Object) error {
+	meta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return err
+	}
+	meta.SelfLink = ""
+	meta.UID = ""
+	meta.ResourceVersion = ""
+	meta.Generation = 0
+	meta.CreationTimestamp = util.Time{}
+	meta.DeletionTimestamp = nil
+	delete(meta.Annotations, LastAppliedConfigAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+
+	v, err := conversion.EnforcePtr(obj)
+	if err != nil {
+		return err
+	}
+	if status := v.FieldByName("Status"); status.IsValid() && status.CanSet() {
+		status.Set(reflect.Zero(status.Type()))
+	}
+	return nil
+}
+
+// fillAllocated sets field to the value allocated by the server if the
+// configuration left it empty.
+func fillAllocated(field *string, allocated string) {
+	if len(*field) == 0 {
+		*field = allocated
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func TestNormalizeForDiff(t *testing.T) {
+	local := &api.Service{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: api.ServiceSpec{
+			Ports: []api.ServicePort{{Port: 80}},
+		},
+	}
+	now := util.Now()
+	live := &api.Service{
+		ObjectMeta: api.ObjectMeta{
+			Name:              "foo",
+			Namespace:         "bar",
+			UID:               "uid",
+			ResourceVersion:   "10",
+			SelfLink:          "/api/v1/namespaces/bar/services/foo",
+			CreationTimestamp: now,
+			DeletionTimestamp: &now,
+			Annotations:       map[string]string{LastAppliedConfigAnnotation: "{}"},
+		},
+		Spec: api.ServiceSpec{
+			ClusterIP: "10.0.0.1",
+			Ports:     []api.ServicePort{{Port: 80, NodePort: 30000}},
+		},
+		Status: api.ServiceStatus{
+			LoadBalancer: api.LoadBalancerStatus{Ingress: []api.LoadBalancerIngress{{IP: "1.2.3.4"}}},
+		},
+	}
+
+	if err := NormalizeForDiff(local, live); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(local, live) {
+		t.Errorf("expected the normalized objects to be equal: %s", util.ObjectDiff(local, live))
+	}
+
+	// A missing live object only clears the local one.
+	local.ResourceVersion = "10"
+	if err := NormalizeForDiff(local, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if local.ResourceVersion != "" {
+		t.Errorf("expected the resource version to be cleared, got %q", local.ResourceVersion)
+	}
+}
@@ -104,7 +104,9 @@ func expandResourceShortcut(resource string) string {
 	shortForms := map[string]string{
 		// Please keep this alphabetized
 		"cs":     "componentstatuses",
+		"ds":     "daemonsets",
 		"ev":     "events",
+		"hpa":    "horizontalpodautoscalers",
 		"limits": "limitRanges",
 		"no":     "nodes",
 		"po":     "pods",
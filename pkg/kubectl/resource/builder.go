@@ -87,9 +87,10 @@ func (b *Builder) Schema(schema validation.Schema) *Builder {
 }
 
 // Filename is parameters passed via a filename argument which may be URLs, the "-" argument indicating
-// STDIN, or paths to files or directories. If ContinueOnError() is set prior to this method being called,
-// objects on the path that are unrecognized will be ignored (but logged at V(2)).
-func (b *Builder) FilenameParam(paths ...string) *Builder {
+// STDIN, or paths to files or directories. Directories are walked recursively if recursive is set. If
+// ContinueOnError() is set prior to this method being called, objects on the path that are unrecognized
+// will be ignored (but logged at V(2)).
+func (b *Builder) FilenameParam(recursive bool, paths ...string) *Builder {
 	for _, s := range paths {
 		switch {
 		case s == "-":
@@ -102,7 +103,7 @@ func (b *Builder) FilenameParam(paths ...string) *Builder {
 			}
 			b.URL(url)
 		default:
-			b.Path(s)
+			b.Path(recursive, s)
 		}
 	}
 	return b
@@ -138,9 +139,11 @@ func (b *Builder) Stream(r io.Reader, name string) *Builder {
 }
 
 // Path is a set of filesystem paths that may be files containing one or more
-// resources. If ContinueOnError() is set prior to this method being called,
-// objects on the path that are unrecognized will be ignored (but logged at V(2)).
-func (b *Builder) Path(paths ...string) *Builder {
+// resources. Directories are walked for files with a known extension, and
+// their subdirectories too if recursive is set. If ContinueOnError() is set
+// prior to this method being called, objects on the path that are unrecognized
+// will be ignored (but logged at V(2)).
+func (b *Builder) Path(recursive bool, paths ...string) *Builder {
 	for _, p := range paths {
 		i, err := os.Stat(p)
 		if os.IsNotExist(err) {
@@ -158,7 +161,7 @@ func (b *Builder) Path(paths ...string) *Builder {
 				Mapper:       b.mapper,
 				Path:         p,
 				Extensions:   []string{".json", ".yaml", ".yml"},
-				Recursive:    false,
+				Recursive:    recursive,
 				IgnoreErrors: b.continueOnError,
 				Schema:       b.schema,
 			}
@@ -592,7 +595,10 @@ func (b *Builder) visitorResult() *Result {
 			if b.flatten {
 				visitors = NewFlattenListVisitor(visitors, b.mapper)
 			}
-			visitors = NewDecoratedVisitor(visitors, RetrieveLatest)
+			// the namespace must be known before the items can be fetched
+			helpers := b.namespaceHelpers()
+			helpers = append(helpers, RetrieveLatest)
+			visitors = NewDecoratedVisitor(visitors, helpers...)
 		}
 		return &Result{singular: singular, visitor: visitors, sources: b.paths}
 	}
@@ -612,14 +618,7 @@ func (b *Builder) Do() *Result {
 	if b.flatten {
 		r.visitor = NewFlattenListVisitor(r.visitor, b.mapper)
 	}
-	helpers := []VisitorFunc{}
-	if b.defaultNamespace {
-		helpers = append(helpers, SetNamespace(b.namespace))
-	}
-	if b.requireNamespace {
-		helpers = append(helpers, RequireNamespace(b.namespace))
-	}
-	helpers = append(helpers, FilterNamespace)
+	helpers := b.namespaceHelpers()
 	if b.requireObject {
 		helpers = append(helpers, RetrieveLazy)
 	}
@@ -630,6 +629,20 @@ func (b *Builder) Do() *Result {
 	return r
 }
 
+// namespaceHelpers returns the visitor functions that default, require and
+// filter the namespace of each item as requested on the Builder.
+func (b *Builder) namespaceHelpers() []VisitorFunc {
+	helpers := []VisitorFunc{}
+	if b.defaultNamespace {
+		helpers = append(helpers, SetNamespace(b.namespace))
+	}
+	if b.requireNamespace {
+		helpers = append(helpers, RequireNamespace(b.namespace))
+	}
+	helpers = append(helpers, FilterNamespace)
+	return helpers
+}
+
 // SplitResourceArgument splits the argument with commas and returns unique
 // strings in the original order.
 func SplitResourceArgument(arg string) []string {
@@ -23,7 +23,10 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/ghodss/yaml"
@@ -177,7 +180,7 @@ func (v *testVisitor) Objects() []runtime.Object {
 
 func TestPathBuilder(t *testing.T) {
 	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
-		FilenameParam("../../../examples/guestbook/redis-master-controller.yaml")
+		FilenameParam(false, "../../../examples/guestbook/redis-master-controller.yaml")
 
 	test := &testVisitor{}
 	singular := false
@@ -227,8 +230,8 @@ func TestNodeBuilder(t *testing.T) {
 
 func TestPathBuilderWithMultiple(t *testing.T) {
 	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
-		FilenameParam("../../../examples/guestbook/redis-master-controller.yaml").
-		FilenameParam("../../../examples/guestbook/redis-master-controller.yaml").
+		FilenameParam(false, "../../../examples/guestbook/redis-master-controller.yaml").
+		FilenameParam(false, "../../../examples/guestbook/redis-master-controller.yaml").
 		NamespaceParam("test").DefaultNamespace()
 
 	test := &testVisitor{}
@@ -247,7 +250,7 @@ func TestPathBuilderWithMultiple(t *testing.T) {
 
 func TestDirectoryBuilder(t *testing.T) {
 	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
-		FilenameParam("../../../examples/guestbook").
+		FilenameParam(false, "../../../examples/guestbook").
 		NamespaceParam("test").DefaultNamespace()
 
 	test := &testVisitor{}
@@ -269,6 +272,121 @@ func TestDirectoryBuilder(t *testing.T) {
 	}
 }
 
+func writeTestFile(t *testing.T, path string, contents string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDirectoryBuilderRecursive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "builder")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	pod := "apiVersion: v1\nkind: Pod\nmetadata:\n  name: %s\n"
+	writeTestFile(t, filepath.Join(dir, "top.yaml"), fmt.Sprintf(pod, "top"))
+	writeTestFile(t, filepath.Join(dir, "nested", "inner.yml"), fmt.Sprintf(pod, "inner"))
+	writeTestFile(t, filepath.Join(dir, "nested", "deeper", "deepest.json"), `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "deepest"}}`)
+	writeTestFile(t, filepath.Join(dir, "nested", "README.md"), "not a resource")
+
+	testCases := map[bool][]string{
+		false: {"top"},
+		true:  {"deepest", "inner", "top"},
+	}
+	for recursive, expected := range testCases {
+		test := &testVisitor{}
+		err := NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
+			FilenameParam(recursive, dir).
+			NamespaceParam("test").DefaultNamespace().
+			Do().Visit(test.Handle)
+		if err != nil {
+			t.Fatalf("%t: unexpected error: %v", recursive, err)
+		}
+		names := []string{}
+		for _, info := range test.Infos {
+			names = append(names, info.Name)
+		}
+		if !reflect.DeepEqual(expected, names) {
+			t.Errorf("%t: expected %v, got %v", recursive, expected, names)
+		}
+	}
+}
+
+func TestPathBuilderMultipleDocuments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "builder")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "multi.yaml")
+	writeTestFile(t, path, `---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: foo
+---
+# an empty document is skipped
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: bar
+`)
+
+	test := &testVisitor{}
+	err = NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
+		FilenameParam(false, path).
+		NamespaceParam("test").DefaultNamespace().
+		Do().Visit(test.Handle)
+	if err != nil || len(test.Infos) != 2 {
+		t.Fatalf("unexpected response: %v %#v", err, test.Infos)
+	}
+	if _, ok := test.Infos[0].Object.(*api.Pod); !ok || test.Infos[0].Name != "foo" {
+		t.Errorf("unexpected info: %#v", test.Infos[0])
+	}
+	if _, ok := test.Infos[1].Object.(*api.Service); !ok || test.Infos[1].Name != "bar" {
+		t.Errorf("unexpected info: %#v", test.Infos[1])
+	}
+}
+
+func TestPathBuilderDocumentError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "builder")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "multi.yaml")
+	writeTestFile(t, path, `apiVersion: v1
+kind: Pod
+metadata:
+  name: foo
+---
+apiVersion: v1
+kind: Unknown
+metadata:
+  name: bar
+`)
+
+	test := &testVisitor{}
+	err = NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
+		FilenameParam(false, path).
+		NamespaceParam("test").DefaultNamespace().
+		Do().Visit(test.Handle)
+	if err == nil || !strings.Contains(err.Error(), path+", document 2") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(test.Infos) != 1 {
+		t.Errorf("unexpected response: %#v", test.Infos)
+	}
+}
+
 func TestURLBuilder(t *testing.T) {
 	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -277,7 +395,7 @@ func TestURLBuilder(t *testing.T) {
 	defer s.Close()
 
 	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
-		FilenameParam(s.URL).
+		FilenameParam(false, s.URL).
 		NamespaceParam("test")
 
 	test := &testVisitor{}
@@ -301,7 +419,7 @@ func TestURLBuilderRequireNamespace(t *testing.T) {
 	defer s.Close()
 
 	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
-		FilenameParam(s.URL).
+		FilenameParam(false, s.URL).
 		NamespaceParam("test").RequireNamespace()
 
 	test := &testVisitor{}
@@ -640,7 +758,7 @@ func TestContinueOnErrorVisitor(t *testing.T) {
 func TestSingularObject(t *testing.T) {
 	obj, err := NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
 		NamespaceParam("test").DefaultNamespace().
-		FilenameParam("../../../examples/guestbook/redis-master-controller.yaml").
+		FilenameParam(false, "../../../examples/guestbook/redis-master-controller.yaml").
 		Flatten().
 		Do().Object()
 
@@ -751,7 +869,7 @@ func TestWatch(t *testing.T) {
 		}),
 	})).
 		NamespaceParam("test").DefaultNamespace().
-		FilenameParam("../../../examples/guestbook/redis-master-service.yaml").Flatten().
+		FilenameParam(false, "../../../examples/guestbook/redis-master-service.yaml").Flatten().
 		Do().Watch("12")
 
 	if err != nil {
@@ -778,8 +896,8 @@ func TestWatch(t *testing.T) {
 func TestWatchMultipleError(t *testing.T) {
 	_, err := NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
 		NamespaceParam("test").DefaultNamespace().
-		FilenameParam("../../../examples/guestbook/redis-master-controller.yaml").Flatten().
-		FilenameParam("../../../examples/guestbook/redis-master-controller.yaml").Flatten().
+		FilenameParam(false, "../../../examples/guestbook/redis-master-controller.yaml").Flatten().
+		FilenameParam(false, "../../../examples/guestbook/redis-master-controller.yaml").Flatten().
 		Do().Watch("")
 
 	if err == nil {
@@ -818,6 +936,30 @@ func TestLatest(t *testing.T) {
 	}
 }
 
+func TestLatestWithDefaultNamespace(t *testing.T) {
+	newRC := &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{Name: "redis-master", Namespace: "test", ResourceVersion: "13"},
+	}
+
+	// the file doesn't carry a namespace, so the default one must be set before
+	// the latest version is fetched
+	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClientWith("", t, map[string]string{
+		"/namespaces/test/replicationcontrollers/redis-master": runtime.EncodeOrDie(latest.Codec, newRC),
+	})).
+		NamespaceParam("test").DefaultNamespace().
+		FilenameParam(false, "../../../examples/guestbook/redis-master-controller.yaml").
+		Flatten().Latest()
+
+	test := &testVisitor{}
+	err := b.Do().Visit(test.Handle)
+	if err != nil || len(test.Infos) != 1 {
+		t.Fatalf("unexpected response: %v %#v", err, test.Infos)
+	}
+	if !api.Semantic.DeepDerivative([]runtime.Object{newRC}, test.Objects()) {
+		t.Errorf("unexpected visited objects: %#v", test.Objects())
+	}
+}
+
 func TestIgnoreStreamErrors(t *testing.T) {
 	pods, svc := testData()
 
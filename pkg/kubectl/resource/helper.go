@@ -19,6 +19,7 @@ package resource
 import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
@@ -39,6 +40,9 @@ type Helper struct {
 	Versioner runtime.ResourceVersioner
 	// True if the resource type is scoped to namespaces
 	NamespaceScoped bool
+	// True if mutations should be validated and admitted by the server
+	// without being persisted
+	DryRun bool
 }
 
 // NewHelper creates a Helper from a ResourceMapping
@@ -93,7 +97,7 @@ func (m *Helper) WatchSingle(namespace, name, resourceVersion string) (watch.Int
 }
 
 func (m *Helper) Delete(namespace, name string) error {
-	return m.RESTClient.Delete().
+	return m.mutation(m.RESTClient.Delete()).
 		NamespaceIfScoped(namespace, m.NamespaceScoped).
 		Resource(m.Resource).
 		Name(name).
@@ -131,10 +135,11 @@ func (m *Helper) Create(namespace string, modify bool, data []byte) (runtime.Obj
 }
 
 func (m *Helper) createResource(c RESTClient, resource, namespace string, data []byte) (runtime.Object, error) {
-	return c.Post().NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Body(data).Do().Get()
+	return m.mutation(c.Post()).NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Body(data).Do().Get()
 }
+
 func (m *Helper) Patch(namespace, name string, pt api.PatchType, data []byte) (runtime.Object, error) {
-	return m.RESTClient.Patch(pt).
+	return m.mutation(m.RESTClient.Patch(pt)).
 		NamespaceIfScoped(namespace, m.NamespaceScoped).
 		Resource(m.Resource).
 		Name(name).
@@ -183,5 +188,13 @@ func (m *Helper) Update(namespace, name string, overwrite bool, data []byte) (ru
 }
 
 func (m *Helper) updateResource(c RESTClient, resource, namespace, name string, data []byte) (runtime.Object, error) {
-	return c.Put().NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Name(name).Body(data).Do().Get()
+	return m.mutation(c.Put()).NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Name(name).Body(data).Do().Get()
+}
+
+// mutation marks a mutating request as a dry run if the helper is configured for one.
+func (m *Helper) mutation(req *client.Request) *client.Request {
+	if m.DryRun {
+		return req.Param("dryRun", "true")
+	}
+	return req
 }
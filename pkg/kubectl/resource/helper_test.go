@@ -481,3 +481,50 @@ func TestHelperUpdate(t *testing.T) {
 		}
 	}
 }
+
+func TestHelperDryRun(t *testing.T) {
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "10"}}
+	data := []byte(runtime.EncodeOrDie(testapi.Codec(), pod))
+	tests := map[string]func(*Helper) error{
+		"create": func(m *Helper) error {
+			_, err := m.Create("bar", false, data)
+			return err
+		},
+		"update": func(m *Helper) error {
+			_, err := m.Update("bar", "foo", false, data)
+			return err
+		},
+		"patch": func(m *Helper) error {
+			_, err := m.Patch("bar", "foo", api.MergePatchType, []byte(`{}`))
+			return err
+		},
+		"delete": func(m *Helper) error {
+			return m.Delete("bar", "foo")
+		},
+	}
+	for name, test := range tests {
+		for _, dryRun := range []bool{false, true} {
+			client := &client.FakeRESTClient{
+				Codec: testapi.Codec(),
+				Resp: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       objBody(pod),
+				},
+			}
+			modifier := &Helper{
+				RESTClient:      client,
+				Codec:           testapi.Codec(),
+				Versioner:       testapi.MetadataAccessor(),
+				NamespaceScoped: true,
+				DryRun:          dryRun,
+			}
+			if err := test(modifier); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if e, a := dryRun, client.Req.URL.Query().Get("dryRun") == "true"; e != a {
+				t.Errorf("%s: expected dry run %t, got request %s", name, e, client.Req.URL)
+			}
+		}
+	}
+}
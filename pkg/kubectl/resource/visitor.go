@@ -204,8 +204,8 @@ func ValidateSchema(data []byte, schema validation.Schema) error {
 	return nil
 }
 
-// PathVisitor visits a given path and returns an object representing the file
-// at that path.
+// PathVisitor visits a given path and returns an object for each document in
+// the file at that path.
 type PathVisitor struct {
 	*Mapper
 	// The file path to load
@@ -217,22 +217,12 @@ type PathVisitor struct {
 }
 
 func (v *PathVisitor) Visit(fn VisitorFunc) error {
-	data, err := ioutil.ReadFile(v.Path)
+	f, err := os.Open(v.Path)
 	if err != nil {
 		return fmt.Errorf("unable to read %q: %v", v.Path, err)
 	}
-	if err := ValidateSchema(data, v.Schema); err != nil {
-		return fmt.Errorf("error validating %q: %v", v.Path, err)
-	}
-	info, err := v.Mapper.InfoForData(data, v.Path)
-	if err != nil {
-		if !v.IgnoreErrors {
-			return err
-		}
-		fmt.Fprintf(os.Stderr, "error: unable to load file %q: %v\n", v.Path, err)
-		return nil
-	}
-	return fn(info)
+	defer f.Close()
+	return NewStreamVisitor(f, v.Mapper, v.Schema, v.Path, v.IgnoreErrors).Visit(fn)
 }
 
 // DirectoryVisitor loads the specified files from a directory and passes them
@@ -280,22 +270,13 @@ func (v *DirectoryVisitor) Visit(fn VisitorFunc) error {
 			return nil
 		}
 
-		data, err := ioutil.ReadFile(path)
-		if err != nil {
-			return fmt.Errorf("unable to read %q: %v", path, err)
-		}
-		if err := ValidateSchema(data, v.Schema); err != nil {
-			return fmt.Errorf("error validating %q: %v", path, err)
+		visitor := &PathVisitor{
+			Mapper:       v.Mapper,
+			Path:         path,
+			IgnoreErrors: v.IgnoreErrors,
+			Schema:       v.Schema,
 		}
-		info, err := v.Mapper.InfoForData(data, path)
-		if err != nil {
-			if !v.IgnoreErrors {
-				return err
-			}
-			fmt.Fprintf(os.Stderr, "error: unable to load file %q: %v\n", path, err)
-			return nil
-		}
-		return fn(info)
+		return visitor.Visit(fn)
 	})
 }
 
@@ -459,38 +440,38 @@ func NewStreamVisitor(r io.Reader, mapper *Mapper, schema validation.Schema, sou
 	return &StreamVisitor{r, mapper, source, ignoreErrors, schema}
 }
 
-// Visit implements Visitor over a stream.
+// Visit implements Visitor over a stream. Errors name the source and the
+// position of the document in the stream.
 func (v *StreamVisitor) Visit(fn VisitorFunc) error {
 	d := yaml.NewYAMLOrJSONDecoder(v.Reader, 4096)
-	for {
+	for doc := 1; ; doc++ {
 		ext := runtime.RawExtension{}
 		if err := d.Decode(&ext); err != nil {
 			if err == io.EOF {
 				return nil
 			}
-			return err
+			return fmt.Errorf("error parsing %s, document %d: %v", v.Source, doc, err)
 		}
 		ext.RawJSON = bytes.TrimSpace(ext.RawJSON)
 		if len(ext.RawJSON) == 0 || bytes.Equal(ext.RawJSON, []byte("null")) {
 			continue
 		}
 		if err := ValidateSchema(ext.RawJSON, v.Schema); err != nil {
-			return err
+			return fmt.Errorf("error validating %s, document %d: %v", v.Source, doc, err)
 		}
 		info, err := v.InfoForData(ext.RawJSON, v.Source)
 		if err != nil {
 			if v.IgnoreErrors {
-				fmt.Fprintf(os.Stderr, "error: could not read an encoded object from %s: %v\n", v.Source, err)
+				fmt.Fprintf(os.Stderr, "error: could not read an encoded object from %s, document %d: %v\n", v.Source, doc, err)
 				glog.V(4).Infof("Unreadable: %s", string(ext.RawJSON))
 				continue
 			}
-			return err
+			return fmt.Errorf("unable to decode %s, document %d: %v", v.Source, doc, err)
 		}
 		if err := fn(info); err != nil {
 			return err
 		}
 	}
-	return nil
 }
 
 func UpdateObjectNamespace(info *Info) error {
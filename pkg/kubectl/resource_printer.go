@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"reflect"
 	"sort"
 	"strings"
@@ -34,6 +35,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/jsonpath"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume"
 	"github.com/ghodss/yaml"
 	"github.com/golang/glog"
@@ -72,6 +74,29 @@ func GetPrinter(format, formatArgument string) (ResourcePrinter, bool, error) {
 		if err != nil {
 			return nil, false, fmt.Errorf("error parsing template %s, %v\n", string(data), err)
 		}
+	case "jsonpath":
+		if len(formatArgument) == 0 {
+			return nil, false, fmt.Errorf("jsonpath template format specified but no template given")
+		}
+		var err error
+		printer, err = NewJSONPathPrinter(formatArgument)
+		if err != nil {
+			return nil, false, fmt.Errorf("error parsing jsonpath %s, %v\n", formatArgument, err)
+		}
+	case "custom-columns":
+		var err error
+		if printer, err = NewCustomColumnsPrinterFromSpec(formatArgument); err != nil {
+			return nil, false, err
+		}
+	case "custom-columns-file":
+		file, err := os.Open(formatArgument)
+		if err != nil {
+			return nil, false, fmt.Errorf("error reading template %s, %v\n", formatArgument, err)
+		}
+		defer file.Close()
+		if printer, err = NewCustomColumnsPrinterFromTemplate(file); err != nil {
+			return nil, false, err
+		}
 	case "":
 		return nil, false, nil
 	default:
@@ -252,6 +277,10 @@ func (h *HumanReadablePrinter) HandledResources() []string {
 var podColumns = []string{"NAME", "READY", "REASON", "RESTARTS", "AGE"}
 var podTemplateColumns = []string{"TEMPLATE", "CONTAINER(S)", "IMAGE(S)", "PODLABELS"}
 var replicationControllerColumns = []string{"CONTROLLER", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "REPLICAS"}
+var jobColumns = []string{"JOB", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "SUCCESSFUL"}
+var daemonSetColumns = []string{"NAME", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "NODE-SELECTOR"}
+var deploymentColumns = []string{"NAME", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "REPLICAS", "UPDATED"}
+var horizontalPodAutoscalerColumns = []string{"NAME", "REFERENCE", "TARGET", "CURRENT", "MINPODS", "MAXPODS"}
 var serviceColumns = []string{"NAME", "LABELS", "SELECTOR", "IP(S)", "PORT(S)"}
 var endpointColumns = []string{"NAME", "ENDPOINTS"}
 var nodeColumns = []string{"NAME", "LABELS", "STATUS"}
@@ -260,6 +289,7 @@ var limitRangeColumns = []string{"NAME"}
 var resourceQuotaColumns = []string{"NAME"}
 var namespaceColumns = []string{"NAME", "LABELS", "STATUS"}
 var secretColumns = []string{"NAME", "TYPE", "DATA"}
+var configMapColumns = []string{"NAME", "DATA"}
 var serviceAccountColumns = []string{"NAME", "SECRETS"}
 var persistentVolumeColumns = []string{"NAME", "LABELS", "CAPACITY", "ACCESSMODES", "STATUS", "CLAIM", "REASON"}
 var persistentVolumeClaimColumns = []string{"NAME", "LABELS", "STATUS", "VOLUME"}
@@ -273,6 +303,14 @@ func (h *HumanReadablePrinter) addDefaultHandlers() {
 	h.Handler(podTemplateColumns, printPodTemplateList)
 	h.Handler(replicationControllerColumns, printReplicationController)
 	h.Handler(replicationControllerColumns, printReplicationControllerList)
+	h.Handler(jobColumns, printJob)
+	h.Handler(jobColumns, printJobList)
+	h.Handler(daemonSetColumns, printDaemonSet)
+	h.Handler(daemonSetColumns, printDaemonSetList)
+	h.Handler(deploymentColumns, printDeployment)
+	h.Handler(deploymentColumns, printDeploymentList)
+	h.Handler(horizontalPodAutoscalerColumns, printHorizontalPodAutoscaler)
+	h.Handler(horizontalPodAutoscalerColumns, printHorizontalPodAutoscalerList)
 	h.Handler(serviceColumns, printService)
 	h.Handler(serviceColumns, printServiceList)
 	h.Handler(endpointColumns, printEndpoints)
@@ -289,6 +327,8 @@ func (h *HumanReadablePrinter) addDefaultHandlers() {
 	h.Handler(namespaceColumns, printNamespaceList)
 	h.Handler(secretColumns, printSecret)
 	h.Handler(secretColumns, printSecretList)
+	h.Handler(configMapColumns, printConfigMap)
+	h.Handler(configMapColumns, printConfigMapList)
 	h.Handler(serviceAccountColumns, printServiceAccount)
 	h.Handler(serviceAccountColumns, printServiceAccountList)
 	h.Handler(persistentVolumeClaimColumns, printPersistentVolumeClaim)
@@ -519,6 +559,193 @@ func printReplicationControllerList(list *api.ReplicationControllerList, w io.Wr
 	return nil
 }
 
+func printJob(job *api.Job, w io.Writer, withNamespace bool, columnLabels []string) error {
+	var name string
+	if withNamespace {
+		name = types.NamespacedName{job.Namespace, job.Name}.String()
+	} else {
+		name = job.Name
+	}
+
+	containers := job.Spec.Template.Spec.Containers
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d",
+		name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(job.Spec.Selector),
+		job.Status.Succeeded,
+	); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(w, appendLabels(job.Labels, columnLabels)); err != nil {
+		return err
+	}
+
+	// Lay out all the other containers on separate lines.
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "", container.Name, container.Image, "", "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printJobList(list *api.JobList, w io.Writer, withNamespace bool, columnLabels []string) error {
+	for _, job := range list.Items {
+		if err := printJob(&job, w, withNamespace, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDaemonSet(ds *api.DaemonSet, w io.Writer, withNamespace bool, columnLabels []string) error {
+	var name string
+	if withNamespace {
+		name = types.NamespacedName{ds.Namespace, ds.Name}.String()
+	} else {
+		name = ds.Name
+	}
+
+	containers := ds.Spec.Template.Spec.Containers
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s",
+		name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(ds.Spec.Selector),
+		formatLabels(ds.Spec.Template.Spec.NodeSelector),
+	); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(w, appendLabels(ds.Labels, columnLabels)); err != nil {
+		return err
+	}
+
+	// Lay out all the other containers on separate lines.
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "", container.Name, container.Image, "", "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDaemonSetList(list *api.DaemonSetList, w io.Writer, withNamespace bool, columnLabels []string) error {
+	for _, ds := range list.Items {
+		if err := printDaemonSet(&ds, w, withNamespace, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDeployment(deployment *api.Deployment, w io.Writer, withNamespace bool, columnLabels []string) error {
+	var name string
+	if withNamespace {
+		name = types.NamespacedName{deployment.Namespace, deployment.Name}.String()
+	} else {
+		name = deployment.Name
+	}
+
+	var containers []api.Container
+	if deployment.Spec.Template != nil {
+		containers = deployment.Spec.Template.Spec.Containers
+	}
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d",
+		name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(deployment.Spec.Selector),
+		deployment.Spec.Replicas,
+		deployment.Status.UpdatedReplicas,
+	); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(w, appendLabels(deployment.Labels, columnLabels)); err != nil {
+		return err
+	}
+
+	// Lay out all the other containers on separate lines.
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", "", container.Name, container.Image, "", "", "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDeploymentList(list *api.DeploymentList, w io.Writer, withNamespace bool, columnLabels []string) error {
+	for _, deployment := range list.Items {
+		if err := printDeployment(&deployment, w, withNamespace, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printHorizontalPodAutoscaler(hpa *api.HorizontalPodAutoscaler, w io.Writer, withNamespace bool, columnLabels []string) error {
+	var name string
+	if withNamespace {
+		name = types.NamespacedName{hpa.Namespace, hpa.Name}.String()
+	} else {
+		name = hpa.Name
+	}
+
+	reference := fmt.Sprintf("%s/%s", hpa.Spec.ScaleRef.Kind, hpa.Spec.ScaleRef.Name)
+	target := "<default>"
+	if hpa.Spec.CPUUtilization != nil {
+		target = fmt.Sprintf("%d%%", hpa.Spec.CPUUtilization.TargetPercentage)
+	}
+	current := "<waiting>"
+	if hpa.Status.CurrentCPUUtilizationPercentage != nil {
+		current = fmt.Sprintf("%d%%", *hpa.Status.CurrentCPUUtilizationPercentage)
+	}
+	minPods := "<unset>"
+	if hpa.Spec.MinReplicas != nil {
+		minPods = fmt.Sprintf("%d", *hpa.Spec.MinReplicas)
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d",
+		name,
+		reference,
+		target,
+		current,
+		minPods,
+		hpa.Spec.MaxReplicas,
+	); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(w, appendLabels(hpa.Labels, columnLabels))
+	return err
+}
+
+func printHorizontalPodAutoscalerList(list *api.HorizontalPodAutoscalerList, w io.Writer, withNamespace bool, columnLabels []string) error {
+	for i := range list.Items {
+		if err := printHorizontalPodAutoscaler(&list.Items[i], w, withNamespace, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printService(svc *api.Service, w io.Writer, withNamespace bool, columnLabels []string) error {
 	var name string
 	if withNamespace {
@@ -641,6 +868,31 @@ func printSecretList(list *api.SecretList, w io.Writer, withNamespace bool, colu
 	return nil
 }
 
+func printConfigMap(item *api.ConfigMap, w io.Writer, withNamespace bool, columnLabels []string) error {
+	var name string
+	if withNamespace {
+		name = types.NamespacedName{item.Namespace, item.Name}.String()
+	} else {
+		name = item.Name
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\t%v", name, len(item.Data)); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(w, appendLabels(item.Labels, columnLabels))
+	return err
+}
+
+func printConfigMapList(list *api.ConfigMapList, w io.Writer, withNamespace bool, columnLabels []string) error {
+	for _, item := range list.Items {
+		if err := printConfigMap(&item, w, withNamespace, columnLabels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func printServiceAccount(item *api.ServiceAccount, w io.Writer, withNamespace bool, columnLabels []string) error {
 	var name string
 	if withNamespace {
@@ -989,6 +1241,51 @@ func (p *TemplatePrinter) safeExecute(w io.Writer, obj interface{}) error {
 	return retErr
 }
 
+// JSONPathPrinter is an implementation of ResourcePrinter which formats data with a JSONPath template.
+type JSONPathPrinter struct {
+	rawTemplate string
+	*jsonpath.JSONPath
+}
+
+func NewJSONPathPrinter(tmpl string) (*JSONPathPrinter, error) {
+	j := jsonpath.New("out")
+	if err := j.Parse(tmpl); err != nil {
+		return nil, err
+	}
+	return &JSONPathPrinter{tmpl, j}, nil
+}
+
+// PrintObj formats the obj with the JSONPath template.
+func (j *JSONPathPrinter) PrintObj(obj runtime.Object, w io.Writer) error {
+	data, err := jsonPathData(obj)
+	if err != nil {
+		return err
+	}
+	if err := j.JSONPath.Execute(w, data); err != nil {
+		fmt.Fprintf(w, "Error executing template: %v\n", err)
+		fmt.Fprintf(w, "template was:\n\t%v\n", j.rawTemplate)
+		fmt.Fprintf(w, "object given to jsonpath engine was:\n\t%#v\n\n", data)
+		return fmt.Errorf("error executing jsonpath '%v': '%v'\n", j.rawTemplate, err)
+	}
+	return nil
+}
+
+// jsonPathData returns the JSON form of obj as generic maps and slices, with
+// numbers kept as json.Number so they print as they were serialized.
+func jsonPathData(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	decoder := json.NewDecoder(bytes.NewBuffer(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func tabbedString(f func(io.Writer) error) (string, error) {
 	out := new(tabwriter.Writer)
 	buf := &bytes.Buffer{}
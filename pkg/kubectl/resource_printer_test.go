@@ -176,6 +176,37 @@ func TestPrintBadTemplate(t *testing.T) {
 	}
 }
 
+func TestPrintJSONPath(t *testing.T) {
+	buf := bytes.NewBuffer([]byte{})
+	printer, found, err := GetPrinter("jsonpath", "{.metadata.name}:{.spec.containers[*].image}")
+	if err != nil || !found {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	unversionedPod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Spec:       api.PodSpec{Containers: []api.Container{{Image: "nginx"}, {Image: "redis"}}},
+	}
+	obj, err := api.Scheme.ConvertToVersion(unversionedPod, testapi.Version())
+	if err != nil {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if err := printer.PrintObj(obj, buf); err != nil {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if buf.String() != "foo:nginx redis" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestPrintBadJSONPath(t *testing.T) {
+	if _, _, err := GetPrinter("jsonpath", ""); err == nil {
+		t.Errorf("unexpected non-error")
+	}
+	if _, _, err := GetPrinter("jsonpath", "{.items[0}"); err == nil {
+		t.Errorf("unexpected non-error")
+	}
+}
+
 func TestPrintBadTemplateFile(t *testing.T) {
 	if _, _, err := GetPrinter("templatefile", ""); err == nil {
 		t.Errorf("unexpected non-error")
@@ -451,6 +482,10 @@ func TestPrinters(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	jsonpathPrinter, err := NewJSONPathPrinter("{.metadata.name}")
+	if err != nil {
+		t.Fatal(err)
+	}
 	printers := map[string]ResourcePrinter{
 		"humanReadable":        NewHumanReadablePrinter(true, false, []string{}),
 		"humanReadableHeaders": NewHumanReadablePrinter(false, false, []string{}),
@@ -458,6 +493,7 @@ func TestPrinters(t *testing.T) {
 		"yaml":                 &YAMLPrinter{},
 		"template":             templatePrinter,
 		"template2":            templatePrinter2,
+		"jsonpath":             jsonpathPrinter,
 	}
 	objects := map[string]runtime.Object{
 		"pod":             &api.Pod{ObjectMeta: om("pod")},
@@ -814,6 +850,83 @@ func TestPrintHumanReadableWithNamespace(t *testing.T) {
 			},
 			printNamespace: true,
 		},
+		{
+			obj: &api.Job{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+				Spec: api.JobSpec{
+					Selector: map[string]string{"name": "foo"},
+					Template: &api.PodTemplateSpec{
+						ObjectMeta: api.ObjectMeta{
+							Labels: map[string]string{"name": "foo"},
+						},
+						Spec: api.PodSpec{
+							Containers: []api.Container{
+								{
+									Image: "foo/bar",
+								},
+							},
+							RestartPolicy: api.RestartPolicyOnFailure,
+						},
+					},
+				},
+			},
+			printNamespace: true,
+		},
+		{
+			obj: &api.DaemonSet{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+				Spec: api.DaemonSetSpec{
+					Selector: map[string]string{"name": "foo"},
+					Template: &api.PodTemplateSpec{
+						ObjectMeta: api.ObjectMeta{
+							Labels: map[string]string{"name": "foo"},
+						},
+						Spec: api.PodSpec{
+							Containers: []api.Container{
+								{
+									Image: "foo/bar",
+								},
+							},
+							RestartPolicy: api.RestartPolicyAlways,
+						},
+					},
+				},
+			},
+			printNamespace: true,
+		},
+		{
+			obj: &api.Deployment{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+				Spec: api.DeploymentSpec{
+					Replicas: 2,
+					Selector: map[string]string{"name": "foo"},
+					Template: &api.PodTemplateSpec{
+						ObjectMeta: api.ObjectMeta{
+							Labels: map[string]string{"name": "foo"},
+						},
+						Spec: api.PodSpec{
+							Containers: []api.Container{
+								{
+									Image: "foo/bar",
+								},
+							},
+							RestartPolicy: api.RestartPolicyAlways,
+						},
+					},
+				},
+			},
+			printNamespace: true,
+		},
+		{
+			obj: &api.HorizontalPodAutoscaler{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+				Spec: api.HorizontalPodAutoscalerSpec{
+					ScaleRef:    api.ScaleReference{Kind: "ReplicationController", Name: "foo"},
+					MaxReplicas: 3,
+				},
+			},
+			printNamespace: true,
+		},
 		{
 			obj: &api.Service{
 				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
@@ -860,6 +973,12 @@ func TestPrintHumanReadableWithNamespace(t *testing.T) {
 			},
 			printNamespace: true,
 		},
+		{
+			obj: &api.ConfigMap{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+			},
+			printNamespace: true,
+		},
 		{
 			obj: &api.ServiceAccount{
 				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
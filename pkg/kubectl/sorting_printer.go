@@ -0,0 +1,119 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/jsonpath"
+)
+
+// SortingPrinter sorts the items of a list by the value of a field before
+// passing the list to a delegate printer. Objects that are not lists are
+// printed unchanged.
+type SortingPrinter struct {
+	SortField string
+	Delegate  ResourcePrinter
+}
+
+// PrintObj implements ResourcePrinter
+func (s *SortingPrinter) PrintObj(obj runtime.Object, out io.Writer) error {
+	itemsPtr, err := runtime.GetItemsPtr(obj)
+	if err != nil {
+		return s.Delegate.PrintObj(obj, out)
+	}
+	if err := SortItems(itemsPtr, s.SortField); err != nil {
+		return err
+	}
+	return s.Delegate.PrintObj(obj, out)
+}
+
+// SortItems stably sorts the slice pointed to by itemsPtr by the value of
+// sortField, a JSONPath expression evaluated against the JSON form of each
+// item. Numbers are compared numerically and other values as text; items
+// without the field sort first.
+func SortItems(itemsPtr interface{}, sortField string) error {
+	field, err := massageJSONPath(sortField)
+	if err != nil {
+		return err
+	}
+	parser := jsonpath.New("sorting")
+	if err := parser.Parse(field); err != nil {
+		return err
+	}
+	items := reflect.ValueOf(itemsPtr)
+	if items.Kind() != reflect.Ptr || items.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("expected pointer to slice, got %T", itemsPtr)
+	}
+	items = items.Elem()
+	sorter := &itemSorter{items: items, keys: make([]reflect.Value, items.Len())}
+	for i := range sorter.keys {
+		data, err := jsonPathData(items.Index(i).Interface())
+		if err != nil {
+			return err
+		}
+		results, err := parser.FindResults(data)
+		if err != nil {
+			return err
+		}
+		if len(results) != 0 && len(results[0]) != 0 {
+			sorter.keys[i] = results[0][0]
+		}
+	}
+	sort.Stable(sorter)
+	return nil
+}
+
+// itemSorter sorts a slice of items by precomputed keys.
+type itemSorter struct {
+	items reflect.Value
+	keys  []reflect.Value
+}
+
+func (s *itemSorter) Len() int {
+	return len(s.keys)
+}
+
+func (s *itemSorter) Swap(i, j int) {
+	a, b := s.items.Index(i), s.items.Index(j)
+	tmp := reflect.New(a.Type()).Elem()
+	tmp.Set(a)
+	a.Set(b)
+	b.Set(tmp)
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+func (s *itemSorter) Less(i, j int) bool {
+	return isLess(s.keys[i], s.keys[j])
+}
+
+func isLess(a, b reflect.Value) bool {
+	a, b = jsonpath.Indirect(a), jsonpath.Indirect(b)
+	if !a.IsValid() || !b.IsValid() {
+		return !a.IsValid() && b.IsValid()
+	}
+	if x, ok := jsonpath.ToFloat(a); ok {
+		if y, ok := jsonpath.ToFloat(b); ok {
+			return x < y
+		}
+	}
+	return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+func TestSortingPrinter(t *testing.T) {
+	int64Ptr := func(i int64) *int64 { return &i }
+
+	a := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "a"}}
+	b := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "b"}}
+	c := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "c"}}
+
+	tests := []struct {
+		obj   runtime.Object
+		sort  runtime.Object
+		field string
+		name  string
+	}{
+		{
+			name: "in-order-already",
+			obj: &api.PodList{
+				Items: []api.Pod{*a, *b, *c},
+			},
+			sort: &api.PodList{
+				Items: []api.Pod{*a, *b, *c},
+			},
+			field: "{.metadata.name}",
+		},
+		{
+			name: "reverse-order",
+			obj: &api.PodList{
+				Items: []api.Pod{*b, *c, *a},
+			},
+			sort: &api.PodList{
+				Items: []api.Pod{*a, *b, *c},
+			},
+			field: "metadata.name",
+		},
+		{
+			name: "random-order-numbers",
+			obj: &api.ReplicationControllerList{
+				Items: []api.ReplicationController{
+					{Spec: api.ReplicationControllerSpec{Replicas: 5}},
+					{Spec: api.ReplicationControllerSpec{Replicas: 1}},
+					{Spec: api.ReplicationControllerSpec{Replicas: 10}},
+				},
+			},
+			sort: &api.ReplicationControllerList{
+				Items: []api.ReplicationController{
+					{Spec: api.ReplicationControllerSpec{Replicas: 1}},
+					{Spec: api.ReplicationControllerSpec{Replicas: 5}},
+					{Spec: api.ReplicationControllerSpec{Replicas: 10}},
+				},
+			},
+			field: "{.spec.replicas}",
+		},
+		{
+			name: "missing-fields-first",
+			obj: &api.PodList{
+				Items: []api.Pod{
+					{Spec: api.PodSpec{ActiveDeadlineSeconds: int64Ptr(3)}},
+					{},
+					{Spec: api.PodSpec{ActiveDeadlineSeconds: int64Ptr(1)}},
+				},
+			},
+			sort: &api.PodList{
+				Items: []api.Pod{
+					{},
+					{Spec: api.PodSpec{ActiveDeadlineSeconds: int64Ptr(1)}},
+					{Spec: api.PodSpec{ActiveDeadlineSeconds: int64Ptr(3)}},
+				},
+			},
+			field: "{.spec.activeDeadlineSeconds}",
+		},
+	}
+	for _, test := range tests {
+		itemsPtr, err := runtime.GetItemsPtr(test.obj)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if err := SortItems(itemsPtr, test.field); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.obj, test.sort) {
+			t.Errorf("[%s]\nexpected:\n%v\nsaw:\n%v", test.name, test.sort, test.obj)
+		}
+	}
+}
+
+func TestSortingPrinterVersionedList(t *testing.T) {
+	list := &api.List{}
+	for _, name := range []string{"b", "c", "a"} {
+		pod, err := api.Scheme.ConvertToVersion(&api.Pod{ObjectMeta: api.ObjectMeta{Name: name}}, testapi.Version())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		list.Items = append(list.Items, pod)
+	}
+	delegate, err := NewJSONPathPrinter("{.items[*].metadata.name}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	printer := &SortingPrinter{SortField: ".metadata.name", Delegate: delegate}
+	buf := &bytes.Buffer{}
+	if err := printer.PrintObj(list, buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "a b c" {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+
+	// objects that are not lists are printed unchanged
+	buf.Reset()
+	printer.Delegate, _ = NewJSONPathPrinter("{.metadata.name}")
+	if err := printer.PrintObj(list.Items[0], buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "a" {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+}
@@ -189,6 +189,35 @@ func recordFirstSeenTime(pod *api.Pod) {
 	pod.Annotations[kubelet.ConfigFirstSeenAnnotationKey] = kubeletTypes.NewTimestamp().GetString()
 }
 
+// localAnnotations are the annotations the kubelet sets on pods itself; they
+// are kept when a source updates a pod.
+var localAnnotations = []string{kubelet.ConfigSourceAnnotationKey, kubelet.ConfigFirstSeenAnnotationKey}
+
+// updatePod copies the spec, labels and annotations of ref into existing, and
+// returns true if any of them changed.
+func updatePod(existing, ref *api.Pod) bool {
+	annotations := make(map[string]string, len(ref.Annotations))
+	for key, value := range ref.Annotations {
+		annotations[key] = value
+	}
+	for _, key := range localAnnotations {
+		if value, found := existing.Annotations[key]; found {
+			annotations[key] = value
+		} else {
+			delete(annotations, key)
+		}
+	}
+	if reflect.DeepEqual(existing.Spec, ref.Spec) &&
+		reflect.DeepEqual(existing.Labels, ref.Labels) &&
+		reflect.DeepEqual(existing.Annotations, annotations) {
+		return false
+	}
+	existing.Spec = ref.Spec
+	existing.Labels = ref.Labels
+	existing.Annotations = annotations
+	return true
+}
+
 func (s *podStorage) merge(source string, change interface{}) (adds, updates, deletes *kubelet.PodUpdate) {
 	s.podLock.Lock()
 	defer s.podLock.Unlock()
@@ -215,9 +244,8 @@ func (s *podStorage) merge(source string, change interface{}) (adds, updates, de
 		for _, ref := range filtered {
 			name := kubecontainer.GetPodFullName(ref)
 			if existing, found := pods[name]; found {
-				if !reflect.DeepEqual(existing.Spec, ref.Spec) {
+				if updatePod(existing, ref) {
 					// this is an update
-					existing.Spec = ref.Spec
 					updates.Pods = append(updates.Pods, existing)
 					continue
 				}
@@ -259,9 +287,8 @@ func (s *podStorage) merge(source string, change interface{}) (adds, updates, de
 			name := kubecontainer.GetPodFullName(ref)
 			if existing, found := oldPods[name]; found {
 				pods[name] = existing
-				if !reflect.DeepEqual(existing.Spec, ref.Spec) {
+				if updatePod(existing, ref) {
 					// this is an update
-					existing.Spec = ref.Spec
 					updates.Pods = append(updates.Pods, existing)
 					continue
 				}
@@ -259,3 +259,33 @@ func TestNewPodAddedUpdatedSet(t *testing.T) {
 		CreatePodUpdate(kubelet.ADD, NoneSource, CreateValidPod("foo4", "new", "test")),
 		CreatePodUpdate(kubelet.UPDATE, NoneSource, pod))
 }
+
+func TestPodLabelsUpdated(t *testing.T) {
+	channel, ch, _ := createPodConfigTester(PodConfigNotificationIncremental)
+
+	podUpdate := CreatePodUpdate(kubelet.ADD, NoneSource, CreateValidPod("foo", "new", ""))
+	channel <- podUpdate
+	expectPodUpdate(t, ch, CreatePodUpdate(kubelet.ADD, NoneSource, CreateValidPod("foo", "new", "test")))
+
+	// a change of only the labels is an update, and keeps the source annotation
+	pod := CreateValidPod("foo", "new", "")
+	pod.Labels = map[string]string{"key": "value"}
+	channel <- CreatePodUpdate(kubelet.UPDATE, NoneSource, pod)
+	expected := CreateValidPod("foo", "new", "test")
+	expected.Labels = map[string]string{"key": "value"}
+	expectPodUpdate(t, ch, CreatePodUpdate(kubelet.UPDATE, NoneSource, expected))
+
+	// so is a change of only the annotations, also when the pods are set
+	pod = CreateValidPod("foo", "new", "")
+	pod.Labels = map[string]string{"key": "value"}
+	pod.Annotations["key"] = "value"
+	channel <- CreatePodUpdate(kubelet.SET, NoneSource, pod)
+	expected = CreateValidPod("foo", "new", "test")
+	expected.Labels = map[string]string{"key": "value"}
+	expected.Annotations["key"] = "value"
+	expectPodUpdate(t, ch, CreatePodUpdate(kubelet.UPDATE, NoneSource, expected))
+
+	// setting the same pod again is a no-op
+	channel <- CreatePodUpdate(kubelet.SET, NoneSource, pod)
+	expectNoPodUpdate(t, ch)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewContainerGCInvalidPolicy(t *testing.T) {
+	_, err := NewContainerGC(&FakeRuntime{}, ContainerGCPolicy{MinAge: -time.Minute})
+	if err == nil {
+		t.Errorf("expected error for negative minimum age")
+	}
+}
+
+func TestContainerGCDelegatesToRuntime(t *testing.T) {
+	runtime := &FakeRuntime{}
+	gc, err := NewContainerGC(runtime, ContainerGCPolicy{MinAge: time.Minute, MaxPerPodContainer: 1, MaxContainers: -1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := gc.GarbageCollect(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := runtime.AssertCalls([]string{"GarbageCollect"}); err != nil {
+		t.Error(err)
+	}
+}
@@ -40,6 +40,7 @@ type FakeRuntime struct {
 	StartedContainers []string
 	KilledContainers  []string
 	VersionInfo       string
+	NetNS             string
 	Err               error
 }
 
@@ -94,6 +95,7 @@ func (f *FakeRuntime) ClearCalls() {
 	f.StartedContainers = []string{}
 	f.KilledContainers = []string{}
 	f.VersionInfo = ""
+	f.NetNS = ""
 	f.Err = nil
 }
 
@@ -242,7 +244,23 @@ func (f *FakeRuntime) RunInContainer(containerID string, cmd []string) ([]byte,
 	return []byte{}, f.Err
 }
 
-func (f *FakeRuntime) GetContainerLogs(pod *api.Pod, containerID, tail string, follow bool, stdout, stderr io.Writer) (err error) {
+func (f *FakeRuntime) AttachContainer(containerID string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.CalledFunctions = append(f.CalledFunctions, "AttachContainer")
+	return f.Err
+}
+
+func (f *FakeRuntime) GetNetNS(containerID string) (string, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.CalledFunctions = append(f.CalledFunctions, "GetNetNS")
+	return f.NetNS, f.Err
+}
+
+func (f *FakeRuntime) GetContainerLogs(pod *api.Pod, containerID, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) (err error) {
 	f.Lock()
 	defer f.Unlock()
 
@@ -284,18 +302,24 @@ func (f *FakeRuntime) RemoveImage(image ImageSpec) error {
 	defer f.Unlock()
 
 	f.CalledFunctions = append(f.CalledFunctions, "RemoveImage")
-	index := 0
 	for i := range f.ImageList {
 		if f.ImageList[i].ID == image.Image {
-			index = i
+			f.ImageList = append(f.ImageList[:i], f.ImageList[i+1:]...)
 			break
 		}
 	}
-	f.ImageList = append(f.ImageList[:index], f.ImageList[index+1:]...)
 
 	return f.Err
 }
 
+func (f *FakeRuntime) GarbageCollect(gcPolicy ContainerGCPolicy) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.CalledFunctions = append(f.CalledFunctions, "GarbageCollect")
+	return f.Err
+}
+
 func (f *FakeRuntime) PortForward(pod *Pod, port uint16, stream io.ReadWriteCloser) error {
 	f.Lock()
 	defer f.Unlock()
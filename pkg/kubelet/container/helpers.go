@@ -19,6 +19,7 @@ package container
 import (
 	"hash/adler32"
 	"strings"
+	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -49,6 +50,22 @@ func TrimRuntimePrefix(fullString string) string {
 	return fullString[idx+len(prefixSeparator):]
 }
 
+// LogSinceTime returns the earliest time from which container logs should be
+// returned for the given options, relative to now. The second return value is
+// false if the options do not restrict the logs by time.
+func LogSinceTime(logOptions *api.PodLogOptions, now time.Time) (time.Time, bool) {
+	if logOptions == nil {
+		return time.Time{}, false
+	}
+	if logOptions.SinceTime != nil {
+		return logOptions.SinceTime.Time, true
+	}
+	if logOptions.SinceSeconds != nil {
+		return now.Add(-time.Duration(*logOptions.SinceSeconds) * time.Second), true
+	}
+	return time.Time{}, false
+}
+
 // ShouldContainerBeRestarted checks whether a container needs to be restarted.
 // TODO(yifan): Think about how to refactor this.
 func ShouldContainerBeRestarted(container *api.Container, pod *api.Pod, podStatus *api.PodStatus, readinessManager *ReadinessManager) bool {
@@ -19,8 +19,10 @@ package container
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 )
 
 func TestEnvVarsToMap(t *testing.T) {
@@ -134,3 +136,29 @@ func TestExpandCommandAndArgs(t *testing.T) {
 
 	}
 }
+
+func TestLogSinceTime(t *testing.T) {
+	now := time.Date(2015, 6, 1, 12, 0, 0, 0, time.UTC)
+	seconds := int64(600)
+	sinceTime := util.NewTime(time.Date(2015, 6, 1, 11, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		opts     *api.PodLogOptions
+		expected time.Time
+		ok       bool
+	}{
+		{nil, time.Time{}, false},
+		{&api.PodLogOptions{}, time.Time{}, false},
+		{&api.PodLogOptions{SinceSeconds: &seconds}, time.Date(2015, 6, 1, 11, 50, 0, 0, time.UTC), true},
+		{&api.PodLogOptions{SinceTime: &sinceTime}, sinceTime.Time, true},
+	}
+	for i, tc := range testCases {
+		since, ok := LogSinceTime(tc.opts, now)
+		if ok != tc.ok {
+			t.Errorf("%d: expected ok=%v, got %v", i, tc.ok, ok)
+		}
+		if !since.Equal(tc.expected) {
+			t.Errorf("%d: expected %v, got %v", i, tc.expected, since)
+		}
+	}
+}
@@ -69,12 +69,23 @@ type Runtime interface {
 	ListImages() ([]Image, error)
 	// Removes the specified image.
 	RemoveImage(image ImageSpec) error
+	// GarbageCollect removes dead containers using the specified container gc
+	// policy.
+	GarbageCollect(gcPolicy ContainerGCPolicy) error
 	// TODO(vmarmol): Unify pod and containerID args.
 	// GetContainerLogs returns logs of a specific container. By
-	// default, it returns a snapshot of the container log. Set 'follow' to true to
-	// stream the log. Set 'follow' to false and specify the number of lines (e.g.
-	// "100" or "all") to tail the log.
-	GetContainerLogs(pod *api.Pod, containerID, tail string, follow bool, stdout, stderr io.Writer) (err error)
+	// default, it returns a snapshot of the container log. Set 'Follow' in
+	// logOptions to true to stream the log. Set 'Follow' to false and specify
+	// the number of lines (e.g. "100" or "all") to tail the log. The since and
+	// timestamps fields of logOptions are honored as well.
+	GetContainerLogs(pod *api.Pod, containerID, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) (err error)
+	// AttachContainer attaches the given streams to the main process of a
+	// running container. Optionally the streams are treated as a tty.
+	// TODO(yifan): Use strong type for containerID.
+	AttachContainer(containerID string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) (err error)
+	// GetNetNS returns the path of the network namespace of the given
+	// container, so that network plugins can configure it.
+	GetNetNS(containerID string) (string, error)
 	// ContainerCommandRunner encapsulates the command runner interfaces for testability.
 	ContainerCommandRunner
 }
@@ -175,8 +186,21 @@ type Container struct {
 	// The timestamp of the creation time of the container.
 	// TODO(yifan): Consider to move it to api.ContainerStatus.
 	Created int64
+	// State is the state of the container, as last reported by the runtime.
+	State ContainerState
 }
 
+// ContainerState is a coarse summary of the state of a container.
+type ContainerState string
+
+const (
+	ContainerStateRunning ContainerState = "running"
+	ContainerStateExited  ContainerState = "exited"
+	// ContainerStateUnknown is the state of containers whose state the
+	// runtime does not report, or that have not been started yet.
+	ContainerStateUnknown ContainerState = ""
+)
+
 // Basic information about a container image.
 type Image struct {
 	// ID of the image.
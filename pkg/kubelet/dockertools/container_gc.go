@@ -14,59 +14,27 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package kubelet
+package dockertools
 
 import (
-	"fmt"
 	"sort"
 	"time"
 
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/dockertools"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/golang/glog"
 )
 
-// Specified a policy for garbage collecting containers.
-type ContainerGCPolicy struct {
-	// Minimum age at which a container can be garbage collected, zero for no limit.
-	MinAge time.Duration
-
-	// Max number of dead containers any single pod (UID, container name) pair is
-	// allowed to have, less than zero for no limit.
-	MaxPerPodContainer int
-
-	// Max number of total dead containers, less than zero for no limit.
-	MaxContainers int
-}
-
-// Manages garbage collection of dead containers.
-//
-// Implementation is thread-compatible.
-type containerGC interface {
-	// Garbage collect containers.
-	GarbageCollect() error
-}
-
+// Garbage collects the dead docker containers of the kubelet.
 // TODO(vmarmol): Preferentially remove pod infra containers.
-type realContainerGC struct {
+type containerGC struct {
 	// Docker client to use.
-	dockerClient dockertools.DockerInterface
-
-	// Policy for garbage collection.
-	policy ContainerGCPolicy
+	client DockerInterface
 }
 
-// New containerGC instance with the specified policy.
-func newContainerGC(dockerClient dockertools.DockerInterface, policy ContainerGCPolicy) (containerGC, error) {
-	if policy.MinAge < 0 {
-		return nil, fmt.Errorf("invalid minimum garbage collection age: %v", policy.MinAge)
-	}
-
-	return &realContainerGC{
-		dockerClient: dockerClient,
-		policy:       policy,
-	}, nil
+func newContainerGC(client DockerInterface) *containerGC {
+	return &containerGC{client: client}
 }
 
 // Internal information kept for containers being considered for GC.
@@ -114,9 +82,10 @@ func (a byCreated) Len() int           { return len(a) }
 func (a byCreated) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a byCreated) Less(i, j int) bool { return a[i].createTime.After(a[j].createTime) }
 
-func (cgc *realContainerGC) GarbageCollect() error {
+// GarbageCollect removes dead containers using the specified container gc policy.
+func (cgc *containerGC) GarbageCollect(gcPolicy kubecontainer.ContainerGCPolicy) error {
 	// Separate containers by evict units.
-	evictUnits, unidentifiedContainers, err := cgc.evictableContainers()
+	evictUnits, unidentifiedContainers, err := cgc.evictableContainers(gcPolicy.MinAge)
 	if err != nil {
 		return err
 	}
@@ -124,21 +93,21 @@ func (cgc *realContainerGC) GarbageCollect() error {
 	// Remove unidentified containers.
 	for _, container := range unidentifiedContainers {
 		glog.Infof("Removing unidentified dead container %q with ID %q", container.name, container.id)
-		err = cgc.dockerClient.RemoveContainer(docker.RemoveContainerOptions{ID: container.id})
+		err = cgc.client.RemoveContainer(docker.RemoveContainerOptions{ID: container.id})
 		if err != nil {
 			glog.Warningf("Failed to remove unidentified dead container %q: %v", container.name, err)
 		}
 	}
 
 	// Enforce max containers per evict unit.
-	if cgc.policy.MaxPerPodContainer >= 0 {
-		cgc.enforceMaxContainersPerEvictUnit(evictUnits, cgc.policy.MaxPerPodContainer)
+	if gcPolicy.MaxPerPodContainer >= 0 {
+		cgc.enforceMaxContainersPerEvictUnit(evictUnits, gcPolicy.MaxPerPodContainer)
 	}
 
 	// Enforce max total number of containers.
-	if cgc.policy.MaxContainers >= 0 && evictUnits.NumContainers() > cgc.policy.MaxContainers {
+	if gcPolicy.MaxContainers >= 0 && evictUnits.NumContainers() > gcPolicy.MaxContainers {
 		// Leave an equal number of containers per evict unit (min: 1).
-		numContainersPerEvictUnit := cgc.policy.MaxContainers / evictUnits.NumEvictUnits()
+		numContainersPerEvictUnit := gcPolicy.MaxContainers / evictUnits.NumEvictUnits()
 		if numContainersPerEvictUnit < 1 {
 			numContainersPerEvictUnit = 1
 		}
@@ -146,21 +115,21 @@ func (cgc *realContainerGC) GarbageCollect() error {
 
 		// If we still need to evict, evict oldest first.
 		numContainers := evictUnits.NumContainers()
-		if numContainers > cgc.policy.MaxContainers {
+		if numContainers > gcPolicy.MaxContainers {
 			flattened := make([]containerGCInfo, 0, numContainers)
 			for uid := range evictUnits {
 				flattened = append(flattened, evictUnits[uid]...)
 			}
 			sort.Sort(byCreated(flattened))
 
-			cgc.removeOldestN(flattened, numContainers-cgc.policy.MaxContainers)
+			cgc.removeOldestN(flattened, numContainers-gcPolicy.MaxContainers)
 		}
 	}
 
 	return nil
 }
 
-func (cgc *realContainerGC) enforceMaxContainersPerEvictUnit(evictUnits containersByEvictUnit, MaxContainers int) {
+func (cgc *containerGC) enforceMaxContainersPerEvictUnit(evictUnits containersByEvictUnit, MaxContainers int) {
 	for uid := range evictUnits {
 		toRemove := len(evictUnits[uid]) - MaxContainers
 
@@ -171,11 +140,11 @@ func (cgc *realContainerGC) enforceMaxContainersPerEvictUnit(evictUnits containe
 }
 
 // Removes the oldest toRemove containers and returns the resulting slice.
-func (cgc *realContainerGC) removeOldestN(containers []containerGCInfo, toRemove int) []containerGCInfo {
+func (cgc *containerGC) removeOldestN(containers []containerGCInfo, toRemove int) []containerGCInfo {
 	// Remove from oldest to newest (last to first).
 	numToKeep := len(containers) - toRemove
 	for i := numToKeep; i < len(containers); i++ {
-		err := cgc.dockerClient.RemoveContainer(docker.RemoveContainerOptions{ID: containers[i].id})
+		err := cgc.client.RemoveContainer(docker.RemoveContainerOptions{ID: containers[i].id})
 		if err != nil {
 			glog.Warningf("Failed to remove dead container %q: %v", containers[i].name, err)
 		}
@@ -186,19 +155,19 @@ func (cgc *realContainerGC) removeOldestN(containers []containerGCInfo, toRemove
 }
 
 // Get all containers that are evictable. Evictable containers are: not running
-// and created more than MinAge ago.
-func (cgc *realContainerGC) evictableContainers() (containersByEvictUnit, []containerGCInfo, error) {
-	containers, err := dockertools.GetKubeletDockerContainers(cgc.dockerClient, true)
+// and created more than minAge ago.
+func (cgc *containerGC) evictableContainers(minAge time.Duration) (containersByEvictUnit, []containerGCInfo, error) {
+	containers, err := GetKubeletDockerContainers(cgc.client, true)
 	if err != nil {
 		return containersByEvictUnit{}, []containerGCInfo{}, err
 	}
 
 	unidentifiedContainers := make([]containerGCInfo, 0)
 	evictUnits := make(containersByEvictUnit)
-	newestGCTime := time.Now().Add(-cgc.policy.MinAge)
+	newestGCTime := time.Now().Add(-minAge)
 	for _, container := range containers {
 		// Prune out running containers.
-		data, err := cgc.dockerClient.InspectContainer(container.ID)
+		data, err := cgc.client.InspectContainer(container.ID)
 		if err != nil {
 			// Container may have been removed already, skip.
 			continue
@@ -214,7 +183,7 @@ func (cgc *realContainerGC) evictableContainers() (containersByEvictUnit, []cont
 			createTime: data.Created,
 		}
 
-		containerName, _, err := dockertools.ParseDockerName(container.Names[0])
+		containerName, _, err := ParseDockerName(container.Names[0])
 
 		if err != nil {
 			unidentifiedContainers = append(unidentifiedContainers, containerInfo)
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package kubelet
+package dockertools
 
 import (
 	"fmt"
@@ -23,15 +23,15 @@ import (
 	"testing"
 	"time"
 
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/dockertools"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func newTestContainerGC(t *testing.T, MinAge time.Duration, MaxPerPodContainer, MaxContainers int) (containerGC, *dockertools.FakeDockerClient) {
-	fakeDocker := new(dockertools.FakeDockerClient)
-	gc, err := newContainerGC(fakeDocker, ContainerGCPolicy{
+func newTestContainerGC(t *testing.T, MinAge time.Duration, MaxPerPodContainer, MaxContainers int) (kubecontainer.ContainerGC, *FakeDockerClient) {
+	dockerManager, fakeDocker := newTestDockerManager()
+	gc, err := kubecontainer.NewContainerGC(dockerManager, kubecontainer.ContainerGCPolicy{
 		MinAge:             MinAge,
 		MaxPerPodContainer: MaxPerPodContainer,
 		MaxContainers:      MaxContainers,
@@ -42,6 +42,7 @@ func newTestContainerGC(t *testing.T, MinAge time.Duration, MaxPerPodContainer,
 
 // Makes a stable time object, lower id is earlier time.
 func makeTime(id int) time.Time {
+	var zero time.Time
 	return zero.Add(time.Duration(id) * time.Second)
 }
 
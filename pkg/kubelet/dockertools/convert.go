@@ -18,6 +18,7 @@ package dockertools
 
 import (
 	"fmt"
+	"strings"
 
 	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
@@ -43,9 +44,23 @@ func toRuntimeContainer(c *docker.APIContainers) (*kubecontainer.Container, erro
 		Image:   c.Image,
 		Hash:    hash,
 		Created: c.Created,
+		State:   toRuntimeContainerState(c.Status),
 	}, nil
 }
 
+// toRuntimeContainerState converts the status docker reports for a container,
+// e.g. "Up 5 seconds" or "Exited (0) 2 minutes ago", to a ContainerState.
+func toRuntimeContainerState(status string) kubecontainer.ContainerState {
+	switch {
+	case strings.HasPrefix(status, "Up"):
+		return kubecontainer.ContainerStateRunning
+	case strings.HasPrefix(status, "Exited"), strings.HasPrefix(status, "Dead"):
+		return kubecontainer.ContainerStateExited
+	default:
+		return kubecontainer.ContainerStateUnknown
+	}
+}
+
 // Converts docker.APIImages to kubecontainer.Image.
 func toRuntimeImage(image *docker.APIImages) (*kubecontainer.Image, error) {
 	if image == nil {
@@ -69,3 +69,19 @@ func TestToRuntimeImage(t *testing.T) {
 		t.Errorf("expected %#v, got %#v", expected, actual)
 	}
 }
+
+func TestToRuntimeContainerState(t *testing.T) {
+	tests := map[string]kubecontainer.ContainerState{
+		"Up 5 seconds":             kubecontainer.ContainerStateRunning,
+		"Up 2 hours (Paused)":      kubecontainer.ContainerStateRunning,
+		"Exited (0) 2 minutes ago": kubecontainer.ContainerStateExited,
+		"Dead":                     kubecontainer.ContainerStateExited,
+		"Created":                  kubecontainer.ContainerStateUnknown,
+		"":                         kubecontainer.ContainerStateUnknown,
+	}
+	for status, expected := range tests {
+		if actual := toRuntimeContainerState(status); actual != expected {
+			t.Errorf("%q: expected state %q, got %q", status, expected, actual)
+		}
+	}
+}
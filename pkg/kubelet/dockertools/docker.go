@@ -69,6 +69,7 @@ type DockerInterface interface {
 	CreateExec(docker.CreateExecOptions) (*docker.Exec, error)
 	StartExec(string, docker.StartExecOptions) error
 	InspectExec(id string) (*docker.ExecInspect, error)
+	AttachToContainer(opts docker.AttachToContainerOptions) error
 }
 
 // KubeletContainerName encapsulates a pod name and a Kubernetes container name.
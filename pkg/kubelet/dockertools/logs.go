@@ -0,0 +1,86 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dockertools
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+// sinceWriter filters a docker log stream that was requested with timestamps,
+// dropping the lines logged before a given time. The leading timestamp is
+// stripped from each line unless keepTimestamps is set.
+type sinceWriter struct {
+	out            io.Writer
+	since          time.Time
+	keepTimestamps bool
+	buf            []byte
+}
+
+func newSinceWriter(out io.Writer, since time.Time, keepTimestamps bool) *sinceWriter {
+	return &sinceWriter{out: out, since: since, keepTimestamps: keepTimestamps}
+}
+
+// Write buffers p and writes every complete line that passes the filter.
+func (w *sinceWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := w.buf[:i+1]
+		w.buf = w.buf[i+1:]
+		if err := w.writeLine(line); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes any incomplete line remaining in the buffer.
+func (w *sinceWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	line := w.buf
+	w.buf = nil
+	return w.writeLine(line)
+}
+
+func (w *sinceWriter) writeLine(line []byte) error {
+	i := bytes.IndexByte(line, ' ')
+	if i < 0 {
+		_, err := w.out.Write(line)
+		return err
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, string(line[:i]))
+	if err != nil {
+		// Not a timestamped line, pass it through unchanged.
+		_, err := w.out.Write(line)
+		return err
+	}
+	if timestamp.Before(w.since) {
+		return nil
+	}
+	if !w.keepTimestamps {
+		line = line[i+1:]
+	}
+	_, err = w.out.Write(line)
+	return err
+}
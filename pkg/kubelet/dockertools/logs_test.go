@@ -0,0 +1,61 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dockertools
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestSinceWriter(t *testing.T) {
+	since := time.Date(2015, 6, 1, 12, 0, 0, 0, time.UTC)
+	input := "2015-06-01T11:59:59.999999999Z old\n" +
+		"2015-06-01T12:00:00.000000001Z new\n" +
+		"untimestamped\n" +
+		"2015-06-01T12:00:01Z partial"
+
+	testCases := []struct {
+		keepTimestamps bool
+		expected       string
+	}{
+		{false, "new\nuntimestamped\npartial"},
+		{true, "2015-06-01T12:00:00.000000001Z new\nuntimestamped\n2015-06-01T12:00:01Z partial"},
+	}
+	for i, tc := range testCases {
+		buf := &bytes.Buffer{}
+		w := newSinceWriter(buf, since, tc.keepTimestamps)
+		// Write in small chunks so that lines are split across writes.
+		data := []byte(input)
+		for len(data) > 0 {
+			n := 7
+			if n > len(data) {
+				n = len(data)
+			}
+			if _, err := w.Write(data[:n]); err != nil {
+				t.Fatalf("%d: unexpected error: %v", i, err)
+			}
+			data = data[n:]
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+		if buf.String() != tc.expected {
+			t.Errorf("%d: expected %q, got %q", i, tc.expected, buf.String())
+		}
+	}
+}
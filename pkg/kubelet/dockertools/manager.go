@@ -38,7 +38,9 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/lifecycle"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/metrics"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network/cni"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/prober"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/qos"
 	kubeletTypes "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/probe"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/securitycontext"
@@ -47,15 +49,10 @@ import (
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/golang/glog"
 	"github.com/golang/groupcache/lru"
+	cadvisorApi "github.com/google/cadvisor/info/v1"
 )
 
 const (
-	// The oom_score_adj of the POD infrastructure container. The default is 0 for
-	// any other docker containers, so any value below that makes it *less* likely
-	// to get OOM killed.
-	podOomScoreAdj           = -100
-	userContainerOomScoreAdj = 0
-
 	maxReasonCacheEntries = 200
 
 	kubernetesPodLabel       = "io.kubernetes.pod.data"
@@ -114,6 +111,12 @@ type DockerManager struct {
 
 	// Handler used to execute commands in containers.
 	execHandler ExecHandler
+
+	// Garbage collector of dead containers.
+	containerGC *containerGC
+
+	// Information about the node, used to compute the oom_score_adj of containers.
+	machineInfo *cadvisorApi.MachineInfo
 }
 
 func NewDockerManager(
@@ -121,6 +124,7 @@ func NewDockerManager(
 	recorder record.EventRecorder,
 	readinessManager *kubecontainer.ReadinessManager,
 	containerRefManager *kubecontainer.RefManager,
+	machineInfo *cadvisorApi.MachineInfo,
 	podInfraContainerImage string,
 	qps float32,
 	burst int,
@@ -179,6 +183,8 @@ func NewDockerManager(
 		generator:              generator,
 		runtimeHooks:           runtimeHooks,
 		execHandler:            execHandler,
+		containerGC:            newContainerGC(client),
+		machineInfo:            machineInfo,
 	}
 	dm.runner = lifecycle.NewHandlerRunner(httpClient, dm, dm)
 	dm.prober = prober.New(dm, readinessManager, containerRefManager, recorder)
@@ -220,26 +226,42 @@ func (sc *stringCache) Get(uid types.UID, name string) (string, bool) {
 }
 
 // GetContainerLogs returns logs of a specific container. By
-// default, it returns a snapshot of the container log. Set 'follow' to true to
-// stream the log. Set 'follow' to false and specify the number of lines (e.g.
-// "100" or "all") to tail the log.
+// default, it returns a snapshot of the container log. Set 'Follow' in
+// logOptions to true to stream the log. Set 'Follow' to false and specify the
+// number of lines (e.g. "100" or "all") to tail the log. Docker has no notion
+// of a since time, so logs are requested with timestamps and filtered here.
 // TODO: Make 'RawTerminal' option  flagable.
-func (dm *DockerManager) GetContainerLogs(pod *api.Pod, containerID, tail string, follow bool, stdout, stderr io.Writer) (err error) {
+func (dm *DockerManager) GetContainerLogs(pod *api.Pod, containerID, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) (err error) {
 	opts := docker.LogsOptions{
 		Container:    containerID,
 		Stdout:       true,
 		Stderr:       true,
 		OutputStream: stdout,
 		ErrorStream:  stderr,
-		Timestamps:   false,
+		Timestamps:   logOptions.Timestamps,
 		RawTerminal:  false,
-		Follow:       follow,
+		Follow:       logOptions.Follow,
 	}
 
-	if !follow {
+	if !logOptions.Follow {
 		opts.Tail = tail
 	}
 
+	if since, ok := kubecontainer.LogSinceTime(logOptions, time.Now()); ok {
+		outFilter := newSinceWriter(stdout, since, logOptions.Timestamps)
+		errFilter := newSinceWriter(stderr, since, logOptions.Timestamps)
+		opts.Timestamps = true
+		opts.OutputStream, opts.ErrorStream = outFilter, errFilter
+		defer func() {
+			if flushErr := outFilter.Flush(); err == nil {
+				err = flushErr
+			}
+			if flushErr := errFilter.Flush(); err == nil {
+				err = flushErr
+			}
+		}()
+	}
+
 	err = dm.client.Logs(opts)
 	return
 }
@@ -262,6 +284,27 @@ type containerStatusResult struct {
 	err    error
 }
 
+// determinePodIP returns the IP of the pod whose infra container is
+// podInfraContainerID. The network plugin is asked first, since it may have
+// configured the network namespace itself; dockerIP, the address docker
+// reported on inspection, is used when the plugin leaves the pod to docker.
+// Pods on the host network are never handed to the plugin, so dockerIP is
+// used for them as is.
+func (dm *DockerManager) determinePodIP(pod *api.Pod, podInfraContainerID, dockerIP string) string {
+	if pod.Spec.HostNetwork {
+		return dockerIP
+	}
+	netStatus, err := dm.networkPlugin.GetPodNetworkStatus(pod.Namespace, pod.Name, kubeletTypes.DockerID(podInfraContainerID))
+	if err != nil {
+		glog.Errorf("Failed to get the network status of pod %q: %v", kubecontainer.GetPodFullName(pod), err)
+		return dockerIP
+	}
+	if netStatus == nil {
+		return dockerIP
+	}
+	return netStatus.IP.String()
+}
+
 func (dm *DockerManager) inspectContainer(dockerID, containerName, tPath string) *containerStatusResult {
 	result := containerStatusResult{api.ContainerStatus{}, "", nil}
 
@@ -422,7 +465,7 @@ func (dm *DockerManager) GetPodStatus(pod *api.Pod) (*api.PodStatus, error) {
 		if dockerContainerName == PodInfraContainerName {
 			// Found network container
 			if result.status.State.Running != nil {
-				podStatus.PodIP = result.ip
+				podStatus.PodIP = dm.determinePodIP(pod, value.ID, result.ip)
 			}
 		} else {
 			// Add user container information.
@@ -593,6 +636,9 @@ func (dm *DockerManager) runContainer(
 			CPUShares:  cpuShares,
 			WorkingDir: container.WorkingDir,
 			Labels:     labels,
+			// Interactive containers:
+			OpenStdin: container.Stdin,
+			Tty:       container.TTY,
 		},
 	}
 
@@ -802,10 +848,14 @@ func (dm *DockerManager) RemoveImage(image kubecontainer.ImageSpec) error {
 	return dm.client.RemoveImage(image.Image)
 }
 
+// GarbageCollect removes dead containers using the specified container gc policy.
+func (dm *DockerManager) GarbageCollect(gcPolicy kubecontainer.ContainerGCPolicy) error {
+	return dm.containerGC.GarbageCollect(gcPolicy)
+}
+
 // podInfraContainerChanged returns true if the pod infra container has changed.
 func (dm *DockerManager) podInfraContainerChanged(pod *api.Pod, podInfraContainer *kubecontainer.Container) (bool, error) {
 	networkMode := ""
-	var ports []api.ContainerPort
 
 	dockerPodInfraContainer, err := dm.client.InspectContainer(string(podInfraContainer.ID))
 	if err != nil {
@@ -816,17 +866,10 @@ func (dm *DockerManager) podInfraContainerChanged(pod *api.Pod, podInfraContaine
 	if dockerPodInfraContainer.HostConfig != nil {
 		networkMode = dockerPodInfraContainer.HostConfig.NetworkMode
 	}
-	if pod.Spec.HostNetwork {
-		if networkMode != "host" {
-			glog.V(4).Infof("host: %v, %v", pod.Spec.HostNetwork, networkMode)
-			return true, nil
-		}
-	} else {
-		// Docker only exports ports from the pod infra container. Let's
-		// collect all of the relevant ports and export them.
-		for _, container := range pod.Spec.Containers {
-			ports = append(ports, container.Ports...)
-		}
+	expectedNetworkMode, ports := dm.podInfraContainerNetwork(pod)
+	if expectedNetworkMode != "" && networkMode != expectedNetworkMode {
+		glog.V(4).Infof("network mode: expected %q, got %q", expectedNetworkMode, networkMode)
+		return true, nil
 	}
 	expectedPodInfraContainer := &api.Container{
 		Name:  PodInfraContainerName,
@@ -1005,6 +1048,37 @@ func (dm *DockerManager) ExecInContainer(containerId string, cmd []string, stdin
 	return dm.execHandler.ExecInContainer(dm.client, container, cmd, stdin, stdout, stderr, tty)
 }
 
+// AttachContainer attaches the given streams to the main process of the
+// container identified by containerId.
+func (dm *DockerManager) AttachContainer(containerId string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) error {
+	opts := docker.AttachToContainerOptions{
+		Container:    containerId,
+		InputStream:  stdin,
+		OutputStream: stdout,
+		ErrorStream:  stderr,
+		Stream:       true,
+		Logs:         true,
+		Stdin:        stdin != nil,
+		Stdout:       stdout != nil,
+		Stderr:       stderr != nil,
+		RawTerminal:  tty,
+	}
+	return dm.client.AttachToContainer(opts)
+}
+
+// GetNetNS returns the network namespace path of the container, which is
+// that of its main process.
+func (dm *DockerManager) GetNetNS(containerID string) (string, error) {
+	inspectResult, err := dm.client.InspectContainer(containerID)
+	if err != nil {
+		return "", err
+	}
+	if inspectResult.State.Pid == 0 {
+		return "", fmt.Errorf("container %q is not running", containerID)
+	}
+	return fmt.Sprintf("/proc/%d/ns/net", inspectResult.State.Pid), nil
+}
+
 func noPodInfraContainerError(podName, podNamespace string) error {
 	return fmt.Errorf("cannot find pod infra container in pod %q", kubecontainer.BuildPodFullName(podName, podNamespace))
 }
@@ -1211,9 +1285,9 @@ func (dm *DockerManager) runContainerInPod(pod *api.Pod, container *api.Containe
 		glog.Errorf("Failed to create symbolic link to the log file of pod %q container %q: %v", podFullName, container.Name, err)
 	}
 
-	// Set OOM score of POD container to lower than those of the other containers
-	// which have OOM score 0 by default in the pod. This ensures that it is
-	// killed only as a last resort.
+	// Set OOM score of the container based on the quality of service of the pod.
+	// The POD container has the lowest score so that it is killed only as a last
+	// resort.
 	containerInfo, err := dm.client.InspectContainer(string(id))
 	if err != nil {
 		return "", err
@@ -1224,7 +1298,7 @@ func (dm *DockerManager) runContainerInPod(pod *api.Pod, container *api.Containe
 		return "", fmt.Errorf("failed to get init PID for Docker container %q", string(id))
 	}
 	if container.Name == PodInfraContainerName {
-		util.ApplyOomScoreAdj(containerInfo.State.Pid, podOomScoreAdj)
+		util.ApplyOomScoreAdj(containerInfo.State.Pid, qos.PodInfraOOMAdj)
 		// currently, Docker does not have a flag by which the ndots option can be passed.
 		// (A seperate issue has been filed with Docker to add a ndots flag)
 		// The addNDotsOption call appends the ndots option to the resolv.conf file generated by docker.
@@ -1234,9 +1308,10 @@ func (dm *DockerManager) runContainerInPod(pod *api.Pod, container *api.Containe
 		err = addNDotsOption(containerInfo.ResolvConfPath)
 	} else {
 		// Children processes of docker daemon will inheritant the OOM score from docker
-		// daemon process. We explicitly apply OOM score 0 by default to the user
-		// containers to avoid daemons or POD containers are killed by oom killer.
-		util.ApplyOomScoreAdj(containerInfo.State.Pid, userContainerOomScoreAdj)
+		// daemon process. We explicitly apply the OOM score of the class of the pod to
+		// the user containers to avoid daemons or POD containers are killed by oom killer.
+		oomScoreAdj := qos.GetContainerOOMScoreAdjust(pod, container, dm.machineInfo.MemoryCapacity)
+		util.ApplyOomScoreAdj(containerInfo.State.Pid, oomScoreAdj)
 	}
 
 	return kubeletTypes.DockerID(id), err
@@ -1272,26 +1347,35 @@ func appendToFile(filePath, stringToAppend string) error {
 	return err
 }
 
+// podInfraContainerNetwork returns the docker network mode of the pod infra
+// container of pod, where "" is docker's default bridge, and the ports it
+// exports.
+func (dm *DockerManager) podInfraContainerNetwork(pod *api.Pod) (string, []api.ContainerPort) {
+	// Use host networking if specified.
+	if pod.Spec.HostNetwork {
+		return "host", nil
+	}
+	// The CNI plugin sets up the network namespace itself, so docker must
+	// leave it without interfaces. Docker cannot export ports from it then.
+	if dm.networkPlugin.Name() == cni.CNIPluginName {
+		return "none", nil
+	}
+	// Docker only exports ports from the pod infra container.  Let's
+	// collect all of the relevant ports and export them.
+	var ports []api.ContainerPort
+	for _, container := range pod.Spec.Containers {
+		ports = append(ports, container.Ports...)
+	}
+	return "", ports
+}
+
 // createPodInfraContainer starts the pod infra container for a pod. Returns the docker container ID of the newly created container.
 func (dm *DockerManager) createPodInfraContainer(pod *api.Pod) (kubeletTypes.DockerID, error) {
 	start := time.Now()
 	defer func() {
 		metrics.ContainerManagerLatency.WithLabelValues("createPodInfraContainer").Observe(metrics.SinceInMicroseconds(start))
 	}()
-	// Use host networking if specified.
-	netNamespace := ""
-	var ports []api.ContainerPort
-
-	if pod.Spec.HostNetwork {
-		netNamespace = "host"
-	} else {
-		// Docker only exports ports from the pod infra container.  Let's
-		// collect all of the relevant ports and export them.
-		for _, container := range pod.Spec.Containers {
-			ports = append(ports, container.Ports...)
-		}
-	}
-
+	netNamespace, ports := dm.podInfraContainerNetwork(pod)
 	container := &api.Container{
 		Name:  PodInfraContainerName,
 		Image: dm.podInfraContainerImage,
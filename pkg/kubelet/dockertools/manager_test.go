@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"reflect"
@@ -35,7 +36,9 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
 	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network/cni"
 	kubeprober "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/prober"
+	kubeletTypes "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/probe"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -1951,6 +1954,14 @@ func TestPortForwardNoSuchContainer(t *testing.T) {
 	}
 }
 
+func TestAttachContainer(t *testing.T) {
+	dm, fakeDocker := newTestDockerManager()
+	if err := dm.AttachContainer("foo", nil, nil, nil, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	verifyCalls(t, fakeDocker, []string{"attach"})
+}
+
 func TestSyncPodWithTerminationLog(t *testing.T) {
 	dm, fakeDocker := newTestDockerManager()
 	container := api.Container{
@@ -2050,3 +2061,178 @@ func TestGetPodStatusSortedContainers(t *testing.T) {
 		}
 	}
 }
+
+type fakeNetworkPlugin struct {
+	ip net.IP
+}
+
+func (plugin *fakeNetworkPlugin) Init(host network.Host) error {
+	return nil
+}
+
+func (plugin *fakeNetworkPlugin) Name() string {
+	return "fake"
+}
+
+func (plugin *fakeNetworkPlugin) SetUpPod(namespace string, name string, id kubeletTypes.DockerID) error {
+	return nil
+}
+
+func (plugin *fakeNetworkPlugin) TearDownPod(namespace string, name string, id kubeletTypes.DockerID) error {
+	return nil
+}
+
+func (plugin *fakeNetworkPlugin) GetPodNetworkStatus(namespace string, name string, id kubeletTypes.DockerID) (*network.PodNetworkStatus, error) {
+	return &network.PodNetworkStatus{IP: plugin.ip}, nil
+}
+
+// fakeCNIPlugin is a fake network plugin that is named like the CNI plugin.
+type fakeCNIPlugin struct {
+	fakeNetworkPlugin
+}
+
+func (plugin *fakeCNIPlugin) Name() string {
+	return cni.CNIPluginName
+}
+
+func TestCreatePodInfraContainerCNI(t *testing.T) {
+	dm, fakeDocker := newTestDockerManager()
+	dm.networkPlugin = &fakeCNIPlugin{fakeNetworkPlugin{ip: net.ParseIP("10.1.0.5")}}
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "foo",
+			Namespace: "new",
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{Name: "bar", Ports: []api.ContainerPort{{ContainerPort: 80, HostPort: 8080}}},
+			},
+		},
+	}
+
+	if _, err := dm.createPodInfraContainer(pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fakeDocker.Lock()
+	defer fakeDocker.Unlock()
+	hostConfig := fakeDocker.Container.HostConfig
+	if e, a := "none", hostConfig.NetworkMode; e != a {
+		t.Errorf("expected network mode %q, got %q", e, a)
+	}
+	if len(hostConfig.PortBindings) != 0 {
+		t.Errorf("expected no port bindings, got %v", hostConfig.PortBindings)
+	}
+}
+
+func TestSyncPodCNIPodInfraContainerChanged(t *testing.T) {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "foo",
+			Namespace: "new",
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{Name: "bar", Ports: []api.ContainerPort{{ContainerPort: 80, HostPort: 8080}}},
+			},
+		},
+	}
+	// Docker cannot export ports from an infra container without network.
+	hash := kubecontainer.HashContainer(&api.Container{Name: PodInfraContainerName, Image: PodInfraContainerImage})
+
+	for _, test := range []struct {
+		networkMode string
+		calls       []string
+	}{
+		{
+			networkMode: "none",
+			calls: []string{
+				// Check the pod infra container.
+				"inspect_container",
+				// Create container.
+				"create", "start", "inspect_container",
+			},
+		},
+		{
+			// Started on docker's bridge, before the CNI plugin was used.
+			networkMode: "",
+			calls: []string{
+				// Check the pod infra container.
+				"inspect_container",
+				// Kill the pod infra container.
+				"inspect_container", "stop",
+				// Create pod infra container.
+				"create", "start", "inspect_container",
+				// Create container.
+				"create", "start", "inspect_container",
+			},
+		},
+	} {
+		dm, fakeDocker := newTestDockerManager()
+		dm.networkPlugin = &fakeCNIPlugin{fakeNetworkPlugin{ip: net.ParseIP("10.1.0.5")}}
+		fakeDocker.ContainerList = []docker.APIContainers{
+			{
+				// pod infra container
+				Names: []string{"/k8s_POD." + strconv.FormatUint(hash, 16) + "_foo_new_12345678_0"},
+				ID:    "9876",
+			},
+		}
+		fakeDocker.ContainerMap = map[string]*docker.Container{
+			"9876": {
+				ID:         "9876",
+				Config:     &docker.Config{},
+				HostConfig: &docker.HostConfig{NetworkMode: test.networkMode},
+			},
+		}
+
+		runSyncPod(t, dm, fakeDocker, pod)
+		verifyCalls(t, fakeDocker, test.calls)
+	}
+}
+
+func TestGetPodStatusPodIP(t *testing.T) {
+	dm, fakeDocker := newTestDockerManager()
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "foo",
+			Namespace: "new",
+		},
+	}
+	fakeDocker.ContainerList = []docker.APIContainers{
+		{
+			ID:    "9876",
+			Names: []string{"/k8s_POD." + strconv.FormatUint(generatePodInfraContainerHash(pod), 16) + "_foo_new_12345678_0"},
+		},
+	}
+	fakeDocker.ContainerMap = map[string]*docker.Container{
+		"9876": {
+			ID:              "9876",
+			Config:          &docker.Config{},
+			State:           docker.State{Running: true},
+			NetworkSettings: &docker.NetworkSettings{IPAddress: "1.2.3.4"},
+		},
+	}
+
+	for _, test := range []struct {
+		plugin      network.NetworkPlugin
+		hostNetwork bool
+		expectedIP  string
+	}{
+		{dm.networkPlugin, false, "1.2.3.4"},
+		{&fakeNetworkPlugin{ip: net.ParseIP("10.1.0.5")}, false, "10.1.0.5"},
+		{&fakeNetworkPlugin{ip: net.ParseIP("10.1.0.5")}, true, "1.2.3.4"},
+	} {
+		dm.networkPlugin = test.plugin
+		pod.Spec.HostNetwork = test.hostNetwork
+		status, err := dm.GetPodStatus(pod)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if status.PodIP != test.expectedIP {
+			t.Errorf("%s plugin, host network %t: expected pod IP %q, got %q", test.plugin.Name(), test.hostNetwork, test.expectedIP, status.PodIP)
+		}
+	}
+}
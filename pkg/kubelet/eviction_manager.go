@@ -0,0 +1,408 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/cadvisor"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+// EvictionSignal is a resource on the node that the eviction manager observes.
+type EvictionSignal string
+
+const (
+	// Memory available on the node: the memory capacity minus the working set of the node.
+	SignalMemoryAvailable EvictionSignal = "memory.available"
+	// Disk space available on the root filesystem of the node.
+	SignalNodeFsAvailable EvictionSignal = "nodefs.available"
+)
+
+// Resource whose usage ranks the pods for eviction when disk is low.
+const resourceDisk api.ResourceName = "disk"
+
+// Reason set on the status of evicted pods.
+const reasonEvicted = "Evicted"
+
+// The resource consumed by pods for each signal.
+var signalToResource = map[EvictionSignal]api.ResourceName{
+	SignalMemoryAvailable: api.ResourceMemory,
+	SignalNodeFsAvailable: resourceDisk,
+}
+
+// EvictionThreshold is crossed when the observed value of a signal falls below Value.
+type EvictionThreshold struct {
+	Signal EvictionSignal
+	Value  resource.Quantity
+	// How long the threshold must be crossed before pods are evicted, zero
+	// for a hard threshold.
+	GracePeriod time.Duration
+}
+
+// Specifies when pods are evicted to reclaim resources of the node.
+type EvictionPolicy struct {
+	// Thresholds that trigger eviction, none to disable eviction.
+	Thresholds []EvictionThreshold
+	// How long a pressure condition is still reported after its thresholds
+	// are no longer crossed.
+	PressureTransitionPeriod time.Duration
+}
+
+// Monitors the resources of the node and evicts pods when they run low.
+//
+// Implementation is thread-safe.
+type evictionManager interface {
+	// Starts monitoring the thresholds of the policy.
+	Start()
+	// Whether a memory threshold is crossed.
+	IsUnderMemoryPressure() bool
+	// Whether a disk threshold is crossed.
+	IsUnderDiskPressure() bool
+}
+
+// Returns the pods that are candidates for eviction.
+type activePodsFunc func() []*api.Pod
+
+// Returns the memory and disk used by the containers of a pod.
+type podUsageFunc func(pod *api.Pod) (api.ResourceList, error)
+
+// Stops the containers of a pod and sets its status.
+type evictPodFunc func(pod *api.Pod, status api.PodStatus) error
+
+type realEvictionManager struct {
+	cadvisor   cadvisor.Interface
+	policy     EvictionPolicy
+	activePods activePodsFunc
+	podUsage   podUsageFunc
+	evictPod   evictPodFunc
+	recorder   record.EventRecorder
+	clock      util.Clock
+
+	// Protects the fields below.
+	lock sync.Mutex
+	// When each threshold was first seen crossed, by index in the policy.
+	thresholdsFirstObservedAt map[int]time.Time
+	// When each signal was last seen crossing one of its thresholds.
+	lastPressureObservedAt map[EvictionSignal]time.Time
+	// Signals currently reported under pressure.
+	signalsUnderPressure map[EvictionSignal]bool
+}
+
+func newEvictionManager(cadvisorInterface cadvisor.Interface, policy EvictionPolicy, activePods activePodsFunc, podUsage podUsageFunc, evictPod evictPodFunc, recorder record.EventRecorder) (evictionManager, error) {
+	if err := validateEvictionPolicy(policy); err != nil {
+		return nil, err
+	}
+	return &realEvictionManager{
+		cadvisor:                  cadvisorInterface,
+		policy:                    policy,
+		activePods:                activePods,
+		podUsage:                  podUsage,
+		evictPod:                  evictPod,
+		recorder:                  recorder,
+		clock:                     util.RealClock{},
+		thresholdsFirstObservedAt: make(map[int]time.Time),
+		lastPressureObservedAt:    make(map[EvictionSignal]time.Time),
+		signalsUnderPressure:      make(map[EvictionSignal]bool),
+	}, nil
+}
+
+func validateEvictionPolicy(policy EvictionPolicy) error {
+	for _, threshold := range policy.Thresholds {
+		if _, ok := signalToResource[threshold.Signal]; !ok {
+			return fmt.Errorf("unsupported eviction signal %q", threshold.Signal)
+		}
+		if threshold.Value.Value() < 0 {
+			return fmt.Errorf("eviction threshold for %q should be non-negative, got %v", threshold.Signal, threshold.Value.String())
+		}
+		if threshold.GracePeriod < 0 {
+			return fmt.Errorf("eviction grace period for %q should be non-negative, got %v", threshold.Signal, threshold.GracePeriod)
+		}
+	}
+	if policy.PressureTransitionPeriod < 0 {
+		return fmt.Errorf("pressure transition period should be non-negative, got %v", policy.PressureTransitionPeriod)
+	}
+	return nil
+}
+
+func (m *realEvictionManager) Start() {
+	if len(m.policy.Thresholds) == 0 {
+		return
+	}
+	go util.Forever(m.synchronize, 10*time.Second)
+}
+
+func (m *realEvictionManager) IsUnderMemoryPressure() bool {
+	return m.isUnderPressure(SignalMemoryAvailable)
+}
+
+func (m *realEvictionManager) IsUnderDiskPressure() bool {
+	return m.isUnderPressure(SignalNodeFsAvailable)
+}
+
+func (m *realEvictionManager) isUnderPressure(signal EvictionSignal) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.signalsUnderPressure[signal]
+}
+
+// synchronize observes the signals of the policy and evicts one pod if a
+// threshold has been crossed for longer than its grace period.
+func (m *realEvictionManager) synchronize() {
+	observations := m.makeObservations()
+	triggered := m.updateThresholds(observations)
+	if len(triggered) == 0 {
+		return
+	}
+	threshold := triggered[0]
+	glog.Infof("Eviction threshold crossed for %q: observed %d, threshold %s", threshold.Signal, observations[threshold.Signal], threshold.Value.String())
+	m.evictOne(signalToResource[threshold.Signal])
+}
+
+// makeObservations returns the observed value of each signal. Signals that
+// cannot be observed are left out.
+func (m *realEvictionManager) makeObservations() map[EvictionSignal]int64 {
+	observations := make(map[EvictionSignal]int64)
+	if available, err := m.memoryAvailable(); err != nil {
+		glog.Errorf("Failed to observe %q: %v", SignalMemoryAvailable, err)
+	} else {
+		observations[SignalMemoryAvailable] = available
+	}
+	if fsInfo, err := m.cadvisor.RootFsInfo(); err != nil {
+		glog.Errorf("Failed to observe %q: %v", SignalNodeFsAvailable, err)
+	} else {
+		observations[SignalNodeFsAvailable] = int64(fsInfo.Available)
+	}
+	return observations
+}
+
+func (m *realEvictionManager) memoryAvailable() (int64, error) {
+	machineInfo, err := m.cadvisor.MachineInfo()
+	if err != nil {
+		return 0, err
+	}
+	rootInfo, err := m.cadvisor.ContainerInfo("/", &cadvisorApi.ContainerInfoRequest{NumStats: 1})
+	if err != nil {
+		return 0, err
+	}
+	if len(rootInfo.Stats) == 0 {
+		return 0, fmt.Errorf("no stats for the root container")
+	}
+	workingSet := rootInfo.Stats[len(rootInfo.Stats)-1].Memory.WorkingSet
+	return machineInfo.MemoryCapacity - int64(workingSet), nil
+}
+
+// updateThresholds records which thresholds are crossed by the observations
+// and returns those that have been crossed for longer than their grace period.
+func (m *realEvictionManager) updateThresholds(observations map[EvictionSignal]int64) []EvictionThreshold {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	now := m.clock.Now()
+	var triggered []EvictionThreshold
+	for i, threshold := range m.policy.Thresholds {
+		observed, ok := observations[threshold.Signal]
+		if !ok || observed >= threshold.Value.Value() {
+			delete(m.thresholdsFirstObservedAt, i)
+			continue
+		}
+		m.lastPressureObservedAt[threshold.Signal] = now
+		firstObservedAt, ok := m.thresholdsFirstObservedAt[i]
+		if !ok {
+			firstObservedAt = now
+			m.thresholdsFirstObservedAt[i] = now
+		}
+		if now.Sub(firstObservedAt) >= threshold.GracePeriod {
+			triggered = append(triggered, threshold)
+		}
+	}
+
+	// Keep reporting pressure for the transition period to avoid flapping.
+	signalsUnderPressure := make(map[EvictionSignal]bool)
+	for signal, lastObservedAt := range m.lastPressureObservedAt {
+		if lastObservedAt.Equal(now) || now.Sub(lastObservedAt) < m.policy.PressureTransitionPeriod {
+			signalsUnderPressure[signal] = true
+		}
+	}
+	m.signalsUnderPressure = signalsUnderPressure
+	return triggered
+}
+
+// evictOne evicts the active pod whose usage of resourceName exceeds its
+// request the most.
+func (m *realEvictionManager) evictOne(resourceName api.ResourceName) {
+	pods := m.activePods()
+	usage := make(map[*api.Pod]int64)
+	for _, pod := range pods {
+		podUsage, err := m.podUsage(pod)
+		if err != nil {
+			glog.Errorf("Failed to get usage of pod %q: %v", kubecontainer.GetPodFullName(pod), err)
+			continue
+		}
+		if quantity, ok := podUsage[resourceName]; ok {
+			usage[pod] = quantity.Value()
+		}
+	}
+	ranked := rankPodsForEviction(pods, resourceName, usage)
+	if len(ranked) == 0 {
+		glog.Infof("No pods to evict to reclaim %s", resourceName)
+		return
+	}
+	pod := ranked[0]
+	message := fmt.Sprintf("The node was low on %s.", resourceName)
+	glog.Infof("Evicting pod %q: %s", kubecontainer.GetPodFullName(pod), message)
+	m.recorder.Eventf(pod, reasonEvicted, "%s", message)
+	status := api.PodStatus{
+		Phase:   api.PodFailed,
+		Reason:  reasonEvicted,
+		Message: message,
+	}
+	if err := m.evictPod(pod, status); err != nil {
+		glog.Errorf("Failed to evict pod %q: %v", kubecontainer.GetPodFullName(pod), err)
+	}
+}
+
+// rankPodsForEviction returns the pods sorted by how much their usage of
+// resourceName exceeds their request, largest first.
+func rankPodsForEviction(pods []*api.Pod, resourceName api.ResourceName, usage map[*api.Pod]int64) []*api.Pod {
+	ranked := podsByUsageAboveRequest{
+		pods:  make([]*api.Pod, len(pods)),
+		score: make(map[*api.Pod]int64),
+	}
+	copy(ranked.pods, pods)
+	for _, pod := range pods {
+		ranked.score[pod] = usage[pod] - podRequest(pod, resourceName)
+	}
+	sort.Stable(ranked)
+	return ranked.pods
+}
+
+// podRequest returns the sum of the requests of the containers of pod for resourceName.
+func podRequest(pod *api.Pod, resourceName api.ResourceName) int64 {
+	var total int64
+	for _, container := range pod.Spec.Containers {
+		if request, ok := container.Resources.Requests[resourceName]; ok {
+			total += request.Value()
+		}
+	}
+	return total
+}
+
+type podsByUsageAboveRequest struct {
+	pods  []*api.Pod
+	score map[*api.Pod]int64
+}
+
+func (s podsByUsageAboveRequest) Len() int {
+	return len(s.pods)
+}
+
+func (s podsByUsageAboveRequest) Swap(i, j int) {
+	s.pods[i], s.pods[j] = s.pods[j], s.pods[i]
+}
+
+func (s podsByUsageAboveRequest) Less(i, j int) bool {
+	return s.score[s.pods[i]] > s.score[s.pods[j]]
+}
+
+// ParseEvictionPolicy builds an eviction policy from flag values. Thresholds
+// are comma-separated "<signal><<quantity>" pairs, e.g.
+// "memory.available<100Mi,nodefs.available<1Gi", and grace periods are
+// comma-separated "<signal>=<duration>" pairs, e.g. "memory.available=1m30s".
+// Every soft threshold requires a grace period.
+func ParseEvictionPolicy(hard, soft, softGracePeriod string, pressureTransitionPeriod time.Duration) (EvictionPolicy, error) {
+	policy := EvictionPolicy{PressureTransitionPeriod: pressureTransitionPeriod}
+	hardThresholds, err := parseEvictionThresholds(hard)
+	if err != nil {
+		return policy, err
+	}
+	softThresholds, err := parseEvictionThresholds(soft)
+	if err != nil {
+		return policy, err
+	}
+	gracePeriods, err := parseEvictionGracePeriods(softGracePeriod)
+	if err != nil {
+		return policy, err
+	}
+	for i := range softThresholds {
+		gracePeriod, ok := gracePeriods[softThresholds[i].Signal]
+		if !ok {
+			return policy, fmt.Errorf("no grace period specified for soft eviction threshold %q", softThresholds[i].Signal)
+		}
+		softThresholds[i].GracePeriod = gracePeriod
+		delete(gracePeriods, softThresholds[i].Signal)
+	}
+	for signal := range gracePeriods {
+		return policy, fmt.Errorf("grace period specified for %q without a soft eviction threshold", signal)
+	}
+	policy.Thresholds = append(hardThresholds, softThresholds...)
+	return policy, validateEvictionPolicy(policy)
+}
+
+func parseEvictionThresholds(value string) ([]EvictionThreshold, error) {
+	var thresholds []EvictionThreshold
+	for _, item := range splitEvictionList(value) {
+		parts := strings.SplitN(item, "<", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid eviction threshold %q, expected <signal><<quantity>", item)
+		}
+		quantity, err := resource.ParseQuantity(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity in eviction threshold %q: %v", item, err)
+		}
+		thresholds = append(thresholds, EvictionThreshold{
+			Signal: EvictionSignal(parts[0]),
+			Value:  *quantity,
+		})
+	}
+	return thresholds, nil
+}
+
+func parseEvictionGracePeriods(value string) (map[EvictionSignal]time.Duration, error) {
+	gracePeriods := make(map[EvictionSignal]time.Duration)
+	for _, item := range splitEvictionList(value) {
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid eviction grace period %q, expected <signal>=<duration>", item)
+		}
+		gracePeriod, err := time.ParseDuration(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration in eviction grace period %q: %v", item, err)
+		}
+		gracePeriods[EvictionSignal(parts[0])] = gracePeriod
+	}
+	return gracePeriods, nil
+}
+
+func splitEvictionList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/cadvisor"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+	cadvisorApiV2 "github.com/google/cadvisor/info/v2"
+)
+
+const mb = 1024 * 1024
+
+// Records the pods evicted by the eviction manager.
+type fakeEvictor struct {
+	evicted  []*api.Pod
+	statuses []api.PodStatus
+}
+
+func (f *fakeEvictor) evictPod(pod *api.Pod, status api.PodStatus) error {
+	f.evicted = append(f.evicted, pod)
+	f.statuses = append(f.statuses, status)
+	return nil
+}
+
+// Returns a cadvisor reporting the specified available memory and root disk space.
+func newEvictionCadvisor(memoryAvailable, fsAvailable uint64) *cadvisor.Mock {
+	mockCadvisor := &cadvisor.Mock{}
+	mockCadvisor.On("MachineInfo").Return(&cadvisorApi.MachineInfo{MemoryCapacity: 1024 * mb}, nil)
+	rootInfo := &cadvisorApi.ContainerInfo{
+		Stats: []*cadvisorApi.ContainerStats{
+			{Memory: cadvisorApi.MemoryStats{WorkingSet: 1024*mb - memoryAvailable}},
+		},
+	}
+	mockCadvisor.On("ContainerInfo", "/", &cadvisorApi.ContainerInfoRequest{NumStats: 1}).Return(rootInfo, nil)
+	mockCadvisor.On("RootFsInfo").Return(cadvisorApiV2.FsInfo{Capacity: 10240 * mb, Available: fsAvailable}, nil)
+	return mockCadvisor
+}
+
+func makeEvictionPod(name string, memoryRequest string) *api.Pod {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       types.UID(name),
+			Name:      name,
+			Namespace: "test",
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{{Name: "foo"}},
+		},
+	}
+	if memoryRequest != "" {
+		pod.Spec.Containers[0].Resources.Requests = api.ResourceList{
+			api.ResourceMemory: resource.MustParse(memoryRequest),
+		}
+	}
+	return pod
+}
+
+func newTestEvictionManager(t *testing.T, policy EvictionPolicy, mockCadvisor *cadvisor.Mock, pods []*api.Pod, usage map[*api.Pod]api.ResourceList) (*realEvictionManager, *fakeEvictor, *util.FakeClock) {
+	evictor := &fakeEvictor{}
+	activePods := func() []*api.Pod { return pods }
+	podUsage := func(pod *api.Pod) (api.ResourceList, error) { return usage[pod], nil }
+	manager, err := newEvictionManager(mockCadvisor, policy, activePods, podUsage, evictor.evictPod, &record.FakeRecorder{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := manager.(*realEvictionManager)
+	clock := &util.FakeClock{Time: time.Now()}
+	m.clock = clock
+	return m, evictor, clock
+}
+
+func TestParseEvictionPolicy(t *testing.T) {
+	policy, err := ParseEvictionPolicy("memory.available<100Mi, nodefs.available<1Gi", "memory.available<300Mi", "memory.available=1m30s", 5*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := EvictionPolicy{
+		Thresholds: []EvictionThreshold{
+			{Signal: SignalMemoryAvailable, Value: resource.MustParse("100Mi")},
+			{Signal: SignalNodeFsAvailable, Value: resource.MustParse("1Gi")},
+			{Signal: SignalMemoryAvailable, Value: resource.MustParse("300Mi"), GracePeriod: 90 * time.Second},
+		},
+		PressureTransitionPeriod: 5 * time.Minute,
+	}
+	if !reflect.DeepEqual(expected, policy) {
+		t.Errorf("expected %+v, got %+v", expected, policy)
+	}
+
+	policy, err = ParseEvictionPolicy("", "", "", 0)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(policy.Thresholds) != 0 {
+		t.Errorf("expected no thresholds, got %+v", policy.Thresholds)
+	}
+}
+
+func TestParseEvictionPolicyErrors(t *testing.T) {
+	testCases := []struct {
+		hard, soft, softGracePeriod string
+	}{
+		{hard: "memory.available"},
+		{hard: "memory.available>100Mi"},
+		{hard: "memory.available<abc"},
+		{hard: "cpu.available<100m"},
+		{soft: "memory.available<100Mi"},
+		{softGracePeriod: "memory.available=1m"},
+		{soft: "memory.available<100Mi", softGracePeriod: "memory.available=forever"},
+		{soft: "memory.available<100Mi", softGracePeriod: "memory.available=-1m"},
+	}
+	for _, test := range testCases {
+		if _, err := ParseEvictionPolicy(test.hard, test.soft, test.softGracePeriod, 0); err == nil {
+			t.Errorf("expected error for %+v", test)
+		}
+	}
+}
+
+func TestEvictionManagerHardThreshold(t *testing.T) {
+	small := makeEvictionPod("small", "")
+	large := makeEvictionPod("large", "")
+	requested := makeEvictionPod("requested", "500Mi")
+	usage := map[*api.Pod]api.ResourceList{
+		small:     {api.ResourceMemory: resource.MustParse("50Mi")},
+		large:     {api.ResourceMemory: resource.MustParse("200Mi")},
+		requested: {api.ResourceMemory: resource.MustParse("600Mi")},
+	}
+	policy := EvictionPolicy{
+		Thresholds: []EvictionThreshold{{Signal: SignalMemoryAvailable, Value: resource.MustParse("100Mi")}},
+	}
+
+	// Enough memory available: nothing is evicted.
+	manager, evictor, _ := newTestEvictionManager(t, policy, newEvictionCadvisor(200*mb, 1024*mb), []*api.Pod{small, large, requested}, usage)
+	manager.synchronize()
+	if len(evictor.evicted) != 0 {
+		t.Errorf("unexpected evictions: %v", evictor.evicted)
+	}
+	if manager.IsUnderMemoryPressure() || manager.IsUnderDiskPressure() {
+		t.Errorf("unexpected pressure")
+	}
+
+	// Low on memory: the pod using the most memory above its request is evicted.
+	manager.cadvisor = newEvictionCadvisor(50*mb, 1024*mb)
+	manager.synchronize()
+	if len(evictor.evicted) != 1 || evictor.evicted[0] != large {
+		t.Fatalf("expected pod %q to be evicted, got %v", large.Name, evictor.evicted)
+	}
+	if evictor.statuses[0].Phase != api.PodFailed || evictor.statuses[0].Reason != reasonEvicted {
+		t.Errorf("unexpected status for evicted pod: %+v", evictor.statuses[0])
+	}
+	if !manager.IsUnderMemoryPressure() {
+		t.Errorf("expected memory pressure")
+	}
+	if manager.IsUnderDiskPressure() {
+		t.Errorf("unexpected disk pressure")
+	}
+}
+
+func TestEvictionManagerSoftThreshold(t *testing.T) {
+	pod := makeEvictionPod("foo", "")
+	usage := map[*api.Pod]api.ResourceList{
+		pod: {resourceDisk: resource.MustParse("1Gi")},
+	}
+	policy := EvictionPolicy{
+		Thresholds: []EvictionThreshold{{Signal: SignalNodeFsAvailable, Value: resource.MustParse("1Gi"), GracePeriod: time.Minute}},
+	}
+	manager, evictor, clock := newTestEvictionManager(t, policy, newEvictionCadvisor(512*mb, 512*mb), []*api.Pod{pod}, usage)
+
+	manager.synchronize()
+	if len(evictor.evicted) != 0 {
+		t.Errorf("unexpected evictions within the grace period: %v", evictor.evicted)
+	}
+	if !manager.IsUnderDiskPressure() {
+		t.Errorf("expected disk pressure")
+	}
+
+	clock.Time = clock.Time.Add(time.Minute)
+	manager.synchronize()
+	if len(evictor.evicted) != 1 || evictor.evicted[0] != pod {
+		t.Errorf("expected pod %q to be evicted, got %v", pod.Name, evictor.evicted)
+	}
+}
+
+func TestEvictionManagerPressureTransitionPeriod(t *testing.T) {
+	policy := EvictionPolicy{
+		Thresholds:               []EvictionThreshold{{Signal: SignalMemoryAvailable, Value: resource.MustParse("100Mi")}},
+		PressureTransitionPeriod: 5 * time.Minute,
+	}
+	manager, _, clock := newTestEvictionManager(t, policy, newEvictionCadvisor(50*mb, 1024*mb), nil, nil)
+	manager.synchronize()
+	if !manager.IsUnderMemoryPressure() {
+		t.Errorf("expected memory pressure")
+	}
+
+	// The threshold is no longer crossed, but the pressure is kept for the transition period.
+	manager.cadvisor = newEvictionCadvisor(200*mb, 1024*mb)
+	clock.Time = clock.Time.Add(time.Minute)
+	manager.synchronize()
+	if !manager.IsUnderMemoryPressure() {
+		t.Errorf("expected memory pressure within the transition period")
+	}
+
+	clock.Time = clock.Time.Add(5 * time.Minute)
+	manager.synchronize()
+	if manager.IsUnderMemoryPressure() {
+		t.Errorf("unexpected memory pressure after the transition period")
+	}
+}
+
+func TestRankPodsForEviction(t *testing.T) {
+	best := makeEvictionPod("best", "1Gi")
+	burst := makeEvictionPod("burst", "100Mi")
+	none := makeEvictionPod("none", "")
+	usage := map[*api.Pod]int64{
+		best:  900 * mb,
+		burst: 300 * mb,
+		none:  150 * mb,
+	}
+	ranked := rankPodsForEviction([]*api.Pod{best, burst, none}, api.ResourceMemory, usage)
+	expected := []*api.Pod{burst, none, best}
+	if !reflect.DeepEqual(expected, ranked) {
+		t.Errorf("expected %v, got %v", expected, ranked)
+	}
+}
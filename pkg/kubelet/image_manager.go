@@ -24,11 +24,10 @@ import (
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/cadvisor"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/dockertools"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
-	docker "github.com/fsouza/go-dockerclient"
 	"github.com/golang/glog"
+	cadvisorApiV2 "github.com/google/cadvisor/info/v2"
 )
 
 // Manages lifecycle of all images.
@@ -58,8 +57,8 @@ type ImageGCPolicy struct {
 }
 
 type realImageManager struct {
-	// Connection to the Docker daemon.
-	dockerClient dockertools.DockerInterface
+	// Container runtime
+	runtime kubecontainer.Runtime
 
 	// Records of images and their use.
 	imageRecords     map[string]*imageRecord
@@ -68,8 +67,8 @@ type realImageManager struct {
 	// The image garbage collection policy in use.
 	policy ImageGCPolicy
 
-	// cAdvisor instance.
-	cadvisor cadvisor.Interface
+	// Returns usage information about the filesystem holding the images.
+	imagesFsInfo func() (cadvisorApiV2.FsInfo, error)
 
 	// Recorder for Kubernetes events.
 	recorder record.EventRecorder
@@ -90,7 +89,7 @@ type imageRecord struct {
 	size int64
 }
 
-func newImageManager(dockerClient dockertools.DockerInterface, cadvisorInterface cadvisor.Interface, recorder record.EventRecorder, nodeRef *api.ObjectReference, policy ImageGCPolicy) (imageManager, error) {
+func newImageManager(runtime kubecontainer.Runtime, imagesFsInfo func() (cadvisorApiV2.FsInfo, error), recorder record.EventRecorder, nodeRef *api.ObjectReference, policy ImageGCPolicy) (imageManager, error) {
 	// Validate policy.
 	if policy.HighThresholdPercent < 0 || policy.HighThresholdPercent > 100 {
 		return nil, fmt.Errorf("invalid HighThresholdPercent %d, must be in range [0-100]", policy.HighThresholdPercent)
@@ -99,10 +98,10 @@ func newImageManager(dockerClient dockertools.DockerInterface, cadvisorInterface
 		return nil, fmt.Errorf("invalid LowThresholdPercent %d, must be in range [0-100]", policy.LowThresholdPercent)
 	}
 	im := &realImageManager{
-		dockerClient: dockerClient,
+		runtime:      runtime,
 		policy:       policy,
 		imageRecords: make(map[string]*imageRecord),
-		cadvisor:     cadvisorInterface,
+		imagesFsInfo: imagesFsInfo,
 		recorder:     recorder,
 		nodeRef:      nodeRef,
 	}
@@ -128,22 +127,26 @@ func (im *realImageManager) Start() error {
 	return nil
 }
 
+// detectImages records the images known to the runtime, and marks those used
+// by a container as used now. Only the containers of the runtime's pods are
+// seen, so an image used solely by containers started outside the kubelet is
+// treated as unused, and it is up to the runtime to refuse to remove it.
 func (im *realImageManager) detectImages(detected time.Time) error {
-	images, err := im.dockerClient.ListImages(docker.ListImagesOptions{})
+	images, err := im.runtime.ListImages()
 	if err != nil {
 		return err
 	}
-	containers, err := im.dockerClient.ListContainers(docker.ListContainersOptions{
-		All: true,
-	})
+	pods, err := im.runtime.GetPods(true)
 	if err != nil {
 		return err
 	}
 
 	// Make a set of images in use by containers.
 	imagesInUse := util.NewStringSet()
-	for _, container := range containers {
-		imagesInUse.Insert(container.Image)
+	for _, pod := range pods {
+		for _, container := range pod.Containers {
+			imagesInUse.Insert(container.Image)
+		}
 	}
 
 	// Add new images and record those being used.
@@ -166,7 +169,7 @@ func (im *realImageManager) detectImages(detected time.Time) error {
 			im.imageRecords[image.ID].lastUsed = now
 		}
 
-		im.imageRecords[image.ID].size = image.VirtualSize
+		im.imageRecords[image.ID].size = image.Size
 	}
 
 	// Remove old images from our records.
@@ -181,7 +184,7 @@ func (im *realImageManager) detectImages(detected time.Time) error {
 
 func (im *realImageManager) GarbageCollect() error {
 	// Get disk usage on disk holding images.
-	fsInfo, err := im.cadvisor.DockerImagesFsInfo()
+	fsInfo, err := im.imagesFsInfo()
 	if err != nil {
 		return err
 	}
@@ -249,10 +252,17 @@ func (im *realImageManager) freeSpace(bytesToFree int64) (int64, error) {
 		if image.lastUsed.After(startTime) {
 			break
 		}
+		// Runtimes report 0 for images whose size they cannot determine.
+		// Removing such images would not count towards bytesToFree, so every
+		// unused image would be removed.
+		if image.size == 0 {
+			glog.V(4).Infof("[ImageManager]: Not removing image %q of unknown size", image.id)
+			continue
+		}
 
 		// Remove image. Continue despite errors.
 		glog.Infof("[ImageManager]: Removing image %q to free %d bytes", image.id, image.size)
-		err := im.dockerClient.RemoveImage(image.id)
+		err := im.runtime.RemoveImage(kubecontainer.ImageSpec{Image: image.id})
 		if err != nil {
 			lastErr = err
 			continue
@@ -286,12 +296,12 @@ func (ev byLastUsedAndDetected) Less(i, j int) bool {
 	}
 }
 
-func isImageUsed(image *docker.APIImages, imagesInUse util.StringSet) bool {
-	// Check the image ID and all the RepoTags.
+func isImageUsed(image *kubecontainer.Image, imagesInUse util.StringSet) bool {
+	// Check the image ID and all the tags.
 	if _, ok := imagesInUse[image.ID]; ok {
 		return true
 	}
-	for _, tag := range image.RepoTags {
+	for _, tag := range image.Tags {
 		if _, ok := imagesInUse[tag]; ok {
 			return true
 		}
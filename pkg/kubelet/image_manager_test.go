@@ -23,9 +23,8 @@ import (
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/cadvisor"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/dockertools"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
-	docker "github.com/fsouza/go-dockerclient"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
 	cadvisorApiV2 "github.com/google/cadvisor/info/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,18 +32,16 @@ import (
 
 var zero time.Time
 
-func newRealImageManager(policy ImageGCPolicy) (*realImageManager, *dockertools.FakeDockerClient, *cadvisor.Mock) {
-	fakeDocker := &dockertools.FakeDockerClient{
-		RemovedImages: util.NewStringSet(),
-	}
+func newRealImageManager(policy ImageGCPolicy) (*realImageManager, *kubecontainer.FakeRuntime, *cadvisor.Mock) {
+	fakeRuntime := &kubecontainer.FakeRuntime{}
 	mockCadvisor := new(cadvisor.Mock)
 	return &realImageManager{
-		dockerClient: fakeDocker,
+		runtime:      fakeRuntime,
 		policy:       policy,
 		imageRecords: make(map[string]*imageRecord),
-		cadvisor:     mockCadvisor,
+		imagesFsInfo: mockCadvisor.DockerImagesFsInfo,
 		recorder:     &record.FakeRecorder{},
-	}, fakeDocker, mockCadvisor
+	}, fakeRuntime, mockCadvisor
 }
 
 // Accessors used for thread-safe testing.
@@ -67,30 +64,40 @@ func imageName(id int) string {
 }
 
 // Make an image with the specified ID.
-func makeImage(id int, size int64) docker.APIImages {
-	return docker.APIImages{
-		ID:          imageName(id),
-		VirtualSize: size,
+func makeImage(id int, size int64) kubecontainer.Image {
+	return kubecontainer.Image{
+		ID:   imageName(id),
+		Size: size,
 	}
 }
 
 // Make a container with the specified ID. It will use the image with the same ID.
-func makeContainer(id int) docker.APIContainers {
-	return docker.APIContainers{
-		ID:    fmt.Sprintf("container-%d", id),
+func makeContainer(id int) *kubecontainer.Container {
+	return &kubecontainer.Container{
+		ID:    types.UID(fmt.Sprintf("container-%d", id)),
 		Image: imageName(id),
 	}
 }
 
+// Make a pod with the specified containers.
+func makePods(containers ...*kubecontainer.Container) []*kubecontainer.Pod {
+	return []*kubecontainer.Pod{
+		{
+			ID:         "pod",
+			Containers: containers,
+		},
+	}
+}
+
 func TestDetectImagesInitialDetect(t *testing.T) {
-	manager, fakeDocker, _ := newRealImageManager(ImageGCPolicy{})
-	fakeDocker.Images = []docker.APIImages{
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 		makeImage(1, 2048),
 	}
-	fakeDocker.ContainerList = []docker.APIContainers{
+	fakeRuntime.PodList = makePods(
 		makeContainer(1),
-	}
+	)
 
 	startTime := time.Now().Add(-time.Millisecond)
 	err := manager.detectImages(zero)
@@ -109,14 +116,14 @@ func TestDetectImagesInitialDetect(t *testing.T) {
 
 func TestDetectImagesWithNewImage(t *testing.T) {
 	// Just one image initially.
-	manager, fakeDocker, _ := newRealImageManager(ImageGCPolicy{})
-	fakeDocker.Images = []docker.APIImages{
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 		makeImage(1, 2048),
 	}
-	fakeDocker.ContainerList = []docker.APIContainers{
+	fakeRuntime.PodList = makePods(
 		makeContainer(1),
-	}
+	)
 
 	err := manager.detectImages(zero)
 	assert := assert.New(t)
@@ -124,7 +131,7 @@ func TestDetectImagesWithNewImage(t *testing.T) {
 	assert.Equal(manager.imageRecordsLen(), 2)
 
 	// Add a new image.
-	fakeDocker.Images = []docker.APIImages{
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 		makeImage(1, 1024),
 		makeImage(2, 1024),
@@ -150,14 +157,14 @@ func TestDetectImagesWithNewImage(t *testing.T) {
 }
 
 func TestDetectImagesContainerStopped(t *testing.T) {
-	manager, fakeDocker, _ := newRealImageManager(ImageGCPolicy{})
-	fakeDocker.Images = []docker.APIImages{
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 		makeImage(1, 2048),
 	}
-	fakeDocker.ContainerList = []docker.APIContainers{
+	fakeRuntime.PodList = makePods(
 		makeContainer(1),
-	}
+	)
 
 	err := manager.detectImages(zero)
 	assert := assert.New(t)
@@ -167,7 +174,7 @@ func TestDetectImagesContainerStopped(t *testing.T) {
 	require.True(t, ok)
 
 	// Simulate container being stopped.
-	fakeDocker.ContainerList = []docker.APIContainers{}
+	fakeRuntime.PodList = []*kubecontainer.Pod{}
 	err = manager.detectImages(time.Now())
 	require.NoError(t, err)
 	assert.Equal(manager.imageRecordsLen(), 2)
@@ -182,14 +189,14 @@ func TestDetectImagesContainerStopped(t *testing.T) {
 }
 
 func TestDetectImagesWithRemovedImages(t *testing.T) {
-	manager, fakeDocker, _ := newRealImageManager(ImageGCPolicy{})
-	fakeDocker.Images = []docker.APIImages{
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 		makeImage(1, 2048),
 	}
-	fakeDocker.ContainerList = []docker.APIContainers{
+	fakeRuntime.PodList = makePods(
 		makeContainer(1),
-	}
+	)
 
 	err := manager.detectImages(zero)
 	assert := assert.New(t)
@@ -197,48 +204,92 @@ func TestDetectImagesWithRemovedImages(t *testing.T) {
 	assert.Equal(manager.imageRecordsLen(), 2)
 
 	// Simulate both images being removed.
-	fakeDocker.Images = []docker.APIImages{}
+	fakeRuntime.ImageList = []kubecontainer.Image{}
 	err = manager.detectImages(time.Now())
 	require.NoError(t, err)
 	assert.Equal(manager.imageRecordsLen(), 0)
 }
 
 func TestFreeSpaceImagesInUseContainersAreIgnored(t *testing.T) {
-	manager, fakeDocker, _ := newRealImageManager(ImageGCPolicy{})
-	fakeDocker.Images = []docker.APIImages{
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 		makeImage(1, 2048),
 	}
-	fakeDocker.ContainerList = []docker.APIContainers{
+	fakeRuntime.PodList = makePods(
 		makeContainer(1),
-	}
+	)
 
 	spaceFreed, err := manager.freeSpace(2048)
 	assert := assert.New(t)
 	require.NoError(t, err)
 	assert.EqualValues(1024, spaceFreed)
-	assert.Len(fakeDocker.RemovedImages, 1)
-	assert.True(fakeDocker.RemovedImages.Has(imageName(0)))
+	assert.Len(fakeRuntime.ImageList, 1)
+	assert.NotEqual(imageName(0), fakeRuntime.ImageList[0].ID)
+}
+
+func TestFreeSpaceImagesOfUnknownSizeAreIgnored(t *testing.T) {
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []kubecontainer.Image{
+		makeImage(0, 0),
+		makeImage(1, 2048),
+	}
+
+	spaceFreed, err := manager.freeSpace(4096)
+	assert := assert.New(t)
+	require.NoError(t, err)
+	assert.EqualValues(2048, spaceFreed)
+	assert.Len(fakeRuntime.ImageList, 1)
+	assert.Equal(imageName(0), fakeRuntime.ImageList[0].ID)
+}
+
+func TestFreeSpaceRktImages(t *testing.T) {
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	// rkt lists images by their key, and its containers refer to them by
+	// name and version.
+	fakeRuntime.ImageList = []kubecontainer.Image{
+		{
+			ID:   "sha512-91e98d7f1679a097c878203c9659f2a26ae394656b3147963324c61fa3832f15",
+			Tags: []string{"coreos.com/etcd:v2.0.9"},
+			Size: 1024,
+		},
+		{
+			ID:   "sha512-a2fb8f390702d3d4e5fb5ad9e0ff8cd6a5fe1fa5d5c7b5bd6e6f2e6d0c4b8c3a",
+			Tags: []string{"coreos.com/flannel:v0.5.0"},
+			Size: 2048,
+		},
+	}
+	fakeRuntime.PodList = makePods(&kubecontainer.Container{
+		ID:    "container-0",
+		Image: "coreos.com/etcd:v2.0.9",
+	})
+
+	spaceFreed, err := manager.freeSpace(2048)
+	assert := assert.New(t)
+	require.NoError(t, err)
+	assert.EqualValues(2048, spaceFreed)
+	assert.Len(fakeRuntime.ImageList, 1)
+	assert.Equal([]string{"coreos.com/etcd:v2.0.9"}, fakeRuntime.ImageList[0].Tags)
 }
 
 func TestFreeSpaceRemoveByLeastRecentlyUsed(t *testing.T) {
-	manager, fakeDocker, _ := newRealImageManager(ImageGCPolicy{})
-	fakeDocker.Images = []docker.APIImages{
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 		makeImage(1, 2048),
 	}
-	fakeDocker.ContainerList = []docker.APIContainers{
+	fakeRuntime.PodList = makePods(
 		makeContainer(0),
 		makeContainer(1),
-	}
+	)
 
 	// Make 1 be more recently used than 0.
 	require.NoError(t, manager.detectImages(zero))
-	fakeDocker.ContainerList = []docker.APIContainers{
+	fakeRuntime.PodList = makePods(
 		makeContainer(1),
-	}
+	)
 	require.NoError(t, manager.detectImages(time.Now()))
-	fakeDocker.ContainerList = []docker.APIContainers{}
+	fakeRuntime.PodList = []*kubecontainer.Pod{}
 	require.NoError(t, manager.detectImages(time.Now()))
 	require.Equal(t, manager.imageRecordsLen(), 2)
 
@@ -246,31 +297,31 @@ func TestFreeSpaceRemoveByLeastRecentlyUsed(t *testing.T) {
 	assert := assert.New(t)
 	require.NoError(t, err)
 	assert.EqualValues(1024, spaceFreed)
-	assert.Len(fakeDocker.RemovedImages, 1)
-	assert.True(fakeDocker.RemovedImages.Has(imageName(0)))
+	assert.Len(fakeRuntime.ImageList, 1)
+	assert.NotEqual(imageName(0), fakeRuntime.ImageList[0].ID)
 }
 
 func TestFreeSpaceTiesBrokenByDetectedTime(t *testing.T) {
-	manager, fakeDocker, _ := newRealImageManager(ImageGCPolicy{})
-	fakeDocker.Images = []docker.APIImages{
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 	}
-	fakeDocker.ContainerList = []docker.APIContainers{
+	fakeRuntime.PodList = makePods(
 		makeContainer(0),
-	}
+	)
 
 	// Make 1 more recently detected but used at the same time as 0.
 	require.NoError(t, manager.detectImages(zero))
-	fakeDocker.Images = []docker.APIImages{
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 		makeImage(1, 2048),
 	}
-	fakeDocker.ContainerList = []docker.APIContainers{
+	fakeRuntime.PodList = makePods(
 		makeContainer(0),
 		makeContainer(1),
-	}
+	)
 	require.NoError(t, manager.detectImages(time.Now()))
-	fakeDocker.ContainerList = []docker.APIContainers{}
+	fakeRuntime.PodList = []*kubecontainer.Pod{}
 	require.NoError(t, manager.detectImages(time.Now()))
 	require.Equal(t, manager.imageRecordsLen(), 2)
 
@@ -278,33 +329,33 @@ func TestFreeSpaceTiesBrokenByDetectedTime(t *testing.T) {
 	assert := assert.New(t)
 	require.NoError(t, err)
 	assert.EqualValues(1024, spaceFreed)
-	assert.Len(fakeDocker.RemovedImages, 1)
-	assert.True(fakeDocker.RemovedImages.Has(imageName(0)))
+	assert.Len(fakeRuntime.ImageList, 1)
+	assert.NotEqual(imageName(0), fakeRuntime.ImageList[0].ID)
 }
 
 func TestFreeSpaceImagesAlsoDoesLookupByRepoTags(t *testing.T) {
-	manager, fakeDocker, _ := newRealImageManager(ImageGCPolicy{})
-	fakeDocker.Images = []docker.APIImages{
+	manager, fakeRuntime, _ := newRealImageManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 1024),
 		{
-			ID:          "5678",
-			RepoTags:    []string{"potato", "salad"},
-			VirtualSize: 2048,
+			ID:   "5678",
+			Tags: []string{"potato", "salad"},
+			Size: 2048,
 		},
 	}
-	fakeDocker.ContainerList = []docker.APIContainers{
-		{
+	fakeRuntime.PodList = makePods(
+		&kubecontainer.Container{
 			ID:    "c5678",
 			Image: "salad",
 		},
-	}
+	)
 
 	spaceFreed, err := manager.freeSpace(1024)
 	assert := assert.New(t)
 	require.NoError(t, err)
 	assert.EqualValues(1024, spaceFreed)
-	assert.Len(fakeDocker.RemovedImages, 1)
-	assert.True(fakeDocker.RemovedImages.Has(imageName(0)))
+	assert.Len(fakeRuntime.ImageList, 1)
+	assert.NotEqual(imageName(0), fakeRuntime.ImageList[0].ID)
 }
 
 func TestGarbageCollectBelowLowThreshold(t *testing.T) {
@@ -339,14 +390,14 @@ func TestGarbageCollectBelowSuccess(t *testing.T) {
 		HighThresholdPercent: 90,
 		LowThresholdPercent:  80,
 	}
-	manager, fakeDocker, mockCadvisor := newRealImageManager(policy)
+	manager, fakeRuntime, mockCadvisor := newRealImageManager(policy)
 
 	// Expect 95% usage and most of it gets freed.
 	mockCadvisor.On("DockerImagesFsInfo").Return(cadvisorApiV2.FsInfo{
 		Usage:    950,
 		Capacity: 1000,
 	}, nil)
-	fakeDocker.Images = []docker.APIImages{
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 450),
 	}
 
@@ -358,14 +409,14 @@ func TestGarbageCollectNotEnoughFreed(t *testing.T) {
 		HighThresholdPercent: 90,
 		LowThresholdPercent:  80,
 	}
-	manager, fakeDocker, mockCadvisor := newRealImageManager(policy)
+	manager, fakeRuntime, mockCadvisor := newRealImageManager(policy)
 
 	// Expect 95% usage and little of it gets freed.
 	mockCadvisor.On("DockerImagesFsInfo").Return(cadvisorApiV2.FsInfo{
 		Usage:    950,
 		Capacity: 1000,
 	}, nil)
-	fakeDocker.Images = []docker.APIImages{
+	fakeRuntime.ImageList = []kubecontainer.Image{
 		makeImage(0, 50),
 	}
 
@@ -49,6 +49,8 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/envvars"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/metrics"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/pleg"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/qos"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/rkt"
 	kubeletTypes "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
@@ -77,6 +79,22 @@ const (
 
 	// Location of container logs.
 	containerLogsDir = "/var/log/containers"
+
+	// Capacity of the channel for receiving pod lifecycle events. This number
+	// is a bit arbitrary and may be adjusted in the future.
+	plegChannelCapacity = 1000
+
+	// Generic PLEG relies on relisting for discovering container events.
+	// The period directly affects the response time of the kubelet to
+	// container changes.
+	plegRelistPeriod = time.Second * 1
+
+	// Pods with configMap volumes are synced at least this often, so that
+	// changes to their config maps reach the volumes without waiting for a
+	// full resync. Changes to a pod's own labels and annotations, which
+	// downwardAPI volumes expose, arrive as pod updates and are synced right
+	// away.
+	configMapVolumeRefreshPeriod = time.Second * 10
 )
 
 var (
@@ -88,9 +106,11 @@ var (
 // SyncHandler is an interface implemented by Kubelet, for testability
 type SyncHandler interface {
 
-	// Syncs current state to match the specified pods. SyncPodType specified what
-	// type of sync is occuring per pod. StartTime specifies the time at which
-	// syncing began (for use in monitoring).
+	// Syncs current state to match the specified pods. Only the pods in
+	// podSyncTypes are synced, and SyncPodType specified what type of sync is
+	// occuring per pod; if podSyncTypes is nil, all the pods are synced.
+	// StartTime specifies the time at which syncing began (for use in
+	// monitoring).
 	SyncPods(pods []*api.Pod, podSyncTypes map[types.UID]SyncPodType, mirrorPods map[string]*api.Pod,
 		startTime time.Time) error
 }
@@ -122,7 +142,7 @@ func NewMainKubelet(
 	resyncInterval time.Duration,
 	pullQPS float32,
 	pullBurst int,
-	containerGCPolicy ContainerGCPolicy,
+	containerGCPolicy kubecontainer.ContainerGCPolicy,
 	sourcesReady SourcesReadyFn,
 	registerNode bool,
 	standaloneMode bool,
@@ -137,6 +157,7 @@ func NewMainKubelet(
 	cadvisorInterface cadvisor.Interface,
 	imageGCPolicy ImageGCPolicy,
 	diskSpacePolicy DiskSpacePolicy,
+	evictionPolicy EvictionPolicy,
 	cloud cloudprovider.Interface,
 	nodeStatusUpdateFrequency time.Duration,
 	resourceContainer string,
@@ -204,14 +225,6 @@ func NewMainKubelet(
 		Namespace: "",
 	}
 
-	containerGC, err := newContainerGC(dockerClient, containerGCPolicy)
-	if err != nil {
-		return nil, err
-	}
-	imageManager, err := newImageManager(dockerClient, cadvisorInterface, recorder, nodeRef, imageGCPolicy)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize image manager: %v", err)
-	}
 	diskSpaceManager, err := newDiskSpaceManager(cadvisorInterface, diskSpacePolicy)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize disk manager: %v", err)
@@ -248,8 +261,6 @@ func NewMainKubelet(
 		streamingConnectionIdleTimeout: streamingConnectionIdleTimeout,
 		recorder:                       recorder,
 		cadvisor:                       cadvisorInterface,
-		containerGC:                    containerGC,
-		imageManager:                   imageManager,
 		diskSpaceManager:               diskSpaceManager,
 		statusManager:                  statusManager,
 		volumeManager:                  volumeManager,
@@ -273,6 +284,14 @@ func NewMainKubelet(
 		klet.networkPlugin = plug
 	}
 
+	// The runtime only needs the memory capacity to score burstable containers
+	// for the OOM killer; without it they are scored just below best effort.
+	machineInfo, err := klet.GetCachedMachineInfo()
+	if err != nil {
+		glog.Errorf("Failed to get machine info, assuming unknown memory capacity: %v", err)
+		machineInfo = &cadvisorApi.MachineInfo{}
+	}
+
 	// Initialize the runtime.
 	switch containerRuntime {
 	case "docker":
@@ -282,6 +301,7 @@ func NewMainKubelet(
 			recorder,
 			readinessManager,
 			containerRefManager,
+			machineInfo,
 			podInfraContainerImage,
 			pullQPS,
 			pullBurst,
@@ -312,6 +332,37 @@ func NewMainKubelet(
 		return nil, fmt.Errorf("unsupported container runtime %q specified", containerRuntime)
 	}
 
+	// setup containerGC
+	containerGC, err := kubecontainer.NewContainerGC(klet.containerRuntime, containerGCPolicy)
+	if err != nil {
+		return nil, err
+	}
+	klet.containerGC = containerGC
+
+	// setup imageManager
+	// Only docker reports the filesystem holding its images; the images of
+	// the other runtimes are assumed to be on the root filesystem.
+	imagesFsInfo := cadvisorInterface.RootFsInfo
+	if containerRuntime == "docker" {
+		imagesFsInfo = cadvisorInterface.DockerImagesFsInfo
+	}
+	imageManager, err := newImageManager(klet.containerRuntime, imagesFsInfo, recorder, nodeRef, imageGCPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize image manager: %v", err)
+	}
+	klet.imageManager = imageManager
+
+	// Pod usage is read from cAdvisor's docker containers, so pods of the
+	// other runtimes could never be ranked for eviction.
+	if containerRuntime != "docker" && len(evictionPolicy.Thresholds) > 0 {
+		return nil, fmt.Errorf("eviction thresholds are only supported with the docker container runtime, not %q", containerRuntime)
+	}
+	evictionManager, err := newEvictionManager(cadvisorInterface, evictionPolicy, klet.getActivePods, klet.getPodUsage, klet.evictPod, recorder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize eviction manager: %v", err)
+	}
+	klet.evictionManager = evictionManager
+
 	// Setup container manager, can fail if the devices hierarchy is not mounted
 	// (it is required by Docker however).
 	containerManager, err := newContainerManager(cadvisorInterface, dockerDaemonContainer, systemContainer, resourceContainer)
@@ -339,6 +390,7 @@ func NewMainKubelet(
 	}
 	klet.runtimeCache = runtimeCache
 	klet.podWorkers = newPodWorkers(runtimeCache, klet.syncPod, recorder)
+	klet.pleg = pleg.NewGenericPLEG(klet.containerRuntime, plegChannelCapacity, plegRelistPeriod)
 
 	metrics.Register(runtimeCache)
 
@@ -439,7 +491,7 @@ type Kubelet struct {
 	recorder record.EventRecorder
 
 	// Policy for handling garbage collection of dead containers.
-	containerGC containerGC
+	containerGC kubecontainer.ContainerGC
 
 	// Manager for images.
 	imageManager imageManager
@@ -447,6 +499,9 @@ type Kubelet struct {
 	// Diskspace manager.
 	diskSpaceManager diskSpaceManager
 
+	// Evicts pods when the node runs low on memory or disk.
+	evictionManager evictionManager
+
 	// Cached MachineInfo returned by cadvisor.
 	machineInfo *cadvisorApi.MachineInfo
 
@@ -506,6 +561,16 @@ type Kubelet struct {
 
 	// Monitor Kubelet's sync loop
 	syncLoopMonitor util.AtomicValue
+
+	// Generates pod lifecycle events, so that the sync loop only wakes the
+	// workers of the pods whose containers changed.
+	pleg pleg.PodLifecycleEventGenerator
+
+	// The time at which all pods were last synced.
+	lastFullSync time.Time
+
+	// The time at which the pods with configMap volumes were last synced.
+	lastConfigMapVolumeRefresh time.Time
 }
 
 // getRootDir returns the full path to the directory under which kubelet can
@@ -717,10 +782,13 @@ func (kl *Kubelet) Run(updates <-chan PodUpdate) {
 		glog.Errorf("Failed to start OOM watching: %v", err)
 	}
 
+	kl.evictionManager.Start()
+
 	go util.Until(kl.updateRuntimeUp, 5*time.Second, util.NeverStop)
 
 	// Run the system oom watcher forever.
 	kl.statusManager.Start()
+	kl.pleg.Start()
 	kl.syncLoop(updates, kl)
 }
 
@@ -985,6 +1053,7 @@ func (kl *Kubelet) makeEnvironmentVariables(pod *api.Pod, container *api.Contain
 	// 3.  Add remaining service environment vars
 
 	tmpEnv := make(map[string]string)
+	configMaps := make(map[string]*api.ConfigMap)
 	mappingFunc := expansion.MappingFuncFor(tmpEnv, serviceEnv)
 	for _, envVar := range container.Env {
 		// Accesses apiserver+Pods.
@@ -998,9 +1067,14 @@ func (kl *Kubelet) makeEnvironmentVariables(pod *api.Pod, container *api.Contain
 		if runtimeVal != "" {
 			// Step 1a: expand variable references
 			runtimeVal = expansion.Expand(runtimeVal, mappingFunc)
-		} else if envVar.ValueFrom != nil && envVar.ValueFrom.FieldRef != nil {
+		} else if envVar.ValueFrom != nil {
 			// Step 1b: resolve alternate env var sources
-			runtimeVal, err = kl.podFieldSelectorRuntimeValue(envVar.ValueFrom.FieldRef, pod)
+			switch {
+			case envVar.ValueFrom.FieldRef != nil:
+				runtimeVal, err = kl.podFieldSelectorRuntimeValue(envVar.ValueFrom.FieldRef, pod)
+			case envVar.ValueFrom.ConfigMapKeyRef != nil:
+				runtimeVal, err = kl.configMapKeyRuntimeValue(envVar.ValueFrom.ConfigMapKeyRef, pod.Namespace, configMaps)
+			}
 			if err != nil {
 				return result, err
 			}
@@ -1026,6 +1100,29 @@ func (kl *Kubelet) podFieldSelectorRuntimeValue(fs *api.ObjectFieldSelector, pod
 	return fieldpath.ExtractFieldPathAsString(pod, internalFieldPath)
 }
 
+// configMapKeyRuntimeValue returns the value of the selected key of a ConfigMap
+// in the given namespace. ConfigMaps already fetched are looked up in cache, so
+// that a container referencing several keys of one ConfigMap fetches it once.
+func (kl *Kubelet) configMapKeyRuntimeValue(ks *api.ConfigMapKeySelector, namespace string, cache map[string]*api.ConfigMap) (string, error) {
+	configMap, ok := cache[ks.Name]
+	if !ok {
+		if kl.kubeClient == nil {
+			return "", fmt.Errorf("cannot get ConfigMap %q without an API server", ks.Name)
+		}
+		var err error
+		configMap, err = kl.kubeClient.ConfigMaps(namespace).Get(ks.Name)
+		if err != nil {
+			return "", err
+		}
+		cache[ks.Name] = configMap
+	}
+	value, ok := configMap.Data[ks.Key]
+	if !ok {
+		return "", fmt.Errorf("couldn't find key %q in ConfigMap %s/%s", ks.Key, namespace, ks.Name)
+	}
+	return value, nil
+}
+
 // getClusterDNS returns a list of the DNS servers and a list of the DNS search
 // domains of the cluster.
 func (kl *Kubelet) getClusterDNS(pod *api.Pod) ([]string, []string, error) {
@@ -1417,6 +1514,13 @@ func (kl *Kubelet) SyncPods(allPods []*api.Pod, podSyncTypes map[types.UID]SyncP
 		uid := pod.UID
 		desiredPods[uid] = empty{}
 
+		// Only wake the workers of the pods that need to be synced.
+		if podSyncTypes != nil {
+			if _, ok := podSyncTypes[uid]; !ok {
+				continue
+			}
+		}
+
 		// Run the sync in an async manifest worker.
 		kl.podWorkers.UpdatePod(pod, mirrorPods[podFullName], func() {
 			metrics.PodWorkerLatency.WithLabelValues(podSyncTypes[pod.UID].String()).Observe(metrics.SinceInMicroseconds(start))
@@ -1612,6 +1716,55 @@ func (kl *Kubelet) handleOutOfDisk(pods []*api.Pod, podSyncTypes map[types.UID]S
 	return fitting
 }
 
+// getActivePods returns the pods that have not terminated.
+func (kl *Kubelet) getActivePods() []*api.Pod {
+	return kl.filterOutTerminatedPods(kl.podManager.GetPods())
+}
+
+// getPodUsage returns the memory and disk used by the running containers of pod.
+// It reads the stats of docker containers, and so only works with the docker
+// runtime; the kubelet refuses eviction thresholds for the other runtimes.
+func (kl *Kubelet) getPodUsage(pod *api.Pod) (api.ResourceList, error) {
+	runningPods, err := kl.runtimeCache.GetPods()
+	if err != nil {
+		return nil, err
+	}
+	runningPod := kubecontainer.Pods(runningPods).FindPodByID(pod.UID)
+	var memory, disk int64
+	for _, container := range runningPod.Containers {
+		info, err := kl.cadvisor.DockerContainer(string(container.ID), &cadvisorApi.ContainerInfoRequest{NumStats: 1})
+		if err != nil {
+			return nil, err
+		}
+		if len(info.Stats) == 0 {
+			continue
+		}
+		stats := info.Stats[len(info.Stats)-1]
+		memory += int64(stats.Memory.WorkingSet)
+		for _, fs := range stats.Filesystem {
+			disk += int64(fs.Usage)
+		}
+	}
+	return api.ResourceList{
+		api.ResourceMemory: *resource.NewQuantity(memory, resource.BinarySI),
+		resourceDisk:       *resource.NewQuantity(disk, resource.BinarySI),
+	}, nil
+}
+
+// evictPod marks pod with the terminal status and stops its containers.
+func (kl *Kubelet) evictPod(pod *api.Pod, status api.PodStatus) error {
+	kl.statusManager.SetPodStatus(pod, status)
+	runningPods, err := kl.runtimeCache.GetPods()
+	if err != nil {
+		return err
+	}
+	runningPod := kubecontainer.Pods(runningPods).FindPodByID(pod.UID)
+	if runningPod.IsEmpty() {
+		return nil
+	}
+	return kl.killPod(runningPod)
+}
+
 // checkNodeSelectorMatching detects pods that do not match node's labels.
 func (kl *Kubelet) checkNodeSelectorMatching(pods []*api.Pod) (fitting []*api.Pod, notFitting []*api.Pod) {
 	if kl.standaloneMode {
@@ -1698,10 +1851,12 @@ func (kl *Kubelet) admitPods(allPods []*api.Pod, podSyncTypes map[types.UID]Sync
 }
 
 // syncLoop is the main loop for processing changes. It watches for changes from
-// three channels (file, apiserver, and http) and creates a union of them. For
-// any new change seen, will run a sync against desired state and running state. If
-// no changes are seen to the configuration, will synchronize the last known desired
-// state every sync_frequency seconds. Never returns.
+// three channels (file, apiserver, and http) and creates a union of them, and for
+// the pod lifecycle events generated from the changes of the containers. For any
+// new change seen, will run a sync against desired state and running state of the
+// pods it affects. Regardless of the changes seen, will synchronize the last known
+// desired state of all pods every sync_frequency seconds, and of the pods with
+// configMap volumes every configMapVolumeRefreshPeriod. Never returns.
 func (kl *Kubelet) syncLoop(updates <-chan PodUpdate, handler SyncHandler) {
 	glog.Info("Starting kubelet main sync loop.")
 	for {
@@ -1722,7 +1877,9 @@ func (kl *Kubelet) syncLoopIteration(updates <-chan PodUpdate, handler SyncHandl
 		return
 	}
 	unsyncedPod := false
+	configMapVolumesRefreshed := false
 	podSyncTypes := make(map[types.UID]SyncPodType)
+	plegCh := kl.pleg.Watch()
 	select {
 	case u, ok := <-updates:
 		if !ok {
@@ -1732,8 +1889,18 @@ func (kl *Kubelet) syncLoopIteration(updates <-chan PodUpdate, handler SyncHandl
 		kl.podManager.UpdatePods(u, podSyncTypes)
 		unsyncedPod = true
 		kl.syncLoopMonitor.Store(time.Now())
-	case <-time.After(kl.resyncInterval):
-		glog.V(4).Infof("Periodic sync")
+	case e := <-plegCh:
+		markPodForSync(podSyncTypes, e.ID)
+		unsyncedPod = true
+	case <-time.After(kl.periodicSyncDelay()):
+		if time.Since(kl.lastFullSync) >= kl.resyncInterval {
+			glog.V(4).Infof("Periodic sync")
+			podSyncTypes = nil
+		} else {
+			glog.V(4).Infof("Refreshing configMap volumes")
+			kl.markPodsWithConfigMapVolumes(podSyncTypes)
+		}
+		configMapVolumesRefreshed = true
 	}
 	start := time.Now()
 	// If we already caught some update, try to wait for some short time
@@ -1743,6 +1910,8 @@ func (kl *Kubelet) syncLoopIteration(updates <-chan PodUpdate, handler SyncHandl
 		case u := <-updates:
 			kl.podManager.UpdatePods(u, podSyncTypes)
 			kl.syncLoopMonitor.Store(time.Now())
+		case e := <-plegCh:
+			markPodForSync(podSyncTypes, e.ID)
 		case <-time.After(5 * time.Millisecond):
 			// Break the for loop.
 			unsyncedPod = false
@@ -1753,9 +1922,46 @@ func (kl *Kubelet) syncLoopIteration(updates <-chan PodUpdate, handler SyncHandl
 	if err := handler.SyncPods(pods, podSyncTypes, mirrorPods, start); err != nil {
 		glog.Errorf("Couldn't sync containers: %v", err)
 	}
+	if podSyncTypes == nil {
+		kl.lastFullSync = start
+	}
+	if configMapVolumesRefreshed {
+		kl.lastConfigMapVolumeRefresh = start
+	}
 	kl.syncLoopMonitor.Store(time.Now())
 }
 
+// periodicSyncDelay returns the time until the next full sync or refresh of
+// the configMap volumes is due.
+func (kl *Kubelet) periodicSyncDelay() time.Duration {
+	delay := kl.resyncInterval - time.Since(kl.lastFullSync)
+	if refresh := configMapVolumeRefreshPeriod - time.Since(kl.lastConfigMapVolumeRefresh); refresh < delay {
+		delay = refresh
+	}
+	return delay
+}
+
+// markPodsWithConfigMapVolumes marks the pods with configMap volumes to be
+// synced, which rewrites the contents of those volumes.
+func (kl *Kubelet) markPodsWithConfigMapVolumes(podSyncTypes map[types.UID]SyncPodType) {
+	for _, pod := range kl.podManager.GetPods() {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.ConfigMap != nil {
+				markPodForSync(podSyncTypes, pod.UID)
+				break
+			}
+		}
+	}
+}
+
+// markPodForSync marks a pod whose containers changed to be synced, unless it
+// is already synced because its spec changed.
+func markPodForSync(podSyncTypes map[types.UID]SyncPodType, uid types.UID) {
+	if _, ok := podSyncTypes[uid]; !ok {
+		podSyncTypes[uid] = SyncPodSync
+	}
+}
+
 func (kl *Kubelet) LatestLoopEntryTime() time.Time {
 	val := kl.syncLoopMonitor.Load()
 	if val == nil {
@@ -1804,7 +2010,7 @@ func (kl *Kubelet) validateContainerStatus(podStatus *api.PodStatus, containerNa
 // GetKubeletContainerLogs returns logs from the container
 // TODO: this method is returning logs of random container attempts, when it should be returning the most recent attempt
 // or all of them.
-func (kl *Kubelet) GetKubeletContainerLogs(podFullName, containerName, tail string, follow, previous bool, stdout, stderr io.Writer) error {
+func (kl *Kubelet) GetKubeletContainerLogs(podFullName, containerName, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error {
 	// TODO(vmarmol): Refactor to not need the pod status and verification.
 	// Pod workers periodically write status to statusManager. If status is not
 	// cached there, something is wrong (or kubelet just restarted and hasn't
@@ -1817,7 +2023,7 @@ func (kl *Kubelet) GetKubeletContainerLogs(podFullName, containerName, tail stri
 		// No log is available if pod is not in a "known" phase (e.g. Unknown).
 		return err
 	}
-	containerID, err := kl.validateContainerStatus(&podStatus, containerName, previous)
+	containerID, err := kl.validateContainerStatus(&podStatus, containerName, logOptions.Previous)
 	if err != nil {
 		// No log is available if the container status is missing or is in the
 		// waiting state.
@@ -1827,7 +2033,7 @@ func (kl *Kubelet) GetKubeletContainerLogs(podFullName, containerName, tail stri
 	if !ok {
 		return fmt.Errorf("unable to get logs for container %q in pod %q: unable to find pod", containerName, podFullName)
 	}
-	return kl.containerRuntime.GetContainerLogs(pod, containerID, tail, follow, stdout, stderr)
+	return kl.containerRuntime.GetContainerLogs(pod, containerID, tail, logOptions, stdout, stderr)
 }
 
 // GetHostname Returns the hostname as the kubelet sees it.
@@ -2061,6 +2267,8 @@ func (kl *Kubelet) setNodeStatus(node *api.Node) error {
 			kl.recordNodeStatusEvent("NodeNotReady")
 		}
 	}
+	kl.setNodePressureCondition(node, api.NodeMemoryPressure, kl.evictionManager.IsUnderMemoryPressure(), "memory", currentTime)
+	kl.setNodePressureCondition(node, api.NodeDiskPressure, kl.evictionManager.IsUnderDiskPressure(), "disk space", currentTime)
 	if oldNodeUnschedulable != node.Spec.Unschedulable {
 		if node.Spec.Unschedulable {
 			kl.recordNodeStatusEvent("NodeNotSchedulable")
@@ -2072,6 +2280,45 @@ func (kl *Kubelet) setNodeStatus(node *api.Node) error {
 	return nil
 }
 
+// setNodePressureCondition sets the condition of conditionType on node to
+// whether the node is low on the named resource.
+func (kl *Kubelet) setNodePressureCondition(node *api.Node, conditionType api.NodeConditionType, underPressure bool, resourceName string, currentTime util.Time) {
+	newCondition := api.NodeCondition{
+		Type:               conditionType,
+		Status:             api.ConditionFalse,
+		Reason:             fmt.Sprintf("kubelet has sufficient %s available", resourceName),
+		LastHeartbeatTime:  currentTime,
+		LastTransitionTime: currentTime,
+	}
+	if underPressure {
+		newCondition.Status = api.ConditionTrue
+		newCondition.Reason = fmt.Sprintf("kubelet has insufficient %s available", resourceName)
+	}
+
+	updated := false
+	transitioned := true
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == conditionType {
+			if node.Status.Conditions[i].Status == newCondition.Status {
+				newCondition.LastTransitionTime = node.Status.Conditions[i].LastTransitionTime
+				transitioned = false
+			}
+			node.Status.Conditions[i] = newCondition
+			updated = true
+		}
+	}
+	if !updated {
+		node.Status.Conditions = append(node.Status.Conditions, newCondition)
+	}
+	if transitioned {
+		if underPressure {
+			kl.recordNodeStatusEvent("NodeHas" + string(conditionType))
+		} else {
+			kl.recordNodeStatusEvent("NodeHasNo" + string(conditionType))
+		}
+	}
+}
+
 func (kl *Kubelet) containerRuntimeUp() bool {
 	kl.runtimeMutex.Lock()
 	defer kl.runtimeMutex.Unlock()
@@ -2247,6 +2494,7 @@ func (kl *Kubelet) generatePodStatus(pod *api.Pod) (api.PodStatus, error) {
 	}
 
 	podStatus.Conditions = append(podStatus.Conditions, getPodReadyCondition(spec, podStatus.ContainerStatuses)...)
+	podStatus.QOSClass = qos.GetPodQOS(pod)
 
 	if !kl.standaloneMode {
 		hostIP, err := kl.GetHostIP()
@@ -2309,6 +2557,21 @@ func (kl *Kubelet) ExecInContainer(podFullName string, podUID types.UID, contain
 	return kl.runner.ExecInContainer(string(container.ID), cmd, stdin, stdout, stderr, tty)
 }
 
+// AttachContainer uses the container runtime to attach the given streams to
+// the given container.
+func (kl *Kubelet) AttachContainer(podFullName string, podUID types.UID, containerName string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) error {
+	podUID = kl.podManager.TranslatePodUID(podUID)
+
+	container, err := kl.findContainer(podFullName, podUID, containerName)
+	if err != nil {
+		return err
+	}
+	if container == nil {
+		return fmt.Errorf("container not found (%q)", containerName)
+	}
+	return kl.containerRuntime.AttachContainer(string(container.ID), stdin, stdout, stderr, tty)
+}
+
 // PortForward connects to the pod's port and copies data between the port
 // and the stream.
 func (kl *Kubelet) PortForward(podFullName string, podUID types.UID, port uint16, stream io.ReadWriteCloser) error {
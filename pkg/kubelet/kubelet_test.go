@@ -41,6 +41,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/pleg"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -117,6 +118,11 @@ func newTestKubelet(t *testing.T) *TestKubelet {
 		t.Fatalf("can't initialize disk space manager: %v", err)
 	}
 	kubelet.diskSpaceManager = diskSpaceManager
+	evictionManager, err := newEvictionManager(mockCadvisor, EvictionPolicy{}, kubelet.getActivePods, kubelet.getPodUsage, kubelet.evictPod, fakeRecorder)
+	if err != nil {
+		t.Fatalf("can't initialize eviction manager: %v", err)
+	}
+	kubelet.evictionManager = evictionManager
 
 	kubelet.containerRuntime = fakeRuntime
 	kubelet.runtimeCache = kubecontainer.NewFakeRuntimeCache(kubelet.containerRuntime)
@@ -128,6 +134,7 @@ func newTestKubelet(t *testing.T) *TestKubelet {
 	kubelet.volumeManager = newVolumeManager()
 	kubelet.containerManager, _ = newContainerManager(mockCadvisor, "", "", "")
 	kubelet.networkConfigured = true
+	kubelet.pleg = pleg.NewGenericPLEG(fakeRuntime, 100, time.Hour)
 	return &TestKubelet{kubelet, fakeRuntime, mockCadvisor, fakeKubeClient, fakeMirrorClient}
 }
 
@@ -322,6 +329,133 @@ func TestSyncLoopTimeUpdate(t *testing.T) {
 	}
 }
 
+// recordingPodWorkers records the pods whose workers were woken.
+type recordingPodWorkers struct {
+	updated []types.UID
+}
+
+func (r *recordingPodWorkers) UpdatePod(pod *api.Pod, mirrorPod *api.Pod, updateComplete func()) {
+	r.updated = append(r.updated, pod.UID)
+}
+
+func (r *recordingPodWorkers) ForgetNonExistingPodWorkers(desiredPods map[types.UID]empty) {}
+
+func TestSyncLoopPodLifecycleEvent(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	testKubelet.fakeCadvisor.On("MachineInfo").Return(&cadvisorApi.MachineInfo{}, nil)
+	testKubelet.fakeCadvisor.On("DockerImagesFsInfo").Return(cadvisorApiv2.FsInfo{}, nil)
+	testKubelet.fakeCadvisor.On("RootFsInfo").Return(cadvisorApiv2.FsInfo{}, nil)
+	kubelet := testKubelet.kubelet
+	kubelet.lastTimestampRuntimeUp = time.Now()
+	kubelet.resyncInterval = time.Hour
+	kubelet.lastFullSync = time.Now()
+	kubelet.lastConfigMapVolumeRefresh = time.Now()
+	podWorkers := &recordingPodWorkers{}
+	kubelet.podWorkers = podWorkers
+
+	pods := newTestPods(2)
+	pods[0].UID = "1234"
+	pods[1].UID = "5678"
+	kubelet.podManager.SetPods(pods)
+
+	// Only the worker of the pod whose container changed is woken.
+	kubelet.pleg.Watch() <- &pleg.PodLifecycleEvent{ID: "5678", Type: pleg.ContainerDied, Data: types.UID("c1")}
+	kubelet.syncLoopIteration(make(chan PodUpdate), kubelet)
+	if expected := []types.UID{"5678"}; !reflect.DeepEqual(expected, podWorkers.updated) {
+		t.Errorf("expected the workers of %v to be woken, got %v", expected, podWorkers.updated)
+	}
+
+	// All the workers are woken when the resync fires.
+	podWorkers.updated = nil
+	kubelet.lastFullSync = time.Now().Add(-time.Hour)
+	kubelet.syncLoopIteration(make(chan PodUpdate), kubelet)
+	if len(podWorkers.updated) != 2 {
+		t.Errorf("expected the workers of all pods to be woken, got %v", podWorkers.updated)
+	}
+	if time.Since(kubelet.lastFullSync) > time.Minute {
+		t.Errorf("expected the time of the last full sync to be updated, got %v", kubelet.lastFullSync)
+	}
+}
+
+func TestSyncLoopPodConfigUpdate(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	testKubelet.fakeCadvisor.On("MachineInfo").Return(&cadvisorApi.MachineInfo{}, nil)
+	testKubelet.fakeCadvisor.On("DockerImagesFsInfo").Return(cadvisorApiv2.FsInfo{}, nil)
+	testKubelet.fakeCadvisor.On("RootFsInfo").Return(cadvisorApiv2.FsInfo{}, nil)
+	kubelet := testKubelet.kubelet
+	kubelet.lastTimestampRuntimeUp = time.Now()
+	kubelet.resyncInterval = time.Hour
+	kubelet.lastFullSync = time.Now()
+	kubelet.lastConfigMapVolumeRefresh = time.Now()
+	podWorkers := &recordingPodWorkers{}
+	kubelet.podWorkers = podWorkers
+
+	pods := newTestPods(2)
+	pods[0].UID = "1234"
+	pods[1].UID = "5678"
+	kubelet.podManager.SetPods(pods)
+
+	// Only the worker of the updated pod is woken.
+	updated := *pods[1]
+	updated.Labels = map[string]string{"key": "value"}
+	updates := make(chan PodUpdate, 1)
+	updates <- PodUpdate{Pods: []*api.Pod{&updated}, Op: UPDATE}
+	kubelet.syncLoopIteration(updates, kubelet)
+	if expected := []types.UID{"5678"}; !reflect.DeepEqual(expected, podWorkers.updated) {
+		t.Errorf("expected the workers of %v to be woken, got %v", expected, podWorkers.updated)
+	}
+
+	// Only the worker of the changed pod is woken when all pods are set.
+	podWorkers.updated = nil
+	changed := *pods[0]
+	changed.Annotations = map[string]string{"key": "value"}
+	updates <- PodUpdate{Pods: []*api.Pod{&changed, &updated}, Op: SET}
+	kubelet.syncLoopIteration(updates, kubelet)
+	if expected := []types.UID{"1234"}; !reflect.DeepEqual(expected, podWorkers.updated) {
+		t.Errorf("expected the workers of %v to be woken, got %v", expected, podWorkers.updated)
+	}
+}
+
+func TestSyncLoopConfigMapVolumeRefresh(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	testKubelet.fakeCadvisor.On("MachineInfo").Return(&cadvisorApi.MachineInfo{}, nil)
+	testKubelet.fakeCadvisor.On("DockerImagesFsInfo").Return(cadvisorApiv2.FsInfo{}, nil)
+	testKubelet.fakeCadvisor.On("RootFsInfo").Return(cadvisorApiv2.FsInfo{}, nil)
+	kubelet := testKubelet.kubelet
+	kubelet.lastTimestampRuntimeUp = time.Now()
+	kubelet.resyncInterval = time.Hour
+	lastFullSync := time.Now()
+	kubelet.lastFullSync = lastFullSync
+	kubelet.lastConfigMapVolumeRefresh = time.Now().Add(-configMapVolumeRefreshPeriod)
+	podWorkers := &recordingPodWorkers{}
+	kubelet.podWorkers = podWorkers
+
+	pods := newTestPods(2)
+	pods[0].UID = "1234"
+	pods[1].UID = "5678"
+	pods[1].Spec.Volumes = []api.Volume{
+		{
+			Name: "config",
+			VolumeSource: api.VolumeSource{
+				ConfigMap: &api.ConfigMapVolumeSource{Name: "config"},
+			},
+		},
+	}
+	kubelet.podManager.SetPods(pods)
+
+	// Only the worker of the pod with a configMap volume is woken.
+	kubelet.syncLoopIteration(make(chan PodUpdate), kubelet)
+	if expected := []types.UID{"5678"}; !reflect.DeepEqual(expected, podWorkers.updated) {
+		t.Errorf("expected the workers of %v to be woken, got %v", expected, podWorkers.updated)
+	}
+	if time.Since(kubelet.lastConfigMapVolumeRefresh) > time.Minute {
+		t.Errorf("expected the time of the last configMap volume refresh to be updated, got %v", kubelet.lastConfigMapVolumeRefresh)
+	}
+	if kubelet.lastFullSync != lastFullSync {
+		t.Errorf("expected the time of the last full sync to be kept, got %v", kubelet.lastFullSync)
+	}
+}
+
 func TestSyncPodsStartPod(t *testing.T) {
 	testKubelet := newTestKubelet(t)
 	testKubelet.fakeCadvisor.On("MachineInfo").Return(&cadvisorApi.MachineInfo{}, nil)
@@ -1359,6 +1493,61 @@ func TestMakeEnvironmentVariables(t *testing.T) {
 	}
 }
 
+func TestMakeEnvironmentVariablesFromConfigMap(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	kl := testKubelet.kubelet
+	kl.serviceLister = testServiceLister{}
+	testKubelet.fakeKubeClient.ReactFn = testclient.NewSimpleFake(&api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Name: "test-config", Namespace: "test1"},
+		Data: map[string]string{
+			"log.level": "debug",
+			"color":     "blue",
+		},
+	}).ReactFn
+
+	testPod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Namespace: "test1",
+			Name:      "dapi-test-pod-name",
+		},
+	}
+	container := &api.Container{
+		Env: []api.EnvVar{
+			{
+				Name: "LOG_LEVEL",
+				ValueFrom: &api.EnvVarSource{
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{Name: "test-config", Key: "log.level"},
+				},
+			},
+			{
+				Name: "COLOR",
+				ValueFrom: &api.EnvVarSource{
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{Name: "test-config", Key: "color"},
+				},
+			},
+		},
+	}
+	result, err := kl.makeEnvironmentVariables(testPod, container)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedEnvs := []kubecontainer.EnvVar{
+		{Name: "LOG_LEVEL", Value: "debug"},
+		{Name: "COLOR", Value: "blue"},
+	}
+	if !reflect.DeepEqual(result, expectedEnvs) {
+		t.Errorf("Unexpected env entries; expected {%v}, got {%v}", expectedEnvs, result)
+	}
+	if len(testKubelet.fakeKubeClient.Actions) != 1 {
+		t.Errorf("Expected the ConfigMap to be fetched once, got actions: %v", testKubelet.fakeKubeClient.Actions)
+	}
+
+	container.Env[1].ValueFrom.ConfigMapKeyRef.Key = "missing"
+	if _, err := kl.makeEnvironmentVariables(testPod, container); err == nil {
+		t.Errorf("Expected an error for a missing ConfigMap key")
+	}
+}
+
 func runningState(cName string) api.ContainerStatus {
 	return api.ContainerStatus{
 		Name: cName,
@@ -1825,6 +2014,41 @@ func TestExecInContainerNoSuchContainer(t *testing.T) {
 	}
 }
 
+func TestAttachContainer(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	kubelet := testKubelet.kubelet
+	fakeRuntime := testKubelet.fakeRuntime
+
+	podName := "podFoo"
+	podNamespace := "nsFoo"
+	fakeRuntime.PodList = []*kubecontainer.Pod{
+		{
+			ID:        "12345678",
+			Name:      podName,
+			Namespace: podNamespace,
+			Containers: []*kubecontainer.Container{
+				{Name: "bar",
+					ID: "barID"},
+			},
+		},
+	}
+	podFullName := kubecontainer.GetPodFullName(&api.Pod{ObjectMeta: api.ObjectMeta{
+		UID:       "12345678",
+		Name:      podName,
+		Namespace: podNamespace,
+	}})
+
+	if err := kubelet.AttachContainer(podFullName, "", "foo", nil, nil, nil, false); err == nil {
+		t.Fatal("unexpected non-error attaching to a missing container")
+	}
+	if err := kubelet.AttachContainer(podFullName, "", "bar", nil, nil, nil, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := fakeRuntime.AssertCalls([]string{"GetPods", "GetPods", "AttachContainer"}); err != nil {
+		t.Error(err)
+	}
+}
+
 type fakeReadWriteCloser struct{}
 
 func (f *fakeReadWriteCloser) Write(data []byte) (int, error) {
@@ -2297,6 +2521,20 @@ func TestUpdateNewNodeStatus(t *testing.T) {
 					LastHeartbeatTime:  util.Time{},
 					LastTransitionTime: util.Time{},
 				},
+				{
+					Type:               api.NodeMemoryPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient memory available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
+				{
+					Type:               api.NodeDiskPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient disk space available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
 			},
 			NodeInfo: api.NodeSystemInfo{
 				MachineID:               "123",
@@ -2328,14 +2566,16 @@ func TestUpdateNewNodeStatus(t *testing.T) {
 	if !ok {
 		t.Errorf("unexpected object type")
 	}
-	if updatedNode.Status.Conditions[0].LastHeartbeatTime.IsZero() {
-		t.Errorf("unexpected zero last probe timestamp")
-	}
-	if updatedNode.Status.Conditions[0].LastTransitionTime.IsZero() {
-		t.Errorf("unexpected zero last transition timestamp")
+	for i := range updatedNode.Status.Conditions {
+		if updatedNode.Status.Conditions[i].LastHeartbeatTime.IsZero() {
+			t.Errorf("unexpected zero last probe timestamp for %v", updatedNode.Status.Conditions[i].Type)
+		}
+		if updatedNode.Status.Conditions[i].LastTransitionTime.IsZero() {
+			t.Errorf("unexpected zero last transition timestamp for %v", updatedNode.Status.Conditions[i].Type)
+		}
+		updatedNode.Status.Conditions[i].LastHeartbeatTime = util.Time{}
+		updatedNode.Status.Conditions[i].LastTransitionTime = util.Time{}
 	}
-	updatedNode.Status.Conditions[0].LastHeartbeatTime = util.Time{}
-	updatedNode.Status.Conditions[0].LastTransitionTime = util.Time{}
 	if !reflect.DeepEqual(expectedNode, updatedNode) {
 		t.Errorf("unexpected objects: %s", util.ObjectDiff(expectedNode, updatedNode))
 	}
@@ -2394,6 +2634,20 @@ func TestUpdateExistingNodeStatus(t *testing.T) {
 					LastHeartbeatTime:  util.Time{}, // placeholder
 					LastTransitionTime: util.Time{}, // placeholder
 				},
+				{
+					Type:               api.NodeMemoryPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient memory available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
+				{
+					Type:               api.NodeDiskPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient disk space available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
 			},
 			NodeInfo: api.NodeSystemInfo{
 				MachineID:               "123",
@@ -2433,13 +2687,77 @@ func TestUpdateExistingNodeStatus(t *testing.T) {
 		t.Errorf("expected \n%#v\n, got \n%#v", updatedNode.Status.Conditions[0].LastTransitionTime.Rfc3339Copy(),
 			util.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC))
 	}
-	updatedNode.Status.Conditions[0].LastHeartbeatTime = util.Time{}
-	updatedNode.Status.Conditions[0].LastTransitionTime = util.Time{}
+	for i := range updatedNode.Status.Conditions {
+		updatedNode.Status.Conditions[i].LastHeartbeatTime = util.Time{}
+		updatedNode.Status.Conditions[i].LastTransitionTime = util.Time{}
+	}
 	if !reflect.DeepEqual(expectedNode, updatedNode) {
 		t.Errorf("expected \n%v\n, got \n%v", expectedNode, updatedNode)
 	}
 }
 
+type fakeEvictionManager struct {
+	memoryPressure bool
+	diskPressure   bool
+}
+
+func (f *fakeEvictionManager) Start() {}
+
+func (f *fakeEvictionManager) IsUnderMemoryPressure() bool {
+	return f.memoryPressure
+}
+
+func (f *fakeEvictionManager) IsUnderDiskPressure() bool {
+	return f.diskPressure
+}
+
+func TestUpdateNodeStatusUnderMemoryPressure(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	kubelet := testKubelet.kubelet
+	kubelet.evictionManager = &fakeEvictionManager{memoryPressure: true}
+	transitionTime := util.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC)
+	node := &api.Node{
+		ObjectMeta: api.ObjectMeta{Name: testKubeletHostname},
+		Status: api.NodeStatus{
+			Conditions: []api.NodeCondition{
+				{
+					Type:               api.NodeMemoryPressure,
+					Status:             api.ConditionFalse,
+					LastTransitionTime: transitionTime,
+				},
+				{
+					Type:               api.NodeDiskPressure,
+					Status:             api.ConditionFalse,
+					LastTransitionTime: transitionTime,
+				},
+			},
+		},
+	}
+	currentTime := util.Now()
+	kubelet.setNodePressureCondition(node, api.NodeMemoryPressure, kubelet.evictionManager.IsUnderMemoryPressure(), "memory", currentTime)
+	kubelet.setNodePressureCondition(node, api.NodeDiskPressure, kubelet.evictionManager.IsUnderDiskPressure(), "disk space", currentTime)
+
+	expected := []api.NodeCondition{
+		{
+			Type:               api.NodeMemoryPressure,
+			Status:             api.ConditionTrue,
+			Reason:             "kubelet has insufficient memory available",
+			LastHeartbeatTime:  currentTime,
+			LastTransitionTime: currentTime,
+		},
+		{
+			Type:               api.NodeDiskPressure,
+			Status:             api.ConditionFalse,
+			Reason:             "kubelet has sufficient disk space available",
+			LastHeartbeatTime:  currentTime,
+			LastTransitionTime: transitionTime,
+		},
+	}
+	if !reflect.DeepEqual(expected, node.Status.Conditions) {
+		t.Errorf("unexpected conditions: %s", util.ObjectDiff(expected, node.Status.Conditions))
+	}
+}
+
 func TestUpdateNodeStatusWithoutContainerRuntime(t *testing.T) {
 	testKubelet := newTestKubelet(t)
 	kubelet := testKubelet.kubelet
@@ -2480,6 +2798,20 @@ func TestUpdateNodeStatusWithoutContainerRuntime(t *testing.T) {
 					LastHeartbeatTime:  util.Time{},
 					LastTransitionTime: util.Time{},
 				},
+				{
+					Type:               api.NodeMemoryPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient memory available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
+				{
+					Type:               api.NodeDiskPressure,
+					Status:             api.ConditionFalse,
+					Reason:             fmt.Sprintf("kubelet has sufficient disk space available"),
+					LastHeartbeatTime:  util.Time{},
+					LastTransitionTime: util.Time{},
+				},
 			},
 			NodeInfo: api.NodeSystemInfo{
 				MachineID:               "123",
@@ -2513,14 +2845,16 @@ func TestUpdateNodeStatusWithoutContainerRuntime(t *testing.T) {
 		t.Errorf("unexpected object type")
 	}
 
-	if updatedNode.Status.Conditions[0].LastHeartbeatTime.IsZero() {
-		t.Errorf("unexpected zero last probe timestamp")
-	}
-	if updatedNode.Status.Conditions[0].LastTransitionTime.IsZero() {
-		t.Errorf("unexpected zero last transition timestamp")
+	for i := range updatedNode.Status.Conditions {
+		if updatedNode.Status.Conditions[i].LastHeartbeatTime.IsZero() {
+			t.Errorf("unexpected zero last probe timestamp for %v", updatedNode.Status.Conditions[i].Type)
+		}
+		if updatedNode.Status.Conditions[i].LastTransitionTime.IsZero() {
+			t.Errorf("unexpected zero last transition timestamp for %v", updatedNode.Status.Conditions[i].Type)
+		}
+		updatedNode.Status.Conditions[i].LastHeartbeatTime = util.Time{}
+		updatedNode.Status.Conditions[i].LastTransitionTime = util.Time{}
 	}
-	updatedNode.Status.Conditions[0].LastHeartbeatTime = util.Time{}
-	updatedNode.Status.Conditions[0].LastTransitionTime = util.Time{}
 	if !reflect.DeepEqual(expectedNode, updatedNode) {
 		t.Errorf("unexpected objects: %s", util.ObjectDiff(expectedNode, updatedNode))
 	}
@@ -2901,6 +3235,38 @@ func TestPrivilegeContainerDisallowed(t *testing.T) {
 	}
 }
 
+func TestGeneratePodStatusQOSClass(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	kubelet := testKubelet.kubelet
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "foo",
+			Namespace: "new",
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Name: "bar",
+					Resources: api.ResourceRequirements{
+						Limits: api.ResourceList{
+							api.ResourceCPU:    resource.MustParse("100m"),
+							api.ResourceMemory: resource.MustParse("100Mi"),
+						},
+					},
+				},
+			},
+		},
+	}
+	status, err := kubelet.generatePodStatus(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.QOSClass != api.PodQOSGuaranteed {
+		t.Errorf("expected QOS class %q, got %q", api.PodQOSGuaranteed, status.QOSClass)
+	}
+}
+
 func TestFilterOutTerminatedPods(t *testing.T) {
 	testKubelet := newTestKubelet(t)
 	kubelet := testKubelet.kubelet
@@ -0,0 +1,232 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cni implements a network plugin that configures pod networking
+// with Container Network Interface (CNI) plugins.
+//
+// The network is described by the first valid *.conf file, in lexical order,
+// under /etc/cni/net.d/. Its "type" names the CNI plugin binary, which is
+// looked up under /opt/cni/bin/. The binary is called with the network
+// configuration on stdin and the following environment:
+//   CNI_COMMAND     ADD when the pod is set up, DEL when it is torn down
+//   CNI_CONTAINERID the docker id of the pod infra container
+//   CNI_NETNS       the path of the infra container's network namespace
+//   CNI_IFNAME      the interface to configure in the namespace (eth0)
+//   CNI_ARGS        the pod namespace, name and infra container id as
+//                   K8S_POD_NAMESPACE, K8S_POD_NAME and K8S_POD_INFRA_CONTAINER_ID
+//   CNI_PATH        the directory holding the CNI plugin binaries
+// Any non-zero exit code is a failure, and the plugin's output is reported.
+//
+// The kubelet starts the infra containers of non-host-network pods with
+// docker's "none" network mode when this plugin is selected, so the CNI
+// plugin finds the namespace without an eth0. Docker cannot publish host
+// ports from such containers. The pod IP is read from the interface in the
+// pod's network namespace, so it does not depend on what docker knows about
+// the infra container.
+//
+// Use the kubelet flag '--network-plugin=cni' to select this plugin.
+package cni
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network"
+	kubeletTypes "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/types"
+	utilexec "github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+	"github.com/golang/glog"
+)
+
+const (
+	CNIPluginName = "cni"
+	DefaultNetDir = "/etc/cni/net.d"
+	DefaultCNIDir = "/opt/cni/bin"
+
+	addCmd           = "ADD"
+	delCmd           = "DEL"
+	podInterfaceName = "eth0"
+)
+
+type cniNetworkPlugin struct {
+	host      network.Host
+	netDir    string
+	cniDir    string
+	netConfig *netConfig
+	// execer runs commands in the pod network namespace.
+	execer utilexec.Interface
+}
+
+// netConfig is a CNI network configuration. Only the fields the kubelet
+// needs are decoded; the plugin is handed the original bytes.
+type netConfig struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Bytes []byte `json:"-"`
+}
+
+// ProbeNetworkPlugins returns the CNI network plugin. Its network
+// configuration is loaded when it is initialized.
+func ProbeNetworkPlugins() []network.NetworkPlugin {
+	return probeNetworkPluginsWithDirs(DefaultNetDir, DefaultCNIDir)
+}
+
+func probeNetworkPluginsWithDirs(netDir, cniDir string) []network.NetworkPlugin {
+	return []network.NetworkPlugin{&cniNetworkPlugin{netDir: netDir, cniDir: cniDir, execer: utilexec.New()}}
+}
+
+func (plugin *cniNetworkPlugin) Init(host network.Host) error {
+	conf, err := getDefaultNetConfig(plugin.netDir)
+	if err != nil {
+		return err
+	}
+	if !isExecutable(plugin.getExecutable(conf)) {
+		return fmt.Errorf("CNI plugin %q of network %q is not an executable under %s", conf.Type, conf.Name, plugin.cniDir)
+	}
+	plugin.netConfig = conf
+	plugin.host = host
+	glog.V(1).Infof("Using CNI network %q of type %q", conf.Name, conf.Type)
+	return nil
+}
+
+func (plugin *cniNetworkPlugin) Name() string {
+	return CNIPluginName
+}
+
+func (plugin *cniNetworkPlugin) SetUpPod(namespace string, name string, id kubeletTypes.DockerID) error {
+	out, err := plugin.invoke(addCmd, namespace, name, id)
+	glog.V(5).Infof("SetUpPod 'cni' network plugin output: %s, %v", string(out), err)
+	return err
+}
+
+func (plugin *cniNetworkPlugin) TearDownPod(namespace string, name string, id kubeletTypes.DockerID) error {
+	out, err := plugin.invoke(delCmd, namespace, name, id)
+	glog.V(5).Infof("TearDownPod 'cni' network plugin output: %s, %v", string(out), err)
+	return err
+}
+
+// GetPodNetworkStatus reads the IP of the pod's interface from inside its
+// network namespace.
+func (plugin *cniNetworkPlugin) GetPodNetworkStatus(namespace string, name string, id kubeletTypes.DockerID) (*network.PodNetworkStatus, error) {
+	netns, err := plugin.host.GetRuntime().GetNetNS(string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the network namespace of container %q: %v", id, err)
+	}
+	out, err := plugin.execer.Command("nsenter", "--net="+netns, "-F", "--",
+		"ip", "-o", "-4", "addr", "show", "dev", podInterfaceName, "scope", "global").CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the address of %s in %s: %v: %s", podInterfaceName, netns, err, string(out))
+	}
+	ip, err := parseIPAddrOutput(string(out))
+	if err != nil {
+		return nil, err
+	}
+	return &network.PodNetworkStatus{IP: ip}, nil
+}
+
+// invoke calls the CNI plugin binary with command for the pod whose infra
+// container is id, and returns its combined output.
+func (plugin *cniNetworkPlugin) invoke(command, namespace, name string, id kubeletTypes.DockerID) ([]byte, error) {
+	netns, err := plugin.host.GetRuntime().GetNetNS(string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the network namespace of container %q: %v", id, err)
+	}
+	cmd := plugin.execer.Command(plugin.getExecutable(plugin.netConfig))
+	cmd.SetEnv(append(os.Environ(),
+		"CNI_COMMAND="+command,
+		"CNI_CONTAINERID="+string(id),
+		"CNI_NETNS="+netns,
+		"CNI_IFNAME="+podInterfaceName,
+		fmt.Sprintf("CNI_ARGS=IgnoreUnknown=1;K8S_POD_NAMESPACE=%s;K8S_POD_NAME=%s;K8S_POD_INFRA_CONTAINER_ID=%s", namespace, name, id),
+		"CNI_PATH="+plugin.cniDir,
+	))
+	cmd.SetStdin(bytes.NewReader(plugin.netConfig.Bytes))
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return out, fmt.Errorf("CNI plugin %q failed to %s pod %s/%s: %v: %s", plugin.netConfig.Type, command, namespace, name, err, string(out))
+	}
+	return out, nil
+}
+
+func (plugin *cniNetworkPlugin) getExecutable(conf *netConfig) string {
+	return path.Join(plugin.cniDir, conf.Type)
+}
+
+// getDefaultNetConfig returns the first valid network configuration in
+// netDir, in lexical order of the file names.
+func getDefaultNetConfig(netDir string) (*netConfig, error) {
+	files, err := filepath.Glob(path.Join(netDir, "*.conf"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		conf, err := loadNetConfig(file)
+		if err != nil {
+			glog.Warningf("Skipping CNI network config %s: %v", file, err)
+			continue
+		}
+		return conf, nil
+	}
+	return nil, fmt.Errorf("no valid CNI network config found in %s", netDir)
+}
+
+func loadNetConfig(file string) (*netConfig, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	conf := &netConfig{}
+	if err := json.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+	if conf.Name == "" {
+		return nil, fmt.Errorf("missing network name")
+	}
+	if conf.Type == "" {
+		return nil, fmt.Errorf("missing plugin type")
+	}
+	conf.Bytes = data
+	return conf, nil
+}
+
+// parseIPAddrOutput returns the address in the output of 'ip -o -4 addr show',
+// which looks like:
+//   2: eth0    inet 10.244.1.5/24 scope global eth0\       valid_lft forever preferred_lft forever
+func parseIPAddrOutput(out string) (net.IP, error) {
+	fields := strings.Fields(out)
+	for i, field := range fields {
+		if field != "inet" || i+1 >= len(fields) {
+			continue
+		}
+		ip, _, err := net.ParseCIDR(fields[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %v", fields[i+1], err)
+		}
+		return ip, nil
+	}
+	return nil, fmt.Errorf("no IPv4 address found in %q", out)
+}
+
+func isExecutable(file string) bool {
+	info, err := os.Stat(file)
+	return err == nil && !info.IsDir() && info.Mode()&0111 != 0
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cni
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/network"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+)
+
+const (
+	testNetConfig = `{"name": "test-net", "type": "fake-cni"}`
+	testNetNS     = "/proc/12345/ns/net"
+)
+
+type fakeNetworkHost struct {
+	runtime kubecontainer.Runtime
+}
+
+func (fnh *fakeNetworkHost) GetPodByName(name, namespace string) (*api.Pod, bool) {
+	return nil, false
+}
+
+func (fnh *fakeNetworkHost) GetKubeClient() client.Interface {
+	return nil
+}
+
+func (fnh *fakeNetworkHost) GetRuntime() kubecontainer.Runtime {
+	return fnh.runtime
+}
+
+// installPluginUnderTest writes netConfig to a network config directory and
+// a fake CNI plugin script to a binary directory. The script records its
+// environment and stdin under the binary directory, prints output and exits
+// with exitCode.
+func installPluginUnderTest(t *testing.T, netConfig, output string, exitCode int) (netDir, cniDir string) {
+	tmpDir, err := ioutil.TempDir("", "cni_test")
+	if err != nil {
+		t.Fatalf("Failed to create a temp dir: %v", err)
+	}
+	netDir = path.Join(tmpDir, "net.d")
+	cniDir = path.Join(tmpDir, "bin")
+	for _, dir := range []string{netDir, cniDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+	}
+	if err := ioutil.WriteFile(path.Join(netDir, "10-test.conf"), []byte(netConfig), 0644); err != nil {
+		t.Fatalf("Failed to write the network config: %v", err)
+	}
+	script := fmt.Sprintf("#!/bin/sh\nenv > %[1]s/$CNI_COMMAND.env\ncat > %[1]s/$CNI_COMMAND.in\necho '%[2]s'\nexit %[3]d\n", cniDir, output, exitCode)
+	if err := ioutil.WriteFile(path.Join(cniDir, "fake-cni"), []byte(script), 0755); err != nil {
+		t.Fatalf("Failed to write the plugin: %v", err)
+	}
+	return netDir, cniDir
+}
+
+func tearDownPlugin(netDir string) {
+	if err := os.RemoveAll(path.Dir(netDir)); err != nil {
+		fmt.Printf("Error in cleaning up test: %v", err)
+	}
+}
+
+func newTestPlugin(t *testing.T, netDir, cniDir string) network.NetworkPlugin {
+	host := &fakeNetworkHost{runtime: &kubecontainer.FakeRuntime{NetNS: testNetNS}}
+	plug, err := network.InitNetworkPlugin(probeNetworkPluginsWithDirs(netDir, cniDir), CNIPluginName, host)
+	if err != nil {
+		t.Fatalf("Failed to select the cni plugin: %v", err)
+	}
+	return plug
+}
+
+func TestSelectPlugin(t *testing.T) {
+	netDir, cniDir := installPluginUnderTest(t, testNetConfig, "{}", 0)
+	defer tearDownPlugin(netDir)
+
+	plug := newTestPlugin(t, netDir, cniDir)
+	if plug.Name() != CNIPluginName {
+		t.Errorf("Wrong plugin selected, chose %s, got %s", CNIPluginName, plug.Name())
+	}
+}
+
+func TestInitFailures(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		netConfig string
+	}{
+		{"invalid json", `{"name":`},
+		{"missing type", `{"name": "test-net"}`},
+		{"missing plugin binary", `{"name": "test-net", "type": "missing-cni"}`},
+	} {
+		netDir, cniDir := installPluginUnderTest(t, test.netConfig, "{}", 0)
+		_, err := network.InitNetworkPlugin(probeNetworkPluginsWithDirs(netDir, cniDir), CNIPluginName, network.NewFakeHost(nil))
+		if err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		tearDownPlugin(netDir)
+	}
+}
+
+func TestSetUpAndTearDownPod(t *testing.T) {
+	netDir, cniDir := installPluginUnderTest(t, testNetConfig, `{"ip4": {"ip": "10.1.0.5/16"}}`, 0)
+	defer tearDownPlugin(netDir)
+	plug := newTestPlugin(t, netDir, cniDir)
+
+	if err := plug.SetUpPod("podNamespace", "podName", "infraID"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := plug.TearDownPod("podNamespace", "podName", "infraID"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, command := range []string{addCmd, delCmd} {
+		env, err := ioutil.ReadFile(path.Join(cniDir, command+".env"))
+		if err != nil {
+			t.Fatalf("%s: plugin was not called: %v", command, err)
+		}
+		for _, expected := range []string{
+			"CNI_COMMAND=" + command,
+			"CNI_CONTAINERID=infraID",
+			"CNI_NETNS=" + testNetNS,
+			"CNI_IFNAME=eth0",
+			"CNI_ARGS=IgnoreUnknown=1;K8S_POD_NAMESPACE=podNamespace;K8S_POD_NAME=podName;K8S_POD_INFRA_CONTAINER_ID=infraID",
+			"CNI_PATH=" + cniDir,
+		} {
+			if !strings.Contains(string(env), expected+"\n") {
+				t.Errorf("%s: expected %q in the plugin environment:\n%s", command, expected, env)
+			}
+		}
+		stdin, err := ioutil.ReadFile(path.Join(cniDir, command+".in"))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", command, err)
+		}
+		if string(stdin) != testNetConfig {
+			t.Errorf("%s: expected the network config %q on stdin, got %q", command, testNetConfig, stdin)
+		}
+	}
+}
+
+func TestSetUpPodFailure(t *testing.T) {
+	netDir, cniDir := installPluginUnderTest(t, testNetConfig, `{"code": 100, "msg": "no addresses left"}`, 1)
+	defer tearDownPlugin(netDir)
+	plug := newTestPlugin(t, netDir, cniDir)
+
+	err := plug.SetUpPod("podNamespace", "podName", "infraID")
+	if err == nil || !strings.Contains(err.Error(), "no addresses left") {
+		t.Errorf("Expected the plugin output in the error, got %v", err)
+	}
+}
+
+func TestGetPodNetworkStatus(t *testing.T) {
+	fcmd := exec.FakeCmd{
+		CombinedOutputScript: []exec.FakeCombinedOutputAction{
+			func() ([]byte, error) {
+				return []byte("2: eth0    inet 10.1.0.5/16 scope global eth0\\       valid_lft forever preferred_lft forever\n"), nil
+			},
+		},
+	}
+	fexec := exec.FakeExec{
+		CommandScript: []exec.FakeCommandAction{
+			func(cmd string, args ...string) exec.Cmd { return exec.InitFakeCmd(&fcmd, cmd, args...) },
+		},
+	}
+	plug := &cniNetworkPlugin{
+		host:   &fakeNetworkHost{runtime: &kubecontainer.FakeRuntime{NetNS: testNetNS}},
+		execer: &fexec,
+	}
+
+	status, err := plug.GetPodNetworkStatus("podNamespace", "podName", "infraID")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if status.IP.String() != "10.1.0.5" {
+		t.Errorf("Expected IP 10.1.0.5, got %v", status.IP)
+	}
+	if fcmd.Argv[0] != "nsenter" || fcmd.Argv[1] != "--net="+testNetNS {
+		t.Errorf("Expected the address to be read in %s, got %v", testNetNS, fcmd.Argv)
+	}
+}
+
+func TestParseIPAddrOutput(t *testing.T) {
+	for _, out := range []string{"", "2: eth0    inet6 fe80::1/64 scope link", "2: eth0    inet bogus scope global eth0"} {
+		if ip, err := parseIPAddrOutput(out); err == nil {
+			t.Errorf("Expected an error for %q, got %v", out, ip)
+		}
+	}
+}
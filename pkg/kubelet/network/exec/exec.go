@@ -136,3 +136,9 @@ func (plugin *execNetworkPlugin) TearDownPod(namespace string, name string, id k
 	glog.V(5).Infof("TearDownPod 'exec' network plugin output: %s, %v", string(out), err)
 	return err
 }
+
+// GetPodNetworkStatus leaves the pod's status to the runtime, as exec plugins
+// have no way to report it.
+func (plugin *execNetworkPlugin) GetPodNetworkStatus(namespace string, name string, id kubeletTypes.DockerID) (*network.PodNetworkStatus, error) {
+	return nil, nil
+}
@@ -18,10 +18,12 @@ package network
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	kubeletTypes "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/errors"
@@ -47,6 +49,18 @@ type NetworkPlugin interface {
 
 	// TearDownPod is the method called before a pod's infra container will be deleted
 	TearDownPod(namespace string, name string, podInfraContainerID kubeletTypes.DockerID) error
+
+	// GetPodNetworkStatus is the method called to obtain the network status
+	// of a running pod, e.g. its IP. It returns nil if the plugin leaves the
+	// pod's networking to the container runtime.
+	GetPodNetworkStatus(namespace string, name string, podInfraContainerID kubeletTypes.DockerID) (*PodNetworkStatus, error)
+}
+
+// PodNetworkStatus stores the network status of a pod as reported by a
+// network plugin.
+type PodNetworkStatus struct {
+	// IP is the primary IP address of the pod.
+	IP net.IP
 }
 
 // Host is an interface that plugins can use to access the kubelet.
@@ -56,6 +70,9 @@ type Host interface {
 
 	// GetKubeClient returns a client interface
 	GetKubeClient() client.Interface
+
+	// GetRuntime returns the container runtime that runs the pods
+	GetRuntime() kubecontainer.Runtime
 }
 
 // InitNetworkPlugin inits the plugin that matches networkPluginName. Plugins must have unique names.
@@ -120,3 +137,7 @@ func (plugin *noopNetworkPlugin) SetUpPod(namespace string, name string, id kube
 func (plugin *noopNetworkPlugin) TearDownPod(namespace string, name string, id kubeletTypes.DockerID) error {
 	return nil
 }
+
+func (plugin *noopNetworkPlugin) GetPodNetworkStatus(namespace string, name string, id kubeletTypes.DockerID) (*PodNetworkStatus, error) {
+	return nil, nil
+}
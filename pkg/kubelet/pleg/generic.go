@@ -0,0 +1,127 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pleg
+
+import (
+	"time"
+
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// GenericPLEG is an extremely simple generic PLEG that relies solely on
+// periodic listing to discover container changes. It should be used as a
+// temporary replacement for container runtimes that do not support a proper
+// event generator yet.
+//
+// Note that GenericPLEG assumes that a container would not be created,
+// terminated, and garbage collected within one relist period. If such an
+// incident happens, GenericPLEG would miss all events regarding this
+// container. The pod would still be synced by the periodic resync of the
+// kubelet.
+type GenericPLEG struct {
+	// The period for relisting.
+	relistPeriod time.Duration
+	// The underlying container runtime.
+	runtime kubecontainer.Runtime
+	// The channel from which the subscriber listens events.
+	eventChannel chan *PodLifecycleEvent
+	// The containers seen in the last relist, indexed by container ID.
+	containers map[types.UID]containerRecord
+}
+
+// containerRecord is the state of a container seen in a relist.
+type containerRecord struct {
+	podID types.UID
+	state kubecontainer.ContainerState
+}
+
+func NewGenericPLEG(runtime kubecontainer.Runtime, channelCapacity int,
+	relistPeriod time.Duration) *GenericPLEG {
+	return &GenericPLEG{
+		relistPeriod: relistPeriod,
+		runtime:      runtime,
+		eventChannel: make(chan *PodLifecycleEvent, channelCapacity),
+		containers:   make(map[types.UID]containerRecord),
+	}
+}
+
+// Watch returns the channel from which the subscriber can receive
+// PodLifecycleEvent events.
+// TODO: support multiple subscribers.
+func (g *GenericPLEG) Watch() chan *PodLifecycleEvent {
+	return g.eventChannel
+}
+
+// Start spawns a goroutine to relist periodically.
+func (g *GenericPLEG) Start() {
+	go util.Until(g.relist, g.relistPeriod, util.NeverStop)
+}
+
+// eventTypeFor returns the type of the event sent when a container is seen in
+// the given state for the first time.
+func eventTypeFor(state kubecontainer.ContainerState) PodLifeCycleEventType {
+	switch state {
+	case kubecontainer.ContainerStateRunning:
+		return ContainerStarted
+	case kubecontainer.ContainerStateExited:
+		return ContainerDied
+	default:
+		return ContainerChanged
+	}
+}
+
+// relist queries the container runtime for the containers of all pods,
+// compares them with the containers seen in the previous relist and sends an
+// event for each container that changed.
+func (g *GenericPLEG) relist() {
+	glog.V(5).Infof("GenericPLEG: Relisting")
+	// Get all the pods, including the ones with exited containers.
+	pods, err := g.runtime.GetPods(true)
+	if err != nil {
+		glog.Errorf("GenericPLEG: Unable to retrieve pods: %v", err)
+		return
+	}
+
+	containers := make(map[types.UID]containerRecord)
+	for _, p := range pods {
+		for _, c := range p.Containers {
+			containers[c.ID] = containerRecord{podID: p.ID, state: c.State}
+		}
+	}
+
+	events := []*PodLifecycleEvent{}
+	for id, c := range containers {
+		if old, found := g.containers[id]; found && old.state == c.state {
+			continue
+		}
+		events = append(events, &PodLifecycleEvent{ID: c.podID, Type: eventTypeFor(c.state), Data: id})
+	}
+	for id, old := range g.containers {
+		if _, found := containers[id]; !found {
+			events = append(events, &PodLifecycleEvent{ID: old.podID, Type: ContainerRemoved, Data: id})
+		}
+	}
+	g.containers = containers
+
+	for _, e := range events {
+		glog.V(4).Infof("GenericPLEG: %v/%v: %v", e.ID, e.Data, e.Type)
+		g.eventChannel <- e
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pleg
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+const largeChannelCap = 100
+
+type sortableEvents []*PodLifecycleEvent
+
+func (a sortableEvents) Len() int      { return len(a) }
+func (a sortableEvents) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a sortableEvents) Less(i, j int) bool {
+	if a[i].ID != a[j].ID {
+		return a[i].ID < a[j].ID
+	}
+	return a[i].Data.(types.UID) < a[j].Data.(types.UID)
+}
+
+func newTestGenericPLEG() (*GenericPLEG, *kubecontainer.FakeRuntime) {
+	fakeRuntime := &kubecontainer.FakeRuntime{}
+	// The channel capacity should be large enough to hold all events in a
+	// single test.
+	return NewGenericPLEG(fakeRuntime, largeChannelCap, 0), fakeRuntime
+}
+
+func getEventsFromChannel(ch <-chan *PodLifecycleEvent) []*PodLifecycleEvent {
+	events := []*PodLifecycleEvent{}
+	for len(ch) > 0 {
+		e := <-ch
+		events = append(events, e)
+	}
+	return events
+}
+
+func createTestContainer(ID string, state kubecontainer.ContainerState) *kubecontainer.Container {
+	return &kubecontainer.Container{
+		ID:    types.UID(ID),
+		State: state,
+	}
+}
+
+func verifyEvents(t *testing.T, expected, actual []*PodLifecycleEvent) {
+	sort.Sort(sortableEvents(expected))
+	sort.Sort(sortableEvents(actual))
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Actual events differ from the expected; expected: %#v, got: %#v", expected, actual)
+	}
+}
+
+func TestRelisting(t *testing.T) {
+	pleg, runtime := newTestGenericPLEG()
+	ch := pleg.Watch()
+
+	// The first relist should send a ContainerStarted event for every running
+	// container and a ContainerDied event for every exited one.
+	runtime.PodList = []*kubecontainer.Pod{
+		{
+			ID: "1234",
+			Containers: []*kubecontainer.Container{
+				createTestContainer("c0", kubecontainer.ContainerStateExited),
+				createTestContainer("c2", kubecontainer.ContainerStateRunning),
+				createTestContainer("c3", kubecontainer.ContainerStateUnknown),
+			},
+		},
+		{
+			ID: "4567",
+			Containers: []*kubecontainer.Container{
+				createTestContainer("c1", kubecontainer.ContainerStateExited),
+			},
+		},
+	}
+	pleg.relist()
+	// Report every running/exited container if we see them for the first time.
+	expected := []*PodLifecycleEvent{
+		{ID: "1234", Type: ContainerDied, Data: types.UID("c0")},
+		{ID: "1234", Type: ContainerStarted, Data: types.UID("c2")},
+		{ID: "4567", Type: ContainerDied, Data: types.UID("c1")},
+		{ID: "1234", Type: ContainerChanged, Data: types.UID("c3")},
+	}
+	actual := getEventsFromChannel(ch)
+	verifyEvents(t, expected, actual)
+
+	// The second relist should not send out any event because no container
+	// changed.
+	pleg.relist()
+	verifyEvents(t, []*PodLifecycleEvent{}, getEventsFromChannel(ch))
+
+	runtime.PodList = []*kubecontainer.Pod{
+		{
+			ID: "1234",
+			Containers: []*kubecontainer.Container{
+				createTestContainer("c2", kubecontainer.ContainerStateExited),
+				createTestContainer("c3", kubecontainer.ContainerStateRunning),
+			},
+		},
+		{
+			ID: "4567",
+			Containers: []*kubecontainer.Container{
+				createTestContainer("c4", kubecontainer.ContainerStateRunning),
+			},
+		},
+	}
+	pleg.relist()
+	// Only report containers that transitioned to running or exited status,
+	// and the ones that were removed.
+	expected = []*PodLifecycleEvent{
+		{ID: "1234", Type: ContainerRemoved, Data: types.UID("c0")},
+		{ID: "1234", Type: ContainerDied, Data: types.UID("c2")},
+		{ID: "1234", Type: ContainerStarted, Data: types.UID("c3")},
+		{ID: "4567", Type: ContainerRemoved, Data: types.UID("c1")},
+		{ID: "4567", Type: ContainerStarted, Data: types.UID("c4")},
+	}
+	actual = getEventsFromChannel(ch)
+	verifyEvents(t, expected, actual)
+}
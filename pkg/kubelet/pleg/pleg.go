@@ -0,0 +1,56 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pleg
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+type PodLifeCycleEventType string
+
+const (
+	// ContainerStarted is sent when a container is seen running.
+	ContainerStarted PodLifeCycleEventType = "ContainerStarted"
+	// ContainerDied is sent when a container is seen exited.
+	ContainerDied PodLifeCycleEventType = "ContainerDied"
+	// ContainerRemoved is sent when a container is no longer reported by
+	// the runtime.
+	ContainerRemoved PodLifeCycleEventType = "ContainerRemoved"
+	// ContainerChanged is sent when a container changes to a state that is
+	// neither running nor exited, e.g. it was created but not started.
+	ContainerChanged PodLifeCycleEventType = "ContainerChanged"
+)
+
+// PodLifecycleEvent is an event that reflects the change of the pod state.
+type PodLifecycleEvent struct {
+	// The pod ID.
+	ID types.UID
+	// The type of the event.
+	Type PodLifeCycleEventType
+	// The accompanied data which varies based on the event type. For the
+	// container events, it is the ID of the container.
+	Data interface{}
+}
+
+// PodLifecycleEventGenerator watches the container runtime and generates
+// events when the containers of a pod change.
+type PodLifecycleEventGenerator interface {
+	// Start starts generating events in the background.
+	Start()
+	// Watch returns the channel the events are sent to.
+	Watch() chan *PodLifecycleEvent
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package kubelet
 
 import (
+	"reflect"
 	"sync"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -103,26 +104,27 @@ func newBasicPodManager(apiserverClient client.Interface) *basicPodManager {
 	return pm
 }
 
-// Update the internal pods with those provided by the update.
+// Update the internal pods with those provided by the update, and mark the
+// pods that are new or changed to be synced.
 func (pm *basicPodManager) UpdatePods(u PodUpdate, podSyncTypes map[types.UID]SyncPodType) {
 	pm.lock.Lock()
 	defer pm.lock.Unlock()
+	oldMirrorPods := pm.mirrorPodByFullName
 	switch u.Op {
 	case SET:
 		glog.V(3).Infof("SET: Containers changed")
 		// Store the new pods. Don't worry about filtering host ports since those
 		// pods will never be looked up.
-		existingPods := make(map[types.UID]struct{})
-		for uid := range pm.podByUID {
-			existingPods[uid] = struct{}{}
-		}
+		existingPods := pm.podByUID
 
 		// Update the internal pods.
 		pm.setPods(u.Pods)
 
-		for uid := range pm.podByUID {
-			if _, ok := existingPods[uid]; !ok {
+		for uid, pod := range pm.podByUID {
+			if existing, ok := existingPods[uid]; !ok {
 				podSyncTypes[uid] = SyncPodCreate
+			} else if !reflect.DeepEqual(existing, pod) {
+				podSyncTypes[uid] = SyncPodUpdate
 			}
 		}
 	case UPDATE:
@@ -139,10 +141,13 @@ func (pm *basicPodManager) UpdatePods(u PodUpdate, podSyncTypes map[types.UID]Sy
 		panic("syncLoop does not support incremental changes")
 	}
 
-	// Mark all remaining pods as sync.
-	for uid := range pm.podByUID {
-		if _, ok := podSyncTypes[uid]; !ok {
-			podSyncTypes[uid] = SyncPodSync
+	// The worker of a static pod creates its mirror pod, so sync the static
+	// pods whose mirror pods were added, changed or removed.
+	for podFullName, pod := range pm.podByFullName {
+		if !reflect.DeepEqual(oldMirrorPods[podFullName], pm.mirrorPodByFullName[podFullName]) {
+			if _, ok := podSyncTypes[pod.UID]; !ok {
+				podSyncTypes[pod.UID] = SyncPodSync
+			}
 		}
 	}
 }
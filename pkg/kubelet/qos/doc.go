@@ -14,18 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package rkt
-
-// ImageManager manages and garbage collects the container images for rkt.
-type ImageManager struct {
-	runtime *runtime
-}
-
-func NewImageManager(r *runtime) *ImageManager {
-	return &ImageManager{runtime: r}
-}
-
-// GarbageCollect collects the images. It is not implemented by rkt yet.
-func (im *ImageManager) GarbageCollect() error {
-	return nil
-}
+// Package qos classifies pods into quality of service classes from the
+// resource requests and limits of their containers, and maps the classes to
+// the oom_score_adj of the containers.
+package qos
@@ -0,0 +1,65 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qos
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+const (
+	// The oom_score_adj of the node daemons, so they are never OOM killed
+	// before a container. They must stay below guaranteedOOMScoreAdj; the
+	// previous defaults of -900 and -899 would let the OOM killer pick the
+	// kubelet or kube-proxy over a guaranteed container.
+	KubeletOOMScoreAdj   int = -999
+	KubeProxyOOMScoreAdj int = -999
+	// The oom_score_adj of the pod infrastructure container, which holds the
+	// namespaces of the pod.
+	PodInfraOOMAdj int = -999
+
+	guaranteedOOMScoreAdj int = -998
+	besteffortOOMScoreAdj int = 1000
+)
+
+// GetContainerOOMScoreAdjust returns the oom_score_adj of container in pod on
+// a node with memoryCapacity bytes of memory. Best effort containers are
+// killed first and guaranteed containers last. Burstable containers are in
+// between: the more memory they request, the later they are killed.
+func GetContainerOOMScoreAdjust(pod *api.Pod, container *api.Container, memoryCapacity int64) int {
+	switch GetPodQOS(pod) {
+	case api.PodQOSGuaranteed:
+		return guaranteedOOMScoreAdj
+	case api.PodQOSBestEffort:
+		return besteffortOOMScoreAdj
+	}
+
+	if memoryCapacity <= 0 {
+		return besteffortOOMScoreAdj - 1
+	}
+	memoryRequest := GetContainerRequest(container, api.ResourceMemory).Value()
+	oomScoreAdjust := besteffortOOMScoreAdj - int((1000*memoryRequest)/memoryCapacity)
+	// A guaranteed container using all the memory of the node has an OOM
+	// score of 1000-998=2; burstable containers must score at least that.
+	if oomScoreAdjust < 1000+guaranteedOOMScoreAdj {
+		return 1000 + guaranteedOOMScoreAdj
+	}
+	// Burstable containers are killed after best effort ones.
+	if oomScoreAdjust == besteffortOOMScoreAdj {
+		return besteffortOOMScoreAdj - 1
+	}
+	return oomScoreAdjust
+}
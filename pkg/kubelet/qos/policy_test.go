@@ -0,0 +1,75 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qos
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+const memoryCapacity = 4000000000
+
+func TestGetContainerOOMScoreAdjust(t *testing.T) {
+	testCases := []struct {
+		resources api.ResourceRequirements
+		expected  int
+	}{
+		// Best effort.
+		{
+			resources: api.ResourceRequirements{},
+			expected:  1000,
+		},
+		// Guaranteed.
+		{
+			resources: api.ResourceRequirements{Limits: getResourceList("100m", "1G")},
+			expected:  -998,
+		},
+		// Burstable: the score decreases with the memory request.
+		{
+			resources: api.ResourceRequirements{
+				Requests: getResourceList("100m", "1G"),
+				Limits:   getResourceList("200m", "2G"),
+			},
+			expected: 750,
+		},
+		// Burstable without a memory request.
+		{
+			resources: api.ResourceRequirements{Requests: getResourceList("100m", "")},
+			expected:  999,
+		},
+		// Burstable requesting all the memory.
+		{
+			resources: api.ResourceRequirements{Requests: getResourceList("", "4G")},
+			expected:  2,
+		},
+	}
+	for i, test := range testCases {
+		pod := newPod("foo", test.resources)
+		actual := GetContainerOOMScoreAdjust(pod, &pod.Spec.Containers[0], memoryCapacity)
+		if actual != test.expected {
+			t.Errorf("case %d: expected oom_score_adj %d, got %d", i, test.expected, actual)
+		}
+	}
+}
+
+func TestGetContainerOOMScoreAdjustUnknownCapacity(t *testing.T) {
+	pod := newPod("foo", api.ResourceRequirements{Requests: getResourceList("", "1G")})
+	if actual := GetContainerOOMScoreAdjust(pod, &pod.Spec.Containers[0], 0); actual != 999 {
+		t.Errorf("expected oom_score_adj 999, got %d", actual)
+	}
+}
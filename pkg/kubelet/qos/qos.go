@@ -0,0 +1,65 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qos
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+)
+
+// The resources that determine the quality of service of a pod.
+var computeResources = []api.ResourceName{api.ResourceCPU, api.ResourceMemory}
+
+// GetPodQOS returns the quality of service class of pod. A container that
+// sets a limit but no request for a resource implicitly requests its limit.
+func GetPodQOS(pod *api.Pod) api.PodQOSClass {
+	bestEffort := true
+	guaranteed := true
+	for i := range pod.Spec.Containers {
+		resources := &pod.Spec.Containers[i].Resources
+		for _, name := range computeResources {
+			request, hasRequest := resources.Requests[name]
+			limit, hasLimit := resources.Limits[name]
+			if hasRequest || hasLimit {
+				bestEffort = false
+			}
+			if !hasLimit || (hasRequest && request.MilliValue() != limit.MilliValue()) {
+				guaranteed = false
+			}
+		}
+	}
+	switch {
+	case bestEffort:
+		return api.PodQOSBestEffort
+	case guaranteed:
+		return api.PodQOSGuaranteed
+	default:
+		return api.PodQOSBurstable
+	}
+}
+
+// GetContainerRequest returns the amount of name requested by container,
+// falling back to its limit when no request is set.
+func GetContainerRequest(container *api.Container, name api.ResourceName) *resource.Quantity {
+	if request, ok := container.Resources.Requests[name]; ok {
+		return &request
+	}
+	if limit, ok := container.Resources.Limits[name]; ok {
+		return &limit
+	}
+	return &resource.Quantity{}
+}
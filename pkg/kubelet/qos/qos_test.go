@@ -0,0 +1,120 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qos
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+)
+
+func getResourceList(cpu, memory string) api.ResourceList {
+	res := api.ResourceList{}
+	if cpu != "" {
+		res[api.ResourceCPU] = resource.MustParse(cpu)
+	}
+	if memory != "" {
+		res[api.ResourceMemory] = resource.MustParse(memory)
+	}
+	return res
+}
+
+func newPod(name string, resources ...api.ResourceRequirements) *api.Pod {
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: name}}
+	for _, r := range resources {
+		pod.Spec.Containers = append(pod.Spec.Containers, api.Container{Name: "foo", Resources: r})
+	}
+	return pod
+}
+
+func TestGetPodQOS(t *testing.T) {
+	testCases := []struct {
+		pod      *api.Pod
+		expected api.PodQOSClass
+	}{
+		{
+			pod:      newPod("no-resources", api.ResourceRequirements{}),
+			expected: api.PodQOSBestEffort,
+		},
+		{
+			pod:      newPod("no-containers"),
+			expected: api.PodQOSBestEffort,
+		},
+		{
+			pod: newPod("requests-equal-limits", api.ResourceRequirements{
+				Requests: getResourceList("100m", "100Mi"),
+				Limits:   getResourceList("100m", "100Mi"),
+			}),
+			expected: api.PodQOSGuaranteed,
+		},
+		{
+			pod: newPod("limits-only", api.ResourceRequirements{
+				Limits: getResourceList("100m", "100Mi"),
+			}),
+			expected: api.PodQOSGuaranteed,
+		},
+		{
+			pod: newPod("requests-below-limits", api.ResourceRequirements{
+				Requests: getResourceList("100m", "50Mi"),
+				Limits:   getResourceList("100m", "100Mi"),
+			}),
+			expected: api.PodQOSBurstable,
+		},
+		{
+			pod: newPod("requests-only", api.ResourceRequirements{
+				Requests: getResourceList("100m", ""),
+			}),
+			expected: api.PodQOSBurstable,
+		},
+		{
+			pod: newPod("cpu-limit-only", api.ResourceRequirements{
+				Limits: getResourceList("100m", ""),
+			}),
+			expected: api.PodQOSBurstable,
+		},
+		{
+			pod: newPod("mixed-containers",
+				api.ResourceRequirements{Limits: getResourceList("100m", "100Mi")},
+				api.ResourceRequirements{}),
+			expected: api.PodQOSBurstable,
+		},
+	}
+	for _, test := range testCases {
+		if actual := GetPodQOS(test.pod); actual != test.expected {
+			t.Errorf("pod %q: expected %v, got %v", test.pod.Name, test.expected, actual)
+		}
+	}
+}
+
+func TestGetContainerRequest(t *testing.T) {
+	container := &api.Container{
+		Resources: api.ResourceRequirements{
+			Requests: getResourceList("100m", ""),
+			Limits:   getResourceList("200m", "100Mi"),
+		},
+	}
+	if actual := GetContainerRequest(container, api.ResourceCPU).MilliValue(); actual != 100 {
+		t.Errorf("expected cpu request 100m, got %dm", actual)
+	}
+	if actual := GetContainerRequest(container, api.ResourceMemory).Value(); actual != 100*1024*1024 {
+		t.Errorf("expected the memory limit as request, got %d", actual)
+	}
+	if actual := GetContainerRequest(&api.Container{}, api.ResourceMemory).Value(); actual != 0 {
+		t.Errorf("expected no memory request, got %d", actual)
+	}
+}
@@ -66,14 +66,12 @@ const (
 
 	dockerPrefix = "docker://"
 
+	// The time format accepted by the --since option of journalctl.
+	journalctlTimeFormat = "2006-01-02 15:04:05"
+
 	authDir            = "auth.d"
 	dockerAuthTemplate = `{"rktKind":"dockerAuth","rktVersion":"v1","registries":[%q],"credentials":{"user":%q,"password":%q}}`
 
-	// TODO(yifan): Merge with ContainerGCPolicy, i.e., derive
-	// the grace period from MinAge in ContainerGCPolicy.
-	//
-	// Duration to wait before discarding inactive pods from garbage
-	defaultGracePeriod = "1m"
 	// Duration to wait before expiring prepared pods.
 	defaultExpirePrepared = "1m"
 )
@@ -664,6 +662,14 @@ func (r *runtime) GetPods(all bool) ([]*kubecontainer.Pod, error) {
 				glog.Warningf("rkt: Cannot construct pod from unit file: %v.", err)
 				continue
 			}
+			// The containers of a pod run and exit together with its unit.
+			state := kubecontainer.ContainerStateExited
+			if u.SubState == "running" {
+				state = kubecontainer.ContainerStateRunning
+			}
+			for _, c := range pod.Containers {
+				c.State = state
+			}
 			pods = append(pods, pod)
 		}
 	}
@@ -813,12 +819,53 @@ func (r *runtime) IsImagePresent(image kubecontainer.ImageSpec) (bool, error) {
 	return true, nil
 }
 
+// ListImages lists all the available appc images on the machine. The size
+// of an image is the size of its ACI in rkt's image store.
 func (r *runtime) ListImages() ([]kubecontainer.Image, error) {
-	return []kubecontainer.Image{}, fmt.Errorf("rkt: ListImages unimplemented")
+	images, err := r.listImages()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]kubecontainer.Image, len(images))
+	for i, img := range images {
+		size, err := r.imageSize(img.id)
+		if err != nil {
+			glog.Warningf("rkt: Cannot get the size of image %q: %v", img.id, err)
+		}
+		result[i] = kubecontainer.Image{
+			ID:   img.id,
+			Tags: []string{img.name + ":" + img.version},
+			Size: size,
+		}
+	}
+	return result, nil
 }
 
+// imageSize returns the size of the ACI of the image with the given key
+// (e.g. sha512-xxx). rkt stores the ACI at cas/blob/<hash type>/<first two
+// characters of the hash>/<key> under its data directory.
+func (r *runtime) imageSize(key string) (int64, error) {
+	parts := strings.SplitN(key, "-", 2)
+	if len(parts) != 2 || len(parts[1]) < 2 {
+		return 0, fmt.Errorf("invalid image key %q", key)
+	}
+	dataDir := rktDataDir
+	if r.config != nil && r.config.Dir != "" {
+		dataDir = r.config.Dir
+	}
+	info, err := os.Stat(path.Join(dataDir, "cas", "blob", parts[0], parts[1][:2], key))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// RemoveImage removes an on-disk image using 'rkt image rm'.
 func (r *runtime) RemoveImage(image kubecontainer.ImageSpec) error {
-	return fmt.Errorf("rkt: RemoveImages unimplemented")
+	if _, err := r.runCommand("image", "rm", image.Image); err != nil {
+		return err
+	}
+	return nil
 }
 
 // SyncPod syncs the running pod to match the specified desired pod.
@@ -891,16 +938,16 @@ func (r *runtime) SyncPod(pod *api.Pod, runningPod kubecontainer.Pod, podStatus
 }
 
 // GetContainerLogs uses journalctl to get the logs of the container.
-// By default, it returns a snapshot of the container log. Set |follow| to true to
-// stream the log. Set |follow| to false and specify the number of lines (e.g.
-// "100" or "all") to tail the log.
+// By default, it returns a snapshot of the container log. Set 'Follow' in
+// logOptions to true to stream the log. Set 'Follow' to false and specify the
+// number of lines (e.g. "100" or "all") to tail the log.
 // TODO(yifan): Currently, it fetches all the containers' log within a pod. We will
 // be able to fetch individual container's log once https://github.com/coreos/rkt/pull/841
 // landed.
-func (r *runtime) GetContainerLogs(pod *api.Pod, containerID string, tail string, follow bool, stdout, stderr io.Writer) error {
+func (r *runtime) GetContainerLogs(pod *api.Pod, containerID string, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error {
 	unitName := makePodServiceFileName(pod.UID)
 	cmd := exec.Command("journalctl", "-u", unitName)
-	if follow {
+	if logOptions.Follow {
 		cmd.Args = append(cmd.Args, "-f")
 	}
 	if tail == "all" {
@@ -911,16 +958,38 @@ func (r *runtime) GetContainerLogs(pod *api.Pod, containerID string, tail string
 			cmd.Args = append(cmd.Args, "-n", tail)
 		}
 	}
+	if since, ok := kubecontainer.LogSinceTime(logOptions, time.Now()); ok {
+		// journalctl interprets the since time in the local timezone.
+		cmd.Args = append(cmd.Args, "--since", since.Local().Format(journalctlTimeFormat))
+	}
+	if logOptions.Timestamps {
+		cmd.Args = append(cmd.Args, "-o", "short-iso")
+	} else {
+		cmd.Args = append(cmd.Args, "-o", "cat")
+	}
 	cmd.Stdout, cmd.Stderr = stdout, stderr
 	return cmd.Start()
 }
 
-// GarbageCollect collects the pods/containers. TODO(yifan): Enforce the gc policy.
-func (r *runtime) GarbageCollect() error {
+// AttachContainer is not supported by rkt yet.
+func (r *runtime) AttachContainer(containerID string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) error {
+	return fmt.Errorf("rkt: AttachContainer unimplemented")
+}
+
+// GetNetNS is not supported by rkt yet.
+func (r *runtime) GetNetNS(containerID string) (string, error) {
+	return "", fmt.Errorf("rkt: GetNetNS unimplemented")
+}
+
+// GarbageCollect collects the pods/containers. Inactive pods are discarded
+// once they have been inactive for the minimum age of the policy.
+// TODO(yifan): Enforce the limits on the number of dead containers; rkt
+// collects whole pods, not single containers.
+func (r *runtime) GarbageCollect(gcPolicy kubecontainer.ContainerGCPolicy) error {
 	if err := exec.Command("systemctl", "reset-failed").Run(); err != nil {
 		glog.Errorf("rkt: Failed to reset failed systemd services: %v", err)
 	}
-	if _, err := r.runCommand("gc", "--grace-period="+defaultGracePeriod, "--expire-prepared="+defaultExpirePrepared); err != nil {
+	if _, err := r.runCommand("gc", "--grace-period="+gcPolicy.MinAge.String(), "--expire-prepared="+defaultExpirePrepared); err != nil {
 		glog.Errorf("rkt: Failed to gc: %v", err)
 		return err
 	}
@@ -34,10 +34,13 @@ import (
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/healthz"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/httplog"
 	kubecontainer "github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/container"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	utilErrors "github.com/GoogleCloudPlatform/kubernetes/pkg/util/errors"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/flushwriter"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/httpstream"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/httpstream/spdy"
@@ -104,7 +107,8 @@ type HostInterface interface {
 	GetPodByName(namespace, name string) (*api.Pod, bool)
 	RunInContainer(name string, uid types.UID, container string, cmd []string) ([]byte, error)
 	ExecInContainer(name string, uid types.UID, container string, cmd []string, in io.Reader, out, err io.WriteCloser, tty bool) error
-	GetKubeletContainerLogs(podFullName, containerName, tail string, follow, previous bool, stdout, stderr io.Writer) error
+	AttachContainer(name string, uid types.UID, container string, in io.Reader, out, err io.WriteCloser, tty bool) error
+	GetKubeletContainerLogs(podFullName, containerName, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error
 	ServeLogs(w http.ResponseWriter, req *http.Request)
 	PortForward(name string, uid types.UID, port uint16, stream io.ReadWriteCloser) error
 	StreamingConnectionIdleTimeout() time.Duration
@@ -143,6 +147,7 @@ func (s *Server) InstallDefaultHandlers() {
 func (s *Server) InstallDebuggingHandlers() {
 	s.mux.HandleFunc("/run/", s.handleRun)
 	s.mux.HandleFunc("/exec/", s.handleExec)
+	s.mux.HandleFunc("/attach/", s.handleAttach)
 	s.mux.HandleFunc("/portForward/", s.handlePortForward)
 
 	s.mux.HandleFunc("/logs/", s.handleLogs)
@@ -245,9 +250,12 @@ func (s *Server) handleContainerLogs(w http.ResponseWriter, req *http.Request) {
 	}
 
 	uriValues := u.Query()
-	follow, _ := strconv.ParseBool(uriValues.Get("follow"))
-	previous, _ := strconv.ParseBool(uriValues.Get("previous"))
 	tail := uriValues.Get("tail")
+	logOptions, err := parseLogOptions(uriValues)
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"message": %q}`, err.Error()), http.StatusBadRequest)
+		return
+	}
 
 	pod, ok := s.host.GetPodByName(podNamespace, podID)
 	if !ok {
@@ -273,13 +281,41 @@ func (s *Server) handleContainerLogs(w http.ResponseWriter, req *http.Request) {
 	fw := flushwriter.Wrap(w)
 	w.Header().Set("Transfer-Encoding", "chunked")
 	w.WriteHeader(http.StatusOK)
-	err = s.host.GetKubeletContainerLogs(kubecontainer.GetPodFullName(pod), containerName, tail, follow, previous, fw, fw)
+	err = s.host.GetKubeletContainerLogs(kubecontainer.GetPodFullName(pod), containerName, tail, logOptions, fw, fw)
 	if err != nil {
 		s.error(w, err)
 		return
 	}
 }
 
+// parseLogOptions builds the log options for a container log request from the
+// query parameters sent by the apiserver.
+func parseLogOptions(query url.Values) (*api.PodLogOptions, error) {
+	logOptions := &api.PodLogOptions{}
+	logOptions.Follow, _ = strconv.ParseBool(query.Get("follow"))
+	logOptions.Previous, _ = strconv.ParseBool(query.Get("previous"))
+	logOptions.Timestamps, _ = strconv.ParseBool(query.Get("timestamps"))
+	if value := query.Get("sinceSeconds"); len(value) > 0 {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sinceSeconds %q: %v", value, err)
+		}
+		logOptions.SinceSeconds = &seconds
+	}
+	if value := query.Get("sinceTime"); len(value) > 0 {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sinceTime %q: %v", value, err)
+		}
+		sinceTime := util.NewTime(t)
+		logOptions.SinceTime = &sinceTime
+	}
+	if errs := validation.ValidatePodLogOptions(logOptions); len(errs) > 0 {
+		return nil, utilErrors.NewAggregate(errs)
+	}
+	return logOptions, nil
+}
+
 // handlePods returns a list of pod bound to the Kubelet and their spec
 func (s *Server) handlePods(w http.ResponseWriter, req *http.Request) {
 	pods := s.host.GetPods()
@@ -391,6 +427,67 @@ func (s *Server) handleExec(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	stdinStream, stdoutStream, stderrStream, errorStream, conn, tty, ok := s.createStreams(w, req)
+	if conn != nil {
+		defer conn.Close()
+	}
+	if errorStream != nil {
+		defer errorStream.Reset()
+	}
+	if !ok {
+		return
+	}
+
+	err = s.host.ExecInContainer(kubecontainer.GetPodFullName(pod), uid, container, u.Query()[api.ExecCommandParamm], stdinStream, stdoutStream, stderrStream, tty)
+	if err != nil {
+		msg := fmt.Sprintf("Error executing command in container: %v", err)
+		glog.Error(msg)
+		errorStream.Write([]byte(msg))
+	}
+}
+
+// handleAttach handles requests to attach to the main process of a container.
+func (s *Server) handleAttach(w http.ResponseWriter, req *http.Request) {
+	u, err := url.ParseRequestURI(req.RequestURI)
+	if err != nil {
+		s.error(w, err)
+		return
+	}
+	podNamespace, podID, uid, container, err := parseContainerCoordinates(u.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	pod, ok := s.host.GetPodByName(podNamespace, podID)
+	if !ok {
+		http.Error(w, "Pod does not exist", http.StatusNotFound)
+		return
+	}
+
+	stdinStream, stdoutStream, stderrStream, errorStream, conn, tty, ok := s.createStreams(w, req)
+	if conn != nil {
+		defer conn.Close()
+	}
+	if errorStream != nil {
+		defer errorStream.Reset()
+	}
+	if !ok {
+		return
+	}
+
+	err = s.host.AttachContainer(kubecontainer.GetPodFullName(pod), uid, container, stdinStream, stdoutStream, stderrStream, tty)
+	if err != nil {
+		msg := fmt.Sprintf("Error attaching to container: %v", err)
+		glog.Error(msg)
+		errorStream.Write([]byte(msg))
+	}
+}
+
+// createStreams upgrades the connection of an exec or attach request and waits
+// for the client to create the requested streams. If ok is false, the request
+// could not be served and the caller should return; the error has already been
+// reported to the client when possible.
+func (s *Server) createStreams(w http.ResponseWriter, req *http.Request) (stdinStream, stdoutStream, stderrStream, errorStream httpstream.Stream, conn httpstream.Connection, tty, ok bool) {
 	req.ParseForm()
 	// start at 1 for error stream
 	expectedStreams := 1
@@ -400,7 +497,7 @@ func (s *Server) handleExec(w http.ResponseWriter, req *http.Request) {
 	if req.FormValue(api.ExecStdoutParam) == "1" {
 		expectedStreams++
 	}
-	tty := req.FormValue(api.ExecTTYParam) == "1"
+	tty = req.FormValue(api.ExecTTYParam) == "1"
 	if !tty && req.FormValue(api.ExecStderrParam) == "1" {
 		expectedStreams++
 	}
@@ -413,7 +510,7 @@ func (s *Server) handleExec(w http.ResponseWriter, req *http.Request) {
 	streamCh := make(chan httpstream.Stream)
 
 	upgrader := spdy.NewResponseUpgrader()
-	conn := upgrader.UpgradeResponse(w, req, func(stream httpstream.Stream) error {
+	conn = upgrader.UpgradeResponse(w, req, func(stream httpstream.Stream) error {
 		streamCh <- stream
 		return nil
 	})
@@ -424,14 +521,12 @@ func (s *Server) handleExec(w http.ResponseWriter, req *http.Request) {
 		// if we weren't successful in upgrading.
 		return
 	}
-	defer conn.Close()
 
 	conn.SetIdleTimeout(s.host.StreamingConnectionIdleTimeout())
 
 	// TODO make it configurable?
 	expired := time.NewTimer(streamCreationTimeout)
 
-	var errorStream, stdinStream, stdoutStream, stderrStream httpstream.Stream
 	receivedStreams := 0
 WaitForStreams:
 	for {
@@ -441,7 +536,6 @@ WaitForStreams:
 			switch streamType {
 			case api.StreamTypeError:
 				errorStream = stream
-				defer errorStream.Reset()
 				receivedStreams++
 			case api.StreamTypeStdin:
 				stdinStream = stream
@@ -471,12 +565,8 @@ WaitForStreams:
 		stdinStream.Close()
 	}
 
-	err = s.host.ExecInContainer(kubecontainer.GetPodFullName(pod), uid, container, u.Query()[api.ExecCommandParamm], stdinStream, stdoutStream, stderrStream, tty)
-	if err != nil {
-		msg := fmt.Sprintf("Error executing command in container: %v", err)
-		glog.Error(msg)
-		errorStream.Write([]byte(msg))
-	}
+	ok = true
+	return
 }
 
 func parsePodCoordinates(path string) (namespace, pod string, uid types.UID, err error) {
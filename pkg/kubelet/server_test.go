@@ -51,8 +51,9 @@ type fakeKubelet struct {
 	runFunc                            func(podFullName string, uid types.UID, containerName string, cmd []string) ([]byte, error)
 	containerVersionFunc               func() (kubecontainer.Version, error)
 	execFunc                           func(pod string, uid types.UID, container string, cmd []string, in io.Reader, out, err io.WriteCloser, tty bool) error
+	attachFunc                         func(pod string, uid types.UID, container string, in io.Reader, out, err io.WriteCloser, tty bool) error
 	portForwardFunc                    func(name string, uid types.UID, port uint16, stream io.ReadWriteCloser) error
-	containerLogsFunc                  func(podFullName, containerName, tail string, follow, pervious bool, stdout, stderr io.Writer) error
+	containerLogsFunc                  func(podFullName, containerName, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error
 	streamingConnectionIdleTimeoutFunc func() time.Duration
 	hostnameFunc                       func() string
 	resyncInterval                     time.Duration
@@ -95,8 +96,8 @@ func (fk *fakeKubelet) ServeLogs(w http.ResponseWriter, req *http.Request) {
 	fk.logFunc(w, req)
 }
 
-func (fk *fakeKubelet) GetKubeletContainerLogs(podFullName, containerName, tail string, follow, previous bool, stdout, stderr io.Writer) error {
-	return fk.containerLogsFunc(podFullName, containerName, tail, follow, previous, stdout, stderr)
+func (fk *fakeKubelet) GetKubeletContainerLogs(podFullName, containerName, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error {
+	return fk.containerLogsFunc(podFullName, containerName, tail, logOptions, stdout, stderr)
 }
 
 func (fk *fakeKubelet) GetHostname() string {
@@ -111,6 +112,10 @@ func (fk *fakeKubelet) ExecInContainer(name string, uid types.UID, container str
 	return fk.execFunc(name, uid, container, cmd, in, out, err, tty)
 }
 
+func (fk *fakeKubelet) AttachContainer(name string, uid types.UID, container string, in io.Reader, out, err io.WriteCloser, tty bool) error {
+	return fk.attachFunc(name, uid, container, in, out, err, tty)
+}
+
 func (fk *fakeKubelet) PortForward(name string, uid types.UID, port uint16, stream io.ReadWriteCloser) error {
 	return fk.portForwardFunc(name, uid, port, stream)
 }
@@ -549,7 +554,7 @@ func setPodByNameFunc(fw *serverTestFramework, namespace, pod, container string)
 }
 
 func setGetContainerLogsFunc(fw *serverTestFramework, t *testing.T, expectedPodName, expectedContainerName, expectedTail string, expectedFollow, expectedPrevious bool, output string) {
-	fw.fakeKubelet.containerLogsFunc = func(podFullName, containerName, tail string, follow, previous bool, stdout, stderr io.Writer) error {
+	fw.fakeKubelet.containerLogsFunc = func(podFullName, containerName, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error {
 		if podFullName != expectedPodName {
 			t.Errorf("expected %s, got %s", expectedPodName, podFullName)
 		}
@@ -559,11 +564,11 @@ func setGetContainerLogsFunc(fw *serverTestFramework, t *testing.T, expectedPodN
 		if tail != expectedTail {
 			t.Errorf("expected %s, got %s", expectedTail, tail)
 		}
-		if follow != expectedFollow {
-			t.Errorf("expected %t, got %t", expectedFollow, follow)
+		if logOptions.Follow != expectedFollow {
+			t.Errorf("expected %t, got %t", expectedFollow, logOptions.Follow)
 		}
-		if previous != expectedPrevious {
-			t.Errorf("expected %t, got %t", expectedPrevious, previous)
+		if logOptions.Previous != expectedPrevious {
+			t.Errorf("expected %t, got %t", expectedPrevious, logOptions.Previous)
 		}
 
 		io.WriteString(stdout, output)
@@ -655,6 +660,70 @@ func TestContainerLogsWithFollow(t *testing.T) {
 	}
 }
 
+func TestContainerLogsWithSince(t *testing.T) {
+	fw := newServerTest()
+	output := "foo bar"
+	podNamespace := "other"
+	podName := "foo"
+	expectedContainerName := "baz"
+	setPodByNameFunc(fw, podNamespace, podName, expectedContainerName)
+	fw.fakeKubelet.containerLogsFunc = func(podFullName, containerName, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error {
+		if logOptions.SinceSeconds == nil || *logOptions.SinceSeconds != 600 {
+			t.Errorf("expected sinceSeconds 600, got %v", logOptions.SinceSeconds)
+		}
+		if logOptions.SinceTime != nil {
+			t.Errorf("expected no sinceTime, got %v", logOptions.SinceTime)
+		}
+		if !logOptions.Timestamps {
+			t.Errorf("expected timestamps to be requested")
+		}
+		io.WriteString(stdout, output)
+		return nil
+	}
+	resp, err := http.Get(fw.testHTTPServer.URL + "/containerLogs/" + podNamespace + "/" + podName + "/" + expectedContainerName + "?sinceSeconds=600&timestamps=true")
+	if err != nil {
+		t.Errorf("Got error GETing: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Errorf("Error reading container logs: %v", err)
+	}
+	result := string(body)
+	if result != output {
+		t.Errorf("Expected: '%v', got: '%v'", output, result)
+	}
+}
+
+func TestContainerLogsWithInvalidSince(t *testing.T) {
+	fw := newServerTest()
+	podNamespace := "other"
+	podName := "foo"
+	expectedContainerName := "baz"
+	setPodByNameFunc(fw, podNamespace, podName, expectedContainerName)
+	fw.fakeKubelet.containerLogsFunc = func(podFullName, containerName, tail string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error {
+		t.Errorf("unexpected call for logs with invalid options")
+		return nil
+	}
+	for _, query := range []string{
+		"sinceSeconds=abc",
+		"sinceSeconds=0",
+		"sinceTime=yesterday",
+		"sinceSeconds=10&sinceTime=2015-06-01T12:00:00Z",
+	} {
+		resp, err := http.Get(fw.testHTTPServer.URL + "/containerLogs/" + podNamespace + "/" + podName + "/" + expectedContainerName + "?" + query)
+		if err != nil {
+			t.Errorf("Got error GETing: %v", err)
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d, got %d", query, http.StatusBadRequest, resp.StatusCode)
+		}
+	}
+}
+
 func TestServeExecInContainerIdleTimeout(t *testing.T) {
 	fw := newServerTest()
 
@@ -693,6 +762,14 @@ func TestServeExecInContainerIdleTimeout(t *testing.T) {
 }
 
 func TestServeExecInContainer(t *testing.T) {
+	testExecAttach(t, "exec")
+}
+
+func TestServeAttachContainer(t *testing.T) {
+	testExecAttach(t, "attach")
+}
+
+func testExecAttach(t *testing.T, verb string) {
 	tests := []struct {
 		stdin              bool
 		stdout             bool
@@ -731,6 +808,16 @@ func TestServeExecInContainer(t *testing.T) {
 		clientStderrReadDone := make(chan struct{})
 
 		fw.fakeKubelet.execFunc = func(podFullName string, uid types.UID, containerName string, cmd []string, in io.Reader, out, stderr io.WriteCloser, tty bool) error {
+			if verb != "exec" {
+				t.Fatalf("%d: unexpected exec for %s", i, verb)
+			}
+			if strings.Join(cmd, " ") != expectedCommand {
+				t.Fatalf("%d: cmd: expected: %s, got %v", i, expectedCommand, cmd)
+			}
+			return fw.fakeKubelet.attachFunc(podFullName, uid, containerName, in, out, stderr, tty)
+		}
+
+		fw.fakeKubelet.attachFunc = func(podFullName string, uid types.UID, containerName string, in io.Reader, out, stderr io.WriteCloser, tty bool) error {
 			defer close(execFuncDone)
 			if podFullName != expectedPodName {
 				t.Fatalf("%d: podFullName: expected %s, got %s", i, expectedPodName, podFullName)
@@ -741,9 +828,6 @@ func TestServeExecInContainer(t *testing.T) {
 			if containerName != expectedContainerName {
 				t.Fatalf("%d: containerName: expected %s, got %s", i, expectedContainerName, containerName)
 			}
-			if strings.Join(cmd, " ") != expectedCommand {
-				t.Fatalf("%d: cmd: expected: %s, got %v", i, expectedCommand, cmd)
-			}
 
 			if test.stdin {
 				if in == nil {
@@ -798,9 +882,12 @@ func TestServeExecInContainer(t *testing.T) {
 
 		var url string
 		if test.uid {
-			url = fw.testHTTPServer.URL + "/exec/" + podNamespace + "/" + podName + "/" + expectedUid + "/" + expectedContainerName + "?command=ls&command=-a"
+			url = fw.testHTTPServer.URL + "/" + verb + "/" + podNamespace + "/" + podName + "/" + expectedUid + "/" + expectedContainerName + "?ignore=1"
 		} else {
-			url = fw.testHTTPServer.URL + "/exec/" + podNamespace + "/" + podName + "/" + expectedContainerName + "?command=ls&command=-a"
+			url = fw.testHTTPServer.URL + "/" + verb + "/" + podNamespace + "/" + podName + "/" + expectedContainerName + "?ignore=1"
+		}
+		if verb == "exec" {
+			url += "&command=ls&command=-a"
 		}
 		if test.stdin {
 			url += "&" + api.ExecStdinParam + "=1"
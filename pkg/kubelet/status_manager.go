@@ -87,6 +87,12 @@ func (s *statusManager) SetPodStatus(pod *api.Pod, status api.PodStatus) {
 	defer s.podStatusesLock.Unlock()
 	oldStatus, found := s.podStatuses[podFullName]
 
+	// a pod never leaves a terminal phase, e.g. after being evicted.
+	if found && podIsTerminated(&oldStatus) && !podIsTerminated(&status) {
+		glog.V(3).Infof("Ignoring status for terminated pod %q - old: %+v new: %+v", podFullName, oldStatus, status)
+		return
+	}
+
 	// ensure that the start time does not change across updates.
 	if found && oldStatus.StartTime != nil {
 		status.StartTime = oldStatus.StartTime
@@ -126,6 +126,18 @@ func TestChangedStatus(t *testing.T) {
 	verifyUpdates(t, syncer, 2)
 }
 
+func TestTerminatedStatusIsKept(t *testing.T) {
+	syncer := newTestStatusManager()
+	syncer.SetPodStatus(testPod, api.PodStatus{Phase: api.PodFailed, Reason: "Evicted"})
+	syncer.SetPodStatus(testPod, api.PodStatus{Phase: api.PodRunning})
+	verifyUpdates(t, syncer, 1)
+
+	status, _ := syncer.GetPodStatus(kubecontainer.GetPodFullName(testPod))
+	if status.Phase != api.PodFailed || status.Reason != "Evicted" {
+		t.Errorf("unexpected status %+v, expected the terminated status to be kept", status)
+	}
+}
+
 func TestChangedStatusKeepsStartTime(t *testing.T) {
 	syncer := newTestStatusManager()
 	now := util.Now()
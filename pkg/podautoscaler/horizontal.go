@@ -0,0 +1,198 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podautoscaler
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/podautoscaler/metrics"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// Usage should differ from the target by more than this fraction before the
+	// autoscaler acts, so that it does not flap around the target.
+	tolerance = 0.1
+
+	// defaultTargetCPUUtilization is used when an autoscaler does not
+	// specify a CPU utilization target.
+	defaultTargetCPUUtilization = 80
+)
+
+// HorizontalController periodically adjusts the number of replicas of the
+// objects referenced by horizontal pod autoscalers to the CPU usage of their
+// pods.
+type HorizontalController struct {
+	client        client.Interface
+	metricsClient metrics.MetricsClient
+	recorder      record.EventRecorder
+
+	// upscaleDelay and downscaleDelay are the stabilization windows: after a
+	// rescale, the autoscaler does not scale up (down) again before this
+	// much time has passed.
+	upscaleDelay   time.Duration
+	downscaleDelay time.Duration
+
+	// now returns the current time; it is replaced in tests.
+	now func() time.Time
+}
+
+// NewHorizontalController creates a new HorizontalController.
+func NewHorizontalController(kubeClient client.Interface, metricsClient metrics.MetricsClient, upscaleDelay, downscaleDelay time.Duration) *HorizontalController {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(kubeClient.Events(""))
+
+	return &HorizontalController{
+		client:         kubeClient,
+		metricsClient:  metricsClient,
+		recorder:       eventBroadcaster.NewRecorder(api.EventSource{Component: "horizontal-pod-autoscaler"}),
+		upscaleDelay:   upscaleDelay,
+		downscaleDelay: downscaleDelay,
+		now:            time.Now,
+	}
+}
+
+// Run begins reconciling the autoscalers every syncPeriod.
+func (a *HorizontalController) Run(syncPeriod time.Duration) {
+	go util.Forever(func() {
+		if err := a.reconcileAutoscalers(); err != nil {
+			glog.Errorf("Couldn't reconcile horizontal pod autoscalers: %v", err)
+		}
+	}, syncPeriod)
+}
+
+func (a *HorizontalController) reconcileAutoscalers() error {
+	list, err := a.client.HorizontalPodAutoscalers(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return fmt.Errorf("error listing autoscalers: %v", err)
+	}
+	for i := range list.Items {
+		hpa := &list.Items[i]
+		if err := a.reconcileAutoscaler(hpa); err != nil {
+			glog.Warningf("Failed to reconcile autoscaler %s/%s: %v", hpa.Namespace, hpa.Name, err)
+		}
+	}
+	return nil
+}
+
+func (a *HorizontalController) reconcileAutoscaler(hpa *api.HorizontalPodAutoscaler) error {
+	ref := hpa.Spec.ScaleRef
+	if ref.Kind != "ReplicationController" {
+		a.recorder.Eventf(hpa, "FailedGetScale", "Unsupported scale target kind %q", ref.Kind)
+		return fmt.Errorf("unsupported scale target kind %q", ref.Kind)
+	}
+	rc, err := a.client.ReplicationControllers(hpa.Namespace).Get(ref.Name)
+	if err != nil {
+		a.recorder.Eventf(hpa, "FailedGetScale", "Failed to get replication controller %s: %v", ref.Name, err)
+		return fmt.Errorf("failed to get replication controller %s: %v", ref.Name, err)
+	}
+	currentReplicas := rc.Spec.Replicas
+
+	targetUtilization := defaultTargetCPUUtilization
+	if hpa.Spec.CPUUtilization != nil {
+		targetUtilization = hpa.Spec.CPUUtilization.TargetPercentage
+	}
+	utilization, err := a.metricsClient.GetCPUUtilization(hpa.Namespace, rc.Spec.Selector)
+	if err != nil {
+		a.recorder.Eventf(hpa, "FailedGetMetrics", "Failed to get CPU utilization: %v", err)
+		return fmt.Errorf("failed to get CPU utilization: %v", err)
+	}
+
+	desiredReplicas := currentReplicas
+	usageRatio := float64(utilization) / float64(targetUtilization)
+	if math.Abs(1.0-usageRatio) > tolerance {
+		desiredReplicas = int(math.Ceil(usageRatio * float64(currentReplicas)))
+	}
+	minReplicas := 1
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	if desiredReplicas < minReplicas {
+		desiredReplicas = minReplicas
+	}
+	if desiredReplicas > hpa.Spec.MaxReplicas {
+		desiredReplicas = hpa.Spec.MaxReplicas
+	}
+
+	now := a.now()
+	if a.shouldScale(hpa, currentReplicas, desiredReplicas, minReplicas, now) {
+		scaler, err := kubectl.ScalerFor(ref.Kind, kubectl.NewScalerClient(a.client))
+		if err != nil {
+			return err
+		}
+		// Fail instead of overwriting the replica count if the controller
+		// was changed since it was read.
+		precondition := &kubectl.ScalePrecondition{Size: currentReplicas, ResourceVersion: rc.ResourceVersion}
+		if err := scaler.Scale(hpa.Namespace, ref.Name, uint(desiredReplicas), precondition, nil, nil); err != nil {
+			a.recorder.Eventf(hpa, "FailedRescale", "New size: %d; error: %v", desiredReplicas, err)
+			return fmt.Errorf("failed to rescale %s: %v", ref.Name, err)
+		}
+		a.recorder.Eventf(hpa, "SuccessfulRescale", "New size: %d; CPU utilization: %d%% of request (target %d%%)", desiredReplicas, utilization, targetUtilization)
+		glog.Infof("Successful rescale of %s/%s, old size: %d, new size: %d, CPU utilization: %d%%",
+			hpa.Namespace, hpa.Name, currentReplicas, desiredReplicas, utilization)
+	} else {
+		desiredReplicas = currentReplicas
+	}
+
+	hpa.Status = api.HorizontalPodAutoscalerStatus{
+		CurrentReplicas:                 currentReplicas,
+		DesiredReplicas:                 desiredReplicas,
+		CurrentCPUUtilizationPercentage: &utilization,
+		LastScaleTime:                   hpa.Status.LastScaleTime,
+	}
+	if desiredReplicas != currentReplicas {
+		lastScaleTime := util.NewTime(now)
+		hpa.Status.LastScaleTime = &lastScaleTime
+	}
+	if _, err := a.client.HorizontalPodAutoscalers(hpa.Namespace).UpdateStatus(hpa); err != nil {
+		a.recorder.Eventf(hpa, "FailedUpdateStatus", "Failed to update status: %v", err)
+		return fmt.Errorf("failed to update status of %s: %v", hpa.Name, err)
+	}
+	return nil
+}
+
+// shouldScale returns true if the replica count should be changed from
+// current to desired now. Counts outside of the autoscaler's bounds are
+// always corrected; otherwise a rescale must wait for the stabilization
+// window in its direction to pass since the last rescale.
+func (a *HorizontalController) shouldScale(hpa *api.HorizontalPodAutoscaler, current, desired, minReplicas int, now time.Time) bool {
+	if desired == current {
+		return false
+	}
+	if current < minReplicas || current > hpa.Spec.MaxReplicas {
+		return true
+	}
+	if hpa.Status.LastScaleTime == nil {
+		return true
+	}
+	delay := a.downscaleDelay
+	if desired > current {
+		delay = a.upscaleDelay
+	}
+	return !hpa.Status.LastScaleTime.Add(delay).After(now)
+}
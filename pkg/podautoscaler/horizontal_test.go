@@ -0,0 +1,229 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podautoscaler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/podautoscaler/metrics"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// fakeSource reports the same CPU usage for every pod.
+type fakeSource struct {
+	milliCPU int64
+	err      error
+}
+
+func (f *fakeSource) GetPodCPUUsage(pod *api.Pod) (map[string]int64, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return map[string]int64{"php": f.milliCPU}, nil
+}
+
+var testSelector = map[string]string{"name": "frontend"}
+
+func newRC(replicas int) *api.ReplicationController {
+	return &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: api.NamespaceDefault, ResourceVersion: "42"},
+		Spec: api.ReplicationControllerSpec{
+			Replicas: replicas,
+			Selector: testSelector,
+		},
+	}
+}
+
+// newPods returns count running pods that each request one CPU.
+func newPods(count int) []api.Pod {
+	pods := []api.Pod{}
+	for i := 0; i < count; i++ {
+		pods = append(pods, api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: fmt.Sprintf("frontend-%d", i), Namespace: api.NamespaceDefault, Labels: testSelector},
+			Spec: api.PodSpec{
+				Containers: []api.Container{{
+					Name: "php",
+					Resources: api.ResourceRequirements{
+						Requests: api.ResourceList{api.ResourceCPU: resource.MustParse("1")},
+					},
+				}},
+			},
+			Status: api.PodStatus{Phase: api.PodRunning},
+		})
+	}
+	return pods
+}
+
+func newAutoscaler(minReplicas, maxReplicas, target int, lastScale *time.Time) *api.HorizontalPodAutoscaler {
+	hpa := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "frontend-scaler", Namespace: api.NamespaceDefault},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:       api.ScaleReference{Kind: "ReplicationController", Name: "frontend"},
+			MinReplicas:    &minReplicas,
+			MaxReplicas:    maxReplicas,
+			CPUUtilization: &api.CPUTargetUtilization{TargetPercentage: target},
+		},
+	}
+	if lastScale != nil {
+		t := util.NewTime(*lastScale)
+		hpa.Status.LastScaleTime = &t
+	}
+	return hpa
+}
+
+// newTestController returns a controller whose fake client serves rc and
+// its pods and records replication controller and status writes.
+func newTestController(rc *api.ReplicationController, source *fakeSource, now time.Time) (*HorizontalController, *testclient.Fake) {
+	fake := &testclient.Fake{}
+	fake.ReactFn = func(action testclient.FakeAction) (runtime.Object, error) {
+		switch action.Action {
+		case testclient.GetControllerAction:
+			copied := *rc
+			return &copied, nil
+		case "list-pods":
+			return &api.PodList{Items: newPods(rc.Spec.Replicas)}, nil
+		}
+		// Echo back what was written, as the apiserver would.
+		if obj, ok := action.Value.(runtime.Object); ok {
+			return obj, nil
+		}
+		return nil, nil
+	}
+	controller := NewHorizontalController(fake, metrics.NewMetricsClient(fake, source), 3*time.Minute, 5*time.Minute)
+	controller.recorder = &record.FakeRecorder{}
+	controller.now = func() time.Time { return now }
+	return controller, fake
+}
+
+// writes returns the replica count written to the replication controller,
+// or -1 if it was not written, and the status written to the autoscaler.
+func writes(fake *testclient.Fake) (int, *api.HorizontalPodAutoscalerStatus) {
+	replicas := -1
+	var status *api.HorizontalPodAutoscalerStatus
+	for _, action := range fake.Actions {
+		switch action.Action {
+		case testclient.UpdateControllerAction:
+			replicas = action.Value.(*api.ReplicationController).Spec.Replicas
+		case "update-status-horizontalpodautoscaler":
+			status = &action.Value.(*api.HorizontalPodAutoscaler).Status
+		}
+	}
+	return replicas, status
+}
+
+func TestReconcileAutoscaler(t *testing.T) {
+	now := time.Date(2015, 7, 1, 12, 0, 0, 0, time.UTC)
+	oneMinuteAgo := now.Add(-time.Minute)
+	fourMinutesAgo := now.Add(-4 * time.Minute)
+
+	tests := []struct {
+		name        string
+		replicas    int
+		milliCPU    int64
+		hpa         *api.HorizontalPodAutoscaler
+		expected    int
+		utilization int
+	}{
+		{"scale up", 3, 1600, newAutoscaler(1, 10, 80, nil), 6, 160},
+		{"scale down", 4, 300, newAutoscaler(1, 10, 60, nil), 2, 30},
+		{"within tolerance", 4, 850, newAutoscaler(1, 10, 80, nil), -1, 85},
+		{"capped at max", 3, 5000, newAutoscaler(1, 5, 50, nil), 5, 500},
+		{"capped at min", 4, 100, newAutoscaler(2, 10, 80, nil), 2, 10},
+		{"upscale stabilization", 3, 1600, newAutoscaler(1, 10, 80, &oneMinuteAgo), -1, 160},
+		{"upscale after window", 3, 1600, newAutoscaler(1, 10, 80, &fourMinutesAgo), 6, 160},
+		{"downscale stabilization", 4, 300, newAutoscaler(1, 10, 60, &fourMinutesAgo), -1, 30},
+		{"below min ignores window", 1, 500, newAutoscaler(2, 10, 80, &oneMinuteAgo), 2, 50},
+		{"above max ignores window", 8, 800, newAutoscaler(1, 5, 80, &oneMinuteAgo), 5, 80},
+	}
+	for _, test := range tests {
+		controller, fake := newTestController(newRC(test.replicas), &fakeSource{milliCPU: test.milliCPU}, now)
+		lastScaleTime := test.hpa.Status.LastScaleTime
+		if err := controller.reconcileAutoscaler(test.hpa); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		replicas, status := writes(fake)
+		if replicas != test.expected {
+			t.Errorf("%s: expected replicas %d to be written, got %d", test.name, test.expected, replicas)
+		}
+		if status == nil {
+			t.Errorf("%s: expected status update", test.name)
+			continue
+		}
+		desired := test.expected
+		if desired == -1 {
+			desired = test.replicas
+		}
+		if status.CurrentReplicas != test.replicas || status.DesiredReplicas != desired {
+			t.Errorf("%s: expected current %d and desired %d replicas, got %#v", test.name, test.replicas, desired, status)
+		}
+		if status.CurrentCPUUtilizationPercentage == nil || *status.CurrentCPUUtilizationPercentage != test.utilization {
+			t.Errorf("%s: expected utilization %d, got %v", test.name, test.utilization, status.CurrentCPUUtilizationPercentage)
+		}
+		expectedScaleTime := lastScaleTime
+		if test.expected != -1 {
+			scaled := util.NewTime(now)
+			expectedScaleTime = &scaled
+		}
+		if !api.Semantic.DeepEqual(status.LastScaleTime, expectedScaleTime) {
+			t.Errorf("%s: expected last scale time %v, got %v", test.name, expectedScaleTime, status.LastScaleTime)
+		}
+	}
+}
+
+func TestReconcileAutoscalerDefaultTarget(t *testing.T) {
+	now := time.Now()
+	controller, fake := newTestController(newRC(2), &fakeSource{milliCPU: 1200}, now)
+	hpa := newAutoscaler(1, 10, 0, nil)
+	hpa.Spec.CPUUtilization = nil
+	if err := controller.reconcileAutoscaler(hpa); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 120% usage against the default 80% target.
+	if replicas, _ := writes(fake); replicas != 3 {
+		t.Errorf("expected replicas 3 to be written, got %d", replicas)
+	}
+}
+
+func TestReconcileAutoscalerMetricsError(t *testing.T) {
+	controller, fake := newTestController(newRC(2), &fakeSource{err: fmt.Errorf("kubelet unreachable")}, time.Now())
+	if err := controller.reconcileAutoscaler(newAutoscaler(1, 10, 80, nil)); err == nil {
+		t.Errorf("expected error")
+	}
+	if replicas, status := writes(fake); replicas != -1 || status != nil {
+		t.Errorf("expected no writes, got replicas %d and status %v", replicas, status)
+	}
+}
+
+func TestReconcileAutoscalerUnsupportedKind(t *testing.T) {
+	controller, fake := newTestController(newRC(2), &fakeSource{milliCPU: 1000}, time.Now())
+	hpa := newAutoscaler(1, 10, 80, nil)
+	hpa.Spec.ScaleRef.Kind = "Pod"
+	if err := controller.reconcileAutoscaler(hpa); err == nil {
+		t.Errorf("expected error")
+	}
+	if len(fake.Actions) != 0 {
+		t.Errorf("expected no client calls, got %v", fake.Actions)
+	}
+}
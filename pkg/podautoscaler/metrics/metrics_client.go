@@ -0,0 +1,148 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+// cpuSamples is the number of stats samples requested from the kubelet for
+// each container. CPU usage is averaged over the window they span.
+const cpuSamples = 60
+
+// MetricsClient is an interface for getting metrics for pods.
+type MetricsClient interface {
+	// GetCPUUtilization returns the average CPU utilization over all running
+	// pods in the namespace matching the selector, represented as a percent of
+	// requested CPU, e.g. 70 means that an average pod uses 70% of the CPU it
+	// requested.
+	GetCPUUtilization(namespace string, selector map[string]string) (int, error)
+}
+
+// PodMetricsSource is a pluggable source of resource usage of single pods.
+type PodMetricsSource interface {
+	// GetPodCPUUsage returns the recent CPU usage of every container of the
+	// pod in millicores, keyed by container name.
+	GetPodCPUUsage(pod *api.Pod) (map[string]int64, error)
+}
+
+// podMetricsClient computes utilization by comparing the usage reported by a
+// PodMetricsSource with the resource requests of the pods.
+type podMetricsClient struct {
+	client client.Interface
+	source PodMetricsSource
+}
+
+// NewMetricsClient returns a MetricsClient that reads pod usage from source.
+func NewMetricsClient(kubeClient client.Interface, source PodMetricsSource) MetricsClient {
+	return &podMetricsClient{
+		client: kubeClient,
+		source: source,
+	}
+}
+
+func (c *podMetricsClient) GetCPUUtilization(namespace string, selector map[string]string) (int, error) {
+	podList, err := c.client.Pods(namespace).List(labels.SelectorFromSet(selector), fields.Everything())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pod list: %v", err)
+	}
+	var requestSum, usageSum int64
+	running := 0
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != api.PodRunning {
+			continue
+		}
+		usage, err := c.source.GetPodCPUUsage(pod)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get CPU usage of pod %s: %v", pod.Name, err)
+		}
+		for _, container := range pod.Spec.Containers {
+			request, found := container.Resources.Requests[api.ResourceCPU]
+			if !found {
+				return 0, fmt.Errorf("missing CPU request for container %s of pod %s", container.Name, pod.Name)
+			}
+			requestSum += request.MilliValue()
+			usageSum += usage[container.Name]
+		}
+		running++
+	}
+	if running == 0 {
+		return 0, fmt.Errorf("no running pods match selector %v", selector)
+	}
+	if requestSum == 0 {
+		return 0, fmt.Errorf("pods matching selector %v request no CPU", selector)
+	}
+	return int(usageSum * 100 / requestSum), nil
+}
+
+// kubeletSource reads pod usage from the /stats endpoint of the kubelet
+// running each pod.
+type kubeletSource struct {
+	getter client.ContainerInfoGetter
+}
+
+// NewKubeletSource returns a PodMetricsSource that queries the kubelets
+// through getter.
+func NewKubeletSource(getter client.ContainerInfoGetter) PodMetricsSource {
+	return &kubeletSource{getter}
+}
+
+func (s *kubeletSource) GetPodCPUUsage(pod *api.Pod) (map[string]int64, error) {
+	if pod.Spec.NodeName == "" {
+		return nil, fmt.Errorf("pod %s is not scheduled", pod.Name)
+	}
+	podID := fmt.Sprintf("%s/%s/%s", pod.Namespace, pod.Name, pod.UID)
+	req := &cadvisorApi.ContainerInfoRequest{NumStats: cpuSamples}
+	result := map[string]int64{}
+	for _, container := range pod.Spec.Containers {
+		info, err := s.getter.GetContainerInfo(pod.Spec.NodeName, podID, container.Name, req)
+		if err != nil {
+			return nil, err
+		}
+		usage, err := cpuUsage(info.Stats)
+		if err != nil {
+			return nil, fmt.Errorf("container %s: %v", container.Name, err)
+		}
+		result[container.Name] = usage
+	}
+	return result, nil
+}
+
+// cpuUsage returns the average CPU usage in millicores over the window
+// spanned by stats. The CPU counters reported by cAdvisor are cumulative, so
+// only the oldest and the newest sample are needed.
+func cpuUsage(stats []*cadvisorApi.ContainerStats) (int64, error) {
+	if len(stats) < 2 {
+		return 0, fmt.Errorf("not enough samples to compute CPU usage")
+	}
+	first, last := stats[0], stats[len(stats)-1]
+	elapsed := last.Timestamp.Sub(first.Timestamp)
+	if elapsed <= 0 || last.Cpu.Usage.Total < first.Cpu.Usage.Total {
+		return 0, fmt.Errorf("CPU samples are not ordered by time")
+	}
+	// Usage is reported in nanoseconds of CPU time.
+	used := int64(last.Cpu.Usage.Total - first.Cpu.Usage.Total)
+	return used * 1000 / elapsed.Nanoseconds(), nil
+}
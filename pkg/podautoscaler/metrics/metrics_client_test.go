@@ -0,0 +1,196 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+// fakeSource reports a fixed CPU usage per pod and container.
+type fakeSource struct {
+	usage map[string]map[string]int64
+}
+
+func (f *fakeSource) GetPodCPUUsage(pod *api.Pod) (map[string]int64, error) {
+	usage, found := f.usage[pod.Name]
+	if !found {
+		return nil, fmt.Errorf("no usage for pod %s", pod.Name)
+	}
+	return usage, nil
+}
+
+// fakeContainerInfoGetter returns canned container info keyed by
+// host/podID/container.
+type fakeContainerInfoGetter struct {
+	infos map[string]*cadvisorApi.ContainerInfo
+}
+
+func (f *fakeContainerInfoGetter) GetContainerInfo(host, podID, containerID string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	info, found := f.infos[host+"/"+podID+"/"+containerID]
+	if !found {
+		return nil, fmt.Errorf("no info for %s/%s on %s", podID, containerID, host)
+	}
+	return info, nil
+}
+
+func (f *fakeContainerInfoGetter) GetRootInfo(host string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeContainerInfoGetter) GetMachineInfo(host string) (*cadvisorApi.MachineInfo, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func newPod(name string, phase api.PodPhase, cpuRequests ...string) api.Pod {
+	pod := api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: api.NamespaceDefault,
+			UID:       types.UID("uid-" + name),
+			Labels:    map[string]string{"name": "frontend"},
+		},
+		Spec:   api.PodSpec{NodeName: "node-1"},
+		Status: api.PodStatus{Phase: phase},
+	}
+	for i, request := range cpuRequests {
+		container := api.Container{Name: fmt.Sprintf("c%d", i)}
+		if request != "" {
+			container.Resources.Requests = api.ResourceList{api.ResourceCPU: resource.MustParse(request)}
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, container)
+	}
+	return pod
+}
+
+func newClient(pods ...api.Pod) *testclient.Fake {
+	return &testclient.Fake{
+		ReactFn: func(action testclient.FakeAction) (runtime.Object, error) {
+			return &api.PodList{Items: pods}, nil
+		},
+	}
+}
+
+func TestGetCPUUtilization(t *testing.T) {
+	source := &fakeSource{usage: map[string]map[string]int64{
+		"a": {"c0": 300, "c1": 100},
+		"b": {"c0": 500},
+	}}
+	client := newClient(
+		newPod("a", api.PodRunning, "500m", "500m"),
+		newPod("b", api.PodRunning, "1"),
+		newPod("pending", api.PodPending, "1"),
+	)
+	utilization, err := NewMetricsClient(client, source).GetCPUUtilization(api.NamespaceDefault, map[string]string{"name": "frontend"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// (300 + 100 + 500) / (500 + 500 + 1000)
+	if utilization != 45 {
+		t.Errorf("expected utilization 45, got %d", utilization)
+	}
+}
+
+func TestGetCPUUtilizationErrors(t *testing.T) {
+	source := &fakeSource{usage: map[string]map[string]int64{"a": {"c0": 100}}}
+	tests := map[string]*testclient.Fake{
+		"no running pods":     newClient(newPod("a", api.PodPending, "1")),
+		"missing request":     newClient(newPod("a", api.PodRunning, "")),
+		"zero request":        newClient(newPod("a", api.PodRunning, "0")),
+		"missing pod metrics": newClient(newPod("b", api.PodRunning, "1")),
+	}
+	for name, client := range tests {
+		if _, err := NewMetricsClient(client, source).GetCPUUtilization(api.NamespaceDefault, nil); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func newStats(start time.Time, cpu ...uint64) []*cadvisorApi.ContainerStats {
+	stats := []*cadvisorApi.ContainerStats{}
+	for i, total := range cpu {
+		stat := &cadvisorApi.ContainerStats{Timestamp: start.Add(time.Duration(i) * time.Second)}
+		stat.Cpu.Usage.Total = total
+		stats = append(stats, stat)
+	}
+	return stats
+}
+
+func TestCPUUsage(t *testing.T) {
+	start := time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		stats    []*cadvisorApi.ContainerStats
+		expected int64
+		err      bool
+	}{
+		{stats: nil, err: true},
+		{stats: newStats(start, 0), err: true},
+		{stats: newStats(start, 2e9, 1e9), err: true},
+		// Half a core over one second.
+		{stats: newStats(start, 0, 5e8), expected: 500},
+		// Two cores over two seconds, intermediate samples are ignored.
+		{stats: newStats(start, 1e9, 1e9, 5e9), expected: 2000},
+	}
+	for i, test := range tests {
+		usage, err := cpuUsage(test.stats)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if usage != test.expected {
+			t.Errorf("%d: expected usage %d, got %d", i, test.expected, usage)
+		}
+	}
+}
+
+func TestKubeletSource(t *testing.T) {
+	start := time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC)
+	getter := &fakeContainerInfoGetter{infos: map[string]*cadvisorApi.ContainerInfo{
+		"node-1/default/a/uid-a/c0": {Stats: newStats(start, 0, 25e7)},
+		"node-1/default/a/uid-a/c1": {Stats: newStats(start, 0, 1e9)},
+	}}
+	source := NewKubeletSource(getter)
+
+	pod := newPod("a", api.PodRunning, "1", "1")
+	usage, err := source.GetPodCPUUsage(&pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usage["c0"] != 250 || usage["c1"] != 1000 {
+		t.Errorf("unexpected usage: %v", usage)
+	}
+
+	pod.Spec.NodeName = ""
+	if _, err := source.GetPodCPUUsage(&pod); err == nil {
+		t.Errorf("expected error for unscheduled pod")
+	}
+}
@@ -18,6 +18,7 @@ package exec
 
 import (
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/probe"
@@ -34,6 +35,10 @@ func (f *FakeCmd) CombinedOutput() ([]byte, error) {
 
 func (f *FakeCmd) SetDir(dir string) {}
 
+func (f *FakeCmd) SetStdin(in io.Reader) {}
+
+func (f *FakeCmd) SetEnv(env []string) {}
+
 type fakeExitError struct {
 	exited     bool
 	statusCode int
@@ -57,17 +57,17 @@ type EndpointsUpdate struct {
 
 // ServiceConfigHandler is an abstract interface of objects which receive update notifications for the set of services.
 type ServiceConfigHandler interface {
-	// OnUpdate gets called when a configuration has been changed by one of the sources.
+	// OnServiceUpdate gets called when a configuration has been changed by one of the sources.
 	// This is the union of all the configuration sources.
-	OnUpdate(services []api.Service)
+	OnServiceUpdate(services []api.Service)
 }
 
 // EndpointsConfigHandler is an abstract interface of objects which receive update notifications for the set of endpoints.
 type EndpointsConfigHandler interface {
-	// OnUpdate gets called when endpoints configuration is changed for a given
+	// OnEndpointsUpdate gets called when endpoints configuration is changed for a given
 	// service on any of the configuration sources. An example is when a new
 	// service comes up, or when containers come up or down for an existing service.
-	OnUpdate(endpoints []api.Endpoints)
+	OnEndpointsUpdate(endpoints []api.Endpoints)
 }
 
 // EndpointsConfig tracks a set of endpoints configurations.
@@ -91,7 +91,7 @@ func NewEndpointsConfig() *EndpointsConfig {
 
 func (c *EndpointsConfig) RegisterHandler(handler EndpointsConfigHandler) {
 	c.bcaster.Add(config.ListenerFunc(func(instance interface{}) {
-		handler.OnUpdate(instance.([]api.Endpoints))
+		handler.OnEndpointsUpdate(instance.([]api.Endpoints))
 	}))
 }
 
@@ -189,7 +189,7 @@ func NewServiceConfig() *ServiceConfig {
 
 func (c *ServiceConfig) RegisterHandler(handler ServiceConfigHandler) {
 	c.bcaster.Add(config.ListenerFunc(func(instance interface{}) {
-		handler.OnUpdate(instance.([]api.Service))
+		handler.OnServiceUpdate(instance.([]api.Service))
 	}))
 }
 
@@ -0,0 +1,736 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iptables
+
+//
+// NOTE: this needs to be tested in e2e since it uses iptables for everything.
+//
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/proxy"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	utilexec "github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+	utiliptables "github.com/GoogleCloudPlatform/kubernetes/pkg/util/iptables"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/slice"
+	"github.com/golang/glog"
+)
+
+// iptablesMinVersion is the minimum version of iptables for which we will use the Proxier
+// from this package instead of the userspace Proxier.  While most of the
+// features we need were available earlier, the '-C' flag was added more
+// recently.  We use that indirectly in Ensure* functions, and if we don't
+// have it, we have to be extra careful about the exact argument strings.
+var iptablesMinVersion = [3]int{1, 4, 11}
+
+// the services chain
+const iptablesServicesChain utiliptables.Chain = "KUBE-SERVICES"
+
+// the nodeports chain
+const iptablesNodePortsChain utiliptables.Chain = "KUBE-NODEPORTS"
+
+// the mark we apply to traffic needing SNAT
+const iptablesMasqueradeMark = "0x4d415351"
+
+// the bridge-netfilter sysctl which makes bridged traffic traverse iptables
+const sysctlBridgeCallIptables = "net/bridge/bridge-nf-call-iptables"
+
+// CanUseIptablesProxier returns true if we should use the iptables Proxier
+// instead of the userspace Proxier.  This is determined by the version of
+// iptables installed on the host.
+func CanUseIptablesProxier(exec utilexec.Interface) (bool, error) {
+	v1, v2, v3, err := utiliptables.GetIptablesVersion(exec)
+	if err != nil {
+		return false, err
+	}
+	for i, v := range []int{v1, v2, v3} {
+		if v != iptablesMinVersion[i] {
+			return v > iptablesMinVersion[i], nil
+		}
+	}
+	return true, nil
+}
+
+// internal struct for storing service information
+type serviceInfo struct {
+	clusterIP           net.IP
+	port                int
+	protocol            api.Protocol
+	nodePort            int
+	loadBalancerStatus  api.LoadBalancerStatus
+	sessionAffinityType api.ServiceAffinity
+	stickyMaxAgeSeconds int
+	// Deprecated, but required for back-compat (including e2e)
+	deprecatedPublicIPs []string
+}
+
+// returns a new serviceInfo struct
+func newServiceInfo() *serviceInfo {
+	return &serviceInfo{
+		sessionAffinityType: api.ServiceAffinityNone, // default
+		stickyMaxAgeSeconds: 180 * 60,                // TODO: paramaterize this in the API.
+	}
+}
+
+// Proxier is an iptables based proxy for connections between a localhost:lport
+// and services that provide the actual backends.
+type Proxier struct {
+	mu                          sync.Mutex // protects serviceMap and endpointsMap
+	serviceMap                  map[proxy.ServicePortName]*serviceInfo
+	endpointsMap                map[proxy.ServicePortName][]string
+	haveReceivedServiceUpdate   bool // true once we've seen an OnServiceUpdate event
+	haveReceivedEndpointsUpdate bool // true once we've seen an OnEndpointsUpdate event
+
+	// These are effectively const and do not need the mutex to be held.
+	syncPeriod    time.Duration
+	iptables      utiliptables.Interface
+	masqueradeAll bool
+}
+
+// Proxier implements ProxyProvider
+var _ proxy.ProxyProvider = &Proxier{}
+
+// NewProxier returns a new Proxier given an iptables Interface instance.
+// Because of the iptables logic, it is assumed that there is only a single Proxier active on a machine.
+// An error will be returned if iptables fails to update or acquire the initial lock.
+// Once a proxier is created, it will keep iptables up to date in the background and
+// will not terminate if a particular iptables call fails.
+func NewProxier(ipt utiliptables.Interface, syncPeriod time.Duration, masqueradeAll bool) (*Proxier, error) {
+	// SyncLoop ticks every syncPeriod, and time.NewTicker panics on a
+	// non-positive duration.
+	if syncPeriod <= 0 {
+		return nil, fmt.Errorf("iptables sync period must be greater than 0, got %v", syncPeriod)
+	}
+
+	// Traffic between pods on the same bridge must pass through iptables,
+	// otherwise the DNAT rules never see it.
+	if err := setSysctl(sysctlBridgeCallIptables, 1); err != nil {
+		glog.Warningf("Can't set sysctl %s: %v", sysctlBridgeCallIptables, err)
+	}
+
+	return &Proxier{
+		serviceMap:    make(map[proxy.ServicePortName]*serviceInfo),
+		endpointsMap:  make(map[proxy.ServicePortName][]string),
+		syncPeriod:    syncPeriod,
+		iptables:      ipt,
+		masqueradeAll: masqueradeAll,
+	}, nil
+}
+
+// CleanupLeftovers removes all iptables rules and chains created by the Proxier.
+// It returns true if an error was encountered. Errors are logged.
+func CleanupLeftovers(ipt utiliptables.Interface) (encounteredError bool) {
+	// Unlink the services chain.
+	args := []string{
+		"-m", "comment", "--comment", "kubernetes service portals",
+		"-j", string(iptablesServicesChain),
+	}
+	tableChainsWithJumpServices := []utiliptables.Chain{utiliptables.ChainOutput, utiliptables.ChainPrerouting}
+	for _, chain := range tableChainsWithJumpServices {
+		if err := ipt.DeleteRule(utiliptables.TableNAT, chain, args...); err != nil {
+			if !utiliptables.IsNotFoundError(err) {
+				glog.Errorf("Error removing pure-iptables proxy rule: %v", err)
+				encounteredError = true
+			}
+		}
+	}
+
+	// Unlink the postrouting chain.
+	args = []string{
+		"-m", "comment", "--comment", "kubernetes service traffic requiring SNAT",
+		"-m", "mark", "--mark", iptablesMasqueradeMark, "-j", "MASQUERADE",
+	}
+	if err := ipt.DeleteRule(utiliptables.TableNAT, utiliptables.ChainPostrouting, args...); err != nil {
+		if !utiliptables.IsNotFoundError(err) {
+			glog.Errorf("Error removing pure-iptables proxy rule: %v", err)
+			encounteredError = true
+		}
+	}
+
+	// Flush and remove all of our chains.
+	if iptablesSaveRaw, err := ipt.Save(utiliptables.TableNAT); err != nil {
+		glog.Errorf("Failed to execute iptables-save for %s: %v", utiliptables.TableNAT, err)
+		encounteredError = true
+	} else {
+		existingNATChains := getChainLines(utiliptables.TableNAT, iptablesSaveRaw)
+		natChains := bytes.NewBuffer(nil)
+		natRules := bytes.NewBuffer(nil)
+		writeLine(natChains, "*nat")
+		// Start with chains we know we need to remove.
+		for _, chain := range []utiliptables.Chain{iptablesServicesChain, iptablesNodePortsChain} {
+			if _, found := existingNATChains[chain]; found {
+				chainString := string(chain)
+				writeLine(natChains, existingNATChains[chain]) // flush
+				writeLine(natRules, "-X", chainString)         // delete
+			}
+		}
+		// Hunt for service and endpoint chains.
+		for chain := range existingNATChains {
+			chainString := string(chain)
+			if strings.HasPrefix(chainString, "KUBE-SVC-") || strings.HasPrefix(chainString, "KUBE-SEP-") {
+				writeLine(natChains, existingNATChains[chain]) // flush
+				writeLine(natRules, "-X", chainString)         // delete
+			}
+		}
+		writeLine(natRules, "COMMIT")
+		natLines := append(natChains.Bytes(), natRules.Bytes()...)
+		// Write it.
+		err = ipt.Restore(utiliptables.TableNAT, natLines, utiliptables.NoFlushTables, utiliptables.RestoreCounters)
+		if err != nil {
+			glog.Errorf("Failed to execute iptables-restore for %s: %v", utiliptables.TableNAT, err)
+			encounteredError = true
+		}
+	}
+	return encounteredError
+}
+
+func (proxier *Proxier) sameConfig(info *serviceInfo, service *api.Service, port *api.ServicePort) bool {
+	if info.protocol != port.Protocol || info.port != port.Port || info.nodePort != port.NodePort {
+		return false
+	}
+	if !info.clusterIP.Equal(net.ParseIP(service.Spec.ClusterIP)) {
+		return false
+	}
+	if !ipsEqual(info.deprecatedPublicIPs, service.Spec.DeprecatedPublicIPs) {
+		return false
+	}
+	if !api.LoadBalancerStatusEqual(&info.loadBalancerStatus, &service.Status.LoadBalancer) {
+		return false
+	}
+	if info.sessionAffinityType != service.Spec.SessionAffinity {
+		return false
+	}
+	return true
+}
+
+func ipsEqual(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i] != rhs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Sync is called to immediately synchronize the proxier state to iptables
+func (proxier *Proxier) Sync() {
+	proxier.mu.Lock()
+	defer proxier.mu.Unlock()
+	proxier.syncProxyRules()
+}
+
+// SyncLoop runs periodic work.  This is expected to run as a goroutine or as the main loop of the app.  It does not return.
+func (proxier *Proxier) SyncLoop() {
+	t := time.NewTicker(proxier.syncPeriod)
+	defer t.Stop()
+	for {
+		<-t.C
+		glog.V(6).Infof("Periodic sync")
+		proxier.Sync()
+	}
+}
+
+// OnServiceUpdate tracks the active set of service proxies.
+// They will be synchronized using syncProxyRules()
+func (proxier *Proxier) OnServiceUpdate(allServices []api.Service) {
+	proxier.mu.Lock()
+	defer proxier.mu.Unlock()
+	proxier.haveReceivedServiceUpdate = true
+
+	activeServices := make(map[proxy.ServicePortName]bool) // use a map as a set
+
+	for i := range allServices {
+		service := &allServices[i]
+
+		// if ClusterIP is "None" or empty, skip proxying
+		if !api.IsServiceIPSet(service) {
+			glog.V(3).Infof("Skipping service %s due to clusterIP = %q", types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service.Spec.ClusterIP)
+			continue
+		}
+
+		for i := range service.Spec.Ports {
+			servicePort := &service.Spec.Ports[i]
+
+			serviceName := proxy.ServicePortName{
+				NamespacedName: types.NamespacedName{
+					Namespace: service.Namespace,
+					Name:      service.Name,
+				},
+				Port: servicePort.Name,
+			}
+			activeServices[serviceName] = true
+			info, exists := proxier.serviceMap[serviceName]
+			if exists && proxier.sameConfig(info, service, servicePort) {
+				// Nothing changed.
+				continue
+			}
+			if exists {
+				// Something changed.
+				glog.V(3).Infof("Something changed for service %q: removing it", serviceName)
+				delete(proxier.serviceMap, serviceName)
+			}
+			serviceIP := net.ParseIP(service.Spec.ClusterIP)
+			glog.V(1).Infof("Adding new service %q at %s:%d/%s", serviceName, serviceIP, servicePort.Port, servicePort.Protocol)
+			info = newServiceInfo()
+			info.clusterIP = serviceIP
+			info.port = servicePort.Port
+			info.protocol = servicePort.Protocol
+			info.nodePort = servicePort.NodePort
+			info.deprecatedPublicIPs = service.Spec.DeprecatedPublicIPs
+			// Deep-copy in case the service instance changes
+			info.loadBalancerStatus = *api.LoadBalancerStatusDeepCopy(&service.Status.LoadBalancer)
+			info.sessionAffinityType = service.Spec.SessionAffinity
+			proxier.serviceMap[serviceName] = info
+
+			glog.V(4).Infof("info: %+v", info)
+		}
+	}
+
+	// Remove services missing from the update.
+	for name := range proxier.serviceMap {
+		if !activeServices[name] {
+			glog.V(1).Infof("Removing service %q", name)
+			delete(proxier.serviceMap, name)
+		}
+	}
+
+	proxier.syncProxyRules()
+}
+
+// OnEndpointsUpdate takes in a slice of updated endpoints.
+func (proxier *Proxier) OnEndpointsUpdate(allEndpoints []api.Endpoints) {
+	proxier.mu.Lock()
+	defer proxier.mu.Unlock()
+	proxier.haveReceivedEndpointsUpdate = true
+
+	registeredEndpoints := make(map[proxy.ServicePortName]bool) // use a map as a set
+
+	// Update endpoints for services.
+	for i := range allEndpoints {
+		svcEndpoints := &allEndpoints[i]
+
+		// We need to build a map of portname -> all ip:ports for that
+		// portname.  Explode Endpoints.Subsets[*] into this structure.
+		portsToEndpoints := map[string][]hostPortPair{}
+		for i := range svcEndpoints.Subsets {
+			ss := &svcEndpoints.Subsets[i]
+			for i := range ss.Ports {
+				port := &ss.Ports[i]
+				for i := range ss.Addresses {
+					addr := &ss.Addresses[i]
+					portsToEndpoints[port.Name] = append(portsToEndpoints[port.Name], hostPortPair{addr.IP, port.Port})
+				}
+			}
+		}
+
+		for portname := range portsToEndpoints {
+			svcPort := proxy.ServicePortName{
+				NamespacedName: types.NamespacedName{
+					Namespace: svcEndpoints.Namespace,
+					Name:      svcEndpoints.Name,
+				},
+				Port: portname,
+			}
+			curEndpoints := proxier.endpointsMap[svcPort]
+			newEndpoints := flattenValidEndpoints(portsToEndpoints[portname])
+
+			if len(curEndpoints) != len(newEndpoints) || !slicesEquiv(slice.CopyStrings(curEndpoints), newEndpoints) {
+				glog.V(1).Infof("Setting endpoints for %q to %+v", svcPort, newEndpoints)
+				proxier.endpointsMap[svcPort] = newEndpoints
+			}
+			registeredEndpoints[svcPort] = true
+		}
+	}
+
+	// Remove endpoints missing from the update.
+	for name := range proxier.endpointsMap {
+		if !registeredEndpoints[name] {
+			glog.V(2).Infof("Removing endpoints for %q", name)
+			delete(proxier.endpointsMap, name)
+		}
+	}
+
+	proxier.syncProxyRules()
+}
+
+// used in OnEndpointsUpdate
+type hostPortPair struct {
+	host string
+	port int
+}
+
+func isValidEndpoint(hpp *hostPortPair) bool {
+	return hpp.host != "" && hpp.port > 0
+}
+
+// Tests whether two slices are equivalent.  This sorts both slices in-place.
+func slicesEquiv(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	if reflect.DeepEqual(slice.SortStrings(lhs), slice.SortStrings(rhs)) {
+		return true
+	}
+	return false
+}
+
+// flattenValidEndpoints returns the valid endpoints as sorted "host:port"
+// strings, so that the generated rules are stable across syncs.
+func flattenValidEndpoints(endpoints []hostPortPair) []string {
+	// Convert Endpoint objects into strings for easier use later.  Ignore
+	// the protocol field - we'll get that from the Service objects.
+	var result []string
+	for i := range endpoints {
+		hpp := &endpoints[i]
+		if isValidEndpoint(hpp) {
+			result = append(result, net.JoinHostPort(hpp.host, strconv.Itoa(hpp.port)))
+		}
+	}
+	return slice.SortStrings(result)
+}
+
+// servicePortChainName takes the ServicePortName for a service and
+// returns the associated iptables chain.  This is computed by hashing (sha256)
+// then encoding to base32 and truncating with the prefix "KUBE-SVC-".  We do
+// this because Iptables Chain Names must be <= 28 chars long, and the longer
+// they are the harder they are to read.
+func servicePortChainName(s proxy.ServicePortName, protocol string) utiliptables.Chain {
+	hash := sha256.Sum256([]byte(s.String() + protocol))
+	encoded := base32.StdEncoding.EncodeToString(hash[:])
+	return utiliptables.Chain("KUBE-SVC-" + encoded[:16])
+}
+
+// This is the same as servicePortChainName but with the endpoint included.
+func servicePortEndpointChainName(s proxy.ServicePortName, protocol string, endpoint string) utiliptables.Chain {
+	hash := sha256.Sum256([]byte(s.String() + protocol + endpoint))
+	encoded := base32.StdEncoding.EncodeToString(hash[:])
+	return utiliptables.Chain("KUBE-SEP-" + encoded[:16])
+}
+
+// This is where all of the iptables-save/restore calls happen.
+// The only other iptables rules are the links from the built-in chains, which
+// are ensured at the top of this function.
+// assumes proxier.mu is held
+func (proxier *Proxier) syncProxyRules() {
+	// don't sync rules till we've received services and endpoints
+	if !proxier.haveReceivedEndpointsUpdate || !proxier.haveReceivedServiceUpdate {
+		glog.V(2).Info("Not syncing iptables until Services and Endpoints have been received from master")
+		return
+	}
+	glog.V(3).Infof("Syncing iptables rules")
+
+	// Ensure main chains and rules are installed.
+	inputChains := []utiliptables.Chain{utiliptables.ChainOutput, utiliptables.ChainPrerouting}
+	// Link the services chain.
+	for _, chain := range inputChains {
+		if _, err := proxier.iptables.EnsureChain(utiliptables.TableNAT, iptablesServicesChain); err != nil {
+			glog.Errorf("Failed to ensure that chain %s exists: %v", iptablesServicesChain, err)
+			return
+		}
+		comment := "kubernetes service portals"
+		args := []string{"-m", "comment", "--comment", comment, "-j", string(iptablesServicesChain)}
+		if _, err := proxier.iptables.EnsureRule(utiliptables.Prepend, utiliptables.TableNAT, chain, args...); err != nil {
+			glog.Errorf("Failed to ensure that chain %s jumps to %s: %v", chain, iptablesServicesChain, err)
+			return
+		}
+	}
+	// Link the output rules.
+	{
+		comment := "kubernetes service traffic requiring SNAT"
+		args := []string{"-m", "comment", "--comment", comment, "-m", "mark", "--mark", iptablesMasqueradeMark, "-j", "MASQUERADE"}
+		if _, err := proxier.iptables.EnsureRule(utiliptables.Append, utiliptables.TableNAT, utiliptables.ChainPostrouting, args...); err != nil {
+			glog.Errorf("Failed to ensure that chain %s obeys MASQUERADE mark: %v", utiliptables.ChainPostrouting, err)
+			return
+		}
+	}
+
+	// Get iptables-save output so we can check for existing chains and rules.
+	// This will be a map of chain name to chain with rules as stored in iptables-save/iptables-restore
+	existingChains := make(map[utiliptables.Chain]string)
+	iptablesSaveRaw, err := proxier.iptables.Save(utiliptables.TableNAT)
+	if err != nil { // if we failed to get any rules
+		glog.Errorf("Failed to execute iptables-save, syncing all rules. %s", err.Error())
+	} else { // otherwise parse the output
+		existingChains = getChainLines(utiliptables.TableNAT, iptablesSaveRaw)
+	}
+
+	chainsLines := bytes.NewBuffer(nil)
+	rulesLines := bytes.NewBuffer(nil)
+
+	// Write table header.
+	writeLine(chainsLines, "*nat")
+
+	// Make sure we keep stats for the top-level chains, if they existed
+	// (which they should have because we created them above).
+	for _, chain := range []utiliptables.Chain{iptablesServicesChain, iptablesNodePortsChain} {
+		if line, found := existingChains[chain]; found {
+			writeLine(chainsLines, line)
+		} else {
+			writeLine(chainsLines, makeChainLine(chain))
+		}
+	}
+
+	// Accumulate chains to keep.
+	activeChains := make(map[utiliptables.Chain]bool) // use a map as a set
+
+	// Build rules for each service.
+	for name, svcInfo := range proxier.serviceMap {
+		protocol := strings.ToLower(string(svcInfo.protocol))
+
+		// Create the per-service chain, retaining counters if possible.
+		svcChain := servicePortChainName(name, protocol)
+		if line, found := existingChains[svcChain]; found {
+			writeLine(chainsLines, line)
+		} else {
+			writeLine(chainsLines, makeChainLine(svcChain))
+		}
+		activeChains[svcChain] = true
+
+		// Capture the clusterIP.
+		args := []string{
+			"-A", string(iptablesServicesChain),
+			"-m", "comment", "--comment", fmt.Sprintf("\"%s cluster IP\"", name.String()),
+			"-m", protocol, "-p", protocol,
+			"-d", fmt.Sprintf("%s/32", svcInfo.clusterIP.String()),
+			"--dport", fmt.Sprintf("%d", svcInfo.port),
+		}
+		if proxier.masqueradeAll {
+			writeLine(rulesLines, append(args, "-j", "MARK", "--set-xmark", fmt.Sprintf("%s/0xffffffff", iptablesMasqueradeMark))...)
+		}
+		writeLine(rulesLines, append(args, "-j", string(svcChain))...)
+
+		// Capture externalIPs.
+		for _, externalIP := range svcInfo.deprecatedPublicIPs {
+			args := []string{
+				"-A", string(iptablesServicesChain),
+				"-m", "comment", "--comment", fmt.Sprintf("\"%s external IP\"", name.String()),
+				"-m", protocol, "-p", protocol,
+				"-d", fmt.Sprintf("%s/32", externalIP),
+				"--dport", fmt.Sprintf("%d", svcInfo.port),
+			}
+			// We have to SNAT packets from external IPs.
+			writeLine(rulesLines, append(args, "-j", "MARK", "--set-xmark", fmt.Sprintf("%s/0xffffffff", iptablesMasqueradeMark))...)
+			writeLine(rulesLines, append(args, "-j", string(svcChain))...)
+		}
+
+		// Capture load-balancer ingress.
+		for _, ingress := range svcInfo.loadBalancerStatus.Ingress {
+			if ingress.IP != "" {
+				args := []string{
+					"-A", string(iptablesServicesChain),
+					"-m", "comment", "--comment", fmt.Sprintf("\"%s loadbalancer IP\"", name.String()),
+					"-m", protocol, "-p", protocol,
+					"-d", fmt.Sprintf("%s/32", ingress.IP),
+					"--dport", fmt.Sprintf("%d", svcInfo.port),
+				}
+				// We have to SNAT packets from external IPs.
+				writeLine(rulesLines, append(args, "-j", "MARK", "--set-xmark", fmt.Sprintf("%s/0xffffffff", iptablesMasqueradeMark))...)
+				writeLine(rulesLines, append(args, "-j", string(svcChain))...)
+			}
+		}
+
+		// Capture nodeports.  If we had more than 2 rules it might be
+		// worthwhile to make a new per-service chain for nodeport rules, but
+		// with just 2 rules it ends up being a waste and a cognitive burden.
+		if svcInfo.nodePort != 0 {
+			args := []string{
+				"-A", string(iptablesNodePortsChain),
+				"-m", "comment", "--comment", name.String(),
+				"-m", protocol, "-p", protocol,
+				"--dport", fmt.Sprintf("%d", svcInfo.nodePort),
+			}
+			// Nodeports need SNAT.
+			writeLine(rulesLines, append(args, "-j", "MARK", "--set-xmark", fmt.Sprintf("%s/0xffffffff", iptablesMasqueradeMark))...)
+			// Jump to the service chain.
+			writeLine(rulesLines, append(args, "-j", string(svcChain))...)
+		}
+
+		// Generate the per-endpoint chains.  We do this in multiple passes so we
+		// can group rules together.
+		endpoints := proxier.endpointsMap[name]
+		endpointChains := make([]utiliptables.Chain, 0, len(endpoints))
+		for _, ep := range endpoints {
+			// Create the endpoint chain, retaining counters if possible.
+			endpointChain := servicePortEndpointChainName(name, protocol, ep)
+			endpointChains = append(endpointChains, endpointChain)
+			if line, found := existingChains[endpointChain]; found {
+				writeLine(chainsLines, line)
+			} else {
+				writeLine(chainsLines, makeChainLine(endpointChain))
+			}
+			activeChains[endpointChain] = true
+		}
+
+		// First write session affinity rules, if applicable.
+		if svcInfo.sessionAffinityType == api.ServiceAffinityClientIP {
+			for _, endpointChain := range endpointChains {
+				writeLine(rulesLines,
+					"-A", string(svcChain),
+					"-m", "comment", "--comment", name.String(),
+					"-m", "recent", "--name", string(endpointChain),
+					"--rcheck", "--seconds", fmt.Sprintf("%d", svcInfo.stickyMaxAgeSeconds), "--reap",
+					"-j", string(endpointChain))
+			}
+		}
+
+		// Now write loadbalancing & DNAT rules.
+		n := len(endpointChains)
+		for i, endpointChain := range endpointChains {
+			// Balancing rules in the per-service chain.
+			args := []string{
+				"-A", string(svcChain),
+				"-m", "comment", "--comment", name.String(),
+			}
+			if i < (n - 1) {
+				// Each rule is a probabilistic match.
+				args = append(args,
+					"-m", "statistic",
+					"--mode", "random",
+					"--probability", fmt.Sprintf("%0.5f", 1.0/float64(n-i)))
+			}
+			// The final (or only if n == 1) rule is a guaranteed match.
+			args = append(args, "-j", string(endpointChain))
+			writeLine(rulesLines, args...)
+
+			// Rules in the per-endpoint chain.
+			args = []string{
+				"-A", string(endpointChain),
+				"-m", "comment", "--comment", name.String(),
+			}
+			// Handle traffic that loops back to the originator with SNAT.
+			// Technically we only need to do this if the endpoint is on this
+			// host, but we don't have that information, so we just do this for
+			// all endpoints.
+			// TODO: if we grow logic to get this node's pod CIDR, we can use it.
+			host, _, err := net.SplitHostPort(endpoints[i])
+			if err != nil {
+				glog.Errorf("Failed to parse endpoint %q: %v", endpoints[i], err)
+				continue
+			}
+			writeLine(rulesLines, append(args,
+				"-s", fmt.Sprintf("%s/32", host),
+				"-j", "MARK", "--set-xmark", fmt.Sprintf("%s/0xffffffff", iptablesMasqueradeMark))...)
+
+			// Update client-affinity lists.
+			if svcInfo.sessionAffinityType == api.ServiceAffinityClientIP {
+				args = append(args, "-m", "recent", "--name", string(endpointChain), "--set")
+			}
+			// DNAT to final destination.
+			args = append(args,
+				"-m", protocol, "-p", protocol,
+				"-j", "DNAT", "--to-destination", endpoints[i])
+			writeLine(rulesLines, args...)
+		}
+	}
+
+	// Delete chains no longer in use.
+	for chain := range existingChains {
+		if !activeChains[chain] {
+			chainString := string(chain)
+			if !strings.HasPrefix(chainString, "KUBE-SVC-") && !strings.HasPrefix(chainString, "KUBE-SEP-") {
+				// Ignore chains that aren't ours.
+				continue
+			}
+			// We must (as per iptables) write a chain-line for it, which has
+			// the nice effect of flushing the chain.  Then we can remove the
+			// chain.
+			writeLine(chainsLines, existingChains[chain])
+			writeLine(rulesLines, "-X", chainString)
+		}
+	}
+
+	// Finally, tail-call to the nodeports chain.  This needs to be after all
+	// other service portal rules.
+	writeLine(rulesLines,
+		"-A", string(iptablesServicesChain),
+		"-m", "comment", "--comment", "\"kubernetes service nodeports; NOTE: this must be the last rule in this chain\"",
+		"-m", "addrtype", "--dst-type", "LOCAL",
+		"-j", string(iptablesNodePortsChain))
+
+	// Write the end-of-table marker.
+	writeLine(rulesLines, "COMMIT")
+
+	// Sync rules.
+	// NOTE: NoFlushTables is used so we don't flush non-kubernetes chains in the table.
+	lines := append(chainsLines.Bytes(), rulesLines.Bytes()...)
+	glog.V(3).Infof("Syncing rules: %s", lines)
+	err = proxier.iptables.Restore(utiliptables.TableNAT, lines, utiliptables.NoFlushTables, utiliptables.RestoreCounters)
+	if err != nil {
+		glog.Errorf("Failed to sync iptables rules: %v", err)
+	}
+}
+
+// Join all words with spaces, terminate with newline and write to buf.
+func writeLine(buf *bytes.Buffer, words ...string) {
+	buf.WriteString(strings.Join(words, " ") + "\n")
+}
+
+// return an iptables-save/restore formatted chain line given a Chain
+func makeChainLine(chain utiliptables.Chain) string {
+	return fmt.Sprintf(":%s - [0:0]", chain)
+}
+
+// getChainLines parses a table's iptables-save data to find chains in the table.
+// It returns a map of iptables.Chain to string where the string is the chain line from the save (with counters etc).
+func getChainLines(table utiliptables.Table, save []byte) map[utiliptables.Chain]string {
+	chainsMap := make(map[utiliptables.Chain]string)
+	tablePrefix := "*" + string(table)
+	lines := strings.Split(string(save), "\n")
+	readIndex := 0
+	// find beginning of table
+	for ; readIndex < len(lines); readIndex++ {
+		if strings.TrimSpace(lines[readIndex]) == tablePrefix {
+			readIndex++
+			break
+		}
+	}
+	// parse table lines
+	for ; readIndex < len(lines); readIndex++ {
+		line := strings.TrimSpace(lines[readIndex])
+		if strings.HasPrefix(line, "COMMIT") || strings.HasPrefix(line, "*") {
+			break
+		} else if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		} else if strings.HasPrefix(line, ":") && len(line) > 1 {
+			chain := utiliptables.Chain(strings.SplitN(line[1:], " ", 2)[0])
+			chainsMap[chain] = line
+		}
+	}
+	return chainsMap
+}
+
+// setSysctl writes a value to the named sysctl, given in path form
+// (e.g. "net/bridge/bridge-nf-call-iptables").
+func setSysctl(sysctl string, newVal int) error {
+	return ioutil.WriteFile(path.Join("/proc/sys", sysctl), []byte(strconv.Itoa(newVal)), 0640)
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iptables
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/proxy"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+	utiliptables "github.com/GoogleCloudPlatform/kubernetes/pkg/util/iptables"
+)
+
+type fakeIptables struct {
+	saveOutput []byte
+	restored   [][]byte
+	ensured    [][]string
+	deleted    [][]string
+}
+
+func (fake *fakeIptables) EnsureChain(table utiliptables.Table, chain utiliptables.Chain) (bool, error) {
+	return false, nil
+}
+
+func (fake *fakeIptables) DeleteChain(table utiliptables.Table, chain utiliptables.Chain) error {
+	return nil
+}
+
+func (fake *fakeIptables) FlushChain(table utiliptables.Table, chain utiliptables.Chain) error {
+	return nil
+}
+
+func (fake *fakeIptables) EnsureRule(position utiliptables.RulePosition, table utiliptables.Table, chain utiliptables.Chain, args ...string) (bool, error) {
+	fake.ensured = append(fake.ensured, append([]string{string(chain)}, args...))
+	return false, nil
+}
+
+func (fake *fakeIptables) DeleteRule(table utiliptables.Table, chain utiliptables.Chain, args ...string) error {
+	fake.deleted = append(fake.deleted, append([]string{string(chain)}, args...))
+	return nil
+}
+
+func (fake *fakeIptables) IsIpv6() bool {
+	return false
+}
+
+func (fake *fakeIptables) Save(table utiliptables.Table) ([]byte, error) {
+	return fake.saveOutput, nil
+}
+
+func (fake *fakeIptables) Restore(table utiliptables.Table, data []byte, flush utiliptables.FlushFlag, counters utiliptables.RestoreCountersFlag) error {
+	fake.restored = append(fake.restored, data)
+	return nil
+}
+
+func checkAllLines(t *testing.T, table utiliptables.Table, save []byte, expectedLines map[utiliptables.Chain]string) {
+	chainLines := getChainLines(table, save)
+	for chain, line := range chainLines {
+		if expected, exists := expectedLines[chain]; exists {
+			if expected != line {
+				t.Errorf("getChainLines expected chain line not present. For chain: %s Expected: %s Got: %s", chain, expected, line)
+			}
+		} else {
+			t.Errorf("getChainLines expected chain not present: %s", chain)
+		}
+	}
+	if len(chainLines) != len(expectedLines) {
+		t.Errorf("expected %d chains, got %d", len(expectedLines), len(chainLines))
+	}
+}
+
+func TestGetChainLines(t *testing.T) {
+	iptables_save := `# Generated by iptables-save v1.4.7 on Wed Oct 29 14:56:01 2014
+	*nat
+	:PREROUTING ACCEPT [2136997:197881818]
+	:POSTROUTING ACCEPT [4284525:258542680]
+	:OUTPUT ACCEPT [5901660:357267963]
+	-A PREROUTING -m addrtype --dst-type LOCAL -j DOCKER
+	COMMIT
+	# Completed on Wed Oct 29 14:56:01 2014`
+	expected := map[utiliptables.Chain]string{
+		utiliptables.ChainPrerouting:  ":PREROUTING ACCEPT [2136997:197881818]",
+		utiliptables.ChainPostrouting: ":POSTROUTING ACCEPT [4284525:258542680]",
+		utiliptables.ChainOutput:      ":OUTPUT ACCEPT [5901660:357267963]",
+	}
+	checkAllLines(t, utiliptables.TableNAT, []byte(iptables_save), expected)
+}
+
+func TestGetChainLinesMultipleTables(t *testing.T) {
+	iptables_save := `# Generated by iptables-save v1.4.21 on Fri Aug  7 14:47:37 2015
+	*nat
+	:PREROUTING ACCEPT [2:138]
+	:INPUT ACCEPT [0:0]
+	:OUTPUT ACCEPT [0:0]
+	:POSTROUTING ACCEPT [0:0]
+	:DOCKER - [0:0]
+	:KUBE-NODEPORTS - [0:0]
+	:KUBE-SERVICES - [0:0]
+	:KUBE-SVC-1111111111111111 - [0:0]
+	:KUBE-SEP-3333333333333333 - [0:0]
+	-A KUBE-SVC-1111111111111111 -m comment --comment "SVC1" -m recent --rcheck --seconds 180 --reap --name KUBE-SEP-3333333333333333 --mask 255.255.255.255 --rsource -j KUBE-SEP-3333333333333333
+	COMMIT
+	# Completed on Fri Aug  7 14:47:37 2015
+	# Generated by iptables-save v1.4.21 on Fri Aug  7 14:47:37 2015
+	*filter
+	:INPUT ACCEPT [2136997:197881818]
+	:FORWARD ACCEPT [0:0]
+	:OUTPUT ACCEPT [0:0]
+	:DOCKER - [0:0]
+	COMMIT
+	`
+	expected := map[utiliptables.Chain]string{
+		utiliptables.ChainPrerouting:                    ":PREROUTING ACCEPT [2:138]",
+		utiliptables.Chain("INPUT"):                     ":INPUT ACCEPT [0:0]",
+		utiliptables.ChainOutput:                        ":OUTPUT ACCEPT [0:0]",
+		utiliptables.ChainPostrouting:                   ":POSTROUTING ACCEPT [0:0]",
+		utiliptables.Chain("DOCKER"):                    ":DOCKER - [0:0]",
+		utiliptables.Chain("KUBE-NODEPORTS"):            ":KUBE-NODEPORTS - [0:0]",
+		utiliptables.Chain("KUBE-SERVICES"):             ":KUBE-SERVICES - [0:0]",
+		utiliptables.Chain("KUBE-SVC-1111111111111111"): ":KUBE-SVC-1111111111111111 - [0:0]",
+		utiliptables.Chain("KUBE-SEP-3333333333333333"): ":KUBE-SEP-3333333333333333 - [0:0]",
+	}
+	checkAllLines(t, utiliptables.TableNAT, []byte(iptables_save), expected)
+}
+
+func TestCanUseIptablesProxier(t *testing.T) {
+	testCases := []struct {
+		version  string
+		expected bool
+	}{
+		{"iptables v1.4.7", false},
+		{"iptables v1.4.11", true},
+		{"iptables v1.4.21", true},
+		{"iptables v1.6.0", true},
+		{"iptables v2.0.0", true},
+	}
+	for _, tc := range testCases {
+		version := tc.version
+		fcmd := exec.FakeCmd{
+			CombinedOutputScript: []exec.FakeCombinedOutputAction{
+				func() ([]byte, error) { return []byte(version), nil },
+			},
+		}
+		fexec := exec.FakeExec{
+			CommandScript: []exec.FakeCommandAction{
+				func(cmd string, args ...string) exec.Cmd { return exec.InitFakeCmd(&fcmd, cmd, args...) },
+			},
+		}
+		ok, err := CanUseIptablesProxier(&fexec)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.version, err)
+		}
+		if ok != tc.expected {
+			t.Errorf("%s: expected %v, got %v", tc.version, tc.expected, ok)
+		}
+	}
+}
+
+func newFakeProxier(ipt utiliptables.Interface) *Proxier {
+	return &Proxier{
+		serviceMap:   make(map[proxy.ServicePortName]*serviceInfo),
+		endpointsMap: make(map[proxy.ServicePortName][]string),
+		iptables:     ipt,
+	}
+}
+
+func testService(sessionAffinity api.ServiceAffinity) api.Service {
+	return api.Service{
+		ObjectMeta: api.ObjectMeta{Name: "echo", Namespace: "testing"},
+		Spec: api.ServiceSpec{
+			ClusterIP:       "10.0.0.10",
+			SessionAffinity: sessionAffinity,
+			Ports: []api.ServicePort{{
+				Name:     "p",
+				Port:     80,
+				NodePort: 30080,
+				Protocol: "TCP",
+			}},
+		},
+	}
+}
+
+func testEndpoints(ips ...string) api.Endpoints {
+	addrs := []api.EndpointAddress{}
+	for _, ip := range ips {
+		addrs = append(addrs, api.EndpointAddress{IP: ip})
+	}
+	return api.Endpoints{
+		ObjectMeta: api.ObjectMeta{Name: "echo", Namespace: "testing"},
+		Subsets: []api.EndpointSubset{{
+			Addresses: addrs,
+			Ports:     []api.EndpointPort{{Name: "p", Port: 8080}},
+		}},
+	}
+}
+
+func TestNewProxierRejectsNonPositiveSyncPeriod(t *testing.T) {
+	for _, period := range []time.Duration{0, -time.Second} {
+		if _, err := NewProxier(&fakeIptables{}, period, false); err == nil {
+			t.Errorf("expected an error for sync period %v", period)
+		}
+	}
+}
+
+func TestNoSyncBeforeUpdates(t *testing.T) {
+	ipt := &fakeIptables{}
+	proxier := newFakeProxier(ipt)
+
+	proxier.OnServiceUpdate([]api.Service{testService(api.ServiceAffinityNone)})
+	if len(ipt.restored) != 0 {
+		t.Errorf("expected no restore before endpoints were received, got %d", len(ipt.restored))
+	}
+	proxier.OnEndpointsUpdate([]api.Endpoints{testEndpoints("1.2.3.4")})
+	if len(ipt.restored) != 1 {
+		t.Errorf("expected a restore once services and endpoints were received, got %d", len(ipt.restored))
+	}
+}
+
+func TestSyncProxyRules(t *testing.T) {
+	ipt := &fakeIptables{}
+	proxier := newFakeProxier(ipt)
+	proxier.OnServiceUpdate([]api.Service{testService(api.ServiceAffinityNone)})
+	proxier.OnEndpointsUpdate([]api.Endpoints{testEndpoints("1.2.3.4", "5.6.7.8")})
+
+	svcName := proxy.ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testing", Name: "echo"}, Port: "p"}
+	svcChain := string(servicePortChainName(svcName, "tcp"))
+	sepChain1 := string(servicePortEndpointChainName(svcName, "tcp", "1.2.3.4:8080"))
+	sepChain2 := string(servicePortEndpointChainName(svcName, "tcp", "5.6.7.8:8080"))
+
+	rules := string(ipt.restored[len(ipt.restored)-1])
+	expected := []string{
+		"*nat",
+		":" + svcChain + " - [0:0]",
+		":" + sepChain1 + " - [0:0]",
+		":" + sepChain2 + " - [0:0]",
+		"-A KUBE-SERVICES -m comment --comment \"testing/echo:p cluster IP\" -m tcp -p tcp -d 10.0.0.10/32 --dport 80 -j " + svcChain,
+		"-A KUBE-NODEPORTS -m comment --comment testing/echo:p -m tcp -p tcp --dport 30080 -j " + svcChain,
+		"-A " + svcChain + " -m comment --comment testing/echo:p -m statistic --mode random --probability 0.50000 -j " + sepChain1,
+		"-A " + svcChain + " -m comment --comment testing/echo:p -j " + sepChain2,
+		"-A " + sepChain1 + " -m comment --comment testing/echo:p -m tcp -p tcp -j DNAT --to-destination 1.2.3.4:8080",
+		"-A " + sepChain2 + " -m comment --comment testing/echo:p -m tcp -p tcp -j DNAT --to-destination 5.6.7.8:8080",
+		"-A KUBE-SERVICES -m comment --comment \"kubernetes service nodeports; NOTE: this must be the last rule in this chain\" -m addrtype --dst-type LOCAL -j KUBE-NODEPORTS",
+		"COMMIT",
+	}
+	for _, line := range expected {
+		if !strings.Contains(rules, line+"\n") {
+			t.Errorf("expected rules to contain %q, got:\n%s", line, rules)
+		}
+	}
+	if strings.Contains(rules, "recent") {
+		t.Errorf("expected no session affinity rules, got:\n%s", rules)
+	}
+}
+
+func TestSyncProxyRulesSessionAffinity(t *testing.T) {
+	ipt := &fakeIptables{}
+	proxier := newFakeProxier(ipt)
+	proxier.OnServiceUpdate([]api.Service{testService(api.ServiceAffinityClientIP)})
+	proxier.OnEndpointsUpdate([]api.Endpoints{testEndpoints("1.2.3.4")})
+
+	svcName := proxy.ServicePortName{NamespacedName: types.NamespacedName{Namespace: "testing", Name: "echo"}, Port: "p"}
+	svcChain := string(servicePortChainName(svcName, "tcp"))
+	sepChain := string(servicePortEndpointChainName(svcName, "tcp", "1.2.3.4:8080"))
+
+	rules := string(ipt.restored[len(ipt.restored)-1])
+	expected := []string{
+		"-A " + svcChain + " -m comment --comment testing/echo:p -m recent --name " + sepChain + " --rcheck --seconds 10800 --reap -j " + sepChain,
+		"-A " + sepChain + " -m comment --comment testing/echo:p -m recent --name " + sepChain + " --set -m tcp -p tcp -j DNAT --to-destination 1.2.3.4:8080",
+	}
+	for _, line := range expected {
+		if !strings.Contains(rules, line+"\n") {
+			t.Errorf("expected rules to contain %q, got:\n%s", line, rules)
+		}
+	}
+}
+
+func TestSyncProxyRulesRemovesStaleChains(t *testing.T) {
+	ipt := &fakeIptables{
+		saveOutput: []byte(`*nat
+:KUBE-SERVICES - [0:0]
+:KUBE-NODEPORTS - [0:0]
+:KUBE-SVC-AAAAAAAAAAAAAAAA - [0:0]
+:DOCKER - [0:0]
+COMMIT
+`),
+	}
+	proxier := newFakeProxier(ipt)
+	proxier.OnServiceUpdate([]api.Service{})
+	proxier.OnEndpointsUpdate([]api.Endpoints{})
+
+	rules := string(ipt.restored[len(ipt.restored)-1])
+	if !strings.Contains(rules, "-X KUBE-SVC-AAAAAAAAAAAAAAAA\n") {
+		t.Errorf("expected stale chain to be deleted, got:\n%s", rules)
+	}
+	if strings.Contains(rules, "DOCKER") {
+		t.Errorf("expected foreign chains to be left alone, got:\n%s", rules)
+	}
+}
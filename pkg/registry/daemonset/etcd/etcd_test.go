@@ -0,0 +1,162 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, *tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
+	storage, statusStorage := NewStorage(helper)
+	return storage, statusStorage, fakeEtcdClient, helper
+}
+
+func validNewDaemonSet() *api.DaemonSet {
+	return &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foo",
+			Namespace: api.NamespaceDefault,
+			Labels:    map[string]string{"a": "b"},
+		},
+		Spec: api.DaemonSetSpec{
+			Selector: map[string]string{"a": "b"},
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: map[string]string{"a": "b"},
+				},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Name:                   "test",
+							Image:                  "test_image",
+							ImagePullPolicy:        api.PullIfNotPresent,
+							TerminationMessagePath: api.TerminationMessagePathDefault,
+						},
+					},
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, _, fakeEtcdClient, _ := newStorage(t)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	validDaemonSet := validNewDaemonSet()
+	validDaemonSet.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		validDaemonSet,
+		// invalid
+		&api.DaemonSet{
+			Spec: api.DaemonSetSpec{},
+		},
+	)
+}
+
+func TestCreateClearsStatus(t *testing.T) {
+	storage, _, _, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	ds := validNewDaemonSet()
+	ds.Status.CurrentNumberScheduled = 3
+	if _, err := storage.Create(ctx, ds); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var dsOut api.DaemonSet
+	key, _ := storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &dsOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dsOut.Status.CurrentNumberScheduled != 0 {
+		t.Errorf("expected status to be cleared on create, got %#v", dsOut.Status)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	storage, statusStorage, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+
+	key, _ := storage.KeyFunc(ctx, "foo")
+	key = etcdtest.AddPrefix(key)
+	dsStart := validNewDaemonSet()
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, dsStart), 1)
+
+	ds := validNewDaemonSet()
+	ds.ResourceVersion = "1"
+	ds.Spec.Template.Spec.NodeSelector = map[string]string{"logging": "enabled"}
+	ds.Status = api.DaemonSetStatus{CurrentNumberScheduled: 1, NumberMisscheduled: 2, DesiredNumberScheduled: 3}
+
+	expected := *dsStart
+	expected.ResourceVersion = "2"
+	expected.Status = ds.Status
+
+	if _, _, err := statusStorage.Update(ctx, ds); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var dsOut api.DaemonSet
+	key, _ = storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &dsOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(expected, dsOut) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(expected, dsOut))
+	}
+}
+
+func TestEtcdUpdateIgnoresStatus(t *testing.T) {
+	storage, _, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+
+	key, _ := storage.KeyFunc(ctx, "foo")
+	key = etcdtest.AddPrefix(key)
+	dsStart := validNewDaemonSet()
+	dsStart.Status.DesiredNumberScheduled = 1
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, dsStart), 1)
+
+	ds := validNewDaemonSet()
+	ds.ResourceVersion = "1"
+	ds.Spec.Template.Spec.NodeSelector = map[string]string{"logging": "enabled"}
+	ds.Status.DesiredNumberScheduled = 7
+
+	if _, _, err := storage.Update(ctx, ds); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var dsOut api.DaemonSet
+	key, _ = storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &dsOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dsOut.Spec.Template.Spec.NodeSelector["logging"] != "enabled" {
+		t.Errorf("expected node selector to be updated, got %v", dsOut.Spec.Template.Spec.NodeSelector)
+	}
+	if dsOut.Status.DesiredNumberScheduled != 1 {
+		t.Errorf("expected status to be preserved, got %#v", dsOut.Status)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemonset
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// daemonSetStrategy implements verification logic for DaemonSets.
+type daemonSetStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating DaemonSet objects.
+var Strategy = daemonSetStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped returns true because all daemon sets need to be within a namespace.
+func (daemonSetStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears the status of a daemon set before creation.
+func (daemonSetStrategy) PrepareForCreate(obj runtime.Object) {
+	daemonSet := obj.(*api.DaemonSet)
+	daemonSet.Status = api.DaemonSetStatus{}
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (daemonSetStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newDaemonSet := obj.(*api.DaemonSet)
+	oldDaemonSet := old.(*api.DaemonSet)
+	newDaemonSet.Status = oldDaemonSet.Status
+}
+
+// Validate validates a new daemon set.
+func (daemonSetStrategy) Validate(ctx api.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	daemonSet := obj.(*api.DaemonSet)
+	return validation.ValidateDaemonSet(daemonSet)
+}
+
+// AllowCreateOnUpdate is false for daemon sets; this means a POST is
+// needed to create one.
+func (daemonSetStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (daemonSetStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	validationErrorList := validation.ValidateDaemonSet(obj.(*api.DaemonSet))
+	updateErrorList := validation.ValidateDaemonSetUpdate(old.(*api.DaemonSet), obj.(*api.DaemonSet))
+	return append(validationErrorList, updateErrorList...)
+}
+
+func (daemonSetStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+type daemonSetStatusStrategy struct {
+	daemonSetStrategy
+}
+
+// StatusStrategy is the logic that applies when updating the status of a DaemonSet.
+var StatusStrategy = daemonSetStatusStrategy{Strategy}
+
+// PrepareForUpdate sets the Spec field which is not allowed to be changed when updating a daemon set's Status.
+func (daemonSetStatusStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newDaemonSet := obj.(*api.DaemonSet)
+	oldDaemonSet := old.(*api.DaemonSet)
+	newDaemonSet.Spec = oldDaemonSet.Spec
+}
+
+func (daemonSetStatusStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateDaemonSetStatusUpdate(old.(*api.DaemonSet), obj.(*api.DaemonSet))
+}
+
+// DaemonSetToSelectableFields returns a field set that represents the object.
+func DaemonSetToSelectableFields(daemonSet *api.DaemonSet) fields.Set {
+	return fields.Set{
+		"metadata.name": daemonSet.Name,
+	}
+}
+
+// MatchDaemonSet is the filter used by the generic etcd backend to route
+// watch events from etcd to clients of the apiserver only interested in specific
+// labels/fields.
+func MatchDaemonSet(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{
+		Label: label,
+		Field: field,
+		GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, error) {
+			daemonSet, ok := obj.(*api.DaemonSet)
+			if !ok {
+				return nil, nil, fmt.Errorf("Given object is not a daemon set.")
+			}
+			return labels.Set(daemonSet.ObjectMeta.Labels), DaemonSetToSelectableFields(daemonSet), nil
+		},
+	}
+}
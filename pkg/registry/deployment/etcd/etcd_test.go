@@ -0,0 +1,171 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, *tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
+	storage, statusStorage := NewStorage(helper)
+	return storage, statusStorage, fakeEtcdClient, helper
+}
+
+func validNewDeployment() *api.Deployment {
+	return &api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foo",
+			Namespace: api.NamespaceDefault,
+			Labels:    map[string]string{"a": "b"},
+		},
+		Spec: api.DeploymentSpec{
+			Replicas: 2,
+			Selector: map[string]string{"a": "b"},
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: map[string]string{"a": "b"},
+				},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Name:                   "test",
+							Image:                  "test_image",
+							ImagePullPolicy:        api.PullIfNotPresent,
+							TerminationMessagePath: api.TerminationMessagePathDefault,
+						},
+					},
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+			Strategy: api.DeploymentStrategy{
+				Type: api.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &api.RollingUpdateDeployment{
+					MaxUnavailable: util.NewIntOrStringFromInt(1),
+					MaxSurge:       util.NewIntOrStringFromInt(1),
+				},
+			},
+			UniqueLabelKey: api.DefaultDeploymentUniqueLabelKey,
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, _, fakeEtcdClient, _ := newStorage(t)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	validDeployment := validNewDeployment()
+	validDeployment.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		validDeployment,
+		// invalid
+		&api.Deployment{
+			Spec: api.DeploymentSpec{},
+		},
+	)
+}
+
+func TestCreateClearsStatus(t *testing.T) {
+	storage, _, _, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	d := validNewDeployment()
+	d.Status.Replicas = 3
+	if _, err := storage.Create(ctx, d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var dOut api.Deployment
+	key, _ := storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &dOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dOut.Status.Replicas != 0 {
+		t.Errorf("expected status to be cleared on create, got %#v", dOut.Status)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	storage, statusStorage, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+
+	key, _ := storage.KeyFunc(ctx, "foo")
+	key = etcdtest.AddPrefix(key)
+	dStart := validNewDeployment()
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, dStart), 1)
+
+	d := validNewDeployment()
+	d.ResourceVersion = "1"
+	d.Spec.Template.Spec.NodeSelector = map[string]string{"logging": "enabled"}
+	d.Status = api.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1}
+
+	expected := *dStart
+	expected.ResourceVersion = "2"
+	expected.Status = d.Status
+
+	if _, _, err := statusStorage.Update(ctx, d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var dOut api.Deployment
+	key, _ = storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &dOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(expected, dOut) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(expected, dOut))
+	}
+}
+
+func TestEtcdUpdateIgnoresStatus(t *testing.T) {
+	storage, _, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+
+	key, _ := storage.KeyFunc(ctx, "foo")
+	key = etcdtest.AddPrefix(key)
+	dStart := validNewDeployment()
+	dStart.Status.UpdatedReplicas = 1
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, dStart), 1)
+
+	d := validNewDeployment()
+	d.ResourceVersion = "1"
+	d.Spec.Template.Spec.NodeSelector = map[string]string{"logging": "enabled"}
+	d.Status.UpdatedReplicas = 7
+
+	if _, _, err := storage.Update(ctx, d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var dOut api.Deployment
+	key, _ = storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &dOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dOut.Spec.Template.Spec.NodeSelector["logging"] != "enabled" {
+		t.Errorf("expected node selector to be updated, got %v", dOut.Spec.Template.Spec.NodeSelector)
+	}
+	if dOut.Status.UpdatedReplicas != 1 {
+		t.Errorf("expected status to be preserved, got %#v", dOut.Status)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// deploymentStrategy implements verification logic for Deployments.
+type deploymentStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating Deployment objects.
+var Strategy = deploymentStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped returns true because all deployments need to be within a namespace.
+func (deploymentStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears the status of a deployment before creation.
+func (deploymentStrategy) PrepareForCreate(obj runtime.Object) {
+	deployment := obj.(*api.Deployment)
+	deployment.Status = api.DeploymentStatus{}
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (deploymentStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newDeployment := obj.(*api.Deployment)
+	oldDeployment := old.(*api.Deployment)
+	newDeployment.Status = oldDeployment.Status
+}
+
+// Validate validates a new deployment.
+func (deploymentStrategy) Validate(ctx api.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	deployment := obj.(*api.Deployment)
+	return validation.ValidateDeployment(deployment)
+}
+
+// AllowCreateOnUpdate is false for deployments; this means a POST is
+// needed to create one.
+func (deploymentStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (deploymentStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	validationErrorList := validation.ValidateDeployment(obj.(*api.Deployment))
+	updateErrorList := validation.ValidateDeploymentUpdate(old.(*api.Deployment), obj.(*api.Deployment))
+	return append(validationErrorList, updateErrorList...)
+}
+
+func (deploymentStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+type deploymentStatusStrategy struct {
+	deploymentStrategy
+}
+
+// StatusStrategy is the logic that applies when updating the status of a Deployment.
+var StatusStrategy = deploymentStatusStrategy{Strategy}
+
+// PrepareForUpdate sets the Spec field which is not allowed to be changed when updating a deployment's Status.
+func (deploymentStatusStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newDeployment := obj.(*api.Deployment)
+	oldDeployment := old.(*api.Deployment)
+	newDeployment.Spec = oldDeployment.Spec
+}
+
+func (deploymentStatusStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateDeploymentStatusUpdate(old.(*api.Deployment), obj.(*api.Deployment))
+}
+
+// DeploymentToSelectableFields returns a field set that represents the object.
+func DeploymentToSelectableFields(deployment *api.Deployment) fields.Set {
+	return fields.Set{
+		"metadata.name": deployment.Name,
+	}
+}
+
+// MatchDeployment is the filter used by the generic etcd backend to route
+// watch events from etcd to clients of the apiserver only interested in specific
+// labels/fields.
+func MatchDeployment(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{
+		Label: label,
+		Field: field,
+		GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, error) {
+			deployment, ok := obj.(*api.Deployment)
+			if !ok {
+				return nil, nil, fmt.Errorf("Given object is not a deployment.")
+			}
+			return labels.Set(deployment.ObjectMeta.Labels), DeploymentToSelectableFields(deployment), nil
+		},
+	}
+}
@@ -24,7 +24,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
 	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
 )
 
 // rest implements a RESTStorage for endpoints against etcd
@@ -32,31 +32,42 @@ type REST struct {
 	*etcdgeneric.Etcd
 }
 
-// NewStorage returns a RESTStorage object that will work against endpoints.
-func NewStorage(h tools.EtcdHelper) *REST {
+// NewStorage returns a RESTStorage object that will work against endpoints. If
+// useCacher is true, watches and versioned lists are served from memory.
+func NewStorage(s storage.Interface, useCacher bool) *REST {
 	prefix := "/services/endpoints"
-	return &REST{
-		&etcdgeneric.Etcd{
-			NewFunc:     func() runtime.Object { return &api.Endpoints{} },
-			NewListFunc: func() runtime.Object { return &api.EndpointsList{} },
-			KeyRootFunc: func(ctx api.Context) string {
-				return etcdgeneric.NamespaceKeyRootFunc(ctx, prefix)
-			},
-			KeyFunc: func(ctx api.Context, name string) (string, error) {
-				return etcdgeneric.NamespaceKeyFunc(ctx, prefix, name)
-			},
-			ObjectNameFunc: func(obj runtime.Object) (string, error) {
-				return obj.(*api.Endpoints).Name, nil
-			},
-			PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
-				return endpoint.MatchEndpoints(label, field)
-			},
-			EndpointName: "endpoints",
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.Endpoints{} },
+		NewListFunc: func() runtime.Object { return &api.EndpointsList{} },
+		KeyRootFunc: func(ctx api.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, prefix)
+		},
+		KeyFunc: func(ctx api.Context, name string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, prefix, name)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.Endpoints).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return endpoint.MatchEndpoints(label, field)
+		},
+		EndpointName: "endpoints",
 
-			CreateStrategy: endpoint.Strategy,
-			UpdateStrategy: endpoint.Strategy,
+		CreateStrategy: endpoint.Strategy,
+		UpdateStrategy: endpoint.Strategy,
 
-			Helper: h,
-		},
+		Storage: s,
+	}
+	if useCacher {
+		store.Cacher = storage.NewCacher(storage.CacherConfig{
+			CacheCapacity:  1000,
+			Storage:        s,
+			ResourcePrefix: prefix,
+			KeyFunc: func(obj runtime.Object) (string, error) {
+				return storage.NamespaceKeyFunc(prefix, obj)
+			},
+			NewListFunc: store.NewListFunc,
+		})
 	}
+	return &REST{store}
 }
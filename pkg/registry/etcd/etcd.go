@@ -27,7 +27,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/endpoint"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/pod"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 
 	"github.com/golang/glog"
@@ -46,17 +46,17 @@ const (
 // Registry implements BindingRegistry, ControllerRegistry, EndpointRegistry,
 // MinionRegistry, PodRegistry and ServiceRegistry, backed by etcd.
 type Registry struct {
-	tools.EtcdHelper
+	storage.Interface
 	pods      pod.Registry
 	endpoints endpoint.Registry
 }
 
 // NewRegistry creates an etcd registry.
-func NewRegistry(helper tools.EtcdHelper, pods pod.Registry, endpoints endpoint.Registry) *Registry {
+func NewRegistry(storage storage.Interface, pods pod.Registry, endpoints endpoint.Registry) *Registry {
 	registry := &Registry{
-		EtcdHelper: helper,
-		pods:       pods,
-		endpoints:  endpoints,
+		Interface: storage,
+		pods:      pods,
+		endpoints: endpoints,
 	}
 	return registry
 }
@@ -89,7 +89,7 @@ func MakeEtcdItemKey(ctx api.Context, prefix string, id string) (string, error)
 func (r *Registry) ListControllers(ctx api.Context) (*api.ReplicationControllerList, error) {
 	controllers := &api.ReplicationControllerList{}
 	key := makeControllerListKey(ctx)
-	err := r.ExtractToList(key, controllers)
+	err := r.List(key, controllers)
 	return controllers, err
 }
 
@@ -98,7 +98,7 @@ func (r *Registry) WatchControllers(ctx api.Context, label labels.Selector, fiel
 	if !field.Empty() {
 		return nil, fmt.Errorf("field selectors are not supported on replication controllers")
 	}
-	version, err := tools.ParseWatchResourceVersion(resourceVersion, "replicationControllers")
+	version, err := storage.ParseWatchResourceVersion(resourceVersion, "replicationControllers")
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +145,7 @@ func (r *Registry) GetController(ctx api.Context, controllerID string) (*api.Rep
 	if err != nil {
 		return nil, err
 	}
-	err = r.ExtractObj(key, &controller, false)
+	err = r.Get(key, &controller, false)
 	if err != nil {
 		return nil, etcderr.InterpretGetError(err, "replicationController", controllerID)
 	}
@@ -159,7 +159,7 @@ func (r *Registry) CreateController(ctx api.Context, controller *api.Replication
 		return nil, err
 	}
 	out := &api.ReplicationController{}
-	err = r.CreateObj(key, controller, out, 0)
+	err = r.Create(key, controller, out, 0)
 	return out, etcderr.InterpretCreateError(err, "replicationController", controller.Name)
 }
 
@@ -170,7 +170,7 @@ func (r *Registry) UpdateController(ctx api.Context, controller *api.Replication
 		return nil, err
 	}
 	out := &api.ReplicationController{}
-	err = r.SetObj(key, controller, out, 0)
+	err = r.Set(key, controller, out, 0)
 	return out, etcderr.InterpretUpdateError(err, "replicationController", controller.Name)
 }
 
@@ -180,7 +180,7 @@ func (r *Registry) DeleteController(ctx api.Context, controllerID string) error
 	if err != nil {
 		return err
 	}
-	err = r.Delete(key, false)
+	err = r.Delete(key, &api.ReplicationController{})
 	return etcderr.InterpretDeleteError(err, "replicationController", controllerID)
 }
 
@@ -197,7 +197,7 @@ func makeServiceKey(ctx api.Context, name string) (string, error) {
 // ListServices obtains a list of Services.
 func (r *Registry) ListServices(ctx api.Context) (*api.ServiceList, error) {
 	list := &api.ServiceList{}
-	err := r.ExtractToList(makeServiceListKey(ctx), list)
+	err := r.List(makeServiceListKey(ctx), list)
 	return list, err
 }
 
@@ -208,7 +208,7 @@ func (r *Registry) CreateService(ctx api.Context, svc *api.Service) (*api.Servic
 		return nil, err
 	}
 	out := &api.Service{}
-	err = r.CreateObj(key, svc, out, 0)
+	err = r.Create(key, svc, out, 0)
 	return out, etcderr.InterpretCreateError(err, "service", svc.Name)
 }
 
@@ -219,7 +219,7 @@ func (r *Registry) GetService(ctx api.Context, name string) (*api.Service, error
 		return nil, err
 	}
 	var svc api.Service
-	err = r.ExtractObj(key, &svc, false)
+	err = r.Get(key, &svc, false)
 	if err != nil {
 		return nil, etcderr.InterpretGetError(err, "service", name)
 	}
@@ -232,7 +232,7 @@ func (r *Registry) DeleteService(ctx api.Context, name string) error {
 	if err != nil {
 		return err
 	}
-	err = r.Delete(key, true)
+	err = r.Delete(key, &api.Service{})
 	if err != nil {
 		return etcderr.InterpretDeleteError(err, "service", name)
 	}
@@ -253,13 +253,13 @@ func (r *Registry) UpdateService(ctx api.Context, svc *api.Service) (*api.Servic
 		return nil, err
 	}
 	out := &api.Service{}
-	err = r.SetObj(key, svc, out, 0)
+	err = r.Set(key, svc, out, 0)
 	return out, etcderr.InterpretUpdateError(err, "service", svc.Name)
 }
 
 // WatchServices begins watching for new, changed, or deleted service configurations.
 func (r *Registry) WatchServices(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
-	version, err := tools.ParseWatchResourceVersion(resourceVersion, "service")
+	version, err := storage.ParseWatchResourceVersion(resourceVersion, "service")
 	if err != nil {
 		return nil, err
 	}
@@ -272,10 +272,10 @@ func (r *Registry) WatchServices(ctx api.Context, label labels.Selector, field f
 			return nil, err
 		}
 		// TODO: use generic.SelectionPredicate
-		return r.Watch(key, version, tools.Everything)
+		return r.Watch(key, version, storage.Everything)
 	}
 	if field.Empty() {
-		return r.WatchList(makeServiceListKey(ctx), version, tools.Everything)
+		return r.WatchList(makeServiceListKey(ctx), version, storage.Everything)
 	}
 	return nil, fmt.Errorf("only the 'name' and default (everything) field selectors are supported")
 }
@@ -46,8 +46,8 @@ func NewTestEtcdRegistry(client tools.EtcdClient) *Registry {
 
 func NewTestEtcdRegistryWithPods(client tools.EtcdClient) *Registry {
 	helper := tools.NewEtcdHelper(client, latest.Codec, etcdtest.PathPrefix())
-	podStorage := podetcd.NewStorage(helper, nil)
-	endpointStorage := endpointetcd.NewStorage(helper)
+	podStorage := podetcd.NewStorage(helper, false, nil)
+	endpointStorage := endpointetcd.NewStorage(helper, false)
 	registry := NewRegistry(helper, pod.NewRegistry(podStorage.Pod), endpoint.NewRegistry(endpointStorage))
 	return registry
 }
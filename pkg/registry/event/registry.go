@@ -21,7 +21,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
 	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
 )
 
 // registry implements custom changes to generic.Etcd.
@@ -30,8 +30,8 @@ type registry struct {
 }
 
 // NewEtcdRegistry returns a registry which will store Events in the given
-// EtcdHelper. ttl is the time that Events will be retained by the system.
-func NewEtcdRegistry(h tools.EtcdHelper, ttl uint64) generic.Registry {
+// storage. ttl is the time that Events will be retained by the system.
+func NewEtcdRegistry(s storage.Interface, ttl uint64) generic.Registry {
 	prefix := "/events"
 	return registry{
 		Etcd: &etcdgeneric.Etcd{
@@ -47,7 +47,7 @@ func NewEtcdRegistry(h tools.EtcdHelper, ttl uint64) generic.Registry {
 			TTLFunc: func(runtime.Object, uint64, bool) (uint64, error) {
 				return ttl, nil
 			},
-			Helper: h,
+			Storage: s,
 		},
 	}
 }
@@ -29,14 +29,15 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 
 	"github.com/golang/glog"
 )
 
-// Etcd implements generic.Registry, backing it with etcd storage.
+// Etcd implements generic.Registry, backing it with a storage.Interface,
+// usually etcd.
 // It's intended to be embeddable, so that you can implement any
 // non-generic functions if needed.
 // You must supply a value for every field below before use; these are
@@ -102,8 +103,14 @@ type Etcd struct {
 	// success status response.
 	ReturnDeletedObject bool
 
-	// Used for all etcd access functions
-	Helper tools.EtcdHelper
+	// Used for all storage access functions
+	Storage storage.Interface
+
+	// Optional in-memory cache of the resource. If set, watches and lists that
+	// specify a resourceVersion are served from it instead of from Storage. The
+	// cached objects are shared, so a Cacher must not be combined with a
+	// Decorator.
+	Cacher *storage.Cacher
 }
 
 // NamespaceKeyRootFunc is the default function for constructing etcd paths to resource directories enforcing namespace rules.
@@ -141,6 +148,12 @@ func (e *Etcd) NewList() runtime.Object {
 	return e.NewListFunc()
 }
 
+// SupportsDryRun implements rest.DryRunner. Create, Update and Delete run the
+// strategy hooks for a dry run but skip the write and the After* hooks.
+func (e *Etcd) SupportsDryRun() bool {
+	return true
+}
+
 // List returns a list of items matching labels and field
 func (e *Etcd) List(ctx api.Context, label labels.Selector, field fields.Selector) (runtime.Object, error) {
 	return e.ListPredicate(ctx, e.PredicateFunc(label, field))
@@ -157,14 +170,14 @@ func (e *Etcd) ListPredicate(ctx api.Context, m generic.Matcher) (runtime.Object
 		if err != nil {
 			return nil, err
 		}
-		err = e.Helper.ExtractObjToList(key, list)
+		err = e.Storage.GetToList(key, list)
 		trace.Step("Object extracted")
 		if err != nil {
 			return nil, err
 		}
 	} else {
 		trace.Step("About to list directory")
-		err := e.Helper.ExtractToList(e.KeyRootFunc(ctx), list)
+		err := e.Storage.List(e.KeyRootFunc(ctx), list)
 		trace.Step("List extracted")
 		if err != nil {
 			return nil, err
@@ -174,6 +187,34 @@ func (e *Etcd) ListPredicate(ctx api.Context, m generic.Matcher) (runtime.Object
 	return generic.FilterList(list, m, generic.DecoratorFunc(e.Decorator))
 }
 
+// ListFromVersion returns a list of items matching labels and field, as of a
+// state that is at least as fresh as resourceVersion.
+func (e *Etcd) ListFromVersion(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (runtime.Object, error) {
+	return e.ListPredicateFromVersion(ctx, e.PredicateFunc(label, field), resourceVersion)
+}
+
+// ListPredicateFromVersion returns a list of all the items matching m. If
+// resourceVersion is set and the resource is cached, the list is served from
+// the cache once it has caught up with resourceVersion.
+func (e *Etcd) ListPredicateFromVersion(ctx api.Context, m generic.Matcher, resourceVersion string) (runtime.Object, error) {
+	if _, ok := m.MatchesSingle(); ok || e.Cacher == nil || len(resourceVersion) == 0 {
+		return e.ListPredicate(ctx, m)
+	}
+	version, err := storage.ParseListResourceVersion(resourceVersion, e.EndpointName)
+	if err != nil {
+		return nil, err
+	}
+	list := e.NewListFunc()
+	trace := util.NewTrace("List " + reflect.TypeOf(list).String() + " from cache")
+	defer trace.LogIfLong(600 * time.Millisecond)
+	if err := e.Cacher.ListFromMemory(e.KeyRootFunc(ctx), version, list); err != nil {
+		return nil, err
+	}
+	trace.Step("List extracted")
+	defer trace.Step("List filtered")
+	return generic.FilterList(list, m, generic.DecoratorFunc(e.Decorator))
+}
+
 // CreateWithName inserts a new item with the provided name
 // DEPRECATED: use Create instead
 func (e *Etcd) CreateWithName(ctx api.Context, name string, obj runtime.Object) error {
@@ -190,7 +231,7 @@ func (e *Etcd) CreateWithName(ctx api.Context, name string, obj runtime.Object)
 	if err != nil {
 		return err
 	}
-	err = e.Helper.CreateObj(key, obj, nil, ttl)
+	err = e.Storage.Create(key, obj, nil, ttl)
 	err = etcderr.InterpretCreateError(err, e.EndpointName, name)
 	if err == nil && e.Decorator != nil {
 		err = e.Decorator(obj)
@@ -217,9 +258,12 @@ func (e *Etcd) Create(ctx api.Context, obj runtime.Object) (runtime.Object, erro
 	if err != nil {
 		return nil, err
 	}
+	if api.IsDryRun(ctx) {
+		return e.dryRunCreate(key, name, obj)
+	}
 	trace.Step("About to create object")
 	out := e.NewFunc()
-	if err := e.Helper.CreateObj(key, obj, out, ttl); err != nil {
+	if err := e.Storage.Create(key, obj, out, ttl); err != nil {
 		err = etcderr.InterpretCreateError(err, e.EndpointName, name)
 		err = rest.CheckGeneratedNameError(e.CreateStrategy, err, obj)
 		return nil, err
@@ -238,6 +282,28 @@ func (e *Etcd) Create(ctx api.Context, obj runtime.Object) (runtime.Object, erro
 	return out, nil
 }
 
+// dryRunCreate returns obj if it could be created at key, without writing it.
+func (e *Etcd) dryRunCreate(key, name string, obj runtime.Object) (runtime.Object, error) {
+	existing := e.NewFunc()
+	if err := e.Storage.Get(key, existing, true); err != nil {
+		return nil, etcderr.InterpretGetError(err, e.EndpointName, name)
+	}
+	version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 {
+		err := kubeerr.NewAlreadyExists(e.EndpointName, name)
+		return nil, rest.CheckGeneratedNameError(e.CreateStrategy, err, obj)
+	}
+	if e.Decorator != nil {
+		if err := e.Decorator(obj); err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
+}
+
 // UpdateWithName updates the item with the provided name
 // DEPRECATED: use Update instead
 func (e *Etcd) UpdateWithName(ctx api.Context, name string, obj runtime.Object) error {
@@ -249,7 +315,7 @@ func (e *Etcd) UpdateWithName(ctx api.Context, name string, obj runtime.Object)
 	if err != nil {
 		return err
 	}
-	err = e.Helper.SetObj(key, obj, nil, ttl)
+	err = e.Storage.Set(key, obj, nil, ttl)
 	err = etcderr.InterpretUpdateError(err, e.EndpointName, name)
 	if err == nil && e.Decorator != nil {
 		err = e.Decorator(obj)
@@ -274,7 +340,7 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	// If AllowUnconditionalUpdate() is true and the object specified by the user does not have a resource version,
 	// then we populate it with the latest version.
 	// Else, we check that the version specified by the user matches the version of latest etcd object.
-	resourceVersion, err := e.Helper.Versioner.ObjectResourceVersion(obj)
+	resourceVersion, err := e.Storage.Versioner().ObjectResourceVersion(obj)
 	if err != nil {
 		return nil, false, err
 	}
@@ -282,8 +348,8 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	// TODO: expose TTL
 	creating := false
 	out := e.NewFunc()
-	err = e.Helper.GuaranteedUpdate(key, out, true, func(existing runtime.Object, res tools.ResponseMeta) (runtime.Object, *uint64, error) {
-		version, err := e.Helper.Versioner.ObjectResourceVersion(existing)
+	tryUpdate := func(existing runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -305,13 +371,13 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 		creating = false
 		if doUnconditionalUpdate {
 			// Update the object's resource version to match the latest etcd object's resource version.
-			err = e.Helper.Versioner.UpdateObject(obj, res.Expiration, res.ResourceVersion)
+			err = e.Storage.Versioner().UpdateObject(obj, res.Expiration, res.ResourceVersion)
 			if err != nil {
 				return nil, nil, err
 			}
 		} else {
 			// Check if the object's resource version matches the latest resource version.
-			newVersion, err := e.Helper.Versioner.ObjectResourceVersion(obj)
+			newVersion, err := e.Storage.Versioner().ObjectResourceVersion(obj)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -330,7 +396,13 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 			return obj, &ttl, nil
 		}
 		return obj, nil, nil
-	})
+	}
+	dryRun := api.IsDryRun(ctx)
+	if dryRun {
+		out, err = e.dryRunUpdate(key, name, tryUpdate)
+	} else {
+		err = e.Storage.GuaranteedUpdate(key, out, true, tryUpdate)
+	}
 
 	if err != nil {
 		if creating {
@@ -341,13 +413,13 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 		}
 		return nil, false, err
 	}
-	if creating {
+	if creating && !dryRun {
 		if e.AfterCreate != nil {
 			if err := e.AfterCreate(out); err != nil {
 				return nil, false, err
 			}
 		}
-	} else {
+	} else if !dryRun {
 		if e.AfterUpdate != nil {
 			if err := e.AfterUpdate(out); err != nil {
 				return nil, false, err
@@ -362,6 +434,21 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	return out, creating, nil
 }
 
+// dryRunUpdate returns the object tryUpdate would write over the current value at key,
+// without writing it.
+func (e *Etcd) dryRunUpdate(key, name string, tryUpdate storage.UpdateFunc) (runtime.Object, error) {
+	existing := e.NewFunc()
+	if err := e.Storage.Get(key, existing, true); err != nil {
+		return nil, etcderr.InterpretGetError(err, e.EndpointName, name)
+	}
+	version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
+	if err != nil {
+		return nil, err
+	}
+	out, _, err := tryUpdate(existing, storage.ResponseMeta{ResourceVersion: version})
+	return out, err
+}
+
 // Get retrieves the item from etcd.
 func (e *Etcd) Get(ctx api.Context, name string) (runtime.Object, error) {
 	obj := e.NewFunc()
@@ -372,7 +459,7 @@ func (e *Etcd) Get(ctx api.Context, name string) (runtime.Object, error) {
 		return nil, err
 	}
 	trace.Step("About to read object")
-	if err := e.Helper.ExtractObj(key, obj, false); err != nil {
+	if err := e.Storage.Get(key, obj, false); err != nil {
 		return nil, etcderr.InterpretGetError(err, e.EndpointName, name)
 	}
 	trace.Step("Object read")
@@ -395,7 +482,7 @@ func (e *Etcd) Delete(ctx api.Context, name string, options *api.DeleteOptions)
 	trace := util.NewTrace("Delete " + reflect.TypeOf(obj).String())
 	defer trace.LogIfLong(time.Second)
 	trace.Step("About to read object")
-	if err := e.Helper.ExtractObj(key, obj, false); err != nil {
+	if err := e.Storage.Get(key, obj, false); err != nil {
 		return nil, etcderr.InterpretDeleteError(err, e.EndpointName, name)
 	}
 
@@ -407,13 +494,13 @@ func (e *Etcd) Delete(ctx api.Context, name string, options *api.DeleteOptions)
 	if err != nil {
 		return nil, err
 	}
-	if pendingGraceful {
+	if pendingGraceful || api.IsDryRun(ctx) {
 		return e.finalizeDelete(obj, false)
 	}
 	if graceful && *options.GracePeriodSeconds != 0 {
 		trace.Step("Graceful deletion")
 		out := e.NewFunc()
-		if err := e.Helper.SetObj(key, obj, out, uint64(*options.GracePeriodSeconds)); err != nil {
+		if err := e.Storage.Set(key, obj, out, uint64(*options.GracePeriodSeconds)); err != nil {
 			return nil, etcderr.InterpretUpdateError(err, e.EndpointName, name)
 		}
 		return e.finalizeDelete(out, true)
@@ -422,7 +509,7 @@ func (e *Etcd) Delete(ctx api.Context, name string, options *api.DeleteOptions)
 	// delete immediately, or no graceful deletion supported
 	out := e.NewFunc()
 	trace.Step("About to delete object")
-	if err := e.Helper.DeleteObj(key, out); err != nil {
+	if err := e.Storage.Delete(key, out); err != nil {
 		return nil, etcderr.InterpretDeleteError(err, e.EndpointName, name)
 	}
 	return e.finalizeDelete(out, true)
@@ -455,7 +542,7 @@ func (e *Etcd) Watch(ctx api.Context, label labels.Selector, field fields.Select
 
 // WatchPredicate starts a watch for the items that m matches.
 func (e *Etcd) WatchPredicate(ctx api.Context, m generic.Matcher, resourceVersion string) (watch.Interface, error) {
-	version, err := tools.ParseWatchResourceVersion(resourceVersion, e.EndpointName)
+	version, err := storage.ParseWatchResourceVersion(resourceVersion, e.EndpointName)
 	if err != nil {
 		return nil, err
 	}
@@ -480,10 +567,16 @@ func (e *Etcd) WatchPredicate(ctx api.Context, m generic.Matcher, resourceVersio
 		if err != nil {
 			return nil, err
 		}
-		return e.Helper.Watch(key, version, filterFunc)
+		if e.Cacher != nil {
+			return e.Cacher.Watch(key, version, filterFunc)
+		}
+		return e.Storage.Watch(key, version, filterFunc)
 	}
 
-	return e.Helper.WatchList(e.KeyRootFunc(ctx), version, filterFunc)
+	if e.Cacher != nil {
+		return e.Cacher.WatchList(e.KeyRootFunc(ctx), version, filterFunc)
+	}
+	return e.Storage.WatchList(e.KeyRootFunc(ctx), version, filterFunc)
 }
 
 // calculateTTL is a helper for retrieving the updated TTL for an object or returning an error
@@ -26,12 +26,11 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage/memory"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
-
-	"github.com/coreos/go-etcd/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 )
 
 type testRESTStrategy struct {
@@ -66,13 +65,11 @@ func hasCreated(t *testing.T, pod *api.Pod) func(runtime.Object) bool {
 	}
 }
 
-func NewTestGenericEtcdRegistry(t *testing.T) (*tools.FakeEtcdClient, *Etcd) {
-	f := tools.NewFakeEtcdClient(t)
-	f.TestIndex = true
-	h := tools.NewEtcdHelper(f, testapi.Codec(), etcdtest.PathPrefix())
+func NewTestGenericEtcdRegistry(t *testing.T) (*memory.Storage, *Etcd) {
+	s := memory.NewStorage(testapi.Codec())
 	strategy := &testRESTStrategy{api.Scheme, api.SimpleNameGenerator, true, false, true}
 	podPrefix := "/pods"
-	return f, &Etcd{
+	return s, &Etcd{
 		NewFunc:        func() runtime.Object { return &api.Pod{} },
 		NewListFunc:    func() runtime.Object { return &api.PodList{} },
 		EndpointName:   "pods",
@@ -85,8 +82,32 @@ func NewTestGenericEtcdRegistry(t *testing.T) (*tools.FakeEtcdClient, *Etcd) {
 			return path.Join(podPrefix, id), nil
 		},
 		ObjectNameFunc: func(obj runtime.Object) (string, error) { return obj.(*api.Pod).Name, nil },
-		Helper:         h,
+		Storage:        s,
+	}
+}
+
+// createPod stores pod under key, and returns it as stored. The registry
+// fills in the objects it is given, so the tests only ever pass it copies
+// of their pods.
+func createPod(t *testing.T, s storage.Interface, key string, pod *api.Pod) *api.Pod {
+	out := &api.Pod{}
+	if err := s.Create(key, pod, out, 0); err != nil {
+		t.Fatalf("unable to create %s: %v", key, err)
 	}
+	return out
+}
+
+// storedPod returns the pod stored under key, or nil if there is none.
+func storedPod(t *testing.T, s storage.Interface, key string) *api.Pod {
+	out := &api.Pod{}
+	err := s.Get(key, out, false)
+	if storage.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("unable to get %s: %v", key, err)
+	}
+	return out
 }
 
 // setMatcher is a matcher that matches any pod with id in the set.
@@ -132,91 +153,52 @@ func TestEtcdList(t *testing.T) {
 		Spec:       api.PodSpec{NodeName: "machine"},
 	}
 
-	singleElemListResp := &etcd.Response{
-		Node: &etcd.Node{
-			Value: runtime.EncodeOrDie(testapi.Codec(), podA),
-		},
-	}
-	normalListResp := &etcd.Response{
-		Node: &etcd.Node{
-			Nodes: []*etcd.Node{
-				{Value: runtime.EncodeOrDie(testapi.Codec(), podA)},
-				{Value: runtime.EncodeOrDie(testapi.Codec(), podB)},
-			},
-		},
-	}
-
 	table := map[string]struct {
-		in      tools.EtcdResponseWithError
-		m       generic.Matcher
-		out     runtime.Object
-		succeed bool
+		existing []*api.Pod
+		m        generic.Matcher
+		out      runtime.Object
+		succeed  bool
 	}{
 		"empty": {
-			in: tools.EtcdResponseWithError{
-				R: &etcd.Response{
-					Node: &etcd.Node{
-						Nodes: []*etcd.Node{},
-					},
-				},
-				E: nil,
-			},
-			m:       everythingMatcher{},
-			out:     &api.PodList{Items: []api.Pod{}},
-			succeed: true,
-		},
-		"notFound": {
-			in: tools.EtcdResponseWithError{
-				R: &etcd.Response{},
-				E: tools.EtcdErrorNotFound,
-			},
 			m:       everythingMatcher{},
 			out:     &api.PodList{Items: []api.Pod{}},
 			succeed: true,
 		},
 		"normal": {
-			in: tools.EtcdResponseWithError{
-				R: normalListResp,
-				E: nil,
-			},
-			m:       everythingMatcher{},
-			out:     &api.PodList{Items: []api.Pod{*podA, *podB}},
-			succeed: true,
+			existing: []*api.Pod{podA, podB},
+			m:        everythingMatcher{},
+			out:      &api.PodList{Items: []api.Pod{*podB, *podA}},
+			succeed:  true,
 		},
 		"normalFiltered": {
-			in: tools.EtcdResponseWithError{
-				R: singleElemListResp,
-				E: nil,
-			},
-			m:       setMatcher{util.NewStringSet("foo")},
-			out:     &api.PodList{Items: []api.Pod{*podA}},
-			succeed: true,
+			existing: []*api.Pod{podA, podB},
+			m:        setMatcher{util.NewStringSet("foo")},
+			out:      &api.PodList{Items: []api.Pod{*podA}},
+			succeed:  true,
+		},
+		"normalFilteredNotFound": {
+			existing: []*api.Pod{podB},
+			m:        setMatcher{util.NewStringSet("foo")},
+			out:      &api.PodList{Items: []api.Pod{}},
+			succeed:  true,
 		},
 		"normalFilteredMatchMultiple": {
-			in: tools.EtcdResponseWithError{
-				R: normalListResp,
-				E: nil,
-			},
-			m:       setMatcher{util.NewStringSet("foo", "makeMatchSingleReturnFalse")},
-			out:     &api.PodList{Items: []api.Pod{*podA}},
-			succeed: true,
+			existing: []*api.Pod{podA, podB},
+			m:        setMatcher{util.NewStringSet("foo", "makeMatchSingleReturnFalse")},
+			out:      &api.PodList{Items: []api.Pod{*podA}},
+			succeed:  true,
 		},
 	}
 
 	for name, item := range table {
-		fakeClient, registry := NewTestGenericEtcdRegistry(t)
-		if name, ok := item.m.MatchesSingle(); ok {
-			key, err := registry.KeyFunc(api.NewContext(), name)
+		s, registry := NewTestGenericEtcdRegistry(t)
+		for _, pod := range item.existing {
+			key, err := registry.KeyFunc(api.NewContext(), pod.Name)
 			if err != nil {
-				t.Errorf("Couldn't create key for %v", name)
+				t.Errorf("Couldn't create key for %v", pod.Name)
 				continue
 			}
-			key = etcdtest.AddPrefix(key)
-			fakeClient.Data[key] = item.in
-		} else {
-			key, _ := registry.KeyFunc(api.NewContext(), name)
-			key = etcdtest.AddPrefix(key)
-			fakeClient.Data[key] = item.in
+			createPod(t, s, key, pod)
 		}
 		list, err := registry.ListPredicate(api.NewContext(), item.m)
 		if e, a := item.succeed, err == nil; e != a {
@@ -229,73 +211,105 @@ func TestEtcdList(t *testing.T) {
 	}
 }
 
-func TestEtcdCreate(t *testing.T) {
+func TestEtcdListFromVersion(t *testing.T) {
 	podA := &api.Pod{
-		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec:       api.PodSpec{NodeName: "machine"},
 	}
 	podB := &api.Pod{
-		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
-		Spec:       api.PodSpec{NodeName: "machine2"},
+		ObjectMeta: api.ObjectMeta{Name: "bar"},
+		Spec:       api.PodSpec{NodeName: "machine"},
 	}
 
-	nodeWithPodA := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podA),
-				ModifiedIndex: 1,
-				CreatedIndex:  1,
-			},
+	s, registry := NewTestGenericEtcdRegistry(t)
+	createPod(t, s, "/pods/foo", podA)
+	createPod(t, s, "/pods/bar", podB)
+	registry.Cacher = storage.NewCacher(storage.CacherConfig{
+		CacheCapacity:  10,
+		Storage:        s,
+		ResourcePrefix: "/pods",
+		KeyFunc: func(obj runtime.Object) (string, error) {
+			return storage.NoNamespaceKeyFunc("/pods", obj)
 		},
-		E: nil,
+		NewListFunc: registry.NewListFunc,
+	})
+	// Point the registry at an empty storage, so that lists served from the
+	// cache can be told apart.
+	registry.Storage = memory.NewStorage(testapi.Codec())
+
+	list, err := registry.ListPredicateFromVersion(api.NewContext(), setMatcher{util.NewStringSet("foo", "makeMatchSingleReturnFalse")}, "2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	podA.ResourceVersion = "1"
+	if e, a := (&api.PodList{Items: []api.Pod{*podA}}), list; !api.Semantic.DeepDerivative(e, a) {
+		t.Errorf("Expected %#v, got %#v", e, a)
+	}
+	if e, a := "2", list.(*api.PodList).ResourceVersion; e != a {
+		t.Errorf("Expected resource version %s, got %s", e, a)
 	}
 
-	emptyNode := tools.EtcdResponseWithError{
-		R: &etcd.Response{},
-		E: tools.EtcdErrorNotFound,
+	// Lists without a resource version still go to storage.
+	list, err = registry.ListPredicateFromVersion(api.NewContext(), everythingMatcher{}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if items := list.(*api.PodList).Items; len(items) != 0 {
+		t.Errorf("Expected an empty list from storage, got %#v", items)
+	}
+
+	if _, err := registry.ListPredicateFromVersion(api.NewContext(), everythingMatcher{}, "invalid"); err == nil {
+		t.Errorf("Expected an error for an invalid resource version")
+	}
+}
+
+func TestEtcdCreate(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+	podB := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+		Spec:       api.PodSpec{NodeName: "machine2"},
 	}
 
 	table := map[string]struct {
-		existing tools.EtcdResponseWithError
-		expect   tools.EtcdResponseWithError
+		existing *api.Pod
+		expect   *api.Pod
 		toCreate runtime.Object
 		objOK    func(obj runtime.Object) bool
 		errOK    func(error) bool
 	}{
 		"normal": {
-			existing: emptyNode,
 			toCreate: podA,
 			objOK:    hasCreated(t, podA),
 			errOK:    func(err error) bool { return err == nil },
 		},
 		"preExisting": {
-			existing: nodeWithPodA,
-			expect:   nodeWithPodA,
+			existing: podA,
+			expect:   podA,
 			toCreate: podB,
 			errOK:    errors.IsAlreadyExists,
 		},
 	}
 
 	for name, item := range table {
-		fakeClient, registry := NewTestGenericEtcdRegistry(t)
-		path := etcdtest.AddPrefix("pods/foo")
-		fakeClient.Data[path] = item.existing
-		obj, err := registry.Create(api.NewDefaultContext(), item.toCreate)
+		s, registry := NewTestGenericEtcdRegistry(t)
+		path := "/pods/foo"
+		if item.existing != nil {
+			createPod(t, s, path, item.existing)
+		}
+		obj, err := registry.Create(api.NewDefaultContext(), api.Scheme.CopyOrDie(item.toCreate))
 		if !item.errOK(err) {
 			t.Errorf("%v: unexpected error: %v", name, err)
 		}
 
-		actual := fakeClient.Data[path]
+		actual := storedPod(t, s, path)
 		if item.objOK != nil {
 			if !item.objOK(obj) {
 				t.Errorf("%v: unexpected returned: %v", name, obj)
 			}
-			actualObj, err := api.Scheme.Decode([]byte(actual.R.Node.Value))
-			if err != nil {
-				t.Errorf("unable to decode stored value for %#v", actual)
-				continue
-			}
-			if !item.objOK(actualObj) {
+			if actual == nil || !item.objOK(actual) {
 				t.Errorf("%v: unexpected response: %v", name, actual)
 			}
 		} else {
@@ -306,6 +320,50 @@ func TestEtcdCreate(t *testing.T) {
 	}
 }
 
+func TestEtcdCreateDryRun(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+
+	table := map[string]struct {
+		existing *api.Pod
+		objOK    func(obj runtime.Object) bool
+		errOK    func(error) bool
+	}{
+		"normal": {
+			objOK: hasCreated(t, podA),
+			errOK: func(err error) bool { return err == nil },
+		},
+		"preExisting": {
+			existing: podA,
+			errOK:    errors.IsAlreadyExists,
+		},
+	}
+
+	for name, item := range table {
+		s, registry := NewTestGenericEtcdRegistry(t)
+		path := "/pods/foo"
+		var existing *api.Pod
+		if item.existing != nil {
+			existing = createPod(t, s, path, item.existing)
+		}
+		obj, err := registry.Create(api.WithDryRun(api.NewDefaultContext()), &api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+			Spec:       api.PodSpec{NodeName: "machine"},
+		})
+		if !item.errOK(err) {
+			t.Errorf("%v: unexpected error: %v", name, err)
+		}
+		if item.objOK != nil && !item.objOK(obj) {
+			t.Errorf("%v: unexpected returned: %v", name, obj)
+		}
+		if e, a := existing, storedPod(t, s, path); !api.Semantic.DeepEqual(e, a) {
+			t.Errorf("%v: storage was modified:\n%s", name, util.ObjectDiff(e, a))
+		}
+	}
+}
+
 // DEPRECATED
 func TestEtcdCreateWithName(t *testing.T) {
 	podA := &api.Pod{
@@ -317,62 +375,42 @@ func TestEtcdCreateWithName(t *testing.T) {
 		Spec:       api.PodSpec{NodeName: "machine2"},
 	}
 
-	nodeWithPodA := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podA),
-				ModifiedIndex: 1,
-				CreatedIndex:  1,
-			},
-		},
-		E: nil,
-	}
-
-	emptyNode := tools.EtcdResponseWithError{
-		R: &etcd.Response{},
-		E: tools.EtcdErrorNotFound,
-	}
-
 	key := "foo"
 
 	table := map[string]struct {
-		existing tools.EtcdResponseWithError
-		expect   tools.EtcdResponseWithError
+		existing *api.Pod
+		expect   *api.Pod
 		toCreate runtime.Object
 		objOK    func(obj runtime.Object) bool
 		errOK    func(error) bool
 	}{
 		"normal": {
-			existing: emptyNode,
 			toCreate: podA,
 			objOK:    hasCreated(t, podA),
 			errOK:    func(err error) bool { return err == nil },
 		},
 		"preExisting": {
-			existing: nodeWithPodA,
-			expect:   nodeWithPodA,
+			existing: podA,
+			expect:   podA,
 			toCreate: podB,
 			errOK:    errors.IsAlreadyExists,
 		},
 	}
 
 	for name, item := range table {
-		fakeClient, registry := NewTestGenericEtcdRegistry(t)
-		path := etcdtest.AddPrefix("pods/foo")
-		fakeClient.Data[path] = item.existing
-		err := registry.CreateWithName(api.NewDefaultContext(), key, item.toCreate)
+		s, registry := NewTestGenericEtcdRegistry(t)
+		path := "/pods/foo"
+		if item.existing != nil {
+			createPod(t, s, path, item.existing)
+		}
+		err := registry.CreateWithName(api.NewDefaultContext(), key, api.Scheme.CopyOrDie(item.toCreate))
 		if !item.errOK(err) {
 			t.Errorf("%v: unexpected error: %v", name, err)
 		}
 
-		actual := fakeClient.Data[path]
+		actual := storedPod(t, s, path)
 		if item.objOK != nil {
-			obj, err := api.Scheme.Decode([]byte(actual.R.Node.Value))
-			if err != nil {
-				t.Errorf("unable to decode stored value for %#v", actual)
-				continue
-			}
-			if !item.objOK(obj) {
+			if actual == nil || !item.objOK(actual) {
 				t.Errorf("%v: unexpected response: %v", name, actual)
 			}
 		} else {
@@ -392,61 +430,19 @@ func TestEtcdUpdate(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
 		Spec:       api.PodSpec{NodeName: "machine2"},
 	}
-	podAWithResourceVersion := &api.Pod{
-		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault, ResourceVersion: "3"},
-		Spec:       api.PodSpec{NodeName: "machine"},
-	}
-	nodeWithPodA := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podA),
-				ModifiedIndex: 1,
-				CreatedIndex:  1,
-			},
-		},
-		E: nil,
-	}
-
-	newerNodeWithPodA := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podA),
-				ModifiedIndex: 2,
-				CreatedIndex:  1,
-			},
-		},
-		E: nil,
-	}
-
-	nodeWithPodB := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podB),
-				ModifiedIndex: 1,
-				CreatedIndex:  1,
-			},
-		},
-		E: nil,
-	}
-
-	nodeWithPodAWithResourceVersion := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podAWithResourceVersion),
-				ModifiedIndex: 3,
-				CreatedIndex:  1,
-			},
-		},
-		E: nil,
+	podBStored := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault, ResourceVersion: "2"},
+		Spec:       api.PodSpec{NodeName: "machine2"},
 	}
-	emptyNode := tools.EtcdResponseWithError{
-		R: &etcd.Response{},
-		E: tools.EtcdErrorNotFound,
+	newerPodAStored := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault, ResourceVersion: "2"},
+		Spec:       api.PodSpec{NodeName: "machine"},
 	}
 
 	table := map[string]struct {
-		existing                 tools.EtcdResponseWithError
-		expect                   tools.EtcdResponseWithError
+		existing                 *api.Pod
+		rewrites                 int
+		expect                   *api.Pod
 		toUpdate                 runtime.Object
 		allowCreate              bool
 		allowUnconditionalUpdate bool
@@ -454,32 +450,31 @@ func TestEtcdUpdate(t *testing.T) {
 		errOK                    func(error) bool
 	}{
 		"normal": {
-			existing: nodeWithPodA,
-			expect:   nodeWithPodB,
+			existing: podA,
+			expect:   podBStored,
 			toUpdate: podB,
 			errOK:    func(err error) bool { return err == nil },
 		},
 		"notExisting": {
-			existing: emptyNode,
-			expect:   emptyNode,
 			toUpdate: podA,
 			errOK:    func(err error) bool { return errors.IsNotFound(err) },
 		},
 		"createIfNotFound": {
-			existing:    emptyNode,
 			toUpdate:    podA,
 			allowCreate: true,
 			objOK:       hasCreated(t, podA),
 			errOK:       func(err error) bool { return err == nil },
 		},
 		"outOfDate": {
-			existing: newerNodeWithPodA,
-			expect:   newerNodeWithPodA,
+			existing: podA,
+			rewrites: 1,
+			expect:   newerPodAStored,
 			toUpdate: podB,
 			errOK:    func(err error) bool { return errors.IsConflict(err) },
 		},
 		"unconditionalUpdate": {
-			existing:                 nodeWithPodAWithResourceVersion,
+			existing:                 podA,
+			rewrites:                 2,
 			allowUnconditionalUpdate: true,
 			toUpdate:                 podA,
 			objOK:                    func(obj runtime.Object) bool { return true },
@@ -488,92 +483,129 @@ func TestEtcdUpdate(t *testing.T) {
 	}
 
 	for name, item := range table {
-		fakeClient, registry := NewTestGenericEtcdRegistry(t)
+		s, registry := NewTestGenericEtcdRegistry(t)
 		registry.UpdateStrategy.(*testRESTStrategy).allowCreateOnUpdate = item.allowCreate
 		registry.UpdateStrategy.(*testRESTStrategy).allowUnconditionalUpdate = item.allowUnconditionalUpdate
-		path := etcdtest.AddPrefix("pods/foo")
-		fakeClient.Data[path] = item.existing
-		obj, _, err := registry.Update(api.NewDefaultContext(), item.toUpdate)
+		path := "/pods/foo"
+		if item.existing != nil {
+			stored := createPod(t, s, path, item.existing)
+			for i := 0; i < item.rewrites; i++ {
+				if err := s.Set(path, stored, stored, 0); err != nil {
+					t.Fatalf("%v: unable to rewrite %s: %v", name, path, err)
+				}
+			}
+		}
+		obj, _, err := registry.Update(api.NewDefaultContext(), api.Scheme.CopyOrDie(item.toUpdate))
 		if !item.errOK(err) {
 			t.Errorf("%v: unexpected error: %v", name, err)
 		}
 
-		actual := fakeClient.Data[path]
+		actual := storedPod(t, s, path)
 		if item.objOK != nil {
 			if !item.objOK(obj) {
 				t.Errorf("%v: unexpected returned: %#v", name, obj)
 			}
-			actualObj, err := api.Scheme.Decode([]byte(actual.R.Node.Value))
-			if err != nil {
-				t.Errorf("unable to decode stored value for %#v", actual)
-				continue
-			}
-			if !item.objOK(actualObj) {
+			if actual == nil || !item.objOK(actual) {
 				t.Errorf("%v: unexpected response: %#v", name, actual)
 			}
 		} else {
-			if e, a := item.expect, actual; !api.Semantic.DeepDerivative(e, a) {
+			if e, a := item.expect, actual; (e == nil) != (a == nil) || !api.Semantic.DeepDerivative(e, a) {
 				t.Errorf("%v:\n%s", name, util.ObjectDiff(e, a))
 			}
 		}
 	}
 }
 
-// DEPRECATED
-func TestEtcdUpdateWithName(t *testing.T) {
+func TestEtcdUpdateDryRun(t *testing.T) {
 	podA := &api.Pod{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec:       api.PodSpec{NodeName: "machine"},
 	}
-	podB := &api.Pod{
-		ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"},
-		Spec:       api.PodSpec{NodeName: "machine2"},
-	}
 
-	nodeWithPodA := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podA),
-				ModifiedIndex: 1,
-				CreatedIndex:  1,
-			},
+	table := map[string]struct {
+		existing    *api.Pod
+		version     string
+		allowCreate bool
+		created     bool
+		errOK       func(error) bool
+	}{
+		"normal": {
+			existing: podA,
+			version:  "1",
+			errOK:    func(err error) bool { return err == nil },
+		},
+		"outOfDate": {
+			existing: podA,
+			version:  "2",
+			errOK:    errors.IsConflict,
+		},
+		"notExisting": {
+			version: "1",
+			errOK:   errors.IsNotFound,
+		},
+		"createIfNotFound": {
+			allowCreate: true,
+			created:     true,
+			errOK:       func(err error) bool { return err == nil },
 		},
-		E: nil,
 	}
 
-	nodeWithPodB := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podB),
-				ModifiedIndex: 1,
-				CreatedIndex:  1,
-			},
-		},
-		E: nil,
+	for name, item := range table {
+		s, registry := NewTestGenericEtcdRegistry(t)
+		registry.UpdateStrategy.(*testRESTStrategy).allowCreateOnUpdate = item.allowCreate
+		path := "/pods/foo"
+		var existing *api.Pod
+		if item.existing != nil {
+			existing = createPod(t, s, path, item.existing)
+		}
+		toUpdate := &api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault, ResourceVersion: item.version},
+			Spec:       api.PodSpec{NodeName: "machine2"},
+		}
+		obj, created, err := registry.Update(api.WithDryRun(api.NewDefaultContext()), toUpdate)
+		if !item.errOK(err) {
+			t.Errorf("%v: unexpected error: %v", name, err)
+			continue
+		}
+		if created != item.created {
+			t.Errorf("%v: expected created %t, got %t", name, item.created, created)
+		}
+		if err == nil && obj.(*api.Pod).Spec.NodeName != "machine2" {
+			t.Errorf("%v: unexpected returned: %v", name, obj)
+		}
+		if e, a := existing, storedPod(t, s, path); !api.Semantic.DeepEqual(e, a) {
+			t.Errorf("%v: storage was modified:\n%s", name, util.ObjectDiff(e, a))
+		}
 	}
+}
 
-	emptyNode := tools.EtcdResponseWithError{
-		R: &etcd.Response{},
-		E: tools.EtcdErrorNotFound,
+// DEPRECATED
+func TestEtcdUpdateWithName(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+	podB := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"},
+		Spec:       api.PodSpec{NodeName: "machine2"},
 	}
 
 	key := "foo"
 
 	table := map[string]struct {
-		existing tools.EtcdResponseWithError
-		expect   tools.EtcdResponseWithError
+		existing *api.Pod
+		expect   *api.Pod
 		toUpdate runtime.Object
 		errOK    func(error) bool
 	}{
 		"normal": {
-			existing: nodeWithPodA,
-			expect:   nodeWithPodB,
+			existing: podA,
+			expect:   &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "2"}, Spec: podB.Spec},
 			toUpdate: podB,
 			errOK:    func(err error) bool { return err == nil },
 		},
 		"notExisting": {
-			existing: emptyNode,
-			expect:   nodeWithPodA,
+			expect:   &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"}, Spec: podA.Spec},
 			toUpdate: podA,
 			// TODO: Should updating a non-existing thing fail?
 			errOK: func(err error) bool { return err == nil },
@@ -581,15 +613,17 @@ func TestEtcdUpdateWithName(t *testing.T) {
 	}
 
 	for name, item := range table {
-		fakeClient, registry := NewTestGenericEtcdRegistry(t)
-		path := etcdtest.AddPrefix("pods/foo")
-		fakeClient.Data[path] = item.existing
-		err := registry.UpdateWithName(api.NewContext(), key, item.toUpdate)
+		s, registry := NewTestGenericEtcdRegistry(t)
+		path := "/pods/foo"
+		if item.existing != nil {
+			createPod(t, s, path, item.existing)
+		}
+		err := registry.UpdateWithName(api.NewContext(), key, api.Scheme.CopyOrDie(item.toUpdate))
 		if !item.errOK(err) {
 			t.Errorf("%v: unexpected error: %v", name, err)
 		}
 
-		if e, a := item.expect, fakeClient.Data[path]; !api.Semantic.DeepDerivative(e, a) {
+		if e, a := item.expect, storedPod(t, s, path); a == nil || !api.Semantic.DeepDerivative(e, a) {
 			t.Errorf("%v:\n%s", name, util.ObjectDiff(e, a))
 		}
 	}
@@ -597,49 +631,37 @@ func TestEtcdUpdateWithName(t *testing.T) {
 
 func TestEtcdGet(t *testing.T) {
 	podA := &api.Pod{
-		ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"},
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec:       api.PodSpec{NodeName: "machine"},
 	}
-
-	nodeWithPodA := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podA),
-				ModifiedIndex: 1,
-				CreatedIndex:  1,
-			},
-		},
-		E: nil,
-	}
-
-	emptyNode := tools.EtcdResponseWithError{
-		R: &etcd.Response{},
-		E: tools.EtcdErrorNotFound,
+	podAStored := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"},
+		Spec:       api.PodSpec{NodeName: "machine"},
 	}
 
 	key := "foo"
 
 	table := map[string]struct {
-		existing tools.EtcdResponseWithError
+		existing *api.Pod
 		expect   runtime.Object
 		errOK    func(error) bool
 	}{
 		"normal": {
-			existing: nodeWithPodA,
-			expect:   podA,
+			existing: podA,
+			expect:   podAStored,
 			errOK:    func(err error) bool { return err == nil },
 		},
 		"notExisting": {
-			existing: emptyNode,
-			expect:   nil,
-			errOK:    errors.IsNotFound,
+			expect: nil,
+			errOK:  errors.IsNotFound,
 		},
 	}
 
 	for name, item := range table {
-		fakeClient, registry := NewTestGenericEtcdRegistry(t)
-		path := etcdtest.AddPrefix("pods/foo")
-		fakeClient.Data[path] = item.existing
+		s, registry := NewTestGenericEtcdRegistry(t)
+		if item.existing != nil {
+			createPod(t, s, "/pods/foo", item.existing)
+		}
 		got, err := registry.Get(api.NewContext(), key)
 		if !item.errOK(err) {
 			t.Errorf("%v: unexpected error: %v", name, err)
@@ -653,63 +675,64 @@ func TestEtcdGet(t *testing.T) {
 
 func TestEtcdDelete(t *testing.T) {
 	podA := &api.Pod{
-		ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"},
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec:       api.PodSpec{NodeName: "machine"},
 	}
 
-	nodeWithPodA := tools.EtcdResponseWithError{
-		R: &etcd.Response{
-			Node: &etcd.Node{
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podA),
-				ModifiedIndex: 1,
-				CreatedIndex:  1,
-			},
-		},
-		E: nil,
-	}
-
-	emptyNode := tools.EtcdResponseWithError{
-		R: &etcd.Response{},
-		E: tools.EtcdErrorNotFound,
-	}
-
 	key := "foo"
 
 	table := map[string]struct {
-		existing tools.EtcdResponseWithError
-		expect   tools.EtcdResponseWithError
+		existing *api.Pod
 		errOK    func(error) bool
 	}{
 		"normal": {
-			existing: nodeWithPodA,
-			expect:   emptyNode,
+			existing: podA,
 			errOK:    func(err error) bool { return err == nil },
 		},
 		"notExisting": {
-			existing: emptyNode,
-			expect:   emptyNode,
-			errOK:    func(err error) bool { return errors.IsNotFound(err) },
+			errOK: func(err error) bool { return errors.IsNotFound(err) },
 		},
 	}
 
 	for name, item := range table {
-		fakeClient, registry := NewTestGenericEtcdRegistry(t)
-		path := etcdtest.AddPrefix("pods/foo")
-		fakeClient.Data[path] = item.existing
+		s, registry := NewTestGenericEtcdRegistry(t)
+		path := "/pods/foo"
+		if item.existing != nil {
+			createPod(t, s, path, item.existing)
+		}
 		obj, err := registry.Delete(api.NewContext(), key, nil)
 		if !item.errOK(err) {
 			t.Errorf("%v: unexpected error: %v (%#v)", name, err, obj)
 		}
 
-		if item.expect.E != nil {
-			item.expect.E.(*etcd.EtcdError).Index = fakeClient.ChangeIndex
-		}
-		if e, a := item.expect, fakeClient.Data[path]; !api.Semantic.DeepDerivative(e, a) {
-			t.Errorf("%v:\n%s", name, util.ObjectDiff(e, a))
+		if a := storedPod(t, s, path); a != nil {
+			t.Errorf("%v: expected %s to be deleted, got %#v", name, path, a)
 		}
 	}
 }
 
+func TestEtcdDeleteDryRun(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+
+	s, registry := NewTestGenericEtcdRegistry(t)
+	path := "/pods/foo"
+	existing := createPod(t, s, path, podA)
+	if _, err := registry.Delete(api.WithDryRun(api.NewContext()), "foo", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := existing, storedPod(t, s, path); !api.Semantic.DeepEqual(e, a) {
+		t.Errorf("storage was modified:\n%s", util.ObjectDiff(e, a))
+	}
+
+	_, err := registry.Delete(api.WithDryRun(api.NewContext()), "bar", nil)
+	if !errors.IsNotFound(err) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
 func TestEtcdWatch(t *testing.T) {
 	table := map[string]generic.Matcher{
 		"single": setMatcher{util.NewStringSet("foo")},
@@ -719,33 +742,25 @@ func TestEtcdWatch(t *testing.T) {
 	for name, m := range table {
 		podA := &api.Pod{
 			ObjectMeta: api.ObjectMeta{
-				Name:            "foo",
-				Namespace:       api.NamespaceDefault,
-				ResourceVersion: "1",
+				Name:      "foo",
+				Namespace: api.NamespaceDefault,
 			},
 			Spec: api.PodSpec{NodeName: "machine"},
 		}
-		respWithPodA := &etcd.Response{
-			Node: &etcd.Node{
-				Key:           "/registry/pods/default/foo",
-				Value:         runtime.EncodeOrDie(testapi.Codec(), podA),
-				ModifiedIndex: 1,
-				CreatedIndex:  1,
-			},
-			Action: "create",
-		}
 
-		fakeClient, registry := NewTestGenericEtcdRegistry(t)
+		s, registry := NewTestGenericEtcdRegistry(t)
+		stored := createPod(t, s, "/pods/foo", podA)
+		// Only changes after version 1 are sent.
 		wi, err := registry.WatchPredicate(api.NewContext(), m, "1")
 		if err != nil {
 			t.Errorf("%v: unexpected error: %v", name, err)
 			continue
 		}
-		fakeClient.WaitForWatchCompletion()
 
-		go func() {
-			fakeClient.WatchResponse <- respWithPodA
-		}()
+		stored.Spec.NodeName = "machine2"
+		if err := s.Set("/pods/foo", stored, stored, 0); err != nil {
+			t.Fatalf("%v: unexpected error: %v", name, err)
+		}
 
 		got, open := <-wi.ResultChan()
 		if !open {
@@ -753,8 +768,12 @@ func TestEtcdWatch(t *testing.T) {
 			continue
 		}
 
-		if e, a := podA, got.Object; !api.Semantic.DeepDerivative(e, a) {
+		if e, a := watch.Modified, got.Type; e != a {
+			t.Errorf("%v: expected %v, got %v", name, e, a)
+		}
+		if e, a := stored, got.Object; !api.Semantic.DeepDerivative(e, a) {
 			t.Errorf("%v: difference: %s", name, util.ObjectDiff(e, a))
 		}
+		wi.Stop()
 	}
 }
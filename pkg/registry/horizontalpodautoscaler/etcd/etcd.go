@@ -0,0 +1,94 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/horizontalpodautoscaler"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
+)
+
+// REST implements a RESTStorage for autoscalers against etcd
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+// autoscalerPrefix is the location for autoscalers in etcd, only exposed
+// for testing
+var autoscalerPrefix = "/horizontalpodautoscalers"
+
+// NewStorage returns a RESTStorage object that will work against autoscalers,
+// and a StatusREST object for updating their status.
+func NewStorage(s storage.Interface) (*REST, *StatusREST) {
+	store := &etcdgeneric.Etcd{
+		NewFunc: func() runtime.Object { return &api.HorizontalPodAutoscaler{} },
+
+		// NewListFunc returns an object capable of storing results of an etcd list.
+		NewListFunc: func() runtime.Object { return &api.HorizontalPodAutoscalerList{} },
+		// Produces a path that etcd understands, to the root of the resource
+		// by combining the namespace in the context with the given prefix
+		KeyRootFunc: func(ctx api.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, autoscalerPrefix)
+		},
+		// Produces a path that etcd understands, to the resource by combining
+		// the namespace in the context with the given prefix
+		KeyFunc: func(ctx api.Context, name string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, autoscalerPrefix, name)
+		},
+		// Retrieve the name field of an autoscaler
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.HorizontalPodAutoscaler).Name, nil
+		},
+		// Used to match objects based on labels/fields for list and watch
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return horizontalpodautoscaler.MatchHorizontalPodAutoscaler(label, field)
+		},
+		EndpointName: "horizontalpodautoscalers",
+
+		// Used to validate autoscaler creation
+		CreateStrategy: horizontalpodautoscaler.Strategy,
+
+		// Used to validate autoscaler updates
+		UpdateStrategy: horizontalpodautoscaler.Strategy,
+
+		Storage: s,
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = horizontalpodautoscaler.StatusStrategy
+
+	return &REST{store}, &StatusREST{store: &statusStore}
+}
+
+// StatusREST implements the REST endpoint for changing the status of an autoscaler.
+type StatusREST struct {
+	store *etcdgeneric.Etcd
+}
+
+func (r *StatusREST) New() runtime.Object {
+	return &api.HorizontalPodAutoscaler{}
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, obj)
+}
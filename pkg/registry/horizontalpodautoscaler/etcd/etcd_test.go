@@ -0,0 +1,151 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, *tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
+	storage, statusStorage := NewStorage(helper)
+	return storage, statusStorage, fakeEtcdClient, helper
+}
+
+func validNewHorizontalPodAutoscaler() *api.HorizontalPodAutoscaler {
+	minReplicas := 1
+	return &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foo",
+			Namespace: api.NamespaceDefault,
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef: api.ScaleReference{
+				Kind: "ReplicationController",
+				Name: "bar",
+			},
+			MinReplicas:    &minReplicas,
+			MaxReplicas:    5,
+			CPUUtilization: &api.CPUTargetUtilization{TargetPercentage: 70},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, _, fakeEtcdClient, _ := newStorage(t)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	validHorizontalPodAutoscaler := validNewHorizontalPodAutoscaler()
+	validHorizontalPodAutoscaler.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		validHorizontalPodAutoscaler,
+		// invalid
+		&api.HorizontalPodAutoscaler{
+			Spec: api.HorizontalPodAutoscalerSpec{},
+		},
+	)
+}
+
+func TestCreateClearsStatus(t *testing.T) {
+	storage, _, _, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	a := validNewHorizontalPodAutoscaler()
+	a.Status.CurrentReplicas = 3
+	if _, err := storage.Create(ctx, a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var aOut api.HorizontalPodAutoscaler
+	key, _ := storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &aOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if aOut.Status.CurrentReplicas != 0 {
+		t.Errorf("expected status to be cleared on create, got %#v", aOut.Status)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	storage, statusStorage, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+
+	key, _ := storage.KeyFunc(ctx, "foo")
+	key = etcdtest.AddPrefix(key)
+	aStart := validNewHorizontalPodAutoscaler()
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, aStart), 1)
+
+	a := validNewHorizontalPodAutoscaler()
+	a.ResourceVersion = "1"
+	a.Spec.MaxReplicas = 10
+	a.Status = api.HorizontalPodAutoscalerStatus{CurrentReplicas: 3, DesiredReplicas: 4}
+
+	expected := *aStart
+	expected.ResourceVersion = "2"
+	expected.Status = a.Status
+
+	if _, _, err := statusStorage.Update(ctx, a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var aOut api.HorizontalPodAutoscaler
+	key, _ = storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &aOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(expected, aOut) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(expected, aOut))
+	}
+}
+
+func TestEtcdUpdateIgnoresStatus(t *testing.T) {
+	storage, _, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+
+	key, _ := storage.KeyFunc(ctx, "foo")
+	key = etcdtest.AddPrefix(key)
+	aStart := validNewHorizontalPodAutoscaler()
+	aStart.Status.DesiredReplicas = 1
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, aStart), 1)
+
+	a := validNewHorizontalPodAutoscaler()
+	a.ResourceVersion = "1"
+	a.Spec.MaxReplicas = 10
+	a.Status.DesiredReplicas = 7
+
+	if _, _, err := storage.Update(ctx, a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var aOut api.HorizontalPodAutoscaler
+	key, _ = storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &aOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if aOut.Spec.MaxReplicas != 10 {
+		t.Errorf("expected max replicas to be updated, got %v", aOut.Spec.MaxReplicas)
+	}
+	if aOut.Status.DesiredReplicas != 1 {
+		t.Errorf("expected status to be preserved, got %#v", aOut.Status)
+	}
+}
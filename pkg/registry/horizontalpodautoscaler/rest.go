@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package horizontalpodautoscaler
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// autoscalerStrategy implements verification logic for HorizontalPodAutoscalers.
+type autoscalerStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating HorizontalPodAutoscaler objects.
+var Strategy = autoscalerStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped returns true because all autoscalers need to be within a namespace.
+func (autoscalerStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears the status of an autoscaler before creation.
+func (autoscalerStrategy) PrepareForCreate(obj runtime.Object) {
+	autoscaler := obj.(*api.HorizontalPodAutoscaler)
+	autoscaler.Status = api.HorizontalPodAutoscalerStatus{}
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (autoscalerStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newAutoscaler := obj.(*api.HorizontalPodAutoscaler)
+	oldAutoscaler := old.(*api.HorizontalPodAutoscaler)
+	newAutoscaler.Status = oldAutoscaler.Status
+}
+
+// Validate validates a new autoscaler.
+func (autoscalerStrategy) Validate(ctx api.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	autoscaler := obj.(*api.HorizontalPodAutoscaler)
+	return validation.ValidateHorizontalPodAutoscaler(autoscaler)
+}
+
+// AllowCreateOnUpdate is false for autoscalers; this means a POST is
+// needed to create one.
+func (autoscalerStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (autoscalerStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	validationErrorList := validation.ValidateHorizontalPodAutoscaler(obj.(*api.HorizontalPodAutoscaler))
+	updateErrorList := validation.ValidateHorizontalPodAutoscalerUpdate(old.(*api.HorizontalPodAutoscaler), obj.(*api.HorizontalPodAutoscaler))
+	return append(validationErrorList, updateErrorList...)
+}
+
+func (autoscalerStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+type autoscalerStatusStrategy struct {
+	autoscalerStrategy
+}
+
+// StatusStrategy is the logic that applies when updating the status of a HorizontalPodAutoscaler.
+var StatusStrategy = autoscalerStatusStrategy{Strategy}
+
+// PrepareForUpdate sets the Spec field which is not allowed to be changed when updating an autoscaler's Status.
+func (autoscalerStatusStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newAutoscaler := obj.(*api.HorizontalPodAutoscaler)
+	oldAutoscaler := old.(*api.HorizontalPodAutoscaler)
+	newAutoscaler.Spec = oldAutoscaler.Spec
+}
+
+func (autoscalerStatusStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateHorizontalPodAutoscalerStatusUpdate(old.(*api.HorizontalPodAutoscaler), obj.(*api.HorizontalPodAutoscaler))
+}
+
+// HorizontalPodAutoscalerToSelectableFields returns a field set that represents the object.
+func HorizontalPodAutoscalerToSelectableFields(autoscaler *api.HorizontalPodAutoscaler) fields.Set {
+	return fields.Set{
+		"metadata.name": autoscaler.Name,
+	}
+}
+
+// MatchHorizontalPodAutoscaler is the filter used by the generic etcd backend to route
+// watch events from etcd to clients of the apiserver only interested in specific
+// labels/fields.
+func MatchHorizontalPodAutoscaler(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{
+		Label: label,
+		Field: field,
+		GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, error) {
+			autoscaler, ok := obj.(*api.HorizontalPodAutoscaler)
+			if !ok {
+				return nil, nil, fmt.Errorf("Given object is not an autoscaler.")
+			}
+			return labels.Set(autoscaler.ObjectMeta.Labels), HorizontalPodAutoscalerToSelectableFields(autoscaler), nil
+		},
+	}
+}
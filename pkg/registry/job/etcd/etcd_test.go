@@ -0,0 +1,203 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, *tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
+	storage, statusStorage := NewStorage(helper)
+	return storage, statusStorage, fakeEtcdClient, helper
+}
+
+func validNewJob() *api.Job {
+	completions := 1
+	parallelism := 1
+	return &api.Job{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foo",
+			Namespace: api.NamespaceDefault,
+			Labels:    map[string]string{"a": "b"},
+		},
+		Spec: api.JobSpec{
+			Completions: &completions,
+			Parallelism: &parallelism,
+			Selector:    map[string]string{"a": "b"},
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: map[string]string{"a": "b"},
+				},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Name:                   "test",
+							Image:                  "test_image",
+							ImagePullPolicy:        api.PullIfNotPresent,
+							TerminationMessagePath: api.TerminationMessagePathDefault,
+						},
+					},
+					RestartPolicy: api.RestartPolicyOnFailure,
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, _, fakeEtcdClient, _ := newStorage(t)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	validJob := validNewJob()
+	validJob.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		validJob,
+		// invalid
+		&api.Job{
+			Spec: api.JobSpec{},
+		},
+	)
+}
+
+func TestCreateClearsStatus(t *testing.T) {
+	storage, _, _, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	job := validNewJob()
+	job.Status.Succeeded = 3
+	if _, err := storage.Create(ctx, job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var jobOut api.Job
+	key, _ := storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &jobOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if jobOut.Status.Succeeded != 0 {
+		t.Errorf("expected status to be cleared on create, got %#v", jobOut.Status)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	storage, statusStorage, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+
+	key, _ := storage.KeyFunc(ctx, "foo")
+	key = etcdtest.AddPrefix(key)
+	jobStart := validNewJob()
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, jobStart), 1)
+
+	job := validNewJob()
+	job.ResourceVersion = "1"
+	parallelism := 5
+	job.Spec.Parallelism = &parallelism
+	job.Status = api.JobStatus{Active: 1, Succeeded: 2, Failed: 3}
+
+	expected := *jobStart
+	expected.ResourceVersion = "2"
+	expected.Status = job.Status
+
+	if _, _, err := statusStorage.Update(ctx, job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var jobOut api.Job
+	key, _ = storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &jobOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(expected, jobOut) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(expected, jobOut))
+	}
+}
+
+func TestEtcdUpdateIgnoresStatus(t *testing.T) {
+	storage, _, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+
+	key, _ := storage.KeyFunc(ctx, "foo")
+	key = etcdtest.AddPrefix(key)
+	jobStart := validNewJob()
+	jobStart.Status.Succeeded = 1
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, jobStart), 1)
+
+	job := validNewJob()
+	job.ResourceVersion = "1"
+	parallelism := 5
+	job.Spec.Parallelism = &parallelism
+	job.Status.Succeeded = 7
+
+	if _, _, err := storage.Update(ctx, job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var jobOut api.Job
+	key, _ = storage.KeyFunc(ctx, "foo")
+	if err := helper.Get(key, &jobOut, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *jobOut.Spec.Parallelism != 5 {
+		t.Errorf("expected parallelism to be updated, got %d", *jobOut.Spec.Parallelism)
+	}
+	if jobOut.Status.Succeeded != 1 {
+		t.Errorf("expected status to be preserved, got %#v", jobOut.Status)
+	}
+}
+
+func TestEtcdListJobsSelector(t *testing.T) {
+	storage, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+
+	first := validNewJob()
+	first.Labels = map[string]string{"app": "batch"}
+	second := validNewJob()
+	second.Name = "bar"
+	key := etcdtest.AddPrefix(storage.KeyRootFunc(ctx))
+	fakeClient.ChangeIndex = 1
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{Value: runtime.EncodeOrDie(latest.Codec, first)},
+					{Value: runtime.EncodeOrDie(latest.Codec, second)},
+				},
+			},
+		},
+	}
+
+	obj, err := storage.List(ctx, labels.SelectorFromSet(labels.Set{"app": "batch"}), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	jobs := obj.(*api.JobList)
+	if len(jobs.Items) != 1 || jobs.Items[0].Name != "foo" {
+		t.Errorf("unexpected list result: %#v", jobs.Items)
+	}
+}
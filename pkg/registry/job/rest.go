@@ -0,0 +1,123 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// jobStrategy implements verification logic for Jobs.
+type jobStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating Job objects.
+var Strategy = jobStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped returns true because all jobs need to be within a namespace.
+func (jobStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears the status of a job before creation.
+func (jobStrategy) PrepareForCreate(obj runtime.Object) {
+	job := obj.(*api.Job)
+	job.Status = api.JobStatus{}
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (jobStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newJob := obj.(*api.Job)
+	oldJob := old.(*api.Job)
+	newJob.Status = oldJob.Status
+}
+
+// Validate validates a new job.
+func (jobStrategy) Validate(ctx api.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	job := obj.(*api.Job)
+	return validation.ValidateJob(job)
+}
+
+// AllowCreateOnUpdate is false for jobs; this means a POST is
+// needed to create one.
+func (jobStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (jobStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	validationErrorList := validation.ValidateJob(obj.(*api.Job))
+	updateErrorList := validation.ValidateJobUpdate(old.(*api.Job), obj.(*api.Job))
+	return append(validationErrorList, updateErrorList...)
+}
+
+func (jobStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+type jobStatusStrategy struct {
+	jobStrategy
+}
+
+// StatusStrategy is the logic that applies when updating the status of a Job.
+var StatusStrategy = jobStatusStrategy{Strategy}
+
+// PrepareForUpdate sets the Spec field which is not allowed to be changed when updating a job's Status.
+func (jobStatusStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newJob := obj.(*api.Job)
+	oldJob := old.(*api.Job)
+	newJob.Spec = oldJob.Spec
+}
+
+func (jobStatusStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateJobStatusUpdate(old.(*api.Job), obj.(*api.Job))
+}
+
+// JobToSelectableFields returns a field set that represents the object.
+func JobToSelectableFields(job *api.Job) fields.Set {
+	return fields.Set{
+		"metadata.name":    job.Name,
+		"status.succeeded": strconv.Itoa(job.Status.Succeeded),
+	}
+}
+
+// MatchJob is the filter used by the generic etcd backend to route
+// watch events from etcd to clients of the apiserver only interested in specific
+// labels/fields.
+func MatchJob(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{
+		Label: label,
+		Field: field,
+		GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, error) {
+			job, ok := obj.(*api.Job)
+			if !ok {
+				return nil, nil, fmt.Errorf("Given object is not a job.")
+			}
+			return labels.Set(job.ObjectMeta.Labels), JobToSelectableFields(job), nil
+		},
+	}
+}
@@ -33,14 +33,14 @@ import (
 	"github.com/coreos/go-etcd/etcd"
 )
 
-func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, *tools.EtcdHelper) {
 	fakeEtcdClient := tools.NewFakeEtcdClient(t)
 	fakeEtcdClient.TestIndex = true
 	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
 	return fakeEtcdClient, helper
 }
 
-func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient, *tools.EtcdHelper) {
 	fakeEtcdClient, h := newHelper(t)
 	storage, _, _ := NewStorage(h)
 	return storage, fakeEtcdClient, h
@@ -108,7 +108,7 @@ func TestCreateSetsFields(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected key error: %v", err)
 	}
-	if err := helper.ExtractObj(key, actual, false); err != nil {
+	if err := helper.Get(key, actual, false); err != nil {
 		t.Fatalf("unexpected extraction error: %v", err)
 	}
 	if actual.Name != namespace.Name {
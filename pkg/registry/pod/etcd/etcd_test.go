@@ -40,16 +40,16 @@ import (
 	"github.com/coreos/go-etcd/etcd"
 )
 
-func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, *tools.EtcdHelper) {
 	fakeEtcdClient := tools.NewFakeEtcdClient(t)
 	fakeEtcdClient.TestIndex = true
 	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
 	return fakeEtcdClient, helper
 }
 
-func newStorage(t *testing.T) (*REST, *BindingREST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+func newStorage(t *testing.T) (*REST, *BindingREST, *StatusREST, *tools.FakeEtcdClient, *tools.EtcdHelper) {
 	fakeEtcdClient, h := newHelper(t)
-	storage := NewStorage(h, nil)
+	storage := NewStorage(h, false, nil)
 	return storage.Pod, storage.Binding, storage.Status, fakeEtcdClient, h
 }
 
@@ -92,7 +92,7 @@ func TestStorage(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	test := resttest.New(t, storage, fakeEtcdClient.SetError)
 	pod := validNewPod()
 	pod.ObjectMeta = api.ObjectMeta{}
@@ -110,7 +110,7 @@ func TestCreate(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	ctx := api.NewDefaultContext()
 	key, _ := storage.Etcd.KeyFunc(ctx, "foo")
 	key = etcdtest.AddPrefix(key)
@@ -149,7 +149,7 @@ func expectPod(t *testing.T, out runtime.Object) (*api.Pod, bool) {
 func TestCreateRegistryError(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
 	fakeEtcdClient.Err = fmt.Errorf("test error")
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 
 	pod := validNewPod()
 	_, err := storage.Create(api.NewDefaultContext(), pod)
@@ -160,7 +160,7 @@ func TestCreateRegistryError(t *testing.T) {
 
 func TestCreateSetsFields(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	pod := validNewPod()
 	_, err := storage.Create(api.NewDefaultContext(), pod)
 	if err != fakeEtcdClient.Err {
@@ -169,7 +169,7 @@ func TestCreateSetsFields(t *testing.T) {
 	ctx := api.NewDefaultContext()
 	key, _ := storage.Etcd.KeyFunc(ctx, "foo")
 	actual := &api.Pod{}
-	if err := helper.ExtractObj(key, actual, false); err != nil {
+	if err := helper.Get(key, actual, false); err != nil {
 		t.Fatalf("unexpected extraction error: %v", err)
 	}
 	if actual.Name != pod.Name {
@@ -183,7 +183,7 @@ func TestCreateSetsFields(t *testing.T) {
 func TestListError(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
 	fakeEtcdClient.Err = fmt.Errorf("test error")
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	pods, err := storage.List(api.NewDefaultContext(), labels.Everything(), fields.Everything())
 	if err != fakeEtcdClient.Err {
 		t.Fatalf("Expected %#v, Got %#v", fakeEtcdClient.Err, err)
@@ -197,7 +197,7 @@ func TestListEmptyPodList(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
 	fakeEtcdClient.ChangeIndex = 1
 	ctx := api.NewContext()
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	key := storage.Etcd.KeyRootFunc(ctx)
 	key = etcdtest.AddPrefix(key)
 
@@ -221,7 +221,7 @@ func TestListEmptyPodList(t *testing.T) {
 func TestListPodList(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
 	ctx := api.NewDefaultContext()
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	key := storage.Etcd.KeyRootFunc(ctx)
 	key = etcdtest.AddPrefix(key)
 	fakeEtcdClient.Data[key] = tools.EtcdResponseWithError{
@@ -266,7 +266,7 @@ func TestListPodList(t *testing.T) {
 func TestListPodListSelection(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
 	ctx := api.NewDefaultContext()
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	rootKey := etcdtest.AddPrefix("pods/default")
 	key := etcdtest.AddPrefix("pods/default/zot")
 	fakeEtcdClient.Data[rootKey] = tools.EtcdResponseWithError{
@@ -368,7 +368,7 @@ func TestListPodListSelection(t *testing.T) {
 }
 
 func TestPodDecode(t *testing.T) {
-	storage := NewStorage(tools.EtcdHelper{}, nil).Pod
+	storage := NewStorage(&tools.EtcdHelper{}, false, nil).Pod
 	expected := validNewPod()
 	body, err := latest.Codec.Encode(expected)
 	if err != nil {
@@ -399,7 +399,7 @@ func TestGet(t *testing.T) {
 			},
 		},
 	}
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 
 	obj, err := storage.Get(api.WithNamespace(api.NewContext(), "test"), "foo")
 	pod := obj.(*api.Pod)
@@ -416,7 +416,7 @@ func TestGet(t *testing.T) {
 func TestPodStorageValidatesCreate(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
 	fakeEtcdClient.Err = fmt.Errorf("test error")
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 
 	pod := validNewPod()
 	pod.Labels = map[string]string{
@@ -434,7 +434,7 @@ func TestPodStorageValidatesCreate(t *testing.T) {
 // TODO: remove, this is covered by RESTTest.TestCreate
 func TestCreatePod(t *testing.T) {
 	_, helper := newHelper(t)
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	ctx := api.NewDefaultContext()
 	key, _ := storage.Etcd.KeyFunc(ctx, "foo")
 
@@ -447,7 +447,7 @@ func TestCreatePod(t *testing.T) {
 		t.Fatalf("unexpected object: %#v", obj)
 	}
 	actual := &api.Pod{}
-	if err := helper.ExtractObj(key, actual, false); err != nil {
+	if err := helper.Get(key, actual, false); err != nil {
 		t.Fatalf("unexpected extraction error: %v", err)
 	}
 	if !api.HasObjectMetaSystemFieldValues(&actual.ObjectMeta) {
@@ -458,7 +458,7 @@ func TestCreatePod(t *testing.T) {
 // TODO: remove, this is covered by RESTTest.TestCreate
 func TestCreateWithConflictingNamespace(t *testing.T) {
 	_, helper := newHelper(t)
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 
 	pod := validNewPod()
 	pod.Namespace = "not-default"
@@ -476,7 +476,7 @@ func TestCreateWithConflictingNamespace(t *testing.T) {
 
 func TestUpdateWithConflictingNamespace(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	ctx := api.NewDefaultContext()
 	key, _ := storage.Etcd.KeyFunc(ctx, "foo")
 	key = etcdtest.AddPrefix(key)
@@ -601,7 +601,7 @@ func TestResourceLocation(t *testing.T) {
 	ctx := api.NewDefaultContext()
 	for _, tc := range testCases {
 		fakeEtcdClient, helper := newHelper(t)
-		storage := NewStorage(helper, nil).Pod
+		storage := NewStorage(helper, false, nil).Pod
 		key, _ := storage.Etcd.KeyFunc(ctx, "foo")
 		key = etcdtest.AddPrefix(key)
 		fakeEtcdClient.Data[key] = tools.EtcdResponseWithError{
@@ -633,7 +633,7 @@ func TestResourceLocation(t *testing.T) {
 func TestDeletePod(t *testing.T) {
 	fakeEtcdClient, helper := newHelper(t)
 	fakeEtcdClient.ChangeIndex = 1
-	storage := NewStorage(helper, nil).Pod
+	storage := NewStorage(helper, false, nil).Pod
 	ctx := api.NewDefaultContext()
 	key, _ := storage.Etcd.KeyFunc(ctx, "foo")
 	key = etcdtest.AddPrefix(key)
@@ -1221,7 +1221,7 @@ func TestEtcdUpdateStatus(t *testing.T) {
 	}
 	var podOut api.Pod
 	key, _ = registry.KeyFunc(ctx, "foo")
-	if err := helper.ExtractObj(key, &podOut, false); err != nil {
+	if err := helper.Get(key, &podOut, false); err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 	if !api.Semantic.DeepEqual(expected, podOut) {
@@ -21,6 +21,8 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
@@ -219,6 +221,15 @@ func LogLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, ct
 	if opts.Previous {
 		params.Add("previous", "true")
 	}
+	if opts.Timestamps {
+		params.Add("timestamps", "true")
+	}
+	if opts.SinceSeconds != nil {
+		params.Add("sinceSeconds", strconv.FormatInt(*opts.SinceSeconds, 10))
+	}
+	if opts.SinceTime != nil {
+		params.Add("sinceTime", opts.SinceTime.Format(time.RFC3339))
+	}
 	loc := &url.URL{
 		Scheme:   nodeScheme,
 		Host:     fmt.Sprintf("%s:%d", nodeHost, nodePort),
@@ -228,9 +239,43 @@ func LogLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, ct
 	return loc, nodeTransport, nil
 }
 
+// AttachLocation returns the attach URL for a pod container. If opts.Container is blank
+// and only one container is present in the pod, that container is used.
+func AttachLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, ctx api.Context, name string, opts *api.PodAttachOptions) (*url.URL, http.RoundTripper, error) {
+	return streamLocation(getter, connInfo, ctx, name, opts.Container, "attach", streamParams(opts.Stdin, opts.Stdout, opts.Stderr, opts.TTY))
+}
+
 // ExecLocation returns the exec URL for a pod container. If opts.Container is blank
 // and only one container is present in the pod, that container is used.
 func ExecLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, ctx api.Context, name string, opts *api.PodExecOptions) (*url.URL, http.RoundTripper, error) {
+	params := streamParams(opts.Stdin, opts.Stdout, opts.Stderr, opts.TTY)
+	for _, c := range opts.Command {
+		params.Add("command", c)
+	}
+	return streamLocation(getter, connInfo, ctx, name, opts.Container, "exec", params)
+}
+
+// streamParams returns the kubelet query parameters selecting the streams of
+// an exec or attach call.
+func streamParams(stdin, stdout, stderr, tty bool) url.Values {
+	params := url.Values{}
+	if stdin {
+		params.Add(api.ExecStdinParam, "1")
+	}
+	if stdout {
+		params.Add(api.ExecStdoutParam, "1")
+	}
+	if stderr {
+		params.Add(api.ExecStderrParam, "1")
+	}
+	if tty {
+		params.Add(api.ExecTTYParam, "1")
+	}
+	return params
+}
+
+// streamLocation returns the URL of a streaming kubelet endpoint for a pod container.
+func streamLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, ctx api.Context, name, container, endpoint string, params url.Values) (*url.URL, http.RoundTripper, error) {
 
 	pod, err := getPod(getter, ctx, name)
 	if err != nil {
@@ -238,7 +283,6 @@ func ExecLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, c
 	}
 
 	// Try to figure out a container
-	container := opts.Container
 	if container == "" {
 		if len(pod.Spec.Containers) == 1 {
 			container = pod.Spec.Containers[0].Name
@@ -255,26 +299,10 @@ func ExecLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, c
 	if err != nil {
 		return nil, nil, err
 	}
-	params := url.Values{}
-	if opts.Stdin {
-		params.Add(api.ExecStdinParam, "1")
-	}
-	if opts.Stdout {
-		params.Add(api.ExecStdoutParam, "1")
-	}
-	if opts.Stderr {
-		params.Add(api.ExecStderrParam, "1")
-	}
-	if opts.TTY {
-		params.Add(api.ExecTTYParam, "1")
-	}
-	for _, c := range opts.Command {
-		params.Add("command", c)
-	}
 	loc := &url.URL{
 		Scheme:   nodeScheme,
 		Host:     fmt.Sprintf("%s:%d", nodeHost, nodePort),
-		Path:     fmt.Sprintf("/exec/%s/%s/%s", pod.Namespace, name, container),
+		Path:     fmt.Sprintf("/%s/%s/%s/%s", endpoint, pod.Namespace, name, container),
 		RawQuery: params.Encode(),
 	}
 	return loc, nodeTransport, nil
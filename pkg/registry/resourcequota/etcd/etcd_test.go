@@ -38,14 +38,14 @@ import (
 	"github.com/coreos/go-etcd/etcd"
 )
 
-func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, *tools.EtcdHelper) {
 	fakeEtcdClient := tools.NewFakeEtcdClient(t)
 	fakeEtcdClient.TestIndex = true
 	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec, etcdtest.PathPrefix())
 	return fakeEtcdClient, helper
 }
 
-func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, *tools.EtcdHelper) {
 	fakeEtcdClient, h := newHelper(t)
 	storage, statusStorage := NewStorage(h)
 	return storage, statusStorage, fakeEtcdClient, h
@@ -133,7 +133,7 @@ func TestCreateSetsFields(t *testing.T) {
 
 	actual := &api.ResourceQuota{}
 	key, _ := storage.Etcd.KeyFunc(ctx, "foo")
-	if err := helper.ExtractObj(key, actual, false); err != nil {
+	if err := helper.Get(key, actual, false); err != nil {
 		t.Fatalf("unexpected extraction error: %v", err)
 	}
 	if actual.Name != resourcequota.Name {
@@ -294,7 +294,7 @@ func TestListResourceQuotaListSelection(t *testing.T) {
 }
 
 func TestResourceQuotaDecode(t *testing.T) {
-	storage, _ := NewStorage(tools.EtcdHelper{})
+	storage, _ := NewStorage(&tools.EtcdHelper{})
 	expected := validNewResourceQuota()
 	body, err := latest.Codec.Encode(expected)
 	if err != nil {
@@ -516,7 +516,7 @@ func TestEtcdUpdateStatus(t *testing.T) {
 	}
 	var resourcequotaOut api.ResourceQuota
 	key, _ = registry.KeyFunc(ctx, "foo")
-	if err := helper.ExtractObj(key, &resourcequotaOut, false); err != nil {
+	if err := helper.Get(key, &resourcequotaOut, false); err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 	if !api.Semantic.DeepEqual(expected, resourcequotaOut) {
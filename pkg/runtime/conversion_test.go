@@ -29,6 +29,7 @@ type InternalComplex struct {
 	Integer   int
 	Integer64 int64
 	Int64     int64
+	Int64Ptr  *int64
 	Bool      bool
 }
 
@@ -38,12 +39,17 @@ type ExternalComplex struct {
 	Integer   int    `json:"int"`
 	Integer64 int64  `json:",omitempty"`
 	Int64     int64
-	Bool      bool `json:"bool"`
+	Int64Ptr  *int64 `json:"int64Ptr,omitempty"`
+	Bool      bool   `json:"bool"`
 }
 
 func (*InternalComplex) IsAnAPIObject() {}
 func (*ExternalComplex) IsAnAPIObject() {}
 
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
 func TestStringMapConversion(t *testing.T) {
 	scheme := runtime.NewScheme()
 	scheme.Log(t)
@@ -84,6 +90,19 @@ func TestStringMapConversion(t *testing.T) {
 			errFn:    func(err error) bool { return err != nil },
 			expected: &ExternalComplex{},
 		},
+		"parses int64 pointer": {
+			input: map[string][]string{
+				"int64Ptr": {"10"},
+			},
+			expected: &ExternalComplex{Int64Ptr: int64Ptr(10)},
+		},
+		"returns error on bad int64 pointer": {
+			input: map[string][]string{
+				"int64Ptr": {"a"},
+			},
+			errFn:    func(err error) bool { return err != nil },
+			expected: &ExternalComplex{},
+		},
 		"parses boolean true": {
 			input: map[string][]string{
 				"bool": {"true"},
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package tools
+package storage
 
 import (
 	"testing"
@@ -26,13 +26,13 @@ import (
 
 func TestObjectVersioner(t *testing.T) {
 	v := APIObjectVersioner{}
-	if ver, err := v.ObjectResourceVersion(&TestResource{ObjectMeta: api.ObjectMeta{ResourceVersion: "5"}}); err != nil || ver != 5 {
+	if ver, err := v.ObjectResourceVersion(&api.Pod{ObjectMeta: api.ObjectMeta{ResourceVersion: "5"}}); err != nil || ver != 5 {
 		t.Errorf("unexpected version: %d %v", ver, err)
 	}
-	if ver, err := v.ObjectResourceVersion(&TestResource{ObjectMeta: api.ObjectMeta{ResourceVersion: "a"}}); err == nil || ver != 0 {
+	if ver, err := v.ObjectResourceVersion(&api.Pod{ObjectMeta: api.ObjectMeta{ResourceVersion: "a"}}); err == nil || ver != 0 {
 		t.Errorf("unexpected version: %d %v", ver, err)
 	}
-	obj := &TestResource{ObjectMeta: api.ObjectMeta{ResourceVersion: "a"}}
+	obj := &api.Pod{ObjectMeta: api.ObjectMeta{ResourceVersion: "a"}}
 	if err := v.UpdateObject(obj, nil, 5); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -40,7 +40,7 @@ func TestObjectVersioner(t *testing.T) {
 		t.Errorf("unexpected resource version: %#v", obj)
 	}
 	now := util.Time{time.Now()}
-	obj = &TestResource{ObjectMeta: api.ObjectMeta{ResourceVersion: "a"}}
+	obj = &api.Pod{ObjectMeta: api.ObjectMeta{ResourceVersion: "a"}}
 	if err := v.UpdateObject(obj, &now.Time, 5); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -0,0 +1,402 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// listTimeout is how long a list waits for the cache to catch up with the
+	// requested resource version.
+	listTimeout = 3 * time.Second
+
+	// watcherBufferSize is the number of events buffered for every watcher.
+	// A watcher that falls further behind is terminated.
+	watcherBufferSize = 100
+)
+
+// CacherConfig contains the configuration for a Cacher.
+type CacherConfig struct {
+	// Maximum number of recent events kept in memory, which bounds how far
+	// back a watch can be resumed.
+	CacheCapacity int
+
+	// Storage used to list and watch the underlying data.
+	Storage Interface
+
+	// The key under which all cached objects are stored; should not include a
+	// trailing "/".
+	ResourcePrefix string
+
+	// KeyFunc returns the key of an object, as passed to the Storage.
+	KeyFunc func(runtime.Object) (string, error)
+
+	// NewListFunc returns an empty list object of the cached type.
+	NewListFunc func() runtime.Object
+}
+
+// Cacher serves watches and lists of a single resource from memory. It keeps
+// a single watch on ResourcePrefix open in the underlying storage and fans the
+// changes out to all of its watchers, so the number of clients no longer
+// affects the load on the storage.
+//
+// Objects returned by watches are shared between watchers and must not be
+// modified.
+type Cacher struct {
+	sync.RWMutex
+
+	// initialized is done once the first list has been loaded.
+	initialized sync.WaitGroup
+
+	storage     Interface
+	keyFunc     func(runtime.Object) (string, error)
+	newListFunc func() runtime.Object
+	prefix      string
+
+	watchCache *watchCache
+
+	watcherIdx int
+	watchers   map[int]*cacheWatcher
+}
+
+// NewCacher creates a Cacher for the given configuration and starts filling
+// it in the background.
+func NewCacher(config CacherConfig) *Cacher {
+	watchCache := newWatchCache(config.CacheCapacity, config.KeyFunc, config.Storage.Versioner())
+	cacher := &Cacher{
+		storage:     config.Storage,
+		keyFunc:     config.KeyFunc,
+		newListFunc: config.NewListFunc,
+		prefix:      config.ResourcePrefix,
+		watchCache:  watchCache,
+		watchers:    make(map[int]*cacheWatcher),
+	}
+	cacher.initialized.Add(1)
+	var once sync.Once
+	watchCache.SetOnReplace(func() {
+		once.Do(cacher.initialized.Done)
+		cacher.Lock()
+		defer cacher.Unlock()
+		// Watchers may have missed events while the cache was relisted,
+		// so force them to start over.
+		cacher.terminateAllWatchers()
+	})
+	watchCache.SetOnEvent(cacher.processEvent)
+
+	go util.Until(cacher.startCaching, 0, util.NeverStop)
+	return cacher
+}
+
+// startCaching lists the resource into the cache and then keeps it up to date
+// with a watch, until the watch ends.
+func (c *Cacher) startCaching() {
+	if err := c.listAndWatch(); err != nil {
+		glog.Errorf("cacher for %s: %v", c.prefix, err)
+		// Don't hammer the storage when it is unavailable.
+		time.Sleep(time.Second)
+	}
+}
+
+func (c *Cacher) listAndWatch() error {
+	list := c.newListFunc()
+	if err := c.storage.List(c.prefix, list); err != nil {
+		return err
+	}
+	items, err := runtime.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	resourceVersion, err := c.listResourceVersion(list)
+	if err != nil {
+		return err
+	}
+	if err := c.watchCache.Replace(items, resourceVersion); err != nil {
+		return err
+	}
+
+	w, err := c.storage.WatchList(c.prefix, resourceVersion+1, Everything)
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+	for event := range w.ResultChan() {
+		switch event.Type {
+		case watch.Added:
+			err = c.watchCache.Add(event.Object)
+		case watch.Modified:
+			err = c.watchCache.Update(event.Object)
+		case watch.Deleted:
+			err = c.watchCache.Delete(event.Object)
+		case watch.Error:
+			return fmt.Errorf("watch failed: %v", errors.FromObject(event.Object))
+		}
+		if err != nil {
+			glog.Errorf("cacher for %s: unable to process %s event: %v", c.prefix, event.Type, err)
+		}
+	}
+	return nil
+}
+
+func (c *Cacher) listResourceVersion(list runtime.Object) (uint64, error) {
+	listMeta, err := api.ListMetaFor(list)
+	if err != nil {
+		return 0, err
+	}
+	if listMeta.ResourceVersion == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(listMeta.ResourceVersion, 10, 64)
+}
+
+// Watch begins watching the specified key, like Interface.Watch, but serves
+// the events from memory.
+func (c *Cacher) Watch(key string, resourceVersion uint64, filter FilterFunc) (watch.Interface, error) {
+	return c.watch(exactKey(key), resourceVersion, filter)
+}
+
+// WatchList begins watching the items under the specified key, like
+// Interface.WatchList, but serves the events from memory. A watch with a
+// resourceVersion that has already left the window of recent events fails.
+func (c *Cacher) WatchList(key string, resourceVersion uint64, filter FilterFunc) (watch.Interface, error) {
+	return c.watch(hasKeyPrefix(key), resourceVersion, filter)
+}
+
+func (c *Cacher) watch(include includeFunc, resourceVersion uint64, filter FilterFunc) (watch.Interface, error) {
+	c.initialized.Wait()
+
+	// resourceVersion follows the Interface.Watch convention of being the
+	// first version to deliver, while the cache deals in the last version
+	// already seen.
+	if resourceVersion > 0 {
+		resourceVersion--
+	}
+
+	// Holding the watchCache lock guarantees that no event is delivered
+	// between computing the initial events and registering the watcher.
+	c.watchCache.RLock()
+	defer c.watchCache.RUnlock()
+	initEvents, err := c.watchCache.getAllEventsSinceThreadUnsafe(resourceVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	watcher := newCacheWatcher(initEvents, include, filter, c.forgetWatcher(c.watcherIdx))
+	c.watchers[c.watcherIdx] = watcher
+	c.watcherIdx++
+	return watcher, nil
+}
+
+// ListFromMemory loads all items under the specified key into listObj, from
+// a state that is at least as fresh as resourceVersion (0 accepts any state).
+// The items are copies and may be modified by the caller.
+func (c *Cacher) ListFromMemory(key string, resourceVersion uint64, listObj runtime.Object) error {
+	c.initialized.Wait()
+
+	elements, currentResourceVersion, err := c.watchCache.WaitUntilFreshAndList(resourceVersion, listTimeout)
+	if err != nil {
+		return err
+	}
+	include := hasKeyPrefix(key)
+	items := []runtime.Object{}
+	for _, element := range elements {
+		if !include(element.Key) {
+			continue
+		}
+		obj, err := api.Scheme.Copy(element.Object)
+		if err != nil {
+			return err
+		}
+		items = append(items, obj)
+	}
+	if err := runtime.SetList(listObj, items); err != nil {
+		return err
+	}
+	if versioner := c.storage.Versioner(); versioner != nil {
+		return versioner.UpdateList(listObj, currentResourceVersion)
+	}
+	return nil
+}
+
+// processEvent hands event to all watchers. It is called by the watchCache
+// with its lock held.
+func (c *Cacher) processEvent(event watchCacheEvent) {
+	c.Lock()
+	defer c.Unlock()
+	for i, watcher := range c.watchers {
+		if !watcher.add(event) {
+			// The watcher can't keep up; terminate it rather than block
+			// everybody else. The client is expected to resume the watch
+			// from the last resource version it has seen.
+			glog.V(2).Infof("cacher for %s: terminating slow watcher", c.prefix)
+			delete(c.watchers, i)
+			watcher.stop()
+		}
+	}
+}
+
+// terminateAllWatchers stops all watchers. Assumes that the lock is held.
+func (c *Cacher) terminateAllWatchers() {
+	for key, watcher := range c.watchers {
+		delete(c.watchers, key)
+		watcher.stop()
+	}
+}
+
+func (c *Cacher) forgetWatcher(index int) func() {
+	return func() {
+		c.Lock()
+		defer c.Unlock()
+		// It's possible that the watcher is already not in the map (e.g. in
+		// case of a relist), so this is a no-op then.
+		delete(c.watchers, index)
+	}
+}
+
+// includeFunc returns true if the given key should be considered part of a watch.
+type includeFunc func(key string) bool
+
+// exactKey is an includeFunc that returns true only for the given key.
+func exactKey(key string) includeFunc {
+	return func(k string) bool {
+		return k == key
+	}
+}
+
+// hasKeyPrefix is an includeFunc that returns true for keys under the given key.
+func hasKeyPrefix(prefix string) includeFunc {
+	prefix = strings.TrimSuffix(prefix, "/") + "/"
+	return func(k string) bool {
+		return strings.HasPrefix(k, prefix)
+	}
+}
+
+// cacheWatcher implements watch.Interface on top of the events of a Cacher.
+type cacheWatcher struct {
+	sync.Mutex
+	input   chan watchCacheEvent
+	result  chan watch.Event
+	done    chan struct{}
+	include includeFunc
+	filter  FilterFunc
+	stopped bool
+	forget  func()
+}
+
+func newCacheWatcher(initEvents []watchCacheEvent, include includeFunc, filter FilterFunc, forget func()) *cacheWatcher {
+	watcher := &cacheWatcher{
+		input:   make(chan watchCacheEvent, watcherBufferSize),
+		result:  make(chan watch.Event),
+		done:    make(chan struct{}),
+		include: include,
+		filter:  filter,
+		forget:  forget,
+	}
+	go watcher.process(initEvents)
+	return watcher
+}
+
+// ResultChan implements watch.Interface.
+func (c *cacheWatcher) ResultChan() <-chan watch.Event {
+	return c.result
+}
+
+// Stop implements watch.Interface.
+func (c *cacheWatcher) Stop() {
+	c.forget()
+	c.stop()
+}
+
+func (c *cacheWatcher) stop() {
+	c.Lock()
+	defer c.Unlock()
+	if !c.stopped {
+		c.stopped = true
+		close(c.done)
+		close(c.input)
+	}
+}
+
+// add queues event for delivery and returns false if the buffer is full.
+// Must only be called by the Cacher, with its lock held.
+func (c *cacheWatcher) add(event watchCacheEvent) bool {
+	select {
+	case c.input <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *cacheWatcher) sendWatchCacheEvent(event watchCacheEvent) {
+	if !c.include(event.Key) {
+		return
+	}
+	if event.Type == watch.Deleted {
+		if c.filter(event.Object) {
+			c.send(watch.Event{Type: watch.Deleted, Object: event.Object})
+		}
+		return
+	}
+	curObjPasses := c.filter(event.Object)
+	oldObjPasses := false
+	if event.PrevObject != nil {
+		oldObjPasses = c.filter(event.PrevObject)
+	}
+	// Some changes to an object may cause it to start or stop matching a
+	// filter. We need to report those as adds/deletes, as the storage watchers do.
+	switch {
+	case curObjPasses && oldObjPasses:
+		c.send(watch.Event{Type: watch.Modified, Object: event.Object})
+	case curObjPasses && !oldObjPasses:
+		c.send(watch.Event{Type: watch.Added, Object: event.Object})
+	case !curObjPasses && oldObjPasses:
+		c.send(watch.Event{Type: watch.Deleted, Object: event.PrevObject})
+	}
+}
+
+func (c *cacheWatcher) send(event watch.Event) {
+	select {
+	case c.result <- event:
+	case <-c.done:
+	}
+}
+
+func (c *cacheWatcher) process(initEvents []watchCacheEvent) {
+	defer util.HandleCrash()
+	defer close(c.result)
+	for _, event := range initEvents {
+		c.sendWatchCacheEvent(event)
+	}
+	for event := range c.input {
+		c.sendWatchCacheEvent(event)
+	}
+}
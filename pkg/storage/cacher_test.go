@@ -0,0 +1,161 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage/memory"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+func newTestCacher(s storage.Interface) *storage.Cacher {
+	prefix := "/pods"
+	return storage.NewCacher(storage.CacherConfig{
+		CacheCapacity:  10,
+		Storage:        s,
+		ResourcePrefix: prefix,
+		KeyFunc: func(obj runtime.Object) (string, error) {
+			return storage.NamespaceKeyFunc(prefix, obj)
+		},
+		NewListFunc: func() runtime.Object { return &api.PodList{} },
+	})
+}
+
+func createTestPod(t *testing.T, s storage.Interface, namespace, name string) {
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: namespace, Name: name}}
+	if err := s.Create("/pods/"+namespace+"/"+name, pod, nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCacherListFromMemory(t *testing.T) {
+	s := memory.NewStorage(testapi.Codec())
+	createTestPod(t, s, "ns", "foo")
+	createTestPod(t, s, "other", "bar")
+	cacher := newTestCacher(s)
+
+	list := &api.PodList{}
+	if err := cacher.ListFromMemory("/pods/ns", 0, list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "foo" {
+		t.Errorf("unexpected list: %#v", list.Items)
+	}
+	if list.ResourceVersion != "2" {
+		t.Errorf("expected resource version 2, got %q", list.ResourceVersion)
+	}
+
+	// Items are copies that can be modified safely.
+	list.Items[0].Name = "changed"
+	list = &api.PodList{}
+	if err := cacher.ListFromMemory("/pods", 0, list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("unexpected list: %#v", list.Items)
+	}
+	for _, pod := range list.Items {
+		if pod.Name == "changed" {
+			t.Errorf("cached object was modified")
+		}
+	}
+}
+
+func TestCacherWatch(t *testing.T) {
+	s := memory.NewStorage(testapi.Codec())
+	createTestPod(t, s, "ns", "foo")
+	cacher := newTestCacher(s)
+
+	// A watch from resource version 0 starts with the current state.
+	initial, err := cacher.WatchList("/pods/ns", 0, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer initial.Stop()
+	event := <-initial.ResultChan()
+	if event.Type != watch.Added || event.Object.(*api.Pod).Name != "foo" {
+		t.Errorf("unexpected event: %#v", event)
+	}
+
+	onlyBar := func(obj runtime.Object) bool {
+		return obj.(*api.Pod).Name == "bar"
+	}
+	filtered, err := cacher.WatchList("/pods/ns", 2, onlyBar)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer filtered.Stop()
+
+	createTestPod(t, s, "ns", "bar")
+	createTestPod(t, s, "other", "bar")
+
+	for _, w := range []watch.Interface{initial, filtered} {
+		event := <-w.ResultChan()
+		if event.Type != watch.Added || event.Object.(*api.Pod).Name != "bar" {
+			t.Errorf("unexpected event: %#v", event)
+		}
+		if e, a := "2", event.Object.(*api.Pod).ResourceVersion; e != a {
+			t.Errorf("expected resource version %s, got %s", e, a)
+		}
+	}
+
+	// A watch can be resumed from a recent resource version.
+	resumed, err := cacher.WatchList("/pods", 3, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resumed.Stop()
+	event = <-resumed.ResultChan()
+	if event.Type != watch.Added || event.Object.(*api.Pod).Namespace != "other" {
+		t.Errorf("unexpected event: %#v", event)
+	}
+
+	// Lists can wait for the cache to reach a resource version.
+	list := &api.PodList{}
+	if err := cacher.ListFromMemory("/pods", 3, list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 3 {
+		t.Errorf("unexpected list: %#v", list.Items)
+	}
+}
+
+func TestCacherWatchSingle(t *testing.T) {
+	s := memory.NewStorage(testapi.Codec())
+	cacher := newTestCacher(s)
+
+	w, err := cacher.Watch("/pods/ns/foo", 1, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	createTestPod(t, s, "ns", "bar")
+	createTestPod(t, s, "ns", "foo")
+	event := <-w.ResultChan()
+	if event.Type != watch.Added || event.Object.(*api.Pod).Name != "foo" {
+		t.Errorf("unexpected event: %#v", event)
+	}
+
+	w.Stop()
+	if _, open := <-w.ResultChan(); open {
+		t.Errorf("expected the result channel to be closed")
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+)
+
+const (
+	ErrCodeKeyNotFound int = iota + 1
+	ErrCodeKeyExists
+	ErrCodeResourceVersionConflicts
+)
+
+var errCodeToMessage = map[int]string{
+	ErrCodeKeyNotFound:              "key not found",
+	ErrCodeKeyExists:                "key exists",
+	ErrCodeResourceVersionConflicts: "resource version conflicts",
+}
+
+// StorageError is returned by implementations of Interface for failures that
+// callers are expected to handle, independently of the backend.
+type StorageError struct {
+	Code            int
+	Key             string
+	ResourceVersion uint64
+}
+
+func (e *StorageError) Error() string {
+	return fmt.Sprintf("StorageError: %s, Code: %d, Key: %s, ResourceVersion: %d",
+		errCodeToMessage[e.Code], e.Code, e.Key, e.ResourceVersion)
+}
+
+// NewKeyNotFoundError returns an error for a key that does not exist.
+func NewKeyNotFoundError(key string, rv uint64) *StorageError {
+	return &StorageError{
+		Code:            ErrCodeKeyNotFound,
+		Key:             key,
+		ResourceVersion: rv,
+	}
+}
+
+// NewKeyExistsError returns an error for a key that already exists.
+func NewKeyExistsError(key string, rv uint64) *StorageError {
+	return &StorageError{
+		Code:            ErrCodeKeyExists,
+		Key:             key,
+		ResourceVersion: rv,
+	}
+}
+
+// NewResourceVersionConflictsError returns an error for a conditional write
+// whose resource version does not match the stored one.
+func NewResourceVersionConflictsError(key string, rv uint64) *StorageError {
+	return &StorageError{
+		Code:            ErrCodeResourceVersionConflicts,
+		Key:             key,
+		ResourceVersion: rv,
+	}
+}
+
+// IsNotFound returns true iff err is a storage error for a missing key.
+func IsNotFound(err error) bool {
+	return isErrCode(err, ErrCodeKeyNotFound)
+}
+
+// IsNodeExist returns true iff err is a storage error for an existing key.
+func IsNodeExist(err error) bool {
+	return isErrCode(err, ErrCodeKeyExists)
+}
+
+// IsTestFailed returns true iff err is a storage write conflict.
+func IsTestFailed(err error) bool {
+	return isErrCode(err, ErrCodeResourceVersionConflicts)
+}
+
+func isErrCode(err error, code int) bool {
+	storageErr, ok := err.(*StorageError)
+	return ok && storageErr != nil && storageErr.Code == code
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Versioner abstracts setting and retrieving metadata fields from the storage
+// response onto the object or list.
+type Versioner interface {
+	// UpdateObject sets storage metadata into an API object. Returns an error if the object
+	// cannot be updated correctly. May return nil if the requested object does not need metadata
+	// from the storage.
+	UpdateObject(obj runtime.Object, expiration *time.Time, resourceVersion uint64) error
+	// UpdateList sets the resource version into an API list object. Returns an error if the object
+	// cannot be updated correctly. May return nil if the requested object does not need metadata
+	// from the storage.
+	UpdateList(obj runtime.Object, resourceVersion uint64) error
+	// ObjectResourceVersion returns the resource version (for persistence) of the specified object.
+	// Should return an error if the specified object does not have a persistable version.
+	ObjectResourceVersion(obj runtime.Object) (uint64, error)
+}
+
+// ResponseMeta contains information about the storage metadata that is associated with
+// an object. It abstracts the actual underlying objects to prevent coupling with a
+// particular backend and to improve testability.
+type ResponseMeta struct {
+	// TTL is the time to live of the node that contained the returned object. It may be
+	// zero or negative in some cases (objects may be expired after the requested
+	// expiration time due to server lag).
+	TTL int64
+	// Expiration is the time at which the node that contained the returned object will expire and be deleted.
+	// This can be nil if there is no expiration time set for the node.
+	Expiration *time.Time
+	// The resource version of the node that contained the returned object.
+	ResourceVersion uint64
+}
+
+// FilterFunc is a predicate which takes an API object and returns true
+// iff the object should remain in the set.
+type FilterFunc func(obj runtime.Object) bool
+
+// Everything is a FilterFunc which accepts all objects.
+func Everything(runtime.Object) bool {
+	return true
+}
+
+// Pass an UpdateFunc to Interface.GuaranteedUpdate to make an update
+// that is guaranteed to succeed.
+// See the comment for GuaranteedUpdate for more details.
+type UpdateFunc func(input runtime.Object, res ResponseMeta) (output runtime.Object, ttl *uint64, err error)
+
+// SimpleUpdateFunc is an UpdateFunc that neither needs the metadata nor changes the TTL.
+type SimpleUpdateFunc func(runtime.Object) (runtime.Object, error)
+
+// SimpleUpdate converts SimpleUpdateFunc into UpdateFunc
+func SimpleUpdate(fn SimpleUpdateFunc) UpdateFunc {
+	return func(input runtime.Object, _ ResponseMeta) (runtime.Object, *uint64, error) {
+		out, err := fn(input)
+		return out, nil, err
+	}
+}
+
+// Interface offers a common interface for object marshaling/unmarshaling operations and
+// hides all the storage-related operations behind it. Keys are paths like
+// "/pods/default/foo"; resource versions are the storage's global, increasing
+// modification index. Errors about missing or conflicting keys are returned as
+// *StorageError, see IsNotFound, IsNodeExist and IsTestFailed.
+type Interface interface {
+	// Backends returns the addresses of the servers backing the storage, if any.
+	Backends() []string
+
+	// Versioner returns the Versioner associated with this interface.
+	Versioner() Versioner
+
+	// Create adds a new object at a key unless it already exists. 'ttl' is time-to-live in seconds,
+	// and 0 means forever. If no error is returned and out is not nil, out will be set to the read value
+	// from the storage.
+	Create(key string, obj, out runtime.Object, ttl uint64) error
+
+	// Set marshals obj and stores it under key. Will do an atomic update if obj's ResourceVersion
+	// field is set. 'ttl' is time-to-live in seconds, and 0 means forever. If no error is returned
+	// and out is not nil, out will be set to the read value from the storage.
+	Set(key string, obj, out runtime.Object, ttl uint64) error
+
+	// Delete removes the specified key and returns the value that existed at that spot.
+	Delete(key string, out runtime.Object) error
+
+	// Watch begins watching the specified key. Events are decoded into API objects,
+	// and any items passing 'filter' are sent down to the returned watch.Interface.
+	// resourceVersion may be used to specify what version to begin watching, which
+	// is the first version the watch will deliver; 0 means the current state,
+	// delivered as a series of Added events, followed by all later changes.
+	Watch(key string, resourceVersion uint64, filter FilterFunc) (watch.Interface, error)
+
+	// WatchList begins watching the specified key's items, with the same
+	// semantics as Watch.
+	WatchList(key string, resourceVersion uint64, filter FilterFunc) (watch.Interface, error)
+
+	// Get unmarshals the object found at key into objPtr. On a not found error, will either
+	// return a zero object of the requested type, or an error, depending on ignoreNotFound.
+	Get(key string, objPtr runtime.Object, ignoreNotFound bool) error
+
+	// GetToList unmarshals the object found at key and opaques it into a *List api object
+	// (an object that satisfies the runtime.IsList definition).
+	GetToList(key string, listObj runtime.Object) error
+
+	// List unmarshals all the objects under key into a *List api object (an object that
+	// satisfies the runtime.IsList definition), and sets its resource version.
+	List(key string, listObj runtime.Object) error
+
+	// GuaranteedUpdate calls "tryUpdate()" to update key "key" that is of type "ptrToType". It keeps
+	// calling tryUpdate() and retrying the update until success if there is a resource version
+	// conflict. Note that object passed to tryUpdate() may change across invocations of tryUpdate()
+	// if other writers are simultaneously updating it, so tryUpdate() needs to take into account the
+	// current contents of the object when deciding how the updated object (that it returns) should look.
+	//
+	// Example:
+	//
+	// s := /* implementation of Interface */
+	// err := s.GuaranteedUpdate("myKey", &MyType{}, true, func(input runtime.Object, res ResponseMeta) (runtime.Object, *uint64, error) {
+	//	// Before each invocation of the user-defined function, "input" is reset to
+	//	// the current contents for "myKey" in the storage.
+	//
+	//	cur := input.(*MyType) // Guaranteed to succeed.
+	//
+	//	// Make a *modification*.
+	//	cur.Counter++
+	//
+	//	// Return the modified object. Return an error to stop iterating. Return a uint64 to alter
+	//	// the TTL on the object, or nil to keep it the same value.
+	//	return cur, nil, nil
+	// })
+	GuaranteedUpdate(key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate UpdateFunc) error
+}
@@ -0,0 +1,523 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// defaultHistoryCapacity is the number of recent changes kept to resume
+// watches from.
+const defaultHistoryCapacity = 1000
+
+// item is a stored value. Items are replaced on every write and never
+// modified, so they may be read without holding the lock.
+type item struct {
+	value         []byte
+	modifiedIndex uint64
+	expiration    *time.Time
+}
+
+// change records a single modification of a key, for watchers.
+type change struct {
+	eventType watch.EventType
+	key       string
+	index     uint64
+	// value is the new value; it is empty for deletions.
+	value      []byte
+	expiration *time.Time
+	// prevValue is the replaced or deleted value; it is empty for additions.
+	prevValue      []byte
+	prevIndex      uint64
+	prevExpiration *time.Time
+}
+
+// Storage is an in-memory implementation of storage.Interface. Objects are
+// kept encoded, exactly as they would be sent to etcd, so that callers can
+// never modify stored state by accident. Like etcd, every change is assigned
+// the next value of a single, storage-wide index, which becomes the resource
+// version of the changed object.
+//
+// Keys with a TTL are expired lazily, on the next operation after their
+// expiration time.
+type Storage struct {
+	lock sync.Mutex
+
+	codec     runtime.Codec
+	versioner storage.Versioner
+	clock     util.Clock
+
+	// index is the version of the most recent change.
+	index    uint64
+	items    map[string]*item
+	expiring map[string]bool
+
+	// history holds the most recent changes, oldest first. All changes
+	// from oldestIndex on are present in history.
+	history         []change
+	historyCapacity int
+	oldestIndex     uint64
+
+	watcherIdx int
+	watchers   map[int]*watcher
+}
+
+// Implements storage.Interface.
+var _ storage.Interface = &Storage{}
+
+// NewStorage returns an empty Storage that encodes objects with codec.
+func NewStorage(codec runtime.Codec) *Storage {
+	return &Storage{
+		codec:           codec,
+		versioner:       storage.APIObjectVersioner{},
+		clock:           util.RealClock{},
+		items:           make(map[string]*item),
+		expiring:        make(map[string]bool),
+		historyCapacity: defaultHistoryCapacity,
+		watchers:        make(map[int]*watcher),
+	}
+}
+
+// Backends implements storage.Interface. An in-memory storage has no servers
+// backing it.
+func (s *Storage) Backends() []string {
+	return nil
+}
+
+// Versioner implements storage.Interface.
+func (s *Storage) Versioner() storage.Versioner {
+	return s.versioner
+}
+
+// Create implements storage.Interface.
+func (s *Storage) Create(key string, obj, out runtime.Object, ttl uint64) error {
+	key = normalizeKey(key)
+	if version, err := s.versioner.ObjectResourceVersion(obj); err == nil && version != 0 {
+		return errors.New("resourceVersion may not be set on objects to be created")
+	}
+	data, err := s.codec.Encode(obj)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	s.expireLocked()
+	if _, found := s.items[key]; found {
+		err := storage.NewKeyExistsError(key, s.index)
+		s.lock.Unlock()
+		return err
+	}
+	created := s.setLocked(key, data, s.expirationFor(ttl))
+	s.lock.Unlock()
+
+	if out == nil {
+		return nil
+	}
+	return s.decode(created, out)
+}
+
+// Set implements storage.Interface.
+func (s *Storage) Set(key string, obj, out runtime.Object, ttl uint64) error {
+	key = normalizeKey(key)
+	version, err := s.versioner.ObjectResourceVersion(obj)
+	if err != nil {
+		version = 0
+	}
+	data, err := s.codec.Encode(obj)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	s.expireLocked()
+	current, found := s.items[key]
+	switch {
+	case version == 0 && found:
+		// As with etcd, an unversioned write only creates.
+		err = storage.NewKeyExistsError(key, s.index)
+	case version != 0 && !found:
+		err = storage.NewKeyNotFoundError(key, s.index)
+	case version != 0 && current.modifiedIndex != version:
+		err = storage.NewResourceVersionConflictsError(key, s.index)
+	}
+	if err != nil {
+		s.lock.Unlock()
+		return err
+	}
+	stored := s.setLocked(key, data, s.expirationFor(ttl))
+	s.lock.Unlock()
+
+	if out == nil {
+		return nil
+	}
+	return s.decode(stored, out)
+}
+
+// Delete implements storage.Interface.
+func (s *Storage) Delete(key string, out runtime.Object) error {
+	key = normalizeKey(key)
+	if _, err := conversion.EnforcePtr(out); err != nil {
+		panic("unable to convert output object to pointer")
+	}
+
+	s.lock.Lock()
+	s.expireLocked()
+	deleted, found := s.items[key]
+	if !found {
+		err := storage.NewKeyNotFoundError(key, s.index)
+		s.lock.Unlock()
+		return err
+	}
+	s.deleteLocked(key)
+	s.lock.Unlock()
+
+	return s.decode(deleted, out)
+}
+
+// Get implements storage.Interface.
+func (s *Storage) Get(key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	key = normalizeKey(key)
+
+	s.lock.Lock()
+	s.expireLocked()
+	current, found := s.items[key]
+	index := s.index
+	s.lock.Unlock()
+
+	if !found {
+		if ignoreNotFound {
+			v, err := conversion.EnforcePtr(objPtr)
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		return storage.NewKeyNotFoundError(key, index)
+	}
+	return s.decode(current, objPtr)
+}
+
+// GetToList implements storage.Interface.
+func (s *Storage) GetToList(key string, listObj runtime.Object) error {
+	key = normalizeKey(key)
+
+	s.lock.Lock()
+	s.expireLocked()
+	var items []*item
+	if current, found := s.items[key]; found {
+		items = append(items, current)
+	}
+	index := s.index
+	s.lock.Unlock()
+
+	return s.decodeList(items, index, listObj)
+}
+
+// List implements storage.Interface. All objects stored under key, at any
+// depth, are returned ordered by their keys.
+func (s *Storage) List(key string, listObj runtime.Object) error {
+	prefix := listPrefix(key)
+
+	s.lock.Lock()
+	s.expireLocked()
+	keys := []string{}
+	for k := range s.items {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	items := make([]*item, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, s.items[k])
+	}
+	index := s.index
+	s.lock.Unlock()
+
+	return s.decodeList(items, index, listObj)
+}
+
+// GuaranteedUpdate implements storage.Interface. tryUpdate is called without
+// holding any lock; its result is only stored if key was not modified in the
+// meantime.
+func (s *Storage) GuaranteedUpdate(key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate storage.UpdateFunc) error {
+	v, err := conversion.EnforcePtr(ptrToType)
+	if err != nil {
+		// Panic is appropriate, because this is a programming error.
+		panic("need ptr to type")
+	}
+	key = normalizeKey(key)
+	for {
+		s.lock.Lock()
+		s.expireLocked()
+		current, found := s.items[key]
+		index := s.index
+		s.lock.Unlock()
+
+		obj := reflect.New(v.Type()).Interface().(runtime.Object)
+		meta := storage.ResponseMeta{}
+		if found {
+			if err := s.decode(current, obj); err != nil {
+				return err
+			}
+			meta.ResourceVersion = current.modifiedIndex
+			if current.expiration != nil {
+				meta.Expiration = current.expiration
+				meta.TTL = int64(current.expiration.Sub(s.clock.Now()) / time.Second)
+			}
+		} else if !ignoreNotFound {
+			return storage.NewKeyNotFoundError(key, index)
+		}
+
+		ret, newTTL, err := tryUpdate(obj, meta)
+		if err != nil {
+			return err
+		}
+		data, err := s.codec.Encode(ret)
+		if err != nil {
+			return err
+		}
+		if found && bytes.Equal(data, current.value) {
+			return s.decode(current, ptrToType)
+		}
+
+		var expiration *time.Time
+		if newTTL != nil {
+			expiration = s.expirationFor(*newTTL)
+		} else if found {
+			expiration = current.expiration
+		}
+
+		s.lock.Lock()
+		s.expireLocked()
+		if latest, exists := s.items[key]; exists != found || latest != current {
+			// Somebody else changed the key; try again.
+			s.lock.Unlock()
+			continue
+		}
+		stored := s.setLocked(key, data, expiration)
+		s.lock.Unlock()
+
+		return s.decode(stored, ptrToType)
+	}
+}
+
+// Watch implements storage.Interface.
+func (s *Storage) Watch(key string, resourceVersion uint64, filter storage.FilterFunc) (watch.Interface, error) {
+	key = normalizeKey(key)
+	return s.watch(func(k string) bool { return k == key }, resourceVersion, filter)
+}
+
+// WatchList implements storage.Interface.
+func (s *Storage) WatchList(key string, resourceVersion uint64, filter storage.FilterFunc) (watch.Interface, error) {
+	prefix := listPrefix(key)
+	return s.watch(func(k string) bool { return strings.HasPrefix(k, prefix) }, resourceVersion, filter)
+}
+
+func (s *Storage) watch(include func(string) bool, resourceVersion uint64, filter storage.FilterFunc) (watch.Interface, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.expireLocked()
+
+	var initial []change
+	if resourceVersion == 0 {
+		// Start with the current state, as a series of additions.
+		keys := []string{}
+		for k := range s.items {
+			if include(k) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			current := s.items[k]
+			initial = append(initial, change{
+				eventType:  watch.Added,
+				key:        k,
+				index:      current.modifiedIndex,
+				value:      current.value,
+				expiration: current.expiration,
+			})
+		}
+	} else {
+		if resourceVersion < s.oldestIndex {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("too old resource version: %d (%d)", resourceVersion, s.oldestIndex))
+		}
+		for _, c := range s.history {
+			if c.index >= resourceVersion && include(c.key) {
+				initial = append(initial, c)
+			}
+		}
+	}
+
+	s.watcherIdx++
+	w := newWatcher(s, s.watcherIdx, include, filter, initial)
+	s.watchers[w.id] = w
+	return w, nil
+}
+
+// setLocked stores data under key as a new version, and notifies watchers.
+func (s *Storage) setLocked(key string, data []byte, expiration *time.Time) *item {
+	s.index++
+	stored := &item{
+		value:         data,
+		modifiedIndex: s.index,
+		expiration:    expiration,
+	}
+	c := change{
+		eventType:  watch.Added,
+		key:        key,
+		index:      s.index,
+		value:      data,
+		expiration: expiration,
+	}
+	if prev, found := s.items[key]; found {
+		c.eventType = watch.Modified
+		c.prevValue = prev.value
+		c.prevIndex = prev.modifiedIndex
+		c.prevExpiration = prev.expiration
+	}
+	s.items[key] = stored
+	if expiration != nil {
+		s.expiring[key] = true
+	} else {
+		delete(s.expiring, key)
+	}
+	s.recordLocked(c)
+	return stored
+}
+
+// deleteLocked removes key as a new version, and notifies watchers.
+func (s *Storage) deleteLocked(key string) {
+	prev := s.items[key]
+	s.index++
+	delete(s.items, key)
+	delete(s.expiring, key)
+	s.recordLocked(change{
+		eventType:      watch.Deleted,
+		key:            key,
+		index:          s.index,
+		prevValue:      prev.value,
+		prevIndex:      prev.modifiedIndex,
+		prevExpiration: prev.expiration,
+	})
+}
+
+func (s *Storage) recordLocked(c change) {
+	s.history = append(s.history, c)
+	if len(s.history) > s.historyCapacity {
+		s.history = s.history[len(s.history)-s.historyCapacity:]
+		s.oldestIndex = s.history[0].index
+	}
+	for _, w := range s.watchers {
+		if w.include(c.key) {
+			w.add(c)
+		}
+	}
+}
+
+// expireLocked deletes all keys whose TTL has passed.
+func (s *Storage) expireLocked() {
+	if len(s.expiring) == 0 {
+		return
+	}
+	now := s.clock.Now()
+	for key := range s.expiring {
+		if !s.items[key].expiration.After(now) {
+			s.deleteLocked(key)
+		}
+	}
+}
+
+func (s *Storage) forgetWatcher(id int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.watchers, id)
+}
+
+// expirationFor returns the expiration time of a key written now with the
+// given ttl in seconds, where 0 means forever.
+func (s *Storage) expirationFor(ttl uint64) *time.Time {
+	if ttl == 0 {
+		return nil
+	}
+	expiration := s.clock.Now().Add(time.Duration(ttl) * time.Second)
+	return &expiration
+}
+
+func (s *Storage) decode(stored *item, objPtr runtime.Object) error {
+	return s.decodeData(stored.value, stored.modifiedIndex, stored.expiration, objPtr)
+}
+
+func (s *Storage) decodeData(data []byte, index uint64, expiration *time.Time, objPtr runtime.Object) error {
+	if err := s.codec.DecodeInto(data, objPtr); err != nil {
+		return err
+	}
+	// being unable to set the version does not prevent the object from being extracted
+	_ = s.versioner.UpdateObject(objPtr, expiration, index)
+	return nil
+}
+
+func (s *Storage) decodeList(items []*item, index uint64, listObj runtime.Object) error {
+	listPtr, err := runtime.GetItemsPtr(listObj)
+	if err != nil {
+		return err
+	}
+	v, err := conversion.EnforcePtr(listPtr)
+	if err != nil || v.Kind() != reflect.Slice {
+		// This should not happen at runtime.
+		panic("need ptr to slice")
+	}
+	for _, stored := range items {
+		obj := reflect.New(v.Type().Elem())
+		if err := s.decode(stored, obj.Interface().(runtime.Object)); err != nil {
+			return err
+		}
+		v.Set(reflect.Append(v, obj.Elem()))
+	}
+	return s.versioner.UpdateList(listObj, index)
+}
+
+// normalizeKey makes "foo", "/foo" and "/foo/" refer to the same key.
+func normalizeKey(key string) string {
+	return path.Join("/", key)
+}
+
+// listPrefix returns the prefix shared by all keys under key.
+func listPrefix(key string) string {
+	prefix := normalizeKey(key)
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix
+}
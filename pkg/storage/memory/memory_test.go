@@ -0,0 +1,361 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+func newPod(namespace, name string) *api.Pod {
+	return &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
+func TestCreate(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	out := &api.Pod{}
+	if err := s.Create("/pods/ns/foo", newPod("ns", "foo"), out, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Name != "foo" || out.ResourceVersion != "1" {
+		t.Errorf("unexpected object: %#v", out)
+	}
+
+	err := s.Create("/pods/ns/foo", newPod("ns", "foo"), nil, 0)
+	if !storage.IsNodeExist(err) {
+		t.Errorf("expected a key exists error, got %v", err)
+	}
+
+	withVersion := newPod("ns", "bar")
+	withVersion.ResourceVersion = "1"
+	if err := s.Create("/pods/ns/bar", withVersion, nil, 0); err == nil {
+		t.Errorf("expected an error creating an object with a resource version")
+	}
+}
+
+func TestGet(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	if err := s.Create("pods/ns/foo/", newPod("ns", "foo"), nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := &api.Pod{}
+	if err := s.Get("/pods/ns/foo", got, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Name != "foo" || got.ResourceVersion != "1" {
+		t.Errorf("unexpected object: %#v", got)
+	}
+
+	err := s.Get("/pods/ns/bar", got, false)
+	if !storage.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	if err := s.Get("/pods/ns/bar", got, true); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, &api.Pod{}) {
+		t.Errorf("expected a zero object, got %#v", got)
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	created := &api.Pod{}
+	if err := s.Set("/pods/ns/foo", newPod("ns", "foo"), created, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := s.Set("/pods/ns/foo", newPod("ns", "foo"), nil, 0); !storage.IsNodeExist(err) {
+		t.Errorf("expected a key exists error, got %v", err)
+	}
+
+	created.Labels = map[string]string{"a": "b"}
+	updated := &api.Pod{}
+	if err := s.Set("/pods/ns/foo", created, updated, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.ResourceVersion != "2" || updated.Labels["a"] != "b" {
+		t.Errorf("unexpected object: %#v", updated)
+	}
+
+	// created still carries the old resource version.
+	if err := s.Set("/pods/ns/foo", created, nil, 0); !storage.IsTestFailed(err) {
+		t.Errorf("expected a conflict, got %v", err)
+	}
+	if err := s.Set("/pods/ns/bar", created, nil, 0); !storage.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	if err := s.Create("/pods/ns/foo", newPod("ns", "foo"), nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deleted := &api.Pod{}
+	if err := s.Delete("/pods/ns/foo", deleted); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deleted.Name != "foo" || deleted.ResourceVersion != "1" {
+		t.Errorf("unexpected object: %#v", deleted)
+	}
+	if err := s.Delete("/pods/ns/foo", &api.Pod{}); !storage.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	for _, pod := range []*api.Pod{newPod("b", "foo"), newPod("a", "foo"), newPod("a", "bar"), newPod("ab", "baz")} {
+		if err := s.Create("/pods/"+pod.Namespace+"/"+pod.Name, pod, nil, 0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := s.Create("/podsandmore/a/foo", newPod("a", "foo"), nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		key      string
+		expected []string
+	}{
+		{"/pods", []string{"a/bar", "a/foo", "ab/baz", "b/foo"}},
+		{"/pods/", []string{"a/bar", "a/foo", "ab/baz", "b/foo"}},
+		{"/pods/a", []string{"a/bar", "a/foo"}},
+		{"/pods/c", []string{}},
+	}
+	for _, testCase := range testCases {
+		list := &api.PodList{}
+		if err := s.List(testCase.key, list); err != nil {
+			t.Errorf("%s: unexpected error: %v", testCase.key, err)
+			continue
+		}
+		names := []string{}
+		for _, pod := range list.Items {
+			names = append(names, pod.Namespace+"/"+pod.Name)
+		}
+		if !reflect.DeepEqual(testCase.expected, names) {
+			t.Errorf("%s: expected %v, got %v", testCase.key, testCase.expected, names)
+		}
+		if list.ResourceVersion != "5" {
+			t.Errorf("%s: expected resource version 5, got %q", testCase.key, list.ResourceVersion)
+		}
+	}
+
+	list := &api.PodList{}
+	if err := s.GetToList("/pods/a/foo", list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "foo" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+}
+
+func TestGuaranteedUpdate(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	addLabel := func(value string) storage.UpdateFunc {
+		return storage.SimpleUpdate(func(obj runtime.Object) (runtime.Object, error) {
+			pod := obj.(*api.Pod)
+			pod.Name = "foo"
+			pod.Labels = map[string]string{"a": value}
+			return pod, nil
+		})
+	}
+
+	if err := s.GuaranteedUpdate("/pods/ns/foo", &api.Pod{}, false, addLabel("1")); !storage.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	out := &api.Pod{}
+	if err := s.GuaranteedUpdate("/pods/ns/foo", out, true, addLabel("1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ResourceVersion != "1" || out.Labels["a"] != "1" {
+		t.Errorf("unexpected object: %#v", out)
+	}
+
+	// A conflicting write makes the update retry with the new object.
+	calls := 0
+	err := s.GuaranteedUpdate("/pods/ns/foo", out, false, func(obj runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		calls++
+		if calls == 1 {
+			if err := s.GuaranteedUpdate("/pods/ns/foo", &api.Pod{}, false, addLabel("2")); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		} else if res.ResourceVersion != 2 {
+			t.Errorf("expected to retry with version 2, got %d", res.ResourceVersion)
+		}
+		pod := obj.(*api.Pod)
+		pod.Labels["b"] = "3"
+		return pod, nil, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+	if out.ResourceVersion != "3" || out.Labels["a"] != "2" || out.Labels["b"] != "3" {
+		t.Errorf("unexpected object: %#v", out)
+	}
+}
+
+func TestTTL(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	clock := &util.FakeClock{Time: time.Now()}
+	s.clock = clock
+	if err := s.Create("/pods/ns/foo", newPod("ns", "foo"), nil, 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	clock.Time = clock.Time.Add(5 * time.Second)
+	err := s.GuaranteedUpdate("/pods/ns/foo", &api.Pod{}, false, func(obj runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		if res.TTL != 5 {
+			t.Errorf("expected a TTL of 5, got %d", res.TTL)
+		}
+		return obj, nil, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	clock.Time = clock.Time.Add(5 * time.Second)
+	if err := s.Get("/pods/ns/foo", &api.Pod{}, false); !storage.IsNotFound(err) {
+		t.Errorf("expected the key to expire, got %v", err)
+	}
+}
+
+func expectEvent(t *testing.T, w watch.Interface, eventType watch.EventType, name, resourceVersion string) {
+	select {
+	case event, ok := <-w.ResultChan():
+		if !ok {
+			t.Fatalf("unexpected end of watch")
+		}
+		pod := event.Object.(*api.Pod)
+		if event.Type != eventType || pod.Name != name || pod.ResourceVersion != resourceVersion {
+			t.Errorf("expected %s of %s at %s, got %s of %s at %s", eventType, name, resourceVersion, event.Type, pod.Name, pod.ResourceVersion)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for %s of %s", eventType, name)
+	}
+}
+
+func TestWatchList(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	if err := s.Create("/pods/ns/foo", newPod("ns", "foo"), nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fromNow, err := s.WatchList("/pods/ns", 0, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer fromNow.Stop()
+	expectEvent(t, fromNow, watch.Added, "foo", "1")
+
+	if err := s.Create("/pods/other/bar", newPod("other", "bar"), nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := s.Create("/pods/ns/bar", newPod("ns", "bar"), nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := s.Delete("/pods/ns/foo", &api.Pod{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectEvent(t, fromNow, watch.Added, "bar", "3")
+	expectEvent(t, fromNow, watch.Deleted, "foo", "4")
+
+	// A watch can be resumed from any version in the history.
+	resumed, err := s.WatchList("/pods", 2, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resumed.Stop()
+	expectEvent(t, resumed, watch.Added, "bar", "2")
+	expectEvent(t, resumed, watch.Added, "bar", "3")
+	expectEvent(t, resumed, watch.Deleted, "foo", "4")
+}
+
+func TestWatchFilter(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	pod := &api.Pod{}
+	if err := s.Create("/pods/ns/foo", newPod("ns", "foo"), pod, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	labeled := func(obj runtime.Object) bool {
+		return obj.(*api.Pod).Labels["a"] == "b"
+	}
+	w, err := s.Watch("/pods/ns/foo", 2, labeled)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+
+	// Objects that start or stop passing the filter are added or deleted.
+	pod.Labels = map[string]string{"a": "b"}
+	if err := s.Set("/pods/ns/foo", pod, pod, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectEvent(t, w, watch.Added, "foo", "2")
+	pod.Spec.NodeName = "node"
+	if err := s.Set("/pods/ns/foo", pod, pod, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectEvent(t, w, watch.Modified, "foo", "3")
+	pod.Labels = nil
+	if err := s.Set("/pods/ns/foo", pod, pod, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectEvent(t, w, watch.Deleted, "foo", "3")
+
+	w.Stop()
+	if _, open := <-w.ResultChan(); open {
+		t.Errorf("expected the result channel to be closed")
+	}
+}
+
+func TestWatchTooOld(t *testing.T) {
+	s := NewStorage(testapi.Codec())
+	s.historyCapacity = 2
+	for i := 0; i < 3; i++ {
+		if err := s.GuaranteedUpdate("/pods/ns/foo", &api.Pod{}, true, storage.SimpleUpdate(func(obj runtime.Object) (runtime.Object, error) {
+			pod := obj.(*api.Pod)
+			pod.Name = "foo"
+			pod.Generation++
+			return pod, nil
+		})); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := s.Watch("/pods/ns/foo", 1, storage.Everything); !errors.IsBadRequest(err) {
+		t.Errorf("expected a bad request error, got %v", err)
+	}
+	w, err := s.Watch("/pods/ns/foo", 2, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+	expectEvent(t, w, watch.Modified, "foo", "2")
+}
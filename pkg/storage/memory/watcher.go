@@ -0,0 +1,159 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+
+	"github.com/golang/glog"
+)
+
+// watcher implements watch.Interface for a Storage. Changes are queued
+// without bound, so that a slow consumer never blocks writers to the storage.
+type watcher struct {
+	storage *Storage
+	id      int
+	include func(key string) bool
+	filter  storage.FilterFunc
+
+	lock    sync.Mutex
+	cond    *sync.Cond
+	pending []change
+	stopped bool
+
+	result chan watch.Event
+	done   chan struct{}
+}
+
+func newWatcher(s *Storage, id int, include func(string) bool, filter storage.FilterFunc, initial []change) *watcher {
+	w := &watcher{
+		storage: s,
+		id:      id,
+		include: include,
+		filter:  filter,
+		pending: initial,
+		result:  make(chan watch.Event),
+		done:    make(chan struct{}),
+	}
+	w.cond = sync.NewCond(&w.lock)
+	go w.run()
+	return w
+}
+
+// ResultChan implements watch.Interface.
+func (w *watcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+// Stop implements watch.Interface.
+func (w *watcher) Stop() {
+	w.storage.forgetWatcher(w.id)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if !w.stopped {
+		w.stopped = true
+		close(w.done)
+		w.cond.Broadcast()
+	}
+}
+
+// add queues c to be sent. It is called with the storage lock held.
+func (w *watcher) add(c change) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.pending = append(w.pending, c)
+	w.cond.Signal()
+}
+
+func (w *watcher) run() {
+	defer close(w.result)
+	for {
+		w.lock.Lock()
+		for len(w.pending) == 0 && !w.stopped {
+			w.cond.Wait()
+		}
+		if w.stopped {
+			w.lock.Unlock()
+			return
+		}
+		changes := w.pending
+		w.pending = nil
+		w.lock.Unlock()
+
+		for _, c := range changes {
+			event, ok := w.convert(c)
+			if !ok {
+				continue
+			}
+			select {
+			case w.result <- event:
+			case <-w.done:
+				return
+			}
+		}
+	}
+}
+
+// convert turns c into the event seen by this watcher, if any. Changes that
+// make an object start or stop passing the filter are reported as additions
+// and deletions respectively.
+func (w *watcher) convert(c change) (watch.Event, bool) {
+	var cur, prev runtime.Object
+	if len(c.value) > 0 {
+		cur = w.decode(c.key, c.value, c.index, c.expiration)
+	}
+	if len(c.prevValue) > 0 {
+		// Deleted objects are sent with the version of their deletion, so
+		// that the watch can be resumed from there.
+		index := c.prevIndex
+		if c.eventType == watch.Deleted {
+			index = c.index
+		}
+		prev = w.decode(c.key, c.prevValue, index, c.prevExpiration)
+	}
+	curPasses := cur != nil && w.filter(cur)
+	prevPasses := prev != nil && w.filter(prev)
+
+	switch {
+	case curPasses && prevPasses:
+		return watch.Event{Type: watch.Modified, Object: cur}, true
+	case curPasses:
+		return watch.Event{Type: watch.Added, Object: cur}, true
+	case prevPasses:
+		return watch.Event{Type: watch.Deleted, Object: prev}, true
+	}
+	return watch.Event{}, false
+}
+
+func (w *watcher) decode(key string, data []byte, index uint64, expiration *time.Time) runtime.Object {
+	obj, err := w.storage.codec.Decode(data)
+	if err != nil {
+		// Ignore this value. If we stop the watch on a bad value, a client that uses
+		// the resourceVersion to resume will never be able to get past a bad value.
+		glog.Errorf("failure to decode api object for %s: %v", key, err)
+		return nil
+	}
+	// being unable to set the version does not prevent the object from being extracted
+	_ = w.storage.versioner.UpdateObject(obj, expiration, index)
+	return obj
+}
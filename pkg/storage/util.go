@@ -0,0 +1,79 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// ParseWatchResourceVersion takes a resource version argument and converts it to
+// the storage version we should pass to Interface.Watch(). Because resourceVersion is
+// an opaque value, the default watch behavior for non-zero watch is to watch
+// the next value (if you pass "1", you will see updates from "2" onwards).
+func ParseWatchResourceVersion(resourceVersion, kind string) (uint64, error) {
+	if resourceVersion == "" || resourceVersion == "0" {
+		return 0, nil
+	}
+	version, err := strconv.ParseUint(resourceVersion, 10, 64)
+	if err != nil {
+		// TODO: Does this need to be a ValidationErrorList?  I can't convince myself it does.
+		return 0, errors.NewInvalid(kind, "", fielderrors.ValidationErrorList{fielderrors.NewFieldInvalid("resourceVersion", resourceVersion, err.Error())})
+	}
+	return version + 1, nil
+}
+
+// ParseListResourceVersion takes a resource version argument of a list and
+// converts it to the minimal storage version the list should be served from.
+func ParseListResourceVersion(resourceVersion, kind string) (uint64, error) {
+	if resourceVersion == "" {
+		return 0, nil
+	}
+	version, err := strconv.ParseUint(resourceVersion, 10, 64)
+	if err != nil {
+		return 0, errors.NewInvalid(kind, "", fielderrors.ValidationErrorList{fielderrors.NewFieldInvalid("resourceVersion", resourceVersion, err.Error())})
+	}
+	return version, nil
+}
+
+// NamespaceKeyFunc returns the key of a namespaced object stored under prefix,
+// in the form <prefix>/<namespace>/<name>.
+func NamespaceKeyFunc(prefix string, obj runtime.Object) (string, error) {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return "", err
+	}
+	if len(objectMeta.Namespace) == 0 {
+		return "", fmt.Errorf("object %q has no namespace", objectMeta.Name)
+	}
+	return prefix + "/" + objectMeta.Namespace + "/" + objectMeta.Name, nil
+}
+
+// NoNamespaceKeyFunc returns the key of a cluster-scoped object stored under
+// prefix, in the form <prefix>/<name>.
+func NoNamespaceKeyFunc(prefix string, obj runtime.Object) (string, error) {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return "", err
+	}
+	return prefix + "/" + objectMeta.Name, nil
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// watchCacheEvent is a single change observed by the watchCache. PrevObject
+// is the state of the object before the change, or nil if it did not exist.
+type watchCacheEvent struct {
+	Type            watch.EventType
+	Key             string
+	Object          runtime.Object
+	PrevObject      runtime.Object
+	ResourceVersion uint64
+}
+
+// watchCacheElement is an object stored in the watchCache together with its key.
+type watchCacheElement struct {
+	Key    string
+	Object runtime.Object
+}
+
+// watchCache keeps the current state of a set of objects together with a
+// sliding window of the most recent changes to them, so that watches can be
+// resumed from any resource version that is still inside the window.
+//
+// It is fed by a single watch (see Cacher) and is safe for concurrent use.
+type watchCache struct {
+	sync.RWMutex
+
+	// cond is signalled whenever resourceVersion changes.
+	cond *sync.Cond
+
+	// capacity is the maximum number of events kept in the window.
+	capacity int
+
+	// keyFunc computes the key of a stored object.
+	keyFunc func(runtime.Object) (string, error)
+
+	// versioner is used to read the resource version of stored objects.
+	versioner Versioner
+
+	// cache is a cyclic buffer of the last events; the window consists of the
+	// elements between startIndex (inclusive) and endIndex (exclusive), modulo
+	// capacity.
+	cache      []watchCacheEvent
+	startIndex int
+	endIndex   int
+
+	// store holds the current state of every object, by key.
+	store map[string]runtime.Object
+
+	// resourceVersion is the resource version up to which the cache is
+	// up to date.
+	resourceVersion uint64
+
+	// oldestResourceVersion is the resource version after which all events
+	// are still present in the window.
+	oldestResourceVersion uint64
+
+	// onReplace, if set, is called after the contents are replaced.
+	onReplace func()
+
+	// onEvent, if set, is called for every event, while the lock is held.
+	onEvent func(watchCacheEvent)
+}
+
+func newWatchCache(capacity int, keyFunc func(runtime.Object) (string, error), versioner Versioner) *watchCache {
+	wc := &watchCache{
+		capacity:  capacity,
+		keyFunc:   keyFunc,
+		versioner: versioner,
+		cache:     make([]watchCacheEvent, capacity),
+		store:     map[string]runtime.Object{},
+	}
+	wc.cond = sync.NewCond(wc.RLocker())
+	return wc
+}
+
+// Add records the creation of obj.
+func (w *watchCache) Add(obj runtime.Object) error {
+	return w.processEvent(watch.Added, obj)
+}
+
+// Update records a modification of obj.
+func (w *watchCache) Update(obj runtime.Object) error {
+	return w.processEvent(watch.Modified, obj)
+}
+
+// Delete records the deletion of obj. The resource version of obj must be
+// the one at which it was deleted.
+func (w *watchCache) Delete(obj runtime.Object) error {
+	return w.processEvent(watch.Deleted, obj)
+}
+
+func (w *watchCache) processEvent(eventType watch.EventType, obj runtime.Object) error {
+	key, err := w.keyFunc(obj)
+	if err != nil {
+		return err
+	}
+	resourceVersion, err := w.versioner.ObjectResourceVersion(obj)
+	if err != nil {
+		return err
+	}
+
+	w.Lock()
+	defer w.Unlock()
+	event := watchCacheEvent{
+		Type:            eventType,
+		Key:             key,
+		Object:          obj,
+		PrevObject:      w.store[key],
+		ResourceVersion: resourceVersion,
+	}
+	if eventType == watch.Deleted {
+		delete(w.store, key)
+	} else {
+		w.store[key] = obj
+	}
+	w.updateCache(event)
+	w.resourceVersion = resourceVersion
+	w.cond.Broadcast()
+	if w.onEvent != nil {
+		w.onEvent(event)
+	}
+	return nil
+}
+
+// updateCache appends event to the window, dropping the oldest event if the
+// window is full. Assumes that the lock is held.
+func (w *watchCache) updateCache(event watchCacheEvent) {
+	if w.endIndex == w.startIndex+w.capacity {
+		// Cache is full - remove the oldest element.
+		w.oldestResourceVersion = w.cache[w.startIndex%w.capacity].ResourceVersion
+		w.startIndex++
+	}
+	w.cache[w.endIndex%w.capacity] = event
+	w.endIndex++
+}
+
+// Replace discards the current contents and window and replaces them with
+// objs, as of resourceVersion.
+func (w *watchCache) Replace(objs []runtime.Object, resourceVersion uint64) error {
+	store := make(map[string]runtime.Object, len(objs))
+	for _, obj := range objs {
+		key, err := w.keyFunc(obj)
+		if err != nil {
+			return err
+		}
+		store[key] = obj
+	}
+
+	w.Lock()
+	defer w.Unlock()
+	w.store = store
+	w.startIndex = 0
+	w.endIndex = 0
+	w.resourceVersion = resourceVersion
+	w.oldestResourceVersion = resourceVersion
+	if w.onReplace != nil {
+		w.onReplace()
+	}
+	w.cond.Broadcast()
+	return nil
+}
+
+// SetOnReplace sets the function called after the contents are replaced.
+func (w *watchCache) SetOnReplace(onReplace func()) {
+	w.Lock()
+	defer w.Unlock()
+	w.onReplace = onReplace
+}
+
+// SetOnEvent sets the function called for every event. It is called with the
+// lock held, so it must not call back into the watchCache.
+func (w *watchCache) SetOnEvent(onEvent func(watchCacheEvent)) {
+	w.Lock()
+	defer w.Unlock()
+	w.onEvent = onEvent
+}
+
+// WaitUntilFreshAndList waits until the cache is at least as fresh as
+// resourceVersion and returns all stored objects together with the resource
+// version they are current as of. It returns an error if the cache does not
+// catch up within timeout.
+func (w *watchCache) WaitUntilFreshAndList(resourceVersion uint64, timeout time.Duration) ([]watchCacheElement, uint64, error) {
+	deadline := time.Now().Add(timeout)
+	timer := time.AfterFunc(timeout, func() {
+		// Wake up the waiters below so that they notice the deadline.
+		w.cond.Broadcast()
+	})
+	defer timer.Stop()
+
+	w.RLock()
+	defer w.RUnlock()
+	for w.resourceVersion < resourceVersion {
+		if time.Now().After(deadline) {
+			return nil, 0, errors.NewTimeoutError(fmt.Sprintf("too large resource version: %d, current: %d", resourceVersion, w.resourceVersion), 1)
+		}
+		w.cond.Wait()
+	}
+	result := make([]watchCacheElement, 0, len(w.store))
+	for key, obj := range w.store {
+		result = append(result, watchCacheElement{Key: key, Object: obj})
+	}
+	return result, w.resourceVersion, nil
+}
+
+// ResourceVersion returns the resource version up to which the cache is up to date.
+func (w *watchCache) ResourceVersion() uint64 {
+	w.RLock()
+	defer w.RUnlock()
+	return w.resourceVersion
+}
+
+// GetAllEventsSince returns all events with a resource version greater than
+// resourceVersion. If resourceVersion is 0, the current state is returned as
+// a series of Added events instead.
+func (w *watchCache) GetAllEventsSince(resourceVersion uint64) ([]watchCacheEvent, error) {
+	w.RLock()
+	defer w.RUnlock()
+	return w.getAllEventsSinceThreadUnsafe(resourceVersion)
+}
+
+// getAllEventsSinceThreadUnsafe is GetAllEventsSince, assuming that the lock is held.
+func (w *watchCache) getAllEventsSinceThreadUnsafe(resourceVersion uint64) ([]watchCacheEvent, error) {
+	if resourceVersion == 0 {
+		result := make([]watchCacheEvent, 0, len(w.store))
+		for key, obj := range w.store {
+			result = append(result, watchCacheEvent{
+				Type:            watch.Added,
+				Key:             key,
+				Object:          obj,
+				ResourceVersion: w.resourceVersion,
+			})
+		}
+		return result, nil
+	}
+	if resourceVersion < w.oldestResourceVersion {
+		return nil, errors.NewBadRequest(fmt.Sprintf("too old resource version: %d (%d)", resourceVersion, w.oldestResourceVersion))
+	}
+
+	size := w.endIndex - w.startIndex
+	// Find the first event newer than resourceVersion.
+	first := sort.Search(size, func(i int) bool {
+		return w.cache[(w.startIndex+i)%w.capacity].ResourceVersion > resourceVersion
+	})
+	result := make([]watchCacheEvent, size-first)
+	for i := 0; i < size-first; i++ {
+		result[i] = w.cache[(w.startIndex+first+i)%w.capacity]
+	}
+	return result, nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+func makeTestPod(name string, resourceVersion uint64) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Namespace:       "ns",
+			Name:            name,
+			ResourceVersion: strconv.FormatUint(resourceVersion, 10),
+		},
+	}
+}
+
+func newTestWatchCache(capacity int) *watchCache {
+	keyFunc := func(obj runtime.Object) (string, error) {
+		return NamespaceKeyFunc("/pods", obj)
+	}
+	return newWatchCache(capacity, keyFunc, APIObjectVersioner{})
+}
+
+func TestWatchCacheBasic(t *testing.T) {
+	store := newTestWatchCache(2)
+
+	if err := store.Add(makeTestPod("pod1", 1)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := store.Update(makeTestPod("pod1", 2)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := store.Add(makeTestPod("pod2", 3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	elements, resourceVersion, err := store.WaitUntilFreshAndList(0, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resourceVersion != 3 {
+		t.Errorf("expected resource version 3, got %d", resourceVersion)
+	}
+	if len(elements) != 2 {
+		t.Errorf("expected 2 elements, got %#v", elements)
+	}
+
+	if err := store.Delete(makeTestPod("pod1", 4)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	elements, _, err = store.WaitUntilFreshAndList(4, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(elements) != 1 || elements[0].Key != "/pods/ns/pod2" {
+		t.Errorf("expected only pod2, got %#v", elements)
+	}
+
+	if err := store.Replace([]runtime.Object{makeTestPod("pod3", 5), makeTestPod("pod4", 6)}, 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	elements, resourceVersion, err = store.WaitUntilFreshAndList(10, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resourceVersion != 10 || len(elements) != 2 {
+		t.Errorf("expected 2 elements at 10, got %#v at %d", elements, resourceVersion)
+	}
+}
+
+func TestWatchCacheEvents(t *testing.T) {
+	store := newTestWatchCache(3)
+	store.Replace([]runtime.Object{}, 1)
+
+	store.Add(makeTestPod("pod", 2))
+	store.Update(makeTestPod("pod", 3))
+	store.Update(makeTestPod("pod", 4))
+
+	events, err := store.GetAllEventsSince(2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %#v", events)
+	}
+	for i, event := range events {
+		if event.Type != watch.Modified {
+			t.Errorf("expected Modified, got %v", event.Type)
+		}
+		if e, a := uint64(3+i), event.ResourceVersion; e != a {
+			t.Errorf("expected resource version %d, got %d", e, a)
+		}
+		if event.PrevObject == nil {
+			t.Errorf("expected a previous object for event %d", i)
+		}
+	}
+
+	// Overflowing the window makes the oldest versions unavailable.
+	store.Delete(makeTestPod("pod", 5))
+	if _, err := store.GetAllEventsSince(1); err == nil {
+		t.Errorf("expected an error for a resource version outside of the window")
+	}
+	events, err = store.GetAllEventsSince(2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 3 || events[2].Type != watch.Deleted {
+		t.Errorf("unexpected events: %#v", events)
+	}
+
+	// Resource version 0 returns the current state.
+	store.Add(makeTestPod("other", 6))
+	events, err = store.GetAllEventsSince(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 1 || events[0].Type != watch.Added || events[0].Key != "/pods/ns/other" {
+		t.Errorf("unexpected events: %#v", events)
+	}
+}
+
+func TestWatchCacheWaitUntilFresh(t *testing.T) {
+	store := newTestWatchCache(3)
+	store.Replace([]runtime.Object{}, 1)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		store.Add(makeTestPod("pod", 5))
+	}()
+	elements, resourceVersion, err := store.WaitUntilFreshAndList(5, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resourceVersion != 5 || len(elements) != 1 {
+		t.Errorf("expected 1 element at 5, got %#v at %d", elements, resourceVersion)
+	}
+
+	if _, _, err := store.WaitUntilFreshAndList(10, 50*time.Millisecond); err == nil {
+		t.Errorf("expected a timeout")
+	}
+}
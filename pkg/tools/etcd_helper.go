@@ -31,6 +31,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/coreos/go-etcd/etcd"
 	"github.com/prometheus/client_golang/prometheus"
@@ -99,12 +100,13 @@ func recordEtcdRequestLatency(verb, resource string, startTime time.Time) {
 }
 
 // EtcdHelper offers common object marshalling/unmarshalling operations on an etcd client.
+// It implements storage.Interface.
 type EtcdHelper struct {
 	Client EtcdGetSet
 	Codec  runtime.Codec
 	Copier runtime.ObjectCopier
 	// optional, no atomic operations can be performed without this interface
-	Versioner EtcdVersioner
+	versioner storage.Versioner
 	// prefix for all etcd keys
 	PathPrefix string
 
@@ -121,17 +123,30 @@ type EtcdHelper struct {
 // NewEtcdHelper creates a helper that works against objects that use the internal
 // Kubernetes API objects.
 // TODO: Refactor to take a runtiem.ObjectCopier
-func NewEtcdHelper(client EtcdGetSet, codec runtime.Codec, prefix string) EtcdHelper {
-	return EtcdHelper{
+func NewEtcdHelper(client EtcdGetSet, codec runtime.Codec, prefix string) *EtcdHelper {
+	return &EtcdHelper{
 		Client:     client,
 		Codec:      codec,
-		Versioner:  APIObjectVersioner{},
+		versioner:  storage.APIObjectVersioner{},
 		Copier:     api.Scheme,
 		PathPrefix: prefix,
 		cache:      util.NewCache(maxEtcdCacheEntries),
 	}
 }
 
+// Implements storage.Interface.
+var _ storage.Interface = &EtcdHelper{}
+
+// Backends returns the etcd members the helper talks to.
+func (h *EtcdHelper) Backends() []string {
+	return h.Client.GetCluster()
+}
+
+// Versioner returns the versioner used to set resource versions on objects.
+func (h *EtcdHelper) Versioner() storage.Versioner {
+	return h.versioner
+}
+
 // IsEtcdNotFound returns true iff err is an etcd not found error.
 func IsEtcdNotFound(err error) bool {
 	return isEtcdErrorNum(err, EtcdErrorCodeNotFound)
@@ -167,6 +182,25 @@ func etcdErrorIndex(err error) (uint64, bool) {
 	return 0, false
 }
 
+// toStorageErr converts etcd errors about missing, existing or modified keys
+// into the equivalent storage errors, and returns other errors unchanged.
+func toStorageErr(err error, key string) error {
+	if err == nil {
+		return nil
+	}
+	index, _ := etcdErrorIndex(err)
+	switch {
+	case IsEtcdNotFound(err):
+		return storage.NewKeyNotFoundError(key, index)
+	case IsEtcdNodeExist(err):
+		return storage.NewKeyExistsError(key, index)
+	case IsEtcdTestFailed(err):
+		return storage.NewResourceVersionConflictsError(key, index)
+	default:
+		return err
+	}
+}
+
 func (h *EtcdHelper) listEtcdNode(key string) ([]*etcd.Node, uint64, error) {
 	result, err := h.Client.Get(key, true, true)
 	if err != nil {
@@ -209,9 +243,9 @@ func (h *EtcdHelper) decodeNodeList(nodes []*etcd.Node, slicePtr interface{}) er
 			if err := h.Codec.DecodeInto([]byte(node.Value), obj.Interface().(runtime.Object)); err != nil {
 				return err
 			}
-			if h.Versioner != nil {
+			if h.versioner != nil {
 				// being unable to set the version does not prevent the object from being extracted
-				_ = h.Versioner.UpdateObject(obj.Interface().(runtime.Object), node.Expiration, node.ModifiedIndex)
+				_ = h.versioner.UpdateObject(obj.Interface().(runtime.Object), node.Expiration, node.ModifiedIndex)
 			}
 			v.Set(reflect.Append(v, obj.Elem()))
 			if node.ModifiedIndex != 0 {
@@ -268,10 +302,10 @@ func (h *EtcdHelper) addToCache(index uint64, obj runtime.Object) {
 	}
 }
 
-// ExtractToList works on a *List api object (an object that satisfies the runtime.IsList
+// List works on a *List api object (an object that satisfies the runtime.IsList
 // definition) and extracts a go object per etcd node into a slice with the resource version.
-func (h *EtcdHelper) ExtractToList(key string, listObj runtime.Object) error {
-	trace := util.NewTrace("ExtractToList " + getTypeName(listObj))
+func (h *EtcdHelper) List(key string, listObj runtime.Object) error {
+	trace := util.NewTrace("List " + getTypeName(listObj))
 	defer trace.LogIfLong(time.Second)
 	listPtr, err := runtime.GetItemsPtr(listObj)
 	if err != nil {
@@ -284,24 +318,24 @@ func (h *EtcdHelper) ExtractToList(key string, listObj runtime.Object) error {
 	recordEtcdRequestLatency("list", getTypeName(listPtr), startTime)
 	trace.Step("Etcd node listed")
 	if err != nil {
-		return err
+		return toStorageErr(err, key)
 	}
 	if err := h.decodeNodeList(nodes, listPtr); err != nil {
 		return err
 	}
 	trace.Step("Node list decoded")
-	if h.Versioner != nil {
-		if err := h.Versioner.UpdateList(listObj, index); err != nil {
+	if h.versioner != nil {
+		if err := h.versioner.UpdateList(listObj, index); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// ExtractObjToList unmarshals json found at key and opaques it into a *List api object
+// GetToList unmarshals json found at key and opaques it into a *List api object
 // (an object that satisfies the runtime.IsList definition).
-func (h *EtcdHelper) ExtractObjToList(key string, listObj runtime.Object) error {
-	trace := util.NewTrace("ExtractObjToList " + getTypeName(listObj))
+func (h *EtcdHelper) GetToList(key string, listObj runtime.Object) error {
+	trace := util.NewTrace("GetToList " + getTypeName(listObj))
 	listPtr, err := runtime.GetItemsPtr(listObj)
 	if err != nil {
 		return err
@@ -316,7 +350,7 @@ func (h *EtcdHelper) ExtractObjToList(key string, listObj runtime.Object) error
 		if IsEtcdNotFound(err) {
 			return nil
 		}
-		return err
+		return toStorageErr(err, key)
 	}
 
 	nodes := make([]*etcd.Node, 0)
@@ -326,21 +360,21 @@ func (h *EtcdHelper) ExtractObjToList(key string, listObj runtime.Object) error
 		return err
 	}
 	trace.Step("Object decoded")
-	if h.Versioner != nil {
-		if err := h.Versioner.UpdateList(listObj, response.EtcdIndex); err != nil {
+	if h.versioner != nil {
+		if err := h.versioner.UpdateList(listObj, response.EtcdIndex); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// ExtractObj unmarshals json found at key into objPtr. On a not found error, will either return
+// Get unmarshals json found at key into objPtr. On a not found error, will either return
 // a zero object of the requested type, or an error, depending on ignoreNotFound. Treats
 // empty responses and nil response nodes exactly like a not found error.
-func (h *EtcdHelper) ExtractObj(key string, objPtr runtime.Object, ignoreNotFound bool) error {
+func (h *EtcdHelper) Get(key string, objPtr runtime.Object, ignoreNotFound bool) error {
 	key = h.PrefixEtcdKey(key)
 	_, _, _, err := h.bodyAndExtractObj(key, objPtr, ignoreNotFound)
-	return err
+	return toStorageErr(err, key)
 }
 
 // bodyAndExtractObj performs the normal Get path to etcd, returning the parsed node and response for additional information
@@ -380,24 +414,24 @@ func (h *EtcdHelper) extractObj(response *etcd.Response, inErr error, objPtr run
 	}
 	body = node.Value
 	err = h.Codec.DecodeInto([]byte(body), objPtr)
-	if h.Versioner != nil {
-		_ = h.Versioner.UpdateObject(objPtr, node.Expiration, node.ModifiedIndex)
+	if h.versioner != nil {
+		_ = h.versioner.UpdateObject(objPtr, node.Expiration, node.ModifiedIndex)
 		// being unable to set the version does not prevent the object from being extracted
 	}
 	return body, node, err
 }
 
-// CreateObj adds a new object at a key unless it already exists. 'ttl' is time-to-live in seconds,
+// Create adds a new object at a key unless it already exists. 'ttl' is time-to-live in seconds,
 // and 0 means forever. If no error is returned and out is not nil, out will be set to the read value
 // from etcd.
-func (h *EtcdHelper) CreateObj(key string, obj, out runtime.Object, ttl uint64) error {
+func (h *EtcdHelper) Create(key string, obj, out runtime.Object, ttl uint64) error {
 	key = h.PrefixEtcdKey(key)
 	data, err := h.Codec.Encode(obj)
 	if err != nil {
 		return err
 	}
-	if h.Versioner != nil {
-		if version, err := h.Versioner.ObjectResourceVersion(obj); err == nil && version != 0 {
+	if h.versioner != nil {
+		if version, err := h.versioner.ObjectResourceVersion(obj); err == nil && version != 0 {
 			return errors.New("resourceVersion may not be set on objects to be created")
 		}
 	}
@@ -406,7 +440,7 @@ func (h *EtcdHelper) CreateObj(key string, obj, out runtime.Object, ttl uint64)
 	response, err := h.Client.Create(key, string(data), ttl)
 	recordEtcdRequestLatency("create", getTypeName(obj), startTime)
 	if err != nil {
-		return err
+		return toStorageErr(err, key)
 	}
 	if out != nil {
 		if _, err := conversion.EnforcePtr(out); err != nil {
@@ -417,17 +451,8 @@ func (h *EtcdHelper) CreateObj(key string, obj, out runtime.Object, ttl uint64)
 	return err
 }
 
-// Delete removes the specified key.
-func (h *EtcdHelper) Delete(key string, recursive bool) error {
-	key = h.PrefixEtcdKey(key)
-	startTime := time.Now()
-	_, err := h.Client.Delete(key, recursive)
-	recordEtcdRequestLatency("delete", "UNKNOWN", startTime)
-	return err
-}
-
-// DeleteObj removes the specified key and returns the value that existed at that spot.
-func (h *EtcdHelper) DeleteObj(key string, out runtime.Object) error {
+// Delete removes the specified key and returns the value that existed at that spot.
+func (h *EtcdHelper) Delete(key string, out runtime.Object) error {
 	key = h.PrefixEtcdKey(key)
 	if _, err := conversion.EnforcePtr(out); err != nil {
 		panic("unable to convert output object to pointer")
@@ -442,13 +467,13 @@ func (h *EtcdHelper) DeleteObj(key string, out runtime.Object) error {
 			_, _, err = h.extractObj(response, err, out, false, true)
 		}
 	}
-	return err
+	return toStorageErr(err, key)
 }
 
-// SetObj marshals obj via json, and stores under key. Will do an atomic update if obj's ResourceVersion
+// Set marshals obj via json, and stores under key. Will do an atomic update if obj's ResourceVersion
 // field is set. 'ttl' is time-to-live in seconds, and 0 means forever. If no error is returned and out is
 // not nil, out will be set to the read value from etcd.
-func (h *EtcdHelper) SetObj(key string, obj, out runtime.Object, ttl uint64) error {
+func (h *EtcdHelper) Set(key string, obj, out runtime.Object, ttl uint64) error {
 	var response *etcd.Response
 	data, err := h.Codec.Encode(obj)
 	if err != nil {
@@ -457,14 +482,14 @@ func (h *EtcdHelper) SetObj(key string, obj, out runtime.Object, ttl uint64) err
 	key = h.PrefixEtcdKey(key)
 
 	create := true
-	if h.Versioner != nil {
-		if version, err := h.Versioner.ObjectResourceVersion(obj); err == nil && version != 0 {
+	if h.versioner != nil {
+		if version, err := h.versioner.ObjectResourceVersion(obj); err == nil && version != 0 {
 			create = false
 			startTime := time.Now()
 			response, err = h.Client.CompareAndSwap(key, string(data), ttl, "", version)
 			recordEtcdRequestLatency("compareAndSwap", getTypeName(obj), startTime)
 			if err != nil {
-				return err
+				return toStorageErr(err, key)
 			}
 		}
 	}
@@ -476,7 +501,7 @@ func (h *EtcdHelper) SetObj(key string, obj, out runtime.Object, ttl uint64) err
 	}
 
 	if err != nil {
-		return err
+		return toStorageErr(err, key)
 	}
 	if out != nil {
 		if _, err := conversion.EnforcePtr(out); err != nil {
@@ -488,34 +513,6 @@ func (h *EtcdHelper) SetObj(key string, obj, out runtime.Object, ttl uint64) err
 	return err
 }
 
-// ResponseMeta contains information about the etcd metadata that is associated with
-// an object. It abstracts the actual underlying objects to prevent coupling with etcd
-// and to improve testability.
-type ResponseMeta struct {
-	// TTL is the time to live of the node that contained the returned object. It may be
-	// zero or negative in some cases (objects may be expired after the requested
-	// expiration time due to server lag).
-	TTL int64
-	// Expiration is the time at which the node that contained the returned object will expire and be deleted.
-	// This can be nil if there is no expiration time set for the node.
-	Expiration *time.Time
-	// The resource version of the node that contained the returned object.
-	ResourceVersion uint64
-}
-
-// Pass an EtcdUpdateFunc to EtcdHelper.GuaranteedUpdate to make an etcd update that is guaranteed to succeed.
-// See the comment for GuaranteedUpdate for more detail.
-type EtcdUpdateFunc func(input runtime.Object, res ResponseMeta) (output runtime.Object, ttl *uint64, err error)
-type SimpleEtcdUpdateFunc func(runtime.Object) (runtime.Object, error)
-
-// SimpleUpdateFunc converts SimpleEtcdUpdateFunc into EtcdUpdateFunc
-func SimpleUpdate(fn SimpleEtcdUpdateFunc) EtcdUpdateFunc {
-	return func(input runtime.Object, _ ResponseMeta) (runtime.Object, *uint64, error) {
-		out, err := fn(input)
-		return out, nil, err
-	}
-}
-
 // GuaranteedUpdate calls "tryUpdate()" to update key "key" that is of type "ptrToType". It keeps
 // calling tryUpdate() and retrying the update until success if there is etcd index conflict. Note that object
 // passed to tryUpdate() may change across invocations of tryUpdate() if other writers are simultaneously
@@ -524,8 +521,8 @@ func SimpleUpdate(fn SimpleEtcdUpdateFunc) EtcdUpdateFunc {
 //
 // Example:
 //
-// h := &util.EtcdHelper{client, encoding, versioning}
-// err := h.GuaranteedUpdate("myKey", &MyType{}, true, func(input runtime.Object, res ResponseMeta) (runtime.Object, *uint64, error) {
+// h := tools.NewEtcdHelper(client, codec, prefix)
+// err := h.GuaranteedUpdate("myKey", &MyType{}, true, func(input runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
 //	// Before each invocation of the user-defined function, "input" is reset to etcd's current contents for "myKey".
 //
 //	cur := input.(*MyType) // Guaranteed to succeed.
@@ -538,7 +535,7 @@ func SimpleUpdate(fn SimpleEtcdUpdateFunc) EtcdUpdateFunc {
 //	return cur, nil, nil
 // })
 //
-func (h *EtcdHelper) GuaranteedUpdate(key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate EtcdUpdateFunc) error {
+func (h *EtcdHelper) GuaranteedUpdate(key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate storage.UpdateFunc) error {
 	v, err := conversion.EnforcePtr(ptrToType)
 	if err != nil {
 		// Panic is appropriate, because this is a programming error.
@@ -549,9 +546,9 @@ func (h *EtcdHelper) GuaranteedUpdate(key string, ptrToType runtime.Object, igno
 		obj := reflect.New(v.Type()).Interface().(runtime.Object)
 		origBody, node, res, err := h.bodyAndExtractObj(key, obj, ignoreNotFound)
 		if err != nil {
-			return err
+			return toStorageErr(err, key)
 		}
-		meta := ResponseMeta{}
+		meta := storage.ResponseMeta{}
 		if node != nil {
 			meta.TTL = node.TTL
 			if node.Expiration != nil {
@@ -594,7 +591,7 @@ func (h *EtcdHelper) GuaranteedUpdate(key string, ptrToType runtime.Object, igno
 				continue
 			}
 			_, _, err = h.extractObj(response, err, ptrToType, false, false)
-			return err
+			return toStorageErr(err, key)
 		}
 
 		if string(data) == origBody {
@@ -610,7 +607,7 @@ func (h *EtcdHelper) GuaranteedUpdate(key string, ptrToType runtime.Object, igno
 			continue
 		}
 		_, _, err = h.extractObj(response, err, ptrToType, false, false)
-		return err
+		return toStorageErr(err, key)
 	}
 }
 
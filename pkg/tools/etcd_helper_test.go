@@ -34,6 +34,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
 	"github.com/coreos/go-etcd/etcd"
 	"github.com/stretchr/testify/assert"
@@ -85,7 +86,7 @@ func getEncodedPod(name string) string {
 	return string(pod)
 }
 
-func TestExtractToList(t *testing.T) {
+func TestList(t *testing.T) {
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
 	key := etcdtest.AddPrefix("/some/key")
@@ -145,7 +146,7 @@ func TestExtractToList(t *testing.T) {
 	}
 
 	var got api.PodList
-	err := helper.ExtractToList("/some/key", &got)
+	err := helper.List("/some/key", &got)
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -154,8 +155,8 @@ func TestExtractToList(t *testing.T) {
 	}
 }
 
-// TestExtractToListAcrossDirectories ensures that the client excludes directories and flattens tree-response - simulates cross-namespace query
-func TestExtractToListAcrossDirectories(t *testing.T) {
+// TestListAcrossDirectories ensures that the client excludes directories and flattens tree-response - simulates cross-namespace query
+func TestListAcrossDirectories(t *testing.T) {
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
 	key := etcdtest.AddPrefix("/some/key")
@@ -229,7 +230,7 @@ func TestExtractToListAcrossDirectories(t *testing.T) {
 	}
 
 	var got api.PodList
-	err := helper.ExtractToList("/some/key", &got)
+	err := helper.List("/some/key", &got)
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -238,7 +239,7 @@ func TestExtractToListAcrossDirectories(t *testing.T) {
 	}
 }
 
-func TestExtractToListExcludesDirectories(t *testing.T) {
+func TestListExcludesDirectories(t *testing.T) {
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
 	key := etcdtest.AddPrefix("/some/key")
@@ -300,7 +301,7 @@ func TestExtractToListExcludesDirectories(t *testing.T) {
 	}
 
 	var got api.PodList
-	err := helper.ExtractToList("/some/key", &got)
+	err := helper.List("/some/key", &got)
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -309,7 +310,7 @@ func TestExtractToListExcludesDirectories(t *testing.T) {
 	}
 }
 
-func TestExtractObj(t *testing.T) {
+func TestGet(t *testing.T) {
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
 	key := etcdtest.AddPrefix("/some/key")
@@ -322,7 +323,7 @@ func TestExtractObj(t *testing.T) {
 	}
 	fakeClient.Set(key, runtime.EncodeOrDie(testapi.Codec(), &expect), 0)
 	var got api.Pod
-	err := helper.ExtractObj("/some/key", &got, false)
+	err := helper.Get("/some/key", &got, false)
 	if err != nil {
 		t.Errorf("Unexpected error %#v", err)
 	}
@@ -331,7 +332,7 @@ func TestExtractObj(t *testing.T) {
 	}
 }
 
-func TestExtractObjNotFoundErr(t *testing.T) {
+func TestGetNotFoundErr(t *testing.T) {
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
 	key1 := etcdtest.AddPrefix("/some/key")
@@ -359,11 +360,14 @@ func TestExtractObjNotFoundErr(t *testing.T) {
 	}
 	try := func(key string) {
 		var got api.Pod
-		err := helper.ExtractObj(key, &got, false)
+		err := helper.Get(key, &got, false)
 		if err == nil {
 			t.Errorf("%s: wanted error but didn't get one", key)
 		}
-		err = helper.ExtractObj(key, &got, true)
+		if key == "/some/key" && !storage.IsNotFound(err) {
+			t.Errorf("%s: wanted a not found error, got %#v", key, err)
+		}
+		err = helper.Get(key, &got, true)
 		if err != nil {
 			t.Errorf("%s: didn't want error but got %#v", key, err)
 		}
@@ -374,12 +378,12 @@ func TestExtractObjNotFoundErr(t *testing.T) {
 	try("/some/key3")
 }
 
-func TestCreateObj(t *testing.T) {
+func TestCreate(t *testing.T) {
 	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
 	returnedObj := &api.Pod{}
-	err := helper.CreateObj("/some/key", obj, returnedObj, 5)
+	err := helper.Create("/some/key", obj, returnedObj, 5)
 	if err != nil {
 		t.Errorf("Unexpected error %#v", err)
 	}
@@ -400,22 +404,35 @@ func TestCreateObj(t *testing.T) {
 	}
 }
 
-func TestCreateObjNilOutParam(t *testing.T) {
+func TestCreateExisting(t *testing.T) {
+	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
+	fakeClient := NewFakeEtcdClient(t)
+	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
+	if err := helper.Create("/some/key", obj, nil, 0); err != nil {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	err := helper.Create("/some/key", obj, nil, 0)
+	if !storage.IsNodeExist(err) {
+		t.Errorf("Expected a key exists error, got %#v", err)
+	}
+}
+
+func TestCreateNilOutParam(t *testing.T) {
 	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
-	err := helper.CreateObj("/some/key", obj, nil, 5)
+	err := helper.Create("/some/key", obj, nil, 5)
 	if err != nil {
 		t.Errorf("Unexpected error %#v", err)
 	}
 }
 
-func TestSetObj(t *testing.T) {
+func TestSet(t *testing.T) {
 	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
 	returnedObj := &api.Pod{}
-	err := helper.SetObj("/some/key", obj, returnedObj, 5)
+	err := helper.Set("/some/key", obj, returnedObj, 5)
 	if err != nil {
 		t.Errorf("Unexpected error %#v", err)
 	}
@@ -437,18 +454,18 @@ func TestSetObj(t *testing.T) {
 	}
 }
 
-func TestSetObjFailCAS(t *testing.T) {
+func TestSetFailCAS(t *testing.T) {
 	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"}}
 	fakeClient := NewFakeEtcdClient(t)
 	fakeClient.CasErr = fakeClient.NewError(123)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
-	err := helper.SetObj("/some/key", obj, nil, 5)
+	err := helper.Set("/some/key", obj, nil, 5)
 	if err == nil {
 		t.Errorf("Expecting error.")
 	}
 }
 
-func TestSetObjWithVersion(t *testing.T) {
+func TestSetWithVersion(t *testing.T) {
 	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"}}
 	fakeClient := NewFakeEtcdClient(t)
 	fakeClient.TestIndex = true
@@ -464,7 +481,7 @@ func TestSetObjWithVersion(t *testing.T) {
 	}
 
 	returnedObj := &api.Pod{}
-	err := helper.SetObj("/some/key", obj, returnedObj, 7)
+	err := helper.Set("/some/key", obj, returnedObj, 7)
 	if err != nil {
 		t.Fatalf("Unexpected error %#v", err)
 	}
@@ -485,13 +502,13 @@ func TestSetObjWithVersion(t *testing.T) {
 	}
 }
 
-func TestSetObjWithoutResourceVersioner(t *testing.T) {
+func TestSetWithoutResourceVersioner(t *testing.T) {
 	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
-	helper.Versioner = nil
+	helper.versioner = nil
 	returnedObj := &api.Pod{}
-	err := helper.SetObj("/some/key", obj, returnedObj, 3)
+	err := helper.Set("/some/key", obj, returnedObj, 3)
 	key := etcdtest.AddPrefix("/some/key")
 	if err != nil {
 		t.Errorf("Unexpected error %#v", err)
@@ -513,12 +530,12 @@ func TestSetObjWithoutResourceVersioner(t *testing.T) {
 	}
 }
 
-func TestSetObjNilOutParam(t *testing.T) {
+func TestSetNilOutParam(t *testing.T) {
 	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	fakeClient := NewFakeEtcdClient(t)
 	helper := NewEtcdHelper(fakeClient, testapi.Codec(), etcdtest.PathPrefix())
-	helper.Versioner = nil
-	err := helper.SetObj("/some/key", obj, nil, 3)
+	helper.versioner = nil
+	err := helper.Set("/some/key", obj, nil, 3)
 	if err != nil {
 		t.Errorf("Unexpected error %#v", err)
 	}
@@ -533,7 +550,7 @@ func TestGuaranteedUpdate(t *testing.T) {
 	// Create a new node.
 	fakeClient.ExpectNotFoundGet(key)
 	obj := &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 1}
-	err := helper.GuaranteedUpdate("/some/key", &TestResource{}, true, SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
+	err := helper.GuaranteedUpdate("/some/key", &TestResource{}, true, storage.SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
 		return obj, nil
 	}))
 	if err != nil {
@@ -552,7 +569,7 @@ func TestGuaranteedUpdate(t *testing.T) {
 	// Update an existing node.
 	callbackCalled := false
 	objUpdate := &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 2}
-	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, true, SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
+	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, true, storage.SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
 		callbackCalled = true
 
 		if in.(*TestResource).Value != 1 {
@@ -588,7 +605,7 @@ func TestGuaranteedUpdateTTL(t *testing.T) {
 	// Create a new node.
 	fakeClient.ExpectNotFoundGet(key)
 	obj := &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 1}
-	err := helper.GuaranteedUpdate("/some/key", &TestResource{}, true, func(in runtime.Object, res ResponseMeta) (runtime.Object, *uint64, error) {
+	err := helper.GuaranteedUpdate("/some/key", &TestResource{}, true, func(in runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
 		if res.TTL != 0 {
 			t.Fatalf("unexpected response meta: %#v", res)
 		}
@@ -614,7 +631,7 @@ func TestGuaranteedUpdateTTL(t *testing.T) {
 	// Update an existing node.
 	callbackCalled := false
 	objUpdate := &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 2}
-	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, true, func(in runtime.Object, res ResponseMeta) (runtime.Object, *uint64, error) {
+	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, true, func(in runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
 		if res.TTL != 10 {
 			t.Fatalf("unexpected response meta: %#v", res)
 		}
@@ -646,7 +663,7 @@ func TestGuaranteedUpdateTTL(t *testing.T) {
 	// Update an existing node and change ttl
 	callbackCalled = false
 	objUpdate = &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 3}
-	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, true, func(in runtime.Object, res ResponseMeta) (runtime.Object, *uint64, error) {
+	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, true, func(in runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
 		if res.TTL != 10 {
 			t.Fatalf("unexpected response meta: %#v", res)
 		}
@@ -689,7 +706,7 @@ func TestGuaranteedUpdateNoChange(t *testing.T) {
 	// Create a new node.
 	fakeClient.ExpectNotFoundGet(key)
 	obj := &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 1}
-	err := helper.GuaranteedUpdate("/some/key", &TestResource{}, true, SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
+	err := helper.GuaranteedUpdate("/some/key", &TestResource{}, true, storage.SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
 		return obj, nil
 	}))
 	if err != nil {
@@ -699,7 +716,7 @@ func TestGuaranteedUpdateNoChange(t *testing.T) {
 	// Update an existing node with the same data
 	callbackCalled := false
 	objUpdate := &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 1}
-	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, true, SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
+	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, true, storage.SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
 		fakeClient.Err = errors.New("should not be called")
 		callbackCalled = true
 		return objUpdate, nil
@@ -722,7 +739,7 @@ func TestGuaranteedUpdateKeyNotFound(t *testing.T) {
 	fakeClient.ExpectNotFoundGet(key)
 	obj := &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 1}
 
-	f := SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
+	f := storage.SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
 		return obj, nil
 	})
 
@@ -759,7 +776,7 @@ func TestGuaranteedUpdate_CreateCollision(t *testing.T) {
 			defer wgDone.Done()
 
 			firstCall := true
-			err := helper.GuaranteedUpdate("/some/key", &TestResource{}, true, SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
+			err := helper.GuaranteedUpdate("/some/key", &TestResource{}, true, storage.SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
 				defer func() { firstCall = false }()
 
 				if firstCall {
@@ -17,9 +17,7 @@ limitations under the License.
 package tools
 
 import (
-	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/coreos/go-etcd/etcd"
-	"time"
 )
 
 const (
@@ -60,19 +58,3 @@ type EtcdGetSet interface {
 	CompareAndSwap(key, value string, ttl uint64, prevValue string, prevIndex uint64) (*etcd.Response, error)
 	Watch(prefix string, waitIndex uint64, recursive bool, receiver chan *etcd.Response, stop chan bool) (*etcd.Response, error)
 }
-
-// EtcdVersioner abstracts setting and retrieving fields from the etcd response onto the object
-// or list.
-type EtcdVersioner interface {
-	// UpdateObject sets etcd storage metadata into an API object. Returns an error if the object
-	// cannot be updated correctly. May return nil if the requested object does not need metadata
-	// from etcd.
-	UpdateObject(obj runtime.Object, expiration *time.Time, resourceVersion uint64) error
-	// UpdateList sets the resource version into an API list object. Returns an error if the object
-	// cannot be updated correctly. May return nil if the requested object does not need metadata
-	// from etcd.
-	UpdateList(obj runtime.Object, resourceVersion uint64) error
-	// ObjectResourceVersion returns the resource version (for persistence) of the specified object.
-	// Should return an error if the specified object does not have a persistable version.
-	ObjectResourceVersion(obj runtime.Object) (uint64, error)
-}
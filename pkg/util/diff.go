@@ -119,3 +119,128 @@ func ObjectGoPrintSideBySide(a, b interface{}) string {
 	w.Flush()
 	return buf.String()
 }
+
+// diffOp is a single line of an edit script produced by UnifiedDiff. kind is
+// ' ' for a line common to both inputs, '-' for a line only in a and '+' for a
+// line only in b. ai and bi are the indexes of the line in a and b before it
+// is consumed.
+type diffOp struct {
+	kind   byte
+	line   string
+	ai, bi int
+}
+
+// UnifiedDiff returns the differences between a and b in the unified diff
+// format, with three lines of context around each change, labeling the inputs
+// with aName and bName. An empty string is returned when a and b are equal.
+func UnifiedDiff(a, b, aName, bName string) string {
+	if a == b {
+		return ""
+	}
+	ops := lineDiff(splitLines(a), splitLines(b))
+
+	const context = 3
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "--- %s\n+++ %s\n", aName, bName)
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		// Extend the hunk until the next change is more than twice the
+		// context away, so that overlapping hunks are merged.
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(ops) && j <= end+2*context; j++ {
+			if ops[j].kind != ' ' {
+				end = j
+			}
+		}
+		i = end + 1
+		end += context
+		if end >= len(ops) {
+			end = len(ops) - 1
+		}
+		writeHunk(out, ops[start:end+1])
+	}
+	return out.String()
+}
+
+// splitLines splits s into lines, ignoring the final newline.
+func splitLines(s string) []string {
+	if len(s) == 0 {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// lineDiff computes a minimal edit script turning a into b from the longest
+// common subsequence of their lines.
+func lineDiff(a, b []string) []diffOp {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := []diffOp{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i], i, j})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', a[i], i, j})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j], i, j})
+			j++
+		}
+	}
+	return ops
+}
+
+// writeHunk writes a single hunk of a unified diff covering ops.
+func writeHunk(out *bytes.Buffer, ops []diffOp) {
+	aLen, bLen := 0, 0
+	for _, op := range ops {
+		if op.kind != '+' {
+			aLen++
+		}
+		if op.kind != '-' {
+			bLen++
+		}
+	}
+	fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(ops[0].ai, aLen), hunkRange(ops[0].bi, bLen))
+	for _, op := range ops {
+		fmt.Fprintf(out, "%c%s\n", op.kind, op.line)
+	}
+}
+
+// hunkRange formats the range of a hunk starting at the zero based index
+// start. An empty range refers to the line before it, as in GNU diff.
+func hunkRange(start, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if length == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected string
+	}{
+		{
+			a:        "a\nb\nc\n",
+			b:        "a\nb\nc\n",
+			expected: "",
+		},
+		{
+			a: "a\nb\nc\n",
+			b: "a\nx\nc\n",
+			expected: `--- a
++++ b
+@@ -1,3 +1,3 @@
+ a
+-b
++x
+ c
+`,
+		},
+		{
+			a: "",
+			b: "a\nb\n",
+			expected: `--- a
++++ b
+@@ -0,0 +1,2 @@
++a
++b
+`,
+		},
+		{
+			a: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			b: "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n",
+			expected: `--- a
++++ b
+@@ -1,3 +1,4 @@
++0
+ 1
+ 2
+ 3
+@@ -9,4 +10,3 @@
+ 9
+ 10
+ 11
+-12
+`,
+		},
+		{
+			a: "1\n2\n3\n4\n5\n6\n7\n",
+			b: "1\nx\n3\n4\n5\n6\ny\n",
+			expected: `--- a
++++ b
+@@ -1,7 +1,7 @@
+ 1
+-2
++x
+ 3
+ 4
+ 5
+ 6
+-7
++y
+`,
+		},
+	}
+	for i, test := range tests {
+		if actual := UnifiedDiff(test.a, test.b, "a", "b"); actual != test.expected {
+			t.Errorf("%d: unexpected diff:\n%s\nexpected:\n%s", i, actual, test.expected)
+		}
+	}
+}
@@ -18,6 +18,8 @@ package iptables
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -49,6 +51,12 @@ type Interface interface {
 	DeleteRule(table Table, chain Chain, args ...string) error
 	// IsIpv6 returns true if this is managing ipv6 tables
 	IsIpv6() bool
+	// Save calls `iptables-save` for table.
+	Save(table Table) ([]byte, error)
+	// Restore runs `iptables-restore` for table, passing data through a temporary file.
+	// flush sets the presence of the "--noflush" flag. see: FlushFlag
+	// counters sets the "--counters" flag. see: RestoreCountersFlag
+	Restore(table Table, data []byte, flush FlushFlag, counters RestoreCountersFlag) error
 }
 
 type Protocol byte
@@ -72,6 +80,25 @@ const (
 	ChainOutput      Chain = "OUTPUT"
 )
 
+// Option flag for Restore
+type RestoreCountersFlag bool
+
+const RestoreCounters RestoreCountersFlag = true
+const NoRestoreCounters RestoreCountersFlag = false
+
+// Option flag for Flush
+type FlushFlag bool
+
+const FlushTables FlushFlag = true
+const NoFlushTables FlushFlag = false
+
+const (
+	cmdIptablesSave     string = "iptables-save"
+	cmdIptablesRestore  string = "iptables-restore"
+	cmdIp6tablesSave    string = "ip6tables-save"
+	cmdIp6tablesRestore string = "ip6tables-restore"
+)
+
 // runner implements Interface in terms of exec("iptables").
 type runner struct {
 	mu       sync.Mutex
@@ -178,6 +205,54 @@ func (runner *runner) IsIpv6() bool {
 	return runner.protocol == ProtocolIpv6
 }
 
+// Save is part of Interface.
+func (runner *runner) Save(table Table) ([]byte, error) {
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+
+	// run and return
+	args := []string{"-t", string(table)}
+	glog.V(4).Infof("running %s %v", runner.saveCommand(), args)
+	return runner.exec.Command(runner.saveCommand(), args...).CombinedOutput()
+}
+
+// Restore is part of Interface.
+func (runner *runner) Restore(table Table, data []byte, flush FlushFlag, counters RestoreCountersFlag) error {
+	// setup args
+	args := []string{"-T", string(table)}
+	if !flush {
+		args = append(args, "--noflush")
+	}
+	if counters {
+		args = append(args, "--counters")
+	}
+
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+
+	// create temp file through which to pass data
+	temp, err := ioutil.TempFile("", "kube-temp-iptables-restore-")
+	if err != nil {
+		return err
+	}
+	// make sure we delete the temp file
+	defer os.Remove(temp.Name())
+	// NOTE: the filename must be the last argument, iptables-restore treats it as its input.
+	args = append(args, temp.Name())
+	_, err = temp.Write(data)
+	temp.Close()
+	if err != nil {
+		return err
+	}
+	// run the command and return the output or an error including the output and error
+	glog.V(4).Infof("running %s %v", runner.restoreCommand(), args)
+	b, err := runner.exec.Command(runner.restoreCommand(), args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%v (%s)", err, b)
+	}
+	return nil
+}
+
 func (runner *runner) iptablesCommand() string {
 	if runner.IsIpv6() {
 		return "ip6tables"
@@ -186,6 +261,20 @@ func (runner *runner) iptablesCommand() string {
 	}
 }
 
+func (runner *runner) saveCommand() string {
+	if runner.IsIpv6() {
+		return cmdIp6tablesSave
+	}
+	return cmdIptablesSave
+}
+
+func (runner *runner) restoreCommand() string {
+	if runner.IsIpv6() {
+		return cmdIp6tablesRestore
+	}
+	return cmdIptablesRestore
+}
+
 func (runner *runner) run(op operation, args []string) ([]byte, error) {
 	iptablesCmd := runner.iptablesCommand()
 
@@ -366,3 +455,17 @@ func iptablesHasCheckCommand(v1 int, v2 int, v3 int) bool {
 	}
 	return false
 }
+
+// IsNotFoundError returns true if the error indicates "not found".  It parses
+// the error string looking for known values, which is imperfect but works in
+// practice.
+func IsNotFoundError(err error) bool {
+	es := err.Error()
+	if strings.Contains(es, "No such file or directory") {
+		return true
+	}
+	if strings.Contains(es, "No chain/target/match by that name") {
+		return true
+	}
+	return false
+}
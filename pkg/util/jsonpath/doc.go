@@ -0,0 +1,31 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jsonpath implements a template language for selecting fields of
+// JSON-like data, following the syntax described at
+// http://goessner.net/articles/JsonPath/. A template mixes literal text with
+// actions delimited by "{" and "}":
+//
+//	{.metadata.name}                         a field
+//	{.items[*].metadata.name}                every element of a list
+//	{.items[0]} {.items[-1:]} {.items[0,2]}  indexes, slices and unions
+//	{..name}                                 recursive descent
+//	{.items[?(@.status.phase=="Running")]}   filters
+//	{range .items[*]}{.metadata.name}{"\n"}{end}
+//
+// Paths are evaluated against maps, slices, arrays and structs; struct fields
+// are addressed by their json tag names.
+package jsonpath
@@ -0,0 +1,540 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// JSONPath is a parsed template that can be executed against data.
+type JSONPath struct {
+	name   string
+	parser *Parser
+}
+
+// New allocates a new JSONPath with the given name.
+func New(name string) *JSONPath {
+	return &JSONPath{name: name}
+}
+
+// Parse parses the given template.
+func (j *JSONPath) Parse(text string) error {
+	parser, err := Parse(j.name, text)
+	if err != nil {
+		return err
+	}
+	j.parser = parser
+	return nil
+}
+
+// Execute applies the template to data and writes the output to w.
+func (j *JSONPath) Execute(w io.Writer, data interface{}) error {
+	if j.parser == nil {
+		return fmt.Errorf("%s is an incomplete jsonpath template", j.name)
+	}
+	root := reflect.ValueOf(data)
+	return j.walkTemplate(w, j.parser.Root, root, root)
+}
+
+// FindResults returns the values selected by each top level node of the
+// template. Literal text is returned as a single string value.
+func (j *JSONPath) FindResults(data interface{}) ([][]reflect.Value, error) {
+	if j.parser == nil {
+		return nil, fmt.Errorf("%s is an incomplete jsonpath template", j.name)
+	}
+	root := reflect.ValueOf(data)
+	results := [][]reflect.Value{}
+	for _, node := range j.parser.Root.Nodes {
+		switch node := node.(type) {
+		case *TextNode:
+			results = append(results, []reflect.Value{reflect.ValueOf(node.Text)})
+		case *ListNode:
+			values, err := j.evalPath(node, root, root)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, values)
+		default:
+			return nil, fmt.Errorf("%s: %s is not supported by FindResults", j.name, node.Type())
+		}
+	}
+	return results, nil
+}
+
+// PrintResults writes values to w separated by spaces. Strings and other
+// scalars are written as text, and maps, slices and structs as JSON.
+func (j *JSONPath) PrintResults(w io.Writer, values []reflect.Value) error {
+	for i, value := range values {
+		if i > 0 {
+			if _, err := fmt.Fprint(w, " "); err != nil {
+				return err
+			}
+		}
+		text, err := evalToText(value)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *JSONPath) walkTemplate(w io.Writer, list *ListNode, root, current reflect.Value) error {
+	for _, node := range list.Nodes {
+		switch node := node.(type) {
+		case *TextNode:
+			if _, err := fmt.Fprint(w, node.Text); err != nil {
+				return err
+			}
+		case *ListNode:
+			values, err := j.evalPath(node, root, current)
+			if err != nil {
+				return err
+			}
+			if err := j.PrintResults(w, values); err != nil {
+				return err
+			}
+		case *RangeNode:
+			values, err := j.evalPath(node.Path, root, current)
+			if err != nil {
+				return err
+			}
+			for _, value := range values {
+				if err := j.walkTemplate(w, node.Body, root, value); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("%s: unexpected node %v", j.name, node)
+		}
+	}
+	return nil
+}
+
+// evalPath applies each element of path in turn, starting from current.
+// After a recursive descent, elements that do not apply to a value are
+// skipped rather than reported as errors.
+func (j *JSONPath) evalPath(path *ListNode, root, current reflect.Value) ([]reflect.Value, error) {
+	values := []reflect.Value{current}
+	lenient := false
+	var err error
+	for _, node := range path.Nodes {
+		switch node := node.(type) {
+		case *RootNode:
+			values = []reflect.Value{root}
+		case *CurrentNode:
+			values = []reflect.Value{current}
+		case *FieldNode:
+			values, err = evalField(values, node.Name, lenient)
+		case *WildcardNode:
+			values = evalWildcard(values)
+		case *RecursiveNode:
+			values = evalRecursive(values)
+			lenient = true
+		case *ArrayNode:
+			values, err = evalArray(values, node, lenient)
+		case *UnionNode:
+			results := []reflect.Value{}
+			for _, value := range values {
+				for _, sub := range node.Nodes {
+					found, err := j.evalPath(sub, root, value)
+					if err != nil {
+						return nil, err
+					}
+					results = append(results, found...)
+				}
+			}
+			values = results
+		case *FilterNode:
+			values, err = j.evalFilter(values, node, root, lenient)
+		case *StringNode:
+			values = []reflect.Value{reflect.ValueOf(node.Value)}
+		case *IntNode:
+			values = []reflect.Value{reflect.ValueOf(node.Value)}
+		case *FloatNode:
+			values = []reflect.Value{reflect.ValueOf(node.Value)}
+		case *BoolNode:
+			values = []reflect.Value{reflect.ValueOf(node.Value)}
+		default:
+			return nil, fmt.Errorf("%s: unexpected node %v", j.name, node)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", j.name, err)
+		}
+	}
+	return values, nil
+}
+
+// Indirect dereferences pointers and interfaces, returning an invalid value
+// for nil.
+func Indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func evalField(values []reflect.Value, name string, lenient bool) ([]reflect.Value, error) {
+	results := []reflect.Value{}
+	for _, value := range values {
+		value = Indirect(value)
+		if !value.IsValid() {
+			continue
+		}
+		switch value.Kind() {
+		case reflect.Map:
+			if value.Type().Key().Kind() != reflect.String {
+				if lenient {
+					continue
+				}
+				return nil, fmt.Errorf("field %q can not be read from map with %s keys", name, value.Type().Key())
+			}
+			if found := value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key())); found.IsValid() {
+				results = append(results, found)
+			}
+		case reflect.Struct:
+			if found, ok := structField(value, name); ok {
+				results = append(results, found)
+			} else if !lenient {
+				return nil, fmt.Errorf("%s is not found", name)
+			}
+		default:
+			if !lenient {
+				return nil, fmt.Errorf("field %q can not be read from %s", name, value.Type())
+			}
+		}
+	}
+	return results, nil
+}
+
+// jsonFieldName returns the name a struct field is serialized under, and
+// whether the field's contents are serialized inline.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	name := strings.Split(tag, ",")[0]
+	if tag == "-" {
+		return "", false
+	}
+	if len(name) == 0 && (field.Anonymous || strings.Contains(tag, ",inline")) {
+		return "", true
+	}
+	if len(name) == 0 {
+		name = field.Name
+	}
+	return name, false
+}
+
+// structField finds the field of a struct serialized as name.
+func structField(value reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if len(field.PkgPath) != 0 && !field.Anonymous {
+			continue
+		}
+		fieldName, inline := jsonFieldName(field)
+		if inline {
+			if inner := Indirect(value.Field(i)); inner.IsValid() && inner.Kind() == reflect.Struct {
+				if found, ok := structField(inner, name); ok {
+					return found, true
+				}
+			}
+			continue
+		}
+		if fieldName == name {
+			return value.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// children returns the elements of a slice or array, the values of a map
+// ordered by key, or the serialized fields of a struct.
+func children(value reflect.Value) []reflect.Value {
+	value = Indirect(value)
+	if !value.IsValid() {
+		return nil
+	}
+	results := []reflect.Value{}
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			results = append(results, value.Index(i))
+		}
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Sort(valuesByString(keys))
+		for _, key := range keys {
+			results = append(results, value.MapIndex(key))
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if len(field.PkgPath) != 0 && !field.Anonymous {
+				continue
+			}
+			name, inline := jsonFieldName(field)
+			if inline {
+				results = append(results, children(value.Field(i))...)
+			} else if len(name) != 0 {
+				results = append(results, value.Field(i))
+			}
+		}
+	}
+	return results
+}
+
+type valuesByString []reflect.Value
+
+func (v valuesByString) Len() int      { return len(v) }
+func (v valuesByString) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v valuesByString) Less(i, j int) bool {
+	return fmt.Sprint(v[i].Interface()) < fmt.Sprint(v[j].Interface())
+}
+
+func evalWildcard(values []reflect.Value) []reflect.Value {
+	results := []reflect.Value{}
+	for _, value := range values {
+		results = append(results, children(value)...)
+	}
+	return results
+}
+
+func evalRecursive(values []reflect.Value) []reflect.Value {
+	results := []reflect.Value{}
+	for _, value := range values {
+		results = append(results, value)
+		results = append(results, evalRecursive(children(value))...)
+	}
+	return results
+}
+
+func evalArray(values []reflect.Value, node *ArrayNode, lenient bool) ([]reflect.Value, error) {
+	results := []reflect.Value{}
+	for _, value := range values {
+		value = Indirect(value)
+		if !value.IsValid() {
+			continue
+		}
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			if lenient {
+				continue
+			}
+			return nil, fmt.Errorf("%s is not an array or slice", value.Type())
+		}
+		length := value.Len()
+		if node.Index {
+			index := node.Params[0].Value
+			if index < 0 {
+				index += length
+			}
+			if index < 0 || index >= length {
+				if lenient {
+					continue
+				}
+				return nil, fmt.Errorf("array index out of bounds: index %d, length %d", node.Params[0].Value, length)
+			}
+			results = append(results, value.Index(index))
+			continue
+		}
+		start, end, step := 0, length, 1
+		if node.Params[0].Known {
+			start = clampIndex(node.Params[0].Value, length)
+		}
+		if node.Params[1].Known {
+			end = clampIndex(node.Params[1].Value, length)
+		}
+		if node.Params[2].Known {
+			step = node.Params[2].Value
+		}
+		if step <= 0 {
+			return nil, fmt.Errorf("array slice step must be positive, got %d", step)
+		}
+		for i := start; i < end; i += step {
+			results = append(results, value.Index(i))
+		}
+	}
+	return results, nil
+}
+
+// clampIndex resolves a negative slice bound relative to length and limits
+// the result to [0, length].
+func clampIndex(i, length int) int {
+	if i < 0 {
+		i += length
+	}
+	if i < 0 {
+		return 0
+	}
+	if i > length {
+		return length
+	}
+	return i
+}
+
+func (j *JSONPath) evalFilter(values []reflect.Value, node *FilterNode, root reflect.Value, lenient bool) ([]reflect.Value, error) {
+	results := []reflect.Value{}
+	for _, value := range values {
+		value = Indirect(value)
+		if !value.IsValid() {
+			continue
+		}
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			if lenient {
+				continue
+			}
+			return nil, fmt.Errorf("%s is not an array or slice and can not be filtered", value.Type())
+		}
+		for i := 0; i < value.Len(); i++ {
+			item := value.Index(i)
+			left, err := j.evalPath(node.Left, root, item)
+			if err != nil {
+				return nil, err
+			}
+			if node.Right == nil {
+				if len(left) != 0 {
+					results = append(results, item)
+				}
+				continue
+			}
+			right, err := j.evalPath(node.Right, root, item)
+			if err != nil {
+				return nil, err
+			}
+			if len(left) == 0 || len(right) == 0 {
+				continue
+			}
+			ok, err := compare(left[0], right[0], node.Operator)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				results = append(results, item)
+			}
+		}
+	}
+	return results, nil
+}
+
+var numberType = reflect.TypeOf(json.Number(""))
+
+// ToFloat returns the numeric value of v, accepting json.Number.
+func ToFloat(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	if v.Type() == numberType {
+		if f, err := json.Number(v.String()).Float64(); err == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
+
+// compare evaluates left op right. Numbers compare numerically and strings
+// lexically; other values only support equality.
+func compare(left, right reflect.Value, op string) (bool, error) {
+	left, right = Indirect(left), Indirect(right)
+	if !left.IsValid() || !right.IsValid() {
+		switch op {
+		case "==":
+			return left.IsValid() == right.IsValid(), nil
+		case "!=":
+			return left.IsValid() != right.IsValid(), nil
+		}
+		return false, nil
+	}
+	var cmp int
+	if l, ok := ToFloat(left); ok {
+		r, ok := ToFloat(right)
+		if !ok {
+			return compareEqual(left, right, op)
+		}
+		switch {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	} else if left.Kind() == reflect.String && right.Kind() == reflect.String {
+		switch l, r := left.String(), right.String(); {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	} else {
+		return compareEqual(left, right, op)
+	}
+	switch op {
+	case "==":
+		return cmp == 0, nil
+	case "!=":
+		return cmp != 0, nil
+	case "<":
+		return cmp < 0, nil
+	case ">":
+		return cmp > 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	}
+	return false, fmt.Errorf("unrecognized filter operator %s", op)
+}
+
+func compareEqual(left, right reflect.Value, op string) (bool, error) {
+	if left.Kind() == reflect.String && right.Kind() == reflect.String {
+		return compare(reflect.ValueOf(left.String()), reflect.ValueOf(right.String()), op)
+	}
+	switch op {
+	case "==":
+		return reflect.DeepEqual(left.Interface(), right.Interface()), nil
+	case "!=":
+		return !reflect.DeepEqual(left.Interface(), right.Interface()), nil
+	}
+	return false, fmt.Errorf("operator %s can not compare %s and %s", op, left.Type(), right.Type())
+}
+
+// evalToText returns the text form of a result.
+func evalToText(v reflect.Value) ([]byte, error) {
+	v = Indirect(v)
+	if !v.IsValid() {
+		return []byte{}, nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		return json.Marshal(v.Interface())
+	}
+	return []byte(fmt.Sprint(v.Interface())), nil
+}
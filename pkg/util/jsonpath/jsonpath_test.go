@@ -0,0 +1,207 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+type jsonpathTest struct {
+	name     string
+	template string
+	input    interface{}
+	expected string
+}
+
+func testJSONPath(tests []jsonpathTest, t *testing.T) {
+	for _, test := range tests {
+		j := New(test.name)
+		if err := j.Parse(test.template); err != nil {
+			t.Errorf("%s: unexpected parse error: %v", test.name, err)
+			continue
+		}
+		buf := &bytes.Buffer{}
+		if err := j.Execute(buf, test.input); err != nil {
+			t.Errorf("%s: unexpected execute error: %v", test.name, err)
+			continue
+		}
+		if buf.String() != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, buf.String())
+		}
+	}
+}
+
+func testJSONPathErrors(tests []jsonpathTest, t *testing.T) {
+	for _, test := range tests {
+		j := New(test.name)
+		if err := j.Parse(test.template); err != nil {
+			t.Errorf("%s: unexpected parse error: %v", test.name, err)
+			continue
+		}
+		buf := &bytes.Buffer{}
+		if err := j.Execute(buf, test.input); err == nil {
+			t.Errorf("%s: expected an error, got %q", test.name, buf.String())
+		}
+	}
+}
+
+const storeData = `{
+  "store": {
+    "book": [
+      {"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+      {"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+      {"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+      {"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+    ],
+    "bicycle": {"color": "red", "price": 19.95, "available": true}
+  }
+}`
+
+func decodeStoreData(t *testing.T) interface{} {
+	var data interface{}
+	decoder := json.NewDecoder(bytes.NewBufferString(storeData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return data
+}
+
+func TestJSONInput(t *testing.T) {
+	data := decodeStoreData(t)
+	tests := []jsonpathTest{
+		{"plain", "hello jsonpath", nil, "hello jsonpath"},
+		{"field", "{.store.bicycle.color}", data, "red"},
+		{"root", "{$.store.bicycle.price}", data, "19.95"},
+		{"bool", "{.store.bicycle.available}", data, "true"},
+		{"missing key", "{.store.bicycle.size}", data, ""},
+		{"object", "{.store.bicycle}", data, `{"available":true,"color":"red","price":19.95}`},
+		{"quoted field", "{['store']['bicycle']['color']}", data, "red"},
+		{"index", "{.store.book[1].author}", data, "Evelyn Waugh"},
+		{"negative index", "{.store.book[-1].author}", data, "J. R. R. Tolkien"},
+		{"slice", "{.store.book[0:2].price}", data, "8.95 12.99"},
+		{"slice with step", "{.store.book[::2].price}", data, "8.95 8.99"},
+		{"negative slice", "{.store.book[-2:].price}", data, "8.99 22.99"},
+		{"index union", "{.store.book[0,3].title}", data, "Sayings of the Century The Lord of the Rings"},
+		{"field union", "{.store.bicycle['color','price']}", data, "red 19.95"},
+		{"wildcard", "{.store.book[*].author}", data, "Nigel Rees Evelyn Waugh Herman Melville J. R. R. Tolkien"},
+		{"map wildcard", "{.store.bicycle.*}", data, "true red 19.95"},
+		{"recursive", "{..price}", data, "19.95 8.95 12.99 8.99 22.99"},
+		{"recursive wildcard", "{.store.book[0]..*}", data, `Nigel Rees reference 8.95 Sayings of the Century`},
+		{"filter", `{.store.book[?(@.price<10)].title}`, data, "Sayings of the Century Moby Dick"},
+		{"filter equal", `{.store.book[?(@.category=="reference")].author}`, data, "Nigel Rees"},
+		{"filter not equal", `{.store.book[?(@.category!='fiction')].author}`, data, "Nigel Rees"},
+		{"filter greater", `{.store.book[?(@.price>=22.99)].title}`, data, "The Lord of the Rings"},
+		{"filter exists", "{.store.book[?(@.isbn)].isbn}", data, "0-553-21311-3 0-395-19395-8"},
+		{"filter root", "{.store.book[?(@.price>$.store.bicycle.price)].price}", data, "22.99"},
+		{"recursive filter", "{..book[?(@.author=='Herman Melville')].price}", data, "8.99"},
+		{"range", `{range .store.book[*]}[{.category}] {.title}{"\n"}{end}`, data,
+			"[reference] Sayings of the Century\n[fiction] Sword of Honour\n[fiction] Moby Dick\n[fiction] The Lord of the Rings\n"},
+		{"nested range", `{range .store.book[0:2]}{range .*}{@}|{end};{end}`, data,
+			"Nigel Rees|reference|8.95|Sayings of the Century|;Evelyn Waugh|fiction|12.99|Sword of Honour|;"},
+		{"literal", `{"{"}{.store.bicycle.color}{"}"}`, data, "{red}"},
+	}
+	testJSONPath(tests, t)
+
+	failures := []jsonpathTest{
+		{"index out of bounds", "{.store.book[10]}", data, ""},
+		{"index of object", "{.store.bicycle[0]}", data, ""},
+		{"field of string", "{.store.bicycle.color.name}", data, ""},
+		{"filter object", "{.store.bicycle[?(@.color)]}", data, ""},
+		{"ordered booleans", "{.store[?(@.available>true)]}", []interface{}{map[string]interface{}{"available": true}}, ""},
+	}
+	testJSONPathErrors(failures, t)
+}
+
+type bookStore struct {
+	Name  string `json:"name"`
+	Books []book `json:"books"`
+	Owner *owner `json:"owner,omitempty"`
+}
+
+type owner struct {
+	Name string `json:"name"`
+}
+
+type meta struct {
+	Title string `json:"title"`
+}
+
+type book struct {
+	meta     `json:",inline"`
+	Category string   `json:"category"`
+	Price    float64  `json:"price"`
+	Tags     []string `json:"tags,omitempty"`
+	Internal string   `json:"-"`
+}
+
+func TestStructInput(t *testing.T) {
+	store := bookStore{
+		Name: "corner store",
+		Books: []book{
+			{meta: meta{Title: "Moby Dick"}, Category: "fiction", Price: 8.99, Tags: []string{"sea", "whale"}},
+			{meta: meta{Title: "Sayings of the Century"}, Category: "reference", Price: 8.95},
+		},
+	}
+	tests := []jsonpathTest{
+		{"field", "{.name}", store, "corner store"},
+		{"pointer", "{.books[0].price}", &store, "8.99"},
+		{"inline", "{.books[*].title}", store, "Moby Dick Sayings of the Century"},
+		{"nil pointer", "{.owner.name}", store, ""},
+		{"struct", "{.books[1]}", store, `{"title":"Sayings of the Century","category":"reference","price":8.95}`},
+		{"filter", "{.books[?(@.price>8.98)].title}", store, "Moby Dick"},
+		{"recursive", "{..tags[1]}", store, "whale"},
+		{"range", `{range .books[*]}{.title}: {.category}{"\n"}{end}`, store, "Moby Dick: fiction\nSayings of the Century: reference\n"},
+	}
+	testJSONPath(tests, t)
+
+	failures := []jsonpathTest{
+		{"unknown field", "{.books[0].author}", store, ""},
+		{"ignored field", "{.books[0].Internal}", store, ""},
+	}
+	testJSONPathErrors(failures, t)
+}
+
+func TestIncompleteTemplate(t *testing.T) {
+	if err := New("empty").Execute(&bytes.Buffer{}, nil); err == nil {
+		t.Errorf("expected an error executing an unparsed template")
+	}
+}
+
+func TestFindResults(t *testing.T) {
+	data := decodeStoreData(t)
+	j := New("find")
+	if err := j.Parse("{.store.bicycle.color}:{.store.book[*].price}"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	results, err := j.FindResults(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 3 || len(results[0]) != 1 || len(results[1]) != 1 || len(results[2]) != 4 {
+		t.Fatalf("unexpected results: %v", results)
+	}
+	buf := &bytes.Buffer{}
+	if err := j.PrintResults(buf, results[2]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "8.95 12.99 8.99 22.99" {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+}
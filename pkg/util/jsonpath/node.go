@@ -0,0 +1,206 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonpath
+
+import "fmt"
+
+// NodeType identifies the type of a parse tree node.
+type NodeType int
+
+const (
+	NodeText NodeType = iota
+	NodeList
+	NodeRange
+	NodeRoot
+	NodeCurrent
+	NodeField
+	NodeWildcard
+	NodeRecursive
+	NodeArray
+	NodeUnion
+	NodeFilter
+	NodeString
+	NodeInt
+	NodeFloat
+	NodeBool
+)
+
+var nodeTypeNames = map[NodeType]string{
+	NodeText:      "NodeText",
+	NodeList:      "NodeList",
+	NodeRange:     "NodeRange",
+	NodeRoot:      "NodeRoot",
+	NodeCurrent:   "NodeCurrent",
+	NodeField:     "NodeField",
+	NodeWildcard:  "NodeWildcard",
+	NodeRecursive: "NodeRecursive",
+	NodeArray:     "NodeArray",
+	NodeUnion:     "NodeUnion",
+	NodeFilter:    "NodeFilter",
+	NodeString:    "NodeString",
+	NodeInt:       "NodeInt",
+	NodeFloat:     "NodeFloat",
+	NodeBool:      "NodeBool",
+}
+
+func (t NodeType) String() string {
+	return nodeTypeNames[t]
+}
+
+// Node is an element of a parsed template.
+type Node interface {
+	Type() NodeType
+	String() string
+}
+
+// ListNode holds a sequence of nodes. The root of a template is a ListNode
+// of text, path and range nodes; each path is itself a ListNode of path
+// elements that are applied in order.
+type ListNode struct {
+	Nodes []Node
+}
+
+func (l *ListNode) append(n Node) {
+	l.Nodes = append(l.Nodes, n)
+}
+
+func (l *ListNode) Type() NodeType { return NodeList }
+func (l *ListNode) String() string { return fmt.Sprintf("%s%v", l.Type(), l.Nodes) }
+
+// TextNode holds literal text.
+type TextNode struct {
+	Text string
+}
+
+func (t *TextNode) Type() NodeType { return NodeText }
+func (t *TextNode) String() string { return fmt.Sprintf("%s: %q", t.Type(), t.Text) }
+
+// RangeNode executes its body once for each result of its path, with the
+// result as the current object.
+type RangeNode struct {
+	Path *ListNode
+	Body *ListNode
+}
+
+func (r *RangeNode) Type() NodeType { return NodeRange }
+func (r *RangeNode) String() string { return fmt.Sprintf("%s: %v %v", r.Type(), r.Path, r.Body) }
+
+// RootNode selects the object passed to Execute ("$").
+type RootNode struct{}
+
+func (r *RootNode) Type() NodeType { return NodeRoot }
+func (r *RootNode) String() string { return r.Type().String() }
+
+// CurrentNode selects the current object ("@").
+type CurrentNode struct{}
+
+func (c *CurrentNode) Type() NodeType { return NodeCurrent }
+func (c *CurrentNode) String() string { return c.Type().String() }
+
+// FieldNode selects a field of an object by name.
+type FieldNode struct {
+	Name string
+}
+
+func (f *FieldNode) Type() NodeType { return NodeField }
+func (f *FieldNode) String() string { return fmt.Sprintf("%s: %s", f.Type(), f.Name) }
+
+// WildcardNode selects every element or field of an object ("*").
+type WildcardNode struct{}
+
+func (w *WildcardNode) Type() NodeType { return NodeWildcard }
+func (w *WildcardNode) String() string { return w.Type().String() }
+
+// RecursiveNode selects an object and all of its descendants ("..").
+type RecursiveNode struct{}
+
+func (r *RecursiveNode) Type() NodeType { return NodeRecursive }
+func (r *RecursiveNode) String() string { return r.Type().String() }
+
+// ParamsEntry is one of the start, end and step values of an ArrayNode.
+// Known is false when the value was omitted.
+type ParamsEntry struct {
+	Value int
+	Known bool
+}
+
+// ArrayNode selects elements of a slice or array, either a single element
+// by index or a [start:end:step] slice. Negative values count from the end.
+type ArrayNode struct {
+	Params [3]ParamsEntry
+	Index  bool
+}
+
+func (a *ArrayNode) Type() NodeType { return NodeArray }
+func (a *ArrayNode) String() string { return fmt.Sprintf("%s: %v", a.Type(), a.Params) }
+
+// UnionNode selects the results of several paths.
+type UnionNode struct {
+	Nodes []*ListNode
+}
+
+func (u *UnionNode) Type() NodeType { return NodeUnion }
+func (u *UnionNode) String() string { return fmt.Sprintf("%s: %v", u.Type(), u.Nodes) }
+
+// FilterNode selects the elements of a slice or array for which a
+// comparison holds. If Right is nil, elements are selected when Left
+// produces a result.
+type FilterNode struct {
+	Left     *ListNode
+	Operator string
+	Right    *ListNode
+}
+
+func (f *FilterNode) Type() NodeType { return NodeFilter }
+func (f *FilterNode) String() string {
+	if f.Right == nil {
+		return fmt.Sprintf("%s: %v", f.Type(), f.Left)
+	}
+	return fmt.Sprintf("%s: %v %s %v", f.Type(), f.Left, f.Operator, f.Right)
+}
+
+// StringNode is a string literal in a filter.
+type StringNode struct {
+	Value string
+}
+
+func (s *StringNode) Type() NodeType { return NodeString }
+func (s *StringNode) String() string { return fmt.Sprintf("%s: %q", s.Type(), s.Value) }
+
+// IntNode is an integer literal in a filter.
+type IntNode struct {
+	Value int
+}
+
+func (i *IntNode) Type() NodeType { return NodeInt }
+func (i *IntNode) String() string { return fmt.Sprintf("%s: %d", i.Type(), i.Value) }
+
+// FloatNode is a floating point literal in a filter.
+type FloatNode struct {
+	Value float64
+}
+
+func (f *FloatNode) Type() NodeType { return NodeFloat }
+func (f *FloatNode) String() string { return fmt.Sprintf("%s: %v", f.Type(), f.Value) }
+
+// BoolNode is a boolean literal in a filter.
+type BoolNode struct {
+	Value bool
+}
+
+func (b *BoolNode) Type() NodeType { return NodeBool }
+func (b *BoolNode) String() string { return fmt.Sprintf("%s: %t", b.Type(), b.Value) }
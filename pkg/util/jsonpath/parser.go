@@ -0,0 +1,365 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parser holds the parse tree of a template.
+type Parser struct {
+	Name string
+	Root *ListNode
+}
+
+// Parse parses a template into a tree of nodes.
+func Parse(name, text string) (*Parser, error) {
+	p := &Parser{Name: name}
+	root, err := p.parseTemplate(text)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	p.Root = root
+	return p, nil
+}
+
+// parseTemplate splits text into literal text and actions, nesting the
+// actions between {range ...} and {end} into range nodes.
+func (p *Parser) parseTemplate(text string) (*ListNode, error) {
+	root := &ListNode{}
+	current := root
+	parents := []*ListNode{}
+	for len(text) > 0 {
+		start := strings.Index(text, "{")
+		if start < 0 {
+			current.append(&TextNode{Text: text})
+			break
+		}
+		if start > 0 {
+			current.append(&TextNode{Text: text[:start]})
+		}
+		end, err := matchDelimiter(text, start, '{', '}')
+		if err != nil {
+			return nil, err
+		}
+		action := strings.TrimSpace(text[start+1 : end])
+		text = text[end+1:]
+
+		switch {
+		case action == "end":
+			if len(parents) == 0 {
+				return nil, fmt.Errorf("unexpected {end}")
+			}
+			current = parents[len(parents)-1]
+			parents = parents[:len(parents)-1]
+		case strings.HasPrefix(action, "range ") || strings.HasPrefix(action, "range\t"):
+			path, err := parsePath(strings.TrimSpace(action[len("range"):]))
+			if err != nil {
+				return nil, err
+			}
+			node := &RangeNode{Path: path, Body: &ListNode{}}
+			current.append(node)
+			parents = append(parents, current)
+			current = node.Body
+		case isQuoted(action):
+			s, err := unquote(action)
+			if err != nil {
+				return nil, err
+			}
+			current.append(&TextNode{Text: s})
+		default:
+			path, err := parsePath(action)
+			if err != nil {
+				return nil, err
+			}
+			current.append(path)
+		}
+	}
+	if len(parents) != 0 {
+		return nil, fmt.Errorf("unclosed {range}")
+	}
+	return root, nil
+}
+
+// parsePath parses the contents of an action into a list of path elements.
+func parsePath(text string) (*ListNode, error) {
+	if len(text) == 0 {
+		return nil, fmt.Errorf("empty path")
+	}
+	list := &ListNode{}
+	for i := 0; i < len(text); {
+		switch {
+		case text[i] == '$':
+			list.append(&RootNode{})
+			i++
+		case text[i] == '@':
+			list.append(&CurrentNode{})
+			i++
+		case strings.HasPrefix(text[i:], ".."):
+			list.append(&RecursiveNode{})
+			i += 2
+			i += parseName(text[i:], list)
+		case text[i] == '.':
+			i++
+			i += parseName(text[i:], list)
+		case text[i] == '[':
+			end, err := matchDelimiter(text, i, '[', ']')
+			if err != nil {
+				return nil, err
+			}
+			node, err := parseBracket(strings.TrimSpace(text[i+1 : end]))
+			if err != nil {
+				return nil, err
+			}
+			list.append(node)
+			i = end + 1
+		default:
+			// a leading field name may omit the dot
+			if len(list.Nodes) != 0 {
+				return nil, fmt.Errorf("unexpected %q in path %q", text[i], text)
+			}
+			n := parseName(text[i:], list)
+			if n == 0 {
+				return nil, fmt.Errorf("unexpected %q in path %q", text[i], text)
+			}
+			i += n
+		}
+	}
+	return list, nil
+}
+
+// parseName appends a field or wildcard node for the name at the start of
+// text, if any, and returns the number of bytes consumed.
+func parseName(text string, list *ListNode) int {
+	n := strings.IndexAny(text, ".[ \t\n=!<>(),")
+	if n < 0 {
+		n = len(text)
+	}
+	switch name := text[:n]; name {
+	case "":
+	case "*":
+		list.append(&WildcardNode{})
+	default:
+		list.append(&FieldNode{Name: name})
+	}
+	return n
+}
+
+// parseBracket parses the contents of a [...] path element.
+func parseBracket(text string) (Node, error) {
+	if strings.HasPrefix(text, "?(") && strings.HasSuffix(text, ")") {
+		return parseFilter(strings.TrimSpace(text[2 : len(text)-1]))
+	}
+	if text == "*" {
+		return &WildcardNode{}, nil
+	}
+	parts, err := splitUnquoted(text, ',')
+	if err != nil {
+		return nil, err
+	}
+	nodes := []Node{}
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if isQuoted(part) {
+			name, err := unquote(part)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, &FieldNode{Name: name})
+			continue
+		}
+		node, err := parseArray(part)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	union := &UnionNode{}
+	for _, node := range nodes {
+		union.Nodes = append(union.Nodes, &ListNode{Nodes: []Node{node}})
+	}
+	return union, nil
+}
+
+// parseArray parses an index or a start:end:step slice.
+func parseArray(text string) (*ArrayNode, error) {
+	values := strings.Split(text, ":")
+	if len(values) > 3 {
+		return nil, fmt.Errorf("invalid array index %q", text)
+	}
+	node := &ArrayNode{Index: len(values) == 1}
+	for i, value := range values {
+		value = strings.TrimSpace(value)
+		if len(value) == 0 {
+			if node.Index {
+				return nil, fmt.Errorf("invalid array index %q", text)
+			}
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid array index %q", text)
+		}
+		node.Params[i] = ParamsEntry{Value: n, Known: true}
+	}
+	return node, nil
+}
+
+var filterOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+// parseFilter parses the expression of a [?(...)] filter.
+func parseFilter(text string) (*FilterNode, error) {
+	pos, op := -1, ""
+	quote := byte(0)
+	for i := 0; i < len(text) && pos < 0; i++ {
+		switch c := text[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		default:
+			for _, candidate := range filterOperators {
+				if strings.HasPrefix(text[i:], candidate) {
+					pos, op = i, candidate
+					break
+				}
+			}
+		}
+	}
+	if pos < 0 {
+		left, err := parseOperand(text)
+		if err != nil {
+			return nil, err
+		}
+		return &FilterNode{Left: left}, nil
+	}
+	left, err := parseOperand(text[:pos])
+	if err != nil {
+		return nil, err
+	}
+	right, err := parseOperand(text[pos+len(op):])
+	if err != nil {
+		return nil, err
+	}
+	return &FilterNode{Left: left, Operator: op, Right: right}, nil
+}
+
+// parseOperand parses one side of a filter comparison, which is either a
+// literal or a path.
+func parseOperand(text string) (*ListNode, error) {
+	text = strings.TrimSpace(text)
+	if isQuoted(text) {
+		s, err := unquote(text)
+		if err != nil {
+			return nil, err
+		}
+		return &ListNode{Nodes: []Node{&StringNode{Value: s}}}, nil
+	}
+	if b, err := strconv.ParseBool(text); err == nil && (text == "true" || text == "false") {
+		return &ListNode{Nodes: []Node{&BoolNode{Value: b}}}, nil
+	}
+	if n, err := strconv.Atoi(text); err == nil {
+		return &ListNode{Nodes: []Node{&IntNode{Value: n}}}, nil
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return &ListNode{Nodes: []Node{&FloatNode{Value: f}}}, nil
+	}
+	return parsePath(text)
+}
+
+// matchDelimiter returns the index of the close delimiter matching the open
+// delimiter at text[start], skipping over quoted strings.
+func matchDelimiter(text string, start int, open, close byte) (int, error) {
+	depth := 0
+	quote := byte(0)
+	for i := start; i < len(text); i++ {
+		switch c := text[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == open:
+			depth++
+		case c == close:
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unclosed %q in %q", open, text[start:])
+}
+
+// splitUnquoted splits text on sep, ignoring separators in quoted strings.
+func splitUnquoted(text string, sep byte) ([]string, error) {
+	parts := []string{}
+	quote := byte(0)
+	last := 0
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == sep:
+			parts = append(parts, text[last:i])
+			last = i + 1
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated string in %q", text)
+	}
+	return append(parts, text[last:]), nil
+}
+
+func isQuoted(text string) bool {
+	if len(text) < 2 {
+		return false
+	}
+	first, last := text[0], text[len(text)-1]
+	return (first == '"' || first == '\'') && first == last
+}
+
+// unquote returns the contents of a quoted string. Double quoted strings
+// follow Go escaping rules; single quoted strings are taken literally.
+func unquote(text string) (string, error) {
+	if text[0] == '\'' {
+		return text[1 : len(text)-1], nil
+	}
+	s, err := strconv.Unquote(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid string %s: %v", text, err)
+	}
+	return s, nil
+}
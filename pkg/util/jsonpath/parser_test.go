@@ -0,0 +1,149 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonpath
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []Node
+	}{
+		{
+			name:     "text",
+			text:     "hello jsonpath",
+			expected: []Node{&TextNode{Text: "hello jsonpath"}},
+		},
+		{
+			name: "field",
+			text: "name: {.metadata.name}",
+			expected: []Node{
+				&TextNode{Text: "name: "},
+				&ListNode{Nodes: []Node{&FieldNode{Name: "metadata"}, &FieldNode{Name: "name"}}},
+			},
+		},
+		{
+			name: "root and quoted field",
+			text: "{$['book']}",
+			expected: []Node{
+				&ListNode{Nodes: []Node{&RootNode{}, &FieldNode{Name: "book"}}},
+			},
+		},
+		{
+			name:     "literal",
+			text:     `{"{\n}"}`,
+			expected: []Node{&TextNode{Text: "{\n}"}},
+		},
+		{
+			name: "array",
+			text: "{[1][-1:][0:4:2][*]}",
+			expected: []Node{
+				&ListNode{Nodes: []Node{
+					&ArrayNode{Params: [3]ParamsEntry{{1, true}}, Index: true},
+					&ArrayNode{Params: [3]ParamsEntry{{-1, true}}},
+					&ArrayNode{Params: [3]ParamsEntry{{0, true}, {4, true}, {2, true}}},
+					&WildcardNode{},
+				}},
+			},
+		},
+		{
+			name: "union",
+			text: "{['a', 'b'][0,2]}",
+			expected: []Node{
+				&ListNode{Nodes: []Node{
+					&UnionNode{Nodes: []*ListNode{
+						{Nodes: []Node{&FieldNode{Name: "a"}}},
+						{Nodes: []Node{&FieldNode{Name: "b"}}},
+					}},
+					&UnionNode{Nodes: []*ListNode{
+						{Nodes: []Node{&ArrayNode{Params: [3]ParamsEntry{{0, true}}, Index: true}}},
+						{Nodes: []Node{&ArrayNode{Params: [3]ParamsEntry{{2, true}}, Index: true}}},
+					}},
+				}},
+			},
+		},
+		{
+			name: "recursive",
+			text: "{..name}",
+			expected: []Node{
+				&ListNode{Nodes: []Node{&RecursiveNode{}, &FieldNode{Name: "name"}}},
+			},
+		},
+		{
+			name: "filter",
+			text: `{.items[?(@.price<=10.5)][?(@.isbn)]}`,
+			expected: []Node{
+				&ListNode{Nodes: []Node{
+					&FieldNode{Name: "items"},
+					&FilterNode{
+						Left:     &ListNode{Nodes: []Node{&CurrentNode{}, &FieldNode{Name: "price"}}},
+						Operator: "<=",
+						Right:    &ListNode{Nodes: []Node{&FloatNode{Value: 10.5}}},
+					},
+					&FilterNode{
+						Left: &ListNode{Nodes: []Node{&CurrentNode{}, &FieldNode{Name: "isbn"}}},
+					},
+				}},
+			},
+		},
+		{
+			name: "range",
+			text: `{range .items[*]}{.name}{"\n"}{end}`,
+			expected: []Node{
+				&RangeNode{
+					Path: &ListNode{Nodes: []Node{&FieldNode{Name: "items"}, &WildcardNode{}}},
+					Body: &ListNode{Nodes: []Node{
+						&ListNode{Nodes: []Node{&FieldNode{Name: "name"}}},
+						&TextNode{Text: "\n"},
+					}},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		parser, err := Parse(test.name, test.text)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.expected, parser.Root.Nodes) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, parser.Root.Nodes)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"unclosed action": "{.name",
+		"unclosed array":  "{.items[0}",
+		"unclosed range":  "{range .items[*]}{.name}",
+		"unexpected end":  "{.name}{end}",
+		"empty action":    "{}",
+		"invalid index":   "{.items[a]}",
+		"invalid slice":   "{.items[1:2:3:4]}",
+		"invalid string":  `{"\q"}`,
+	}
+	for name, text := range tests {
+		if _, err := Parse(name, text); err == nil {
+			t.Errorf("%s: expected an error parsing %q", name, text)
+		}
+	}
+}
@@ -44,12 +44,9 @@ func StrategicMergePatchData(original, patch []byte, dataStruct interface{}) ([]
 		return nil, err
 	}
 
-	t := reflect.TypeOf(dataStruct)
-	if t.Kind() == reflect.Ptr {
-		t = t.Elem()
-	}
-	if t.Kind() != reflect.Struct {
-		return nil, fmt.Errorf("strategic merge patch needs a struct, %s received instead", t.Kind().String())
+	t, err := getTagStructType(dataStruct)
+	if err != nil {
+		return nil, err
 	}
 
 	result, err := mergeMap(o, p, t)
@@ -62,6 +59,262 @@ func StrategicMergePatchData(original, patch []byte, dataStruct interface{}) ([]
 
 const specialKey = "$patch"
 
+// CreateTwoWayMergePatch creates a strategic merge patch that turns the
+// original document into the modified one. The dataStruct is used to look up
+// the patch strategy and merge key of each field.
+func CreateTwoWayMergePatch(original, modified []byte, dataStruct interface{}) ([]byte, error) {
+	originalMap, err := unmarshalToMap(original)
+	if err != nil {
+		return nil, err
+	}
+	modifiedMap, err := unmarshalToMap(modified)
+	if err != nil {
+		return nil, err
+	}
+	t, err := getTagStructType(dataStruct)
+	if err != nil {
+		return nil, err
+	}
+
+	patchMap, err := diffMaps(originalMap, modifiedMap, t, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(patchMap)
+}
+
+// CreateThreeWayMergePatch creates a strategic merge patch that brings the
+// current document in line with the modified one, while deleting the fields
+// that were present in the original document but have since been removed from
+// the modified one. Fields present only in the current document, such as those
+// set by the server or by other clients, are left alone. An empty original
+// document is allowed.
+func CreateThreeWayMergePatch(original, modified, current []byte, dataStruct interface{}) ([]byte, error) {
+	originalMap, err := unmarshalToMap(original)
+	if err != nil {
+		return nil, err
+	}
+	modifiedMap, err := unmarshalToMap(modified)
+	if err != nil {
+		return nil, err
+	}
+	currentMap, err := unmarshalToMap(current)
+	if err != nil {
+		return nil, err
+	}
+	t, err := getTagStructType(dataStruct)
+	if err != nil {
+		return nil, err
+	}
+
+	// Additions and changes are computed against the current document, so
+	// that anything that has drifted from the modified document is restored.
+	deltaMap, err := diffMaps(currentMap, modifiedMap, t, false, true)
+	if err != nil {
+		return nil, err
+	}
+	// Deletions are computed against the original document, so that only the
+	// fields the caller previously set are removed.
+	deletionsMap, err := diffMaps(originalMap, modifiedMap, t, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	patchMap, err := mergeMap(deletionsMap, deltaMap, t)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(patchMap)
+}
+
+// Returns a patch map that turns original into modified. Additions and changes
+// are left out of the patch if ignoreChangesAndAdditions is set, and deletions
+// are left out if ignoreDeletions is set. A null value in modified is treated
+// as a deletion.
+func diffMaps(original, modified map[string]interface{}, t reflect.Type, ignoreChangesAndAdditions, ignoreDeletions bool) (map[string]interface{}, error) {
+	patch := map[string]interface{}{}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	for key, modifiedValue := range modified {
+		originalValue, ok := original[key]
+		if modifiedValue == nil {
+			if !ignoreDeletions && ok && originalValue != nil {
+				patch[key] = nil
+			}
+			continue
+		}
+		if !ok || reflect.TypeOf(originalValue) != reflect.TypeOf(modifiedValue) {
+			// The key is new or its value changed type, so take the modified
+			// value wholesale.
+			if !ignoreChangesAndAdditions {
+				patch[key] = modifiedValue
+			}
+			continue
+		}
+
+		switch originalTyped := originalValue.(type) {
+		case map[string]interface{}:
+			modifiedTyped := modifiedValue.(map[string]interface{})
+			fieldType, fieldPatchStrategy, _, err := forkedjson.LookupPatchMetadata(t, key)
+			if err != nil {
+				return nil, err
+			}
+			if fieldPatchStrategy == "replace" {
+				if !ignoreChangesAndAdditions && !reflect.DeepEqual(originalTyped, modifiedTyped) {
+					patch[key] = modifiedValue
+				}
+				continue
+			}
+			patchValue, err := diffMaps(originalTyped, modifiedTyped, fieldType, ignoreChangesAndAdditions, ignoreDeletions)
+			if err != nil {
+				return nil, err
+			}
+			if len(patchValue) > 0 {
+				patch[key] = patchValue
+			}
+		case []interface{}:
+			modifiedTyped := modifiedValue.([]interface{})
+			fieldType, fieldPatchStrategy, fieldPatchMergeKey, err := forkedjson.LookupPatchMetadata(t, key)
+			if err != nil {
+				return nil, err
+			}
+			if fieldPatchStrategy == "merge" {
+				patchValue, err := diffLists(originalTyped, modifiedTyped, fieldType.Elem(), fieldPatchMergeKey, ignoreChangesAndAdditions, ignoreDeletions)
+				if err != nil {
+					return nil, err
+				}
+				if len(patchValue) > 0 {
+					patch[key] = patchValue
+				}
+				continue
+			}
+			if !ignoreChangesAndAdditions && !reflect.DeepEqual(originalTyped, modifiedTyped) {
+				patch[key] = modifiedValue
+			}
+		default:
+			if !ignoreChangesAndAdditions && !reflect.DeepEqual(originalValue, modifiedValue) {
+				patch[key] = modifiedValue
+			}
+		}
+	}
+
+	if !ignoreDeletions {
+		for key := range original {
+			if _, ok := modified[key]; !ok {
+				patch[key] = nil
+			}
+		}
+	}
+
+	return patch, nil
+}
+
+// Returns a patch list that turns original into modified for a list that is
+// merged by mergeKey. Elements removed from the list are expressed with the
+// "$patch: delete" directive. Lists of scalars are merged by union, so only
+// additions can be expressed for them.
+func diffLists(original, modified []interface{}, elemType reflect.Type, mergeKey string, ignoreChangesAndAdditions, ignoreDeletions bool) ([]interface{}, error) {
+	patch := []interface{}{}
+	if len(original) == 0 && len(modified) == 0 {
+		return patch, nil
+	}
+	t, err := sliceElementType(original, modified)
+	if err != nil {
+		return nil, fmt.Errorf("types of list elements need to be the same, type: %s: %v",
+			elemType.Kind().String(), err)
+	}
+	if t.Kind() == reflect.Slice {
+		return nil, fmt.Errorf("not supporting diffing lists of lists yet")
+	}
+	if t.Kind() != reflect.Map {
+		if ignoreChangesAndAdditions {
+			return patch, nil
+		}
+		for _, v := range modified {
+			if !containsScalar(original, v) {
+				patch = append(patch, v)
+			}
+		}
+		return patch, nil
+	}
+
+	if mergeKey == "" {
+		return nil, fmt.Errorf("cannot diff lists without merge key for type %s", elemType.Kind().String())
+	}
+
+	for _, v := range modified {
+		typedV := v.(map[string]interface{})
+		mergeValue, ok := typedV[mergeKey]
+		if !ok {
+			return nil, fmt.Errorf("all list elements need the merge key %s", mergeKey)
+		}
+		originalMap, _, found := findMapInSliceBasedOnKeyValue(original, mergeKey, mergeValue)
+		if !found {
+			if !ignoreChangesAndAdditions {
+				patch = append(patch, v)
+			}
+			continue
+		}
+		patchValue, err := diffMaps(originalMap, typedV, elemType, ignoreChangesAndAdditions, ignoreDeletions)
+		if err != nil {
+			return nil, err
+		}
+		if len(patchValue) > 0 {
+			patchValue[mergeKey] = mergeValue
+			patch = append(patch, patchValue)
+		}
+	}
+
+	if !ignoreDeletions {
+		for _, v := range original {
+			typedV := v.(map[string]interface{})
+			mergeValue, ok := typedV[mergeKey]
+			if !ok {
+				return nil, fmt.Errorf("all list elements need the merge key %s", mergeKey)
+			}
+			if _, _, found := findMapInSliceBasedOnKeyValue(modified, mergeKey, mergeValue); !found {
+				patch = append(patch, map[string]interface{}{mergeKey: mergeValue, specialKey: "delete"})
+			}
+		}
+	}
+
+	return patch, nil
+}
+
+func containsScalar(s []interface{}, v interface{}) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Unmarshals a JSON document into a map. An empty document yields an empty map.
+func unmarshalToMap(js []byte) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	if len(js) == 0 {
+		return m, nil
+	}
+	if err := json.Unmarshal(js, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func getTagStructType(dataStruct interface{}) (reflect.Type, error) {
+	t := reflect.TypeOf(dataStruct)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("strategic merge patch needs a struct, %s received instead", t.Kind().String())
+	}
+	return t, nil
+}
+
 // Merge fields from a patch map into the original map. Note: This may modify
 // both the original map and the patch because getting a deep copy of a map in
 // golang is highly non-trivial.
@@ -31,6 +31,27 @@ type TestCases struct {
 	SortMergeListTestCases   []SortMergeListCase
 }
 
+type CreatePatchTestCases struct {
+	TwoWayCases   []TwoWayCase
+	ThreeWayCases []ThreeWayCase
+}
+
+type TwoWayCase struct {
+	Description string
+	Original    map[string]interface{}
+	Modified    map[string]interface{}
+	Patch       map[string]interface{}
+}
+
+type ThreeWayCase struct {
+	Description string
+	Original    map[string]interface{}
+	Modified    map[string]interface{}
+	Current     map[string]interface{}
+	Patch       map[string]interface{}
+	Result      map[string]interface{}
+}
+
 type StrategicMergePatchCase struct {
 	Description string
 	Patch       map[string]interface{}
@@ -478,6 +499,242 @@ func TestSortMergeLists(t *testing.T) {
 	}
 }
 
+var createPatchTestCaseData = []byte(`
+twoWayCases:
+  - description: no changes
+    original:
+      name: 1
+      value: 1
+    modified:
+      name: 1
+      value: 1
+    patch: {}
+  - description: add, change and delete fields
+    original:
+      name: 1
+      value: 1
+    modified:
+      name: 2
+      simpleMap:
+        key1: val1
+    patch:
+      name: 2
+      value: null
+      simpleMap:
+        key1: val1
+  - description: merge, change and delete elements of a merging list
+    original:
+      mergingList:
+        - name: 1
+          value: 1
+        - name: 2
+          value: 2
+    modified:
+      mergingList:
+        - name: 1
+          value: 3
+        - name: 3
+    patch:
+      mergingList:
+        - name: 1
+          value: 3
+        - name: 3
+        - name: 2
+          $patch: delete
+  - description: replace a non merging list
+    original:
+      nonMergingList:
+        - name: 1
+        - name: 2
+    modified:
+      nonMergingList:
+        - name: 1
+    patch:
+      nonMergingList:
+        - name: 1
+  - description: add to a merging list of scalars
+    original:
+      mergingIntList:
+        - 1
+    modified:
+      mergingIntList:
+        - 1
+        - 2
+    patch:
+      mergingIntList:
+        - 2
+threeWayCases:
+  - description: keep fields set by others
+    original:
+      name: 1
+    modified:
+      name: 1
+      value: 1
+    current:
+      name: 1
+      simpleMap:
+        key1: val1
+    patch:
+      value: 1
+    result:
+      name: 1
+      value: 1
+      simpleMap:
+        key1: val1
+  - description: delete only fields removed from the original
+    original:
+      name: 1
+      value: 1
+      simpleMap:
+        key1: val1
+    modified:
+      name: 1
+      simpleMap:
+        key3: val3
+    current:
+      name: 1
+      value: 1
+      simpleMap:
+        key1: val1
+        key2: val2
+    patch:
+      value: null
+      simpleMap:
+        key1: null
+        key3: val3
+    result:
+      name: 1
+      simpleMap:
+        key2: val2
+        key3: val3
+  - description: restore drifted fields
+    original:
+      name: 1
+      value: 1
+    modified:
+      name: 1
+      value: 1
+    current:
+      name: 1
+      value: 2
+    patch:
+      value: 1
+    result:
+      name: 1
+      value: 1
+  - description: merge and delete elements of a merging list
+    original:
+      mergingList:
+        - name: 1
+        - name: 2
+    modified:
+      mergingList:
+        - name: 1
+          value: 1
+    current:
+      mergingList:
+        - name: 1
+        - name: 2
+        - name: 3
+    patch:
+      mergingList:
+        - name: 2
+          $patch: delete
+        - name: 1
+          value: 1
+    result:
+      mergingList:
+        - name: 1
+          value: 1
+        - name: 3
+  - description: empty original
+    modified:
+      name: 1
+    current:
+      value: 1
+    patch:
+      name: 1
+    result:
+      name: 1
+      value: 1
+`)
+
+func TestCreateTwoWayMergePatch(t *testing.T) {
+	tc := CreatePatchTestCases{}
+	err := yaml.Unmarshal(createPatchTestCaseData, &tc)
+	if err != nil {
+		t.Fatalf("can't unmarshal test cases: %v", err)
+	}
+
+	var e MergeItem
+	for _, c := range tc.TwoWayCases {
+		patch, err := CreateTwoWayMergePatch(toJSON(c.Original), toJSON(c.Modified), e)
+		if err != nil {
+			t.Errorf("%s: error creating patch: %v", c.Description, err)
+			continue
+		}
+		if !jsonEqual(patch, toJSON(c.Patch)) {
+			t.Errorf("%s: expected patch:\n%s\ngot patch:\n%s", c.Description, toYAML(c.Patch), jsonToYAML(patch))
+			continue
+		}
+
+		// Applying the patch to the original must yield the modified document.
+		result, err := StrategicMergePatchData(toJSON(c.Original), patch, e)
+		if err != nil {
+			t.Errorf("%s: error applying patch: %v", c.Description, err)
+			continue
+		}
+		if !jsonEqual(result, toJSON(c.Modified)) {
+			t.Errorf("%s: expected result:\n%s\ngot result:\n%s", c.Description, toYAML(c.Modified), jsonToYAML(result))
+		}
+	}
+}
+
+func TestCreateThreeWayMergePatch(t *testing.T) {
+	tc := CreatePatchTestCases{}
+	err := yaml.Unmarshal(createPatchTestCaseData, &tc)
+	if err != nil {
+		t.Fatalf("can't unmarshal test cases: %v", err)
+	}
+
+	var e MergeItem
+	for _, c := range tc.ThreeWayCases {
+		var original []byte
+		if c.Original != nil {
+			original = toJSON(c.Original)
+		}
+		patch, err := CreateThreeWayMergePatch(original, toJSON(c.Modified), toJSON(c.Current), e)
+		if err != nil {
+			t.Errorf("%s: error creating patch: %v", c.Description, err)
+			continue
+		}
+		if !jsonEqual(patch, toJSON(c.Patch)) {
+			t.Errorf("%s: expected patch:\n%s\ngot patch:\n%s", c.Description, toYAML(c.Patch), jsonToYAML(patch))
+			continue
+		}
+
+		result, err := StrategicMergePatchData(toJSON(c.Current), patch, e)
+		if err != nil {
+			t.Errorf("%s: error applying patch: %v", c.Description, err)
+			continue
+		}
+		if !jsonEqual(result, toJSON(c.Result)) {
+			t.Errorf("%s: expected result:\n%s\ngot result:\n%s", c.Description, toYAML(c.Result), jsonToYAML(result))
+		}
+	}
+}
+
+func jsonEqual(a, b []byte) bool {
+	var objA, objB interface{}
+	if err := json.Unmarshal(a, &objA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &objB); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(objA, objB)
+}
+
 func toYAML(v interface{}) string {
 	y, err := yaml.Marshal(v)
 	if err != nil {
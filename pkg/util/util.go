@@ -173,7 +173,46 @@ func (intstr IntOrString) MarshalJSON() ([]byte, error) {
 	}
 }
 
+// GetIntOrPercentValue returns the integer value held by intstr, and whether
+// it was given as a percentage ("25%") rather than an absolute number.
+func GetIntOrPercentValue(intstr *IntOrString) (int, bool, error) {
+	switch intstr.Kind {
+	case IntstrInt:
+		return intstr.IntVal, false, nil
+	case IntstrString:
+		if !strings.HasSuffix(intstr.StrVal, "%") {
+			return 0, false, fmt.Errorf("invalid value %q: must be an integer or a percentage", intstr.StrVal)
+		}
+		v, err := strconv.Atoi(strings.TrimSuffix(intstr.StrVal, "%"))
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid value %q: %v", intstr.StrVal, err)
+		}
+		return v, true, nil
+	}
+	return 0, false, fmt.Errorf("invalid value for IntOrString: invalid type")
+}
+
+// GetScaledValueFromIntOrPercent resolves intstr against total: absolute
+// values are returned unchanged, percentages are scaled and rounded up when
+// roundUp is set, down otherwise.
+func GetScaledValueFromIntOrPercent(intstr *IntOrString, total int, roundUp bool) (int, error) {
+	value, isPercent, err := GetIntOrPercentValue(intstr)
+	if err != nil {
+		return 0, err
+	}
+	if !isPercent {
+		return value, nil
+	}
+	if roundUp {
+		return (value*total + 99) / 100, nil
+	}
+	return value * total / 100, nil
+}
+
 func (intstr *IntOrString) Fuzz(c fuzz.Continue) {
+	if intstr == nil {
+		return
+	}
 	if c.RandBool() {
 		intstr.Kind = IntstrInt
 		c.Fuzz(&intstr.IntVal)
@@ -224,6 +224,40 @@ func TestIntOrStringMarshalJSONUnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestGetScaledValueFromIntOrPercent(t *testing.T) {
+	cases := []struct {
+		input     IntOrString
+		total     int
+		roundUp   bool
+		expected  int
+		expectErr bool
+	}{
+		{NewIntOrStringFromInt(3), 10, true, 3, false},
+		{NewIntOrStringFromString("25%"), 10, true, 3, false},
+		{NewIntOrStringFromString("25%"), 10, false, 2, false},
+		{NewIntOrStringFromString("100%"), 7, false, 7, false},
+		{NewIntOrStringFromString("25"), 10, true, 0, true},
+		{NewIntOrStringFromString("a%"), 10, true, 0, true},
+	}
+
+	for i, c := range cases {
+		value, err := GetScaledValueFromIntOrPercent(&c.input, c.total, c.roundUp)
+		if c.expectErr {
+			if err == nil {
+				t.Errorf("%d: expected error for %v", i, c.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if value != c.expected {
+			t.Errorf("%d: expected %d, got %d", i, c.expected, value)
+		}
+	}
+}
+
 func TestStringDiff(t *testing.T) {
 	diff := StringDiff("aaabb", "aaacc")
 	expect := "aaa\n\nA: bb\n\nB: cc\n\n"
@@ -42,7 +42,7 @@ func ToJSON(data []byte) ([]byte, error) {
 // separating individual documents. It first converts the YAML
 // body to JSON, then unmarshals the JSON.
 type YAMLToJSONDecoder struct {
-	scanner *bufio.Scanner
+	reader *YAMLReader
 }
 
 // NewYAMLToJSONDecoder decodes YAML documents from the provided
@@ -50,10 +50,8 @@ type YAMLToJSONDecoder struct {
 // the YAML spec) into its own chunk, converting it to JSON via
 // yaml.YAMLToJSON, and then passing it to json.Decoder.
 func NewYAMLToJSONDecoder(r io.Reader) *YAMLToJSONDecoder {
-	scanner := bufio.NewScanner(r)
-	scanner.Split(splitYAMLDocument)
 	return &YAMLToJSONDecoder{
-		scanner: scanner,
+		reader: NewYAMLReader(bufio.NewReader(r)),
 	}
 }
 
@@ -61,50 +59,66 @@ func NewYAMLToJSONDecoder(r io.Reader) *YAMLToJSONDecoder {
 // an error. The decoding rules match json.Unmarshal, not
 // yaml.Unmarshal.
 func (d *YAMLToJSONDecoder) Decode(into interface{}) error {
-	if d.scanner.Scan() {
-		data, err := yaml.YAMLToJSON(d.scanner.Bytes())
-		if err != nil {
-			return err
-		}
-		return json.Unmarshal(data, into)
+	doc, err := d.reader.Read()
+	if err != nil {
+		return err
 	}
-	err := d.scanner.Err()
-	if err == nil {
-		err = io.EOF
+	data, err := yaml.YAMLToJSON(doc)
+	if err != nil {
+		return err
 	}
-	return err
+	return json.Unmarshal(data, into)
 }
 
-const yamlSeparator = "\n---"
+// YAMLReader reads the individual documents of a YAML stream. It reads a
+// line at a time, so there is no limit on the size of a document.
+type YAMLReader struct {
+	reader *bufio.Reader
+}
 
-// splitYAMLDocument is a bufio.SplitFunc for splitting YAML streams into individual documents.
-func splitYAMLDocument(data []byte, atEOF bool) (advance int, token []byte, err error) {
-	if atEOF && len(data) == 0 {
-		return 0, nil, nil
-	}
-	sep := len([]byte(yamlSeparator))
-	if i := bytes.Index(data, []byte(yamlSeparator)); i >= 0 {
-		// We have a potential document terminator
-		i += sep
-		after := data[i:]
-		if len(after) == 0 {
-			// we can't read any more characters
-			if atEOF {
-				return len(data), data[:len(data)-sep], nil
+// NewYAMLReader returns a reader for the YAML documents in r.
+func NewYAMLReader(r *bufio.Reader) *YAMLReader {
+	return &YAMLReader{reader: r}
+}
+
+// Read returns the next document of the stream without its separator line,
+// or io.EOF when there are no more documents. A separator at the start of
+// the stream does not produce an empty document.
+func (r *YAMLReader) Read() ([]byte, error) {
+	var buffer bytes.Buffer
+	for {
+		line, err := r.reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if isYAMLSeparator(line) {
+			if buffer.Len() != 0 {
+				return buffer.Bytes(), nil
 			}
-			return 0, nil, nil
+		} else {
+			buffer.Write(line)
 		}
-		if j := bytes.IndexByte(after, '\n'); j >= 0 {
-			return i + j + 1, data[0 : i-sep], nil
+		if err == io.EOF {
+			if buffer.Len() != 0 {
+				return buffer.Bytes(), nil
+			}
+			return nil, io.EOF
 		}
-		return 0, nil, nil
 	}
-	// If we're at EOF, we have a final, non-terminated line. Return it.
-	if atEOF {
-		return len(data), data, nil
+}
+
+// isYAMLSeparator returns true if line is a document separator, optionally
+// followed by whitespace and a comment.
+func isYAMLSeparator(line []byte) bool {
+	line = bytes.TrimRight(line, " \t\r\n")
+	if !bytes.HasPrefix(line, []byte("---")) {
+		return false
+	}
+	rest := line[3:]
+	if len(rest) == 0 {
+		return true
 	}
-	// Request more data.
-	return 0, nil, nil
+	return (rest[0] == ' ' || rest[0] == '\t') && bytes.HasPrefix(bytes.TrimLeft(rest, " \t"), []byte("#"))
 }
 
 // decoder is a convenience interface for Decode.
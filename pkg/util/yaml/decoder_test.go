@@ -22,41 +22,42 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
+	"strings"
 	"testing"
 )
 
-func TestSplitYAMLDocument(t *testing.T) {
+func TestYAMLReader(t *testing.T) {
+	large := "a: " + strings.Repeat("x", 100*1024) + "\n"
 	testCases := []struct {
 		input  string
-		atEOF  bool
-		expect string
-		adv    int
+		expect []string
 	}{
-		{"foo", true, "foo", 3},
-		{"fo", false, "", 0},
-
-		{"---", true, "---", 3},
-		{"---\n", true, "---\n", 4},
-		{"---\n", false, "", 0},
-
-		{"\n---\n", false, "", 5},
-		{"\n---\n", true, "", 5},
-
-		{"abc\n---\ndef", true, "abc", 8},
-		{"def", true, "def", 3},
-		{"", true, "", 0},
+		{"", []string{}},
+		{"foo: bar", []string{"foo: bar"}},
+		{"---\nfoo: bar\n", []string{"foo: bar\n"}},
+		{"foo: bar\n---\nbaz: biz\n", []string{"foo: bar\n", "baz: biz\n"}},
+		{"foo: bar\r\n---\r\nbaz: biz\r\n", []string{"foo: bar\r\n", "baz: biz\r\n"}},
+		{"foo: bar\n--- # second\nbaz: biz\n---\n", []string{"foo: bar\n", "baz: biz\n"}},
+		{"foo: bar\n---#not a separator\n", []string{"foo: bar\n---#not a separator\n"}},
+		{"foo: |\n  ----\n", []string{"foo: |\n  ----\n"}},
+		{large + "---\n" + large, []string{large, large}},
 	}
 	for i, testCase := range testCases {
-		adv, token, err := splitYAMLDocument([]byte(testCase.input), testCase.atEOF)
-		if err != nil {
-			t.Errorf("%d: unexpected error: %v", i, err)
-			continue
-		}
-		if adv != testCase.adv {
-			t.Errorf("%d: advance did not match: %d %d", i, testCase.adv, adv)
+		r := NewYAMLReader(bufio.NewReader(strings.NewReader(testCase.input)))
+		docs := []string{}
+		for {
+			doc, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%d: unexpected error: %v", i, err)
+			}
+			docs = append(docs, string(doc))
 		}
-		if testCase.expect != string(token) {
-			t.Errorf("%d: token did not match: %q %q", i, testCase.expect, string(token))
+		if !reflect.DeepEqual(testCase.expect, docs) {
+			t.Errorf("%d: unexpected documents: %q", i, docs)
 		}
 	}
 }
@@ -76,29 +77,6 @@ func TestGuessJSON(t *testing.T) {
 	}
 }
 
-func TestScanYAML(t *testing.T) {
-	s := bufio.NewScanner(bytes.NewReader([]byte(`---
-stuff: 1
-
----       
-  `)))
-	s.Split(splitYAMLDocument)
-	if !s.Scan() {
-		t.Fatalf("should have been able to scan")
-	}
-	t.Logf("scan: %s", s.Text())
-	if !s.Scan() {
-		t.Fatalf("should have been able to scan")
-	}
-	t.Logf("scan: %s", s.Text())
-	if s.Scan() {
-		t.Fatalf("scan should have been done")
-	}
-	if s.Err() != nil {
-		t.Fatalf("err should have been nil: %v", s.Err())
-	}
-}
-
 func TestDecodeYAML(t *testing.T) {
 	s := NewYAMLToJSONDecoder(bytes.NewReader([]byte(`---
 stuff: 1
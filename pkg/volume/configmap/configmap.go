@@ -0,0 +1,227 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume"
+	volumeutil "github.com/GoogleCloudPlatform/kubernetes/pkg/volume/util"
+	"github.com/golang/glog"
+)
+
+// ProbeVolumePlugin is the entry point for plugin detection in a package.
+func ProbeVolumePlugins() []volume.VolumePlugin {
+	return []volume.VolumePlugin{&configMapPlugin{}}
+}
+
+const (
+	configMapPluginName = "kubernetes.io/configmap"
+)
+
+// configMapPlugin implements the VolumePlugin interface.
+type configMapPlugin struct {
+	host volume.VolumeHost
+}
+
+var _ volume.VolumePlugin = &configMapPlugin{}
+
+func (plugin *configMapPlugin) Init(host volume.VolumeHost) {
+	plugin.host = host
+}
+
+func (plugin *configMapPlugin) Name() string {
+	return configMapPluginName
+}
+
+func (plugin *configMapPlugin) CanSupport(spec *volume.Spec) bool {
+	return spec.VolumeSource.ConfigMap != nil
+}
+
+func (plugin *configMapPlugin) NewBuilder(spec *volume.Spec, pod *api.Pod, opts volume.VolumeOptions, mounter mount.Interface) (volume.Builder, error) {
+	return &configMapVolume{spec.Name, *pod, plugin, spec.VolumeSource.ConfigMap.Name, &opts, mounter}, nil
+}
+
+func (plugin *configMapPlugin) NewCleaner(volName string, podUID types.UID, mounter mount.Interface) (volume.Cleaner, error) {
+	return &configMapVolume{volName, api.Pod{ObjectMeta: api.ObjectMeta{UID: podUID}}, plugin, "", nil, mounter}, nil
+}
+
+// configMapVolume handles retrieving ConfigMaps from the API server
+// and placing their data into the volume on the host.
+type configMapVolume struct {
+	volName       string
+	pod           api.Pod
+	plugin        *configMapPlugin
+	configMapName string
+	opts          *volume.VolumeOptions
+	mounter       mount.Interface
+}
+
+func (cv *configMapVolume) SetUp() error {
+	return cv.SetUpAt(cv.GetPath())
+}
+
+// This is the spec for the volume that this plugin wraps.
+var wrappedVolumeSpec = &volume.Spec{
+	Name:         "not-used",
+	VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageMediumMemory}},
+}
+
+// SetUpAt sets up the wrapped tmpfs the first time it is called, and on every
+// call writes the current contents of the ConfigMap into it. The kubelet calls
+// SetUp each time it syncs the pod, which is how changes to the ConfigMap reach
+// volumes that are already mounted.
+func (cv *configMapVolume) SetUpAt(dir string) error {
+	isMnt, err := cv.mounter.IsMountPoint(dir)
+	// Getting an os.IsNotExist err from is a contingency; the directory
+	// may not exist yet, in which case, setup should run.
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// If the plugin readiness file is present for this volume and the setup
+	// dir is a mountpoint, the volume only needs its data refreshed.
+	ready := volumeutil.IsReady(cv.getMetaDir()) && isMnt
+	if !ready {
+		glog.V(3).Infof("Setting up volume %v for pod %v at %v", cv.volName, cv.pod.UID, dir)
+
+		// Wrap EmptyDir, let it do the setup.
+		wrapped, err := cv.plugin.host.NewWrapperBuilder(wrappedVolumeSpec, &cv.pod, *cv.opts, cv.mounter)
+		if err != nil {
+			return err
+		}
+		if err := wrapped.SetUpAt(dir); err != nil {
+			return err
+		}
+	}
+
+	if err := cv.writeConfigMapData(dir); err != nil {
+		if ready {
+			// The volume already holds a previous version of the data; keep
+			// serving it rather than failing the pod sync, and retry on the
+			// next one.
+			glog.Errorf("Couldn't refresh volume %v for pod %v: %v", cv.volName, cv.pod.UID, err)
+			return nil
+		}
+		return err
+	}
+
+	volumeutil.SetReady(cv.getMetaDir())
+
+	return nil
+}
+
+// writeConfigMapData makes the files in dir mirror the data of the ConfigMap:
+// each key is written to a file of the same name, and files for keys that are
+// no longer present are removed. Changed files are replaced atomically, so
+// containers never observe a partially written value.
+func (cv *configMapVolume) writeConfigMapData(dir string) error {
+	kubeClient := cv.plugin.host.GetKubeClient()
+	if kubeClient == nil {
+		return fmt.Errorf("Cannot setup configMap volume %v because kube client is not configured", cv.volName)
+	}
+
+	configMap, err := kubeClient.ConfigMaps(cv.pod.Namespace).Get(cv.configMapName)
+	if err != nil {
+		glog.Errorf("Couldn't get configMap %v/%v", cv.pod.Namespace, cv.configMapName)
+		return err
+	}
+	glog.V(4).Infof("Received configMap %v/%v containing (%v) pieces of data", cv.pod.Namespace, cv.configMapName, len(configMap.Data))
+
+	for name, data := range configMap.Data {
+		hostFilePath := path.Join(dir, name)
+		if current, err := ioutil.ReadFile(hostFilePath); err == nil && bytes.Equal(current, []byte(data)) {
+			continue
+		}
+		glog.V(3).Infof("Writing configMap data %v/%v/%v (%v bytes) to host file %v", cv.pod.Namespace, cv.configMapName, name, len(data), hostFilePath)
+		if err := writeFileAtomically(hostFilePath, []byte(data), 0444); err != nil {
+			glog.Errorf("Error writing configMap data to host path: %v, %v", hostFilePath, err)
+			return err
+		}
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if _, found := configMap.Data[file.Name()]; found || !file.Mode().IsRegular() {
+			continue
+		}
+		hostFilePath := path.Join(dir, file.Name())
+		glog.V(3).Infof("Removing host file %v no longer present in configMap %v/%v", hostFilePath, cv.pod.Namespace, cv.configMapName)
+		if err := os.Remove(hostFilePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeFileAtomically writes data to a temporary file next to filename and
+// renames it into place.
+func writeFileAtomically(filename string, data []byte, perm os.FileMode) error {
+	tmp, err := ioutil.TempFile(path.Dir(filename), ".tmp-"+path.Base(filename))
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), perm)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filename)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func (cv *configMapVolume) GetPath() string {
+	return cv.plugin.host.GetPodVolumeDir(cv.pod.UID, util.EscapeQualifiedNameForDisk(configMapPluginName), cv.volName)
+}
+
+func (cv *configMapVolume) TearDown() error {
+	return cv.TearDownAt(cv.GetPath())
+}
+
+func (cv *configMapVolume) TearDownAt(dir string) error {
+	glog.V(3).Infof("Tearing down volume %v for pod %v at %v", cv.volName, cv.pod.UID, dir)
+
+	// Wrap EmptyDir, let it do the teardown.
+	wrapped, err := cv.plugin.host.NewWrapperCleaner(wrappedVolumeSpec, cv.pod.UID, cv.mounter)
+	if err != nil {
+		return err
+	}
+	return wrapped.TearDownAt(dir)
+}
+
+func (cv *configMapVolume) getMetaDir() string {
+	return path.Join(cv.plugin.host.GetPodPluginDir(cv.pod.UID, util.EscapeQualifiedNameForDisk(configMapPluginName)), cv.volName)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/testclient"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume/empty_dir"
+)
+
+func newTestHost(t *testing.T, client client.Interface) (string, volume.VolumeHost) {
+	tempDir, err := ioutil.TempDir("/tmp", "configmap_volume_test.")
+	if err != nil {
+		t.Fatalf("can't make a temp rootdir: %v", err)
+	}
+
+	return tempDir, volume.NewFakeVolumeHost(tempDir, client, empty_dir.ProbeVolumePlugins())
+}
+
+func TestCanSupport(t *testing.T) {
+	pluginMgr := volume.VolumePluginMgr{}
+	_, host := newTestHost(t, nil)
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	if plugin.Name() != configMapPluginName {
+		t.Errorf("Wrong name: %s", plugin.Name())
+	}
+	if !plugin.CanSupport(&volume.Spec{Name: "foo", VolumeSource: api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{Name: ""}}}) {
+		t.Errorf("Expected true")
+	}
+	if plugin.CanSupport(&volume.Spec{Name: "foo", VolumeSource: api.VolumeSource{Secret: &api.SecretVolumeSource{SecretName: ""}}}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	var (
+		testPodUID     = types.UID("test_pod_uid")
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+
+		volumeSpec = volumeSpec(testVolumeName, testName)
+		configMap  = configMap(testNamespace, testName)
+		client     = testclient.NewSimpleFake(&configMap)
+		pluginMgr  = volume.VolumePluginMgr{}
+		_, host    = newTestHost(t, client)
+	)
+
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: testNamespace, UID: testPodUID}}
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec), pod, volume.VolumeOptions{}, &mount.FakeMounter{})
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Fatalf("Got a nil Builder")
+	}
+
+	volumePath := builder.GetPath()
+	if !strings.HasSuffix(volumePath, fmt.Sprintf("pods/test_pod_uid/volumes/kubernetes.io~configmap/test_volume_name")) {
+		t.Errorf("Got unexpected path: %s", volumePath)
+	}
+
+	err = builder.SetUp()
+	if err != nil {
+		t.Errorf("Failed to setup volume: %v", err)
+	}
+	if _, err := os.Stat(volumePath); err != nil {
+		if os.IsNotExist(err) {
+			t.Errorf("SetUp() failed, volume path not created: %s", volumePath)
+		} else {
+			t.Errorf("SetUp() failed: %v", err)
+		}
+	}
+
+	doTestConfigMapDataInVolume(volumePath, configMap, t)
+}
+
+// Test that setting up an already set up volume brings its files in line with
+// the current contents of the ConfigMap, and that a failure to fetch the
+// ConfigMap at that point leaves the previous data in place.
+func TestPluginUpdate(t *testing.T) {
+	var (
+		testPodUID     = types.UID("test_pod_uid2")
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+
+		volumeSpec = volumeSpec(testVolumeName, testName)
+		current    = configMap(testNamespace, testName)
+		getErr     error
+		client     = &testclient.Fake{ReactFn: func(testclient.FakeAction) (runtime.Object, error) {
+			return &current, getErr
+		}}
+		pluginMgr = volume.VolumePluginMgr{}
+		_, host   = newTestHost(t, client)
+	)
+
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: testNamespace, UID: testPodUID}}
+	mounter := &mount.FakeMounter{}
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec), pod, volume.VolumeOptions{}, mounter)
+	if err != nil {
+		t.Fatalf("Failed to make a new Builder: %v", err)
+	}
+	volumePath := builder.GetPath()
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to setup volume: %v", err)
+	}
+	doTestConfigMapDataInVolume(volumePath, current, t)
+
+	updated := configMap(testNamespace, testName)
+	updated.Data = map[string]string{
+		"data-1": "value-1",
+		"data-2": "new-value-2",
+		"data-4": "value-4",
+	}
+	current = updated
+	mounter.ResetLog()
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to refresh volume: %v", err)
+	}
+	if len(mounter.Log) != 0 {
+		t.Errorf("Unexpected calls made to mounter: %v", mounter.Log)
+	}
+	doTestConfigMapDataInVolume(volumePath, updated, t)
+	if _, err := os.Stat(path.Join(volumePath, "data-3")); !os.IsNotExist(err) {
+		t.Errorf("Expected file for removed key to be deleted, got: %v", err)
+	}
+
+	getErr = errors.New("fake error")
+	if err := builder.SetUp(); err != nil {
+		t.Errorf("Expected refresh failure to be tolerated, got: %v", err)
+	}
+	doTestConfigMapDataInVolume(volumePath, updated, t)
+}
+
+func volumeSpec(volumeName, configMapName string) *api.Volume {
+	return &api.Volume{
+		Name: volumeName,
+		VolumeSource: api.VolumeSource{
+			ConfigMap: &api.ConfigMapVolumeSource{
+				Name: configMapName,
+			},
+		},
+	}
+}
+
+func configMap(namespace, name string) api.ConfigMap {
+	return api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Data: map[string]string{
+			"data-1": "value-1",
+			"data-2": "value-2",
+			"data-3": "value-3",
+		},
+	}
+}
+
+func doTestConfigMapDataInVolume(volumePath string, configMap api.ConfigMap, t *testing.T) {
+	for key, value := range configMap.Data {
+		configMapDataHostPath := path.Join(volumePath, key)
+		if _, err := os.Stat(configMapDataHostPath); err != nil {
+			t.Fatalf("SetUp() failed, couldn't find configMap data on disk: %v", configMapDataHostPath)
+		} else {
+			actualValue, err := ioutil.ReadFile(configMapDataHostPath)
+			if err != nil {
+				t.Fatalf("Couldn't read configMap data from: %v", configMapDataHostPath)
+			}
+
+			if value != string(actualValue) {
+				t.Errorf("Unexpected value; expected %q, got %q", value, string(actualValue))
+			}
+		}
+	}
+}
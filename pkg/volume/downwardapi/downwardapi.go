@@ -0,0 +1,261 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downwardapi
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fieldpath"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume"
+	volumeutil "github.com/GoogleCloudPlatform/kubernetes/pkg/volume/util"
+	"github.com/golang/glog"
+)
+
+// ProbeVolumePlugin is the entry point for plugin detection in a package.
+func ProbeVolumePlugins() []volume.VolumePlugin {
+	return []volume.VolumePlugin{&downwardAPIPlugin{}}
+}
+
+const (
+	downwardAPIPluginName = "kubernetes.io/downward-api"
+
+	// dataDirName is the name of the symlink, in the root of the volume,
+	// that points at the directory holding the current version of the data.
+	// Every user-visible entry of the volume is a symlink through it, so
+	// replacing this one link swaps all the files at once.
+	dataDirName = "..downwardapi"
+	// dataDirTmpName is the name under which the new version of the data
+	// symlink is created before being renamed over dataDirName.
+	dataDirTmpName = "..downwardapi_tmp"
+)
+
+// downwardAPIPlugin implements the VolumePlugin interface.
+type downwardAPIPlugin struct {
+	host volume.VolumeHost
+}
+
+var _ volume.VolumePlugin = &downwardAPIPlugin{}
+
+func (plugin *downwardAPIPlugin) Init(host volume.VolumeHost) {
+	plugin.host = host
+}
+
+func (plugin *downwardAPIPlugin) Name() string {
+	return downwardAPIPluginName
+}
+
+func (plugin *downwardAPIPlugin) CanSupport(spec *volume.Spec) bool {
+	return spec.VolumeSource.DownwardAPI != nil
+}
+
+func (plugin *downwardAPIPlugin) NewBuilder(spec *volume.Spec, pod *api.Pod, opts volume.VolumeOptions, mounter mount.Interface) (volume.Builder, error) {
+	return &downwardAPIVolume{spec.Name, spec.VolumeSource.DownwardAPI.Items, *pod, plugin, &opts, mounter}, nil
+}
+
+func (plugin *downwardAPIPlugin) NewCleaner(volName string, podUID types.UID, mounter mount.Interface) (volume.Cleaner, error) {
+	return &downwardAPIVolume{volName, nil, api.Pod{ObjectMeta: api.ObjectMeta{UID: podUID}}, plugin, nil, mounter}, nil
+}
+
+// downwardAPIVolume handles writing the selected fields of a pod into the
+// volume on the host.
+type downwardAPIVolume struct {
+	volName string
+	items   []api.DownwardAPIVolumeFile
+	pod     api.Pod
+	plugin  *downwardAPIPlugin
+	opts    *volume.VolumeOptions
+	mounter mount.Interface
+}
+
+func (dv *downwardAPIVolume) SetUp() error {
+	return dv.SetUpAt(dv.GetPath())
+}
+
+// This is the spec for the volume that this plugin wraps.
+var wrappedVolumeSpec = &volume.Spec{
+	Name:         "not-used",
+	VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}},
+}
+
+// SetUpAt sets up the wrapped EmptyDir the first time it is called, and on
+// every call brings the files in the volume up to date with the pod it was
+// built for. The kubelet builds the volume from its latest copy of the pod
+// each time it syncs the pod, and its pod config passes label and annotation
+// changes into that copy, which is how they reach volumes that are already
+// set up.
+func (dv *downwardAPIVolume) SetUpAt(dir string) error {
+	if !volumeutil.IsReady(dv.getMetaDir()) {
+		glog.V(3).Infof("Setting up volume %v for pod %v at %v", dv.volName, dv.pod.UID, dir)
+
+		// Wrap EmptyDir, let it do the setup.
+		wrapped, err := dv.plugin.host.NewWrapperBuilder(wrappedVolumeSpec, &dv.pod, *dv.opts, dv.mounter)
+		if err != nil {
+			return err
+		}
+		if err := wrapped.SetUpAt(dir); err != nil {
+			return err
+		}
+	}
+
+	data, err := dv.collectData()
+	if err != nil {
+		return err
+	}
+	if err := writeData(dir, data); err != nil {
+		glog.Errorf("Error writing downward API data for pod %v to %v: %v", dv.pod.UID, dir, err)
+		return err
+	}
+
+	volumeutil.SetReady(dv.getMetaDir())
+
+	return nil
+}
+
+// collectData returns the contents of each file of the volume, keyed by the
+// file's path relative to the volume root.
+func (dv *downwardAPIVolume) collectData() (map[string]string, error) {
+	data := make(map[string]string)
+	for _, item := range dv.items {
+		internalFieldPath, _, err := api.Scheme.ConvertFieldLabel(item.FieldRef.APIVersion, "Pod", item.FieldRef.FieldPath, "")
+		if err != nil {
+			return nil, err
+		}
+		value, err := fieldpath.ExtractFieldPathAsString(&dv.pod, internalFieldPath)
+		if err != nil {
+			return nil, err
+		}
+		data[path.Clean(item.Path)] = value
+	}
+	return data, nil
+}
+
+// writeData makes the volume in dir hold exactly the given data. If the data
+// differs from what the volume holds, it is written to a new directory and the
+// data symlink is switched over to it with a single rename, so that readers
+// see either the old or the new set of files and never a mix of both.
+func writeData(dir string, data map[string]string) error {
+	dataDirPath := path.Join(dir, dataDirName)
+	oldTarget, err := os.Readlink(dataDirPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if oldTarget != "" && dataMatches(path.Join(dir, oldTarget), data) {
+		return nil
+	}
+
+	newDataDir, err := ioutil.TempDir(dir, time.Now().Format("..2006_01_02_15_04_05."))
+	if err != nil {
+		return err
+	}
+	// Remove the new directory unless the data symlink ends up pointing at it,
+	// so that failed writes do not leave stale copies behind in the volume.
+	swapped := false
+	defer func() {
+		if !swapped {
+			if err := os.RemoveAll(newDataDir); err != nil {
+				glog.Errorf("Error removing unused downward API data at %v: %v", newDataDir, err)
+			}
+		}
+	}()
+	// TempDir creates the directory readable by its owner only.
+	if err := os.Chmod(newDataDir, 0755); err != nil {
+		return err
+	}
+	for name, value := range data {
+		filePath := path.Join(newDataDir, name)
+		if err := os.MkdirAll(path.Dir(filePath), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filePath, []byte(value), 0644); err != nil {
+			return err
+		}
+	}
+
+	tmpLinkPath := path.Join(dir, dataDirTmpName)
+	if err := os.Remove(tmpLinkPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Symlink(path.Base(newDataDir), tmpLinkPath); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpLinkPath, dataDirPath); err != nil {
+		return err
+	}
+	swapped = true
+
+	// Link each top-level entry of the data into the volume root. The links
+	// resolve through the data symlink, so they only need creating once.
+	for name := range data {
+		top := strings.SplitN(name, "/", 2)[0]
+		linkPath := path.Join(dir, top)
+		if _, err := os.Lstat(linkPath); err == nil {
+			continue
+		}
+		if err := os.Symlink(path.Join(dataDirName, top), linkPath); err != nil {
+			return err
+		}
+	}
+
+	if oldTarget != "" {
+		if err := os.RemoveAll(path.Join(dir, oldTarget)); err != nil {
+			glog.Errorf("Error removing old downward API data at %v: %v", path.Join(dir, oldTarget), err)
+		}
+	}
+	return nil
+}
+
+// dataMatches reports whether the files under dataDir hold the given data.
+func dataMatches(dataDir string, data map[string]string) bool {
+	for name, value := range data {
+		current, err := ioutil.ReadFile(path.Join(dataDir, name))
+		if err != nil || string(current) != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (dv *downwardAPIVolume) GetPath() string {
+	return dv.plugin.host.GetPodVolumeDir(dv.pod.UID, util.EscapeQualifiedNameForDisk(downwardAPIPluginName), dv.volName)
+}
+
+func (dv *downwardAPIVolume) TearDown() error {
+	return dv.TearDownAt(dv.GetPath())
+}
+
+func (dv *downwardAPIVolume) TearDownAt(dir string) error {
+	glog.V(3).Infof("Tearing down volume %v for pod %v at %v", dv.volName, dv.pod.UID, dir)
+
+	// Wrap EmptyDir, let it do the teardown.
+	wrapped, err := dv.plugin.host.NewWrapperCleaner(wrappedVolumeSpec, dv.pod.UID, dv.mounter)
+	if err != nil {
+		return err
+	}
+	return wrapped.TearDownAt(dir)
+}
+
+func (dv *downwardAPIVolume) getMetaDir() string {
+	return path.Join(dv.plugin.host.GetPodPluginDir(dv.pod.UID, util.EscapeQualifiedNameForDisk(downwardAPIPluginName)), dv.volName)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downwardapi
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume/empty_dir"
+)
+
+func newTestHost(t *testing.T) (string, volume.VolumeHost) {
+	tempDir, err := ioutil.TempDir("/tmp", "downwardapi_volume_test.")
+	if err != nil {
+		t.Fatalf("can't make a temp rootdir: %v", err)
+	}
+
+	return tempDir, volume.NewFakeVolumeHost(tempDir, nil, empty_dir.ProbeVolumePlugins())
+}
+
+func TestCanSupport(t *testing.T) {
+	pluginMgr := volume.VolumePluginMgr{}
+	_, host := newTestHost(t)
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(downwardAPIPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	if plugin.Name() != downwardAPIPluginName {
+		t.Errorf("Wrong name: %s", plugin.Name())
+	}
+	if !plugin.CanSupport(&volume.Spec{Name: "foo", VolumeSource: api.VolumeSource{DownwardAPI: &api.DownwardAPIVolumeSource{}}}) {
+		t.Errorf("Expected true")
+	}
+	if plugin.CanSupport(&volume.Spec{Name: "foo", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func volumeSpec(volumeName string) *api.Volume {
+	return &api.Volume{
+		Name: volumeName,
+		VolumeSource: api.VolumeSource{
+			DownwardAPI: &api.DownwardAPIVolumeSource{
+				Items: []api.DownwardAPIVolumeFile{
+					{Path: "labels", FieldRef: api.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.labels"}},
+					{Path: "annotations", FieldRef: api.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.annotations"}},
+					{Path: "pod/name", FieldRef: api.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.name"}},
+				},
+			},
+		},
+	}
+}
+
+func testPod(uid types.UID, labels map[string]string) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:        "test_pod_name",
+			Namespace:   "test_pod_namespace",
+			UID:         uid,
+			Labels:      labels,
+			Annotations: map[string]string{"a": "1"},
+		},
+	}
+}
+
+func setUp(t *testing.T, plugin volume.VolumePlugin, pod *api.Pod) string {
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec("test_volume_name")), pod, volume.VolumeOptions{}, &mount.FakeMounter{})
+	if err != nil {
+		t.Fatalf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Fatalf("Got a nil Builder")
+	}
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to setup volume: %v", err)
+	}
+	return builder.GetPath()
+}
+
+func expectFile(t *testing.T, volumePath, name, expected string) {
+	data, err := ioutil.ReadFile(path.Join(volumePath, name))
+	if err != nil {
+		t.Errorf("Couldn't read %v: %v", name, err)
+		return
+	}
+	if string(data) != expected {
+		t.Errorf("Unexpected contents of %v; expected %q, got %q", name, expected, string(data))
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	pluginMgr := volume.VolumePluginMgr{}
+	_, host := newTestHost(t)
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+	plugin, err := pluginMgr.FindPluginByName(downwardAPIPluginName)
+	if err != nil {
+		t.Fatalf("Can't find the plugin by name")
+	}
+
+	volumePath := setUp(t, plugin, testPod("test_pod_uid", map[string]string{"app": "web", "tier": "frontend"}))
+
+	expectFile(t, volumePath, "labels", "app=\"web\"\ntier=\"frontend\"\n")
+	expectFile(t, volumePath, "annotations", "a=\"1\"\n")
+	expectFile(t, volumePath, "pod/name", "test_pod_name")
+}
+
+// Test that setting up the volume again for a pod whose metadata changed swaps
+// in a new copy of the data, and leaves the volume alone otherwise.
+func TestPluginUpdate(t *testing.T) {
+	pluginMgr := volume.VolumePluginMgr{}
+	_, host := newTestHost(t)
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+	plugin, err := pluginMgr.FindPluginByName(downwardAPIPluginName)
+	if err != nil {
+		t.Fatalf("Can't find the plugin by name")
+	}
+
+	volumePath := setUp(t, plugin, testPod("test_pod_uid2", map[string]string{"app": "web"}))
+	oldTarget, err := os.Readlink(path.Join(volumePath, dataDirName))
+	if err != nil {
+		t.Fatalf("Couldn't read data link: %v", err)
+	}
+
+	setUp(t, plugin, testPod("test_pod_uid2", map[string]string{"app": "web"}))
+	if target, _ := os.Readlink(path.Join(volumePath, dataDirName)); target != oldTarget {
+		t.Errorf("Expected data link to be unchanged, got %q instead of %q", target, oldTarget)
+	}
+
+	setUp(t, plugin, testPod("test_pod_uid2", map[string]string{"app": "web", "version": "v2"}))
+	expectFile(t, volumePath, "labels", "app=\"web\"\nversion=\"v2\"\n")
+	expectFile(t, volumePath, "pod/name", "test_pod_name")
+	if target, _ := os.Readlink(path.Join(volumePath, dataDirName)); target == oldTarget {
+		t.Errorf("Expected data link to point at new data")
+	}
+	if _, err := os.Stat(path.Join(volumePath, oldTarget)); !os.IsNotExist(err) {
+		t.Errorf("Expected old data to be removed, got: %v", err)
+	}
+	if _, err := os.Lstat(path.Join(volumePath, dataDirTmpName)); !os.IsNotExist(err) {
+		t.Errorf("Expected temporary data link to be gone, got: %v", err)
+	}
+}
+
+// Test that a write that fails part way does not leave its data behind.
+func TestWriteDataFailureCleansUp(t *testing.T) {
+	dir, err := ioutil.TempDir("/tmp", "downwardapi_volume_test.")
+	if err != nil {
+		t.Fatalf("can't make a temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// "labels" can't be both a file and a directory.
+	if err := writeData(dir, map[string]string{"labels": "a", "labels/name": "b"}); err == nil {
+		t.Fatalf("Expected an error writing conflicting paths")
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Couldn't read volume dir: %v", err)
+	}
+	for _, entry := range entries {
+		t.Errorf("Expected an empty volume, found %v", entry.Name())
+	}
+}
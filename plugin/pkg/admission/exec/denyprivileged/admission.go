@@ -33,8 +33,8 @@ func init() {
 	})
 }
 
-// denyExecOnPrivileged is an implementation of admission.Interface which says no to a pod/exec on
-// a privileged pod
+// denyExecOnPrivileged is an implementation of admission.Interface which says no to a pod/exec or
+// pod/attach on a privileged pod
 type denyExecOnPrivileged struct {
 	*admission.Handler
 	client client.Interface
@@ -45,8 +45,8 @@ func (d *denyExecOnPrivileged) Admit(a admission.Attributes) (err error) {
 	if !ok {
 		return errors.NewBadRequest("a connect request was received, but could not convert the request object.")
 	}
-	// Only handle exec requests on pods
-	if connectRequest.ResourcePath != "pods/exec" {
+	// Only handle exec and attach requests on pods
+	if connectRequest.ResourcePath != "pods/exec" && connectRequest.ResourcePath != "pods/attach" {
 		return nil
 	}
 	pod, err := d.client.Pods(a.GetNamespace()).Get(connectRequest.Name)
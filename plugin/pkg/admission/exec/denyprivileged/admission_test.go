@@ -28,14 +28,16 @@ import (
 
 // TestAdmission verifies a namespace is created on create requests for namespace managed resources
 func TestAdmissionAccept(t *testing.T) {
-	testAdmission(t, acceptPod("podname"), true)
+	testAdmission(t, acceptPod("podname"), "exec", true)
+	testAdmission(t, acceptPod("podname"), "attach", true)
 }
 
 func TestAdmissionDeny(t *testing.T) {
-	testAdmission(t, denyPod("podname"), false)
+	testAdmission(t, denyPod("podname"), "exec", false)
+	testAdmission(t, denyPod("podname"), "attach", false)
 }
 
-func testAdmission(t *testing.T, pod *api.Pod, shouldAccept bool) {
+func testAdmission(t *testing.T, pod *api.Pod, subresource string, shouldAccept bool) {
 	mockClient := &testclient.Fake{
 		ReactFn: func(action testclient.FakeAction) (runtime.Object, error) {
 			if action.Action == "get-pod" && action.Value.(string) == pod.Name {
@@ -48,8 +50,8 @@ func testAdmission(t *testing.T, pod *api.Pod, shouldAccept bool) {
 	handler := &denyExecOnPrivileged{
 		client: mockClient,
 	}
-	req := &rest.ConnectRequest{Name: pod.Name, ResourcePath: "pods/exec"}
-	err := handler.Admit(admission.NewAttributesRecord(req, "Pod", "test", "name", "pods", "exec", admission.Connect, nil))
+	req := &rest.ConnectRequest{Name: pod.Name, ResourcePath: "pods/" + subresource}
+	err := handler.Admit(admission.NewAttributesRecord(req, "Pod", "test", "name", "pods", subresource, admission.Connect, nil))
 	if shouldAccept && err != nil {
 		t.Errorf("Unexpected error returned from admission handler: %v", err)
 	}
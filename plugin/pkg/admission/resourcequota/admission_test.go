@@ -83,6 +83,45 @@ func TestAdmissionIgnoresSubresources(t *testing.T) {
 
 }
 
+func TestAdmissionDryRun(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{"namespace": cache.MetaNamespaceIndexFunc})
+	client := &testclient.Fake{}
+	handler := createResourceQuota(client, indexer)
+
+	quota := &api.ResourceQuota{}
+	quota.Name = "quota"
+	quota.Namespace = "test"
+	quota.Status = api.ResourceQuotaStatus{
+		Hard: api.ResourceList{},
+		Used: api.ResourceList{},
+	}
+	quota.Status.Hard[api.ResourceMemory] = resource.MustParse("2Gi")
+	quota.Status.Used[api.ResourceMemory] = resource.MustParse("1Gi")
+
+	indexer.Add(quota)
+
+	newPod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: quota.Namespace},
+		Spec: api.PodSpec{
+			Volumes:    []api.Volume{{Name: "vol"}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "2Gi")}},
+		}}
+
+	err := handler.Admit(admission.NewDryRunAttributes(admission.NewAttributesRecord(newPod, "Pod", newPod.Namespace, "123", "pods", "", admission.Create, nil)))
+	if err == nil {
+		t.Errorf("Expected an error because the pod exceeded allowed quota")
+	}
+
+	newPod.Spec.Containers[0].Resources = getResourceRequirements("100m", "512Mi")
+	err = handler.Admit(admission.NewDryRunAttributes(admission.NewAttributesRecord(newPod, "Pod", newPod.Namespace, "123", "pods", "", admission.Create, nil)))
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(client.Actions) != 0 {
+		t.Errorf("Expected no quota usage to be recorded for a dry run, got %v", client.Actions)
+	}
+}
+
 func TestIncrementUsagePods(t *testing.T) {
 	namespace := "default"
 	client := testclient.NewSimpleFake(&api.PodList{
@@ -21,6 +21,7 @@ import (
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/qos"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/algorithm"
 )
@@ -103,12 +104,15 @@ type resourceRequest struct {
 	memory   int64
 }
 
+// getResourceRequest returns the resources requested by the containers of pod.
+// Pods are fit by their requests rather than their limits, so that best effort
+// pods, which request nothing, can overcommit the node.
 func getResourceRequest(pod *api.Pod) resourceRequest {
 	result := resourceRequest{}
 	for ix := range pod.Spec.Containers {
-		limits := pod.Spec.Containers[ix].Resources.Limits
-		result.memory += limits.Memory().Value()
-		result.milliCPU += limits.Cpu().MilliValue()
+		container := &pod.Spec.Containers[ix]
+		result.memory += qos.GetContainerRequest(container, api.ResourceMemory).Value()
+		result.milliCPU += qos.GetContainerRequest(container, api.ResourceCPU).MilliValue()
 	}
 	return result
 }
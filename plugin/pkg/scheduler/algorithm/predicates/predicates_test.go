@@ -54,15 +54,19 @@ func makeResources(milliCPU int64, memory int64, pods int64) api.NodeResources {
 	}
 }
 
+func makeResourceList(req resourceRequest) api.ResourceList {
+	return api.ResourceList{
+		api.ResourceCPU:    *resource.NewMilliQuantity(req.milliCPU, resource.DecimalSI),
+		api.ResourceMemory: *resource.NewQuantity(req.memory, resource.BinarySI),
+	}
+}
+
 func newResourcePod(usage ...resourceRequest) *api.Pod {
 	containers := []api.Container{}
 	for _, req := range usage {
 		containers = append(containers, api.Container{
 			Resources: api.ResourceRequirements{
-				Limits: api.ResourceList{
-					api.ResourceCPU:    *resource.NewMilliQuantity(req.milliCPU, resource.DecimalSI),
-					api.ResourceMemory: *resource.NewQuantity(req.memory, resource.BinarySI),
-				},
+				Requests: makeResourceList(req),
 			},
 		})
 	}
@@ -73,6 +77,21 @@ func newResourcePod(usage ...resourceRequest) *api.Pod {
 	}
 }
 
+func newResourceLimitsPod(requests, limits resourceRequest) *api.Pod {
+	return &api.Pod{
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Resources: api.ResourceRequirements{
+						Requests: makeResourceList(requests),
+						Limits:   makeResourceList(limits),
+					},
+				},
+			},
+		},
+	}
+}
+
 func TestPodFitsResources(t *testing.T) {
 
 	enoughPodsTests := []struct {
@@ -121,6 +140,28 @@ func TestPodFitsResources(t *testing.T) {
 			fits: true,
 			test: "equal edge case",
 		},
+		{
+			pod: newResourceLimitsPod(resourceRequest{milliCPU: 1, memory: 1}, resourceRequest{milliCPU: 10, memory: 20}),
+			existingPods: []*api.Pod{
+				newResourceLimitsPod(resourceRequest{milliCPU: 5, memory: 5}, resourceRequest{milliCPU: 10, memory: 20}),
+			},
+			fits: true,
+			test: "limits above the capacity fit when requests fit",
+		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{Resources: api.ResourceRequirements{Limits: makeResourceList(resourceRequest{milliCPU: 1, memory: 2})}},
+					},
+				},
+			},
+			existingPods: []*api.Pod{
+				newResourcePod(resourceRequest{milliCPU: 5, memory: 19}),
+			},
+			fits: false,
+			test: "limits without requests count as requests",
+		},
 	}
 
 	for _, test := range enoughPodsTests {
@@ -25,6 +25,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/storage"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools/etcdtest"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
@@ -54,10 +55,10 @@ func (c stringCodec) DecodeInto(data []byte, obj runtime.Object) error {
 
 func TestSetObj(t *testing.T) {
 	client := framework.NewEtcdClient()
-	helper := tools.EtcdHelper{Client: client, Codec: stringCodec{}}
+	helper := &tools.EtcdHelper{Client: client, Codec: stringCodec{}}
 	framework.WithEtcdKey(func(key string) {
 		fakeObject := fakeAPIObject("object")
-		if err := helper.SetObj(key, &fakeObject, nil, 0); err != nil {
+		if err := helper.Set(key, &fakeObject, nil, 0); err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
 		resp, err := client.Get(key, false, false)
@@ -72,14 +73,14 @@ func TestSetObj(t *testing.T) {
 
 func TestExtractObj(t *testing.T) {
 	client := framework.NewEtcdClient()
-	helper := tools.EtcdHelper{Client: client, Codec: stringCodec{}}
+	helper := &tools.EtcdHelper{Client: client, Codec: stringCodec{}}
 	framework.WithEtcdKey(func(key string) {
 		_, err := client.Set(key, "object", 0)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
 		s := fakeAPIObject("")
-		if err := helper.ExtractObj(key, &s, false); err != nil {
+		if err := helper.Get(key, &s, false); err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
 		if s != "object" {
@@ -100,7 +101,7 @@ func TestWatch(t *testing.T) {
 		expectedVersion := resp.Node.ModifiedIndex
 
 		// watch should load the object at the current index
-		w, err := helper.Watch(key, 0, tools.Everything)
+		w, err := helper.Watch(key, 0, storage.Everything)
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
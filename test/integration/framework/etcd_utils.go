@@ -41,7 +41,7 @@ func NewEtcdClient() *etcd.Client {
 	return etcd.NewClient([]string{})
 }
 
-func NewHelper() (tools.EtcdHelper, error) {
+func NewHelper() (*tools.EtcdHelper, error) {
 	return master.NewEtcdHelper(NewEtcdClient(), testapi.Version(), etcdtest.PathPrefix())
 }
 
@@ -125,7 +125,7 @@ func startMasterOrDie(masterConfig *master.Config) (*master.Master, *httptest.Se
 		m.Handler.ServeHTTP(w, req)
 	}))
 
-	var helper tools.EtcdHelper
+	var helper *tools.EtcdHelper
 	var err error
 	if masterConfig == nil {
 		helper, err = master.NewEtcdHelper(NewEtcdClient(), "", etcdtest.PathPrefix())
@@ -133,7 +133,7 @@ func startMasterOrDie(masterConfig *master.Config) (*master.Master, *httptest.Se
 			glog.Fatalf("Failed to create etcd helper for master %v", err)
 		}
 		masterConfig = &master.Config{
-			EtcdHelper:        helper,
+			DatabaseStorage:   helper,
 			KubeletClient:     client.FakeKubeletClient{},
 			EnableLogsSupport: false,
 			EnableProfiling:   true,
@@ -143,10 +143,10 @@ func startMasterOrDie(masterConfig *master.Config) (*master.Master, *httptest.Se
 			AdmissionControl:  admit.NewAlwaysAdmit(),
 		}
 	} else {
-		helper = masterConfig.EtcdHelper
+		helper = masterConfig.DatabaseStorage.(*tools.EtcdHelper)
 	}
 	m = master.New(masterConfig)
-	return m, s, &helper
+	return m, s, helper
 }
 
 func (m *MasterComponents) stopRCManager() {
@@ -264,7 +264,7 @@ func RunAMaster(t *testing.T) (*master.Master, *httptest.Server) {
 	}
 
 	m := master.New(&master.Config{
-		EtcdHelper:        helper,
+		DatabaseStorage:   helper,
 		KubeletClient:     client.FakeKubeletClient{},
 		EnableLogsSupport: false,
 		EnableProfiling:   true,
@@ -73,7 +73,7 @@ func runAMaster(t *testing.T) (*master.Master, *httptest.Server) {
 	}
 
 	m := master.New(&master.Config{
-		EtcdHelper:            helper,
+		DatabaseStorage:       helper,
 		KubeletClient:         client.FakeKubeletClient{},
 		EnableCoreControllers: true,
 		EnableLogsSupport:     false,